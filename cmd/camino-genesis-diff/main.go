@@ -0,0 +1,61 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// camino-genesis-diff reports differences between platform chain states
+// produced by two genesis config files.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/genesis/diff"
+)
+
+func main() {
+	var jsonOutput bool
+	flag.BoolVar(&jsonOutput, "json", false, "Print report as json")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <before genesis json> <after genesis json>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	before, err := genesis.GetConfigFile(flag.Arg(0))
+	if err != nil {
+		log.Fatalf("failed to load genesis config: %s\n", err)
+	}
+	after, err := genesis.GetConfigFile(flag.Arg(1))
+	if err != nil {
+		log.Fatalf("failed to load genesis config: %s\n", err)
+	}
+
+	report, err := diff.Diff(before, after)
+	if err != nil {
+		log.Fatalf("failed to diff genesis configs: %s\n", err)
+	}
+
+	if jsonOutput {
+		reportBytes, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			log.Fatalf("failed to marshal report: %s\n", err)
+		}
+		fmt.Println(string(reportBytes))
+		return
+	}
+
+	if report.IsEmpty() {
+		fmt.Println("no differences")
+		return
+	}
+	fmt.Print(report.String())
+}
@@ -0,0 +1,324 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/nodeid"
+	"github.com/ava-labs/avalanchego/utils/perms"
+
+	decredSecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+const (
+	genesisFileName    = "genesis.json"
+	keysFileName       = "keys.json"
+	stakingDirName     = "staking"
+	depositOfferMemo   = "camino-genesis deposit offer"
+	keysAddressChainID = "P"
+)
+
+var (
+	errStandardNetwork        = errors.New("can't generate genesis for standard network")
+	errNoNodes                = errors.New("at least one node is required")
+	errNoFundedKeys           = errors.New("at least one funded key is required")
+	errZeroValidatorDuration  = errors.New("validator duration must be non-zero")
+	errZeroDepositDuration    = errors.New("deposit duration must be non-zero when deposit amount is set")
+	errDepositDurationTooBig  = errors.New("deposit duration is too big")
+	errNoCChainGenesis        = errors.New("c-chain genesis is empty")
+	errOutputDirAlreadyExists = errors.New("output directory already exists")
+)
+
+// networkSpec describes test network that will be generated
+type networkSpec struct {
+	NetworkID uint32
+	StartTime uint64
+
+	// Number of validator nodes. Each node gets its own consortium member owner address.
+	Nodes             int
+	ValidatorAmount   uint64
+	ValidatorDuration uint64
+
+	// Number of funded non-validator keys. First funded key is used as initial admin.
+	FundedKeys int
+	// X-chain amount, given to every generated address (node owners and funded keys)
+	XAmount uint64
+	// Free (unlocked) P-chain amount, given to every funded key
+	PAmount uint64
+	// Deposited P-chain amount, given to every funded key
+	DepositAmount   uint64
+	DepositDuration uint64
+	// Deposit offer interest rate nominator, with 1_000_000 being 100% per year
+	DepositInterestRateNominator uint64
+
+	CChainGenesis string
+	Message       string
+}
+
+func (s *networkSpec) Verify() error {
+	switch {
+	case constants.IsActiveNetwork(s.NetworkID) || s.NetworkID == constants.LocalID:
+		return fmt.Errorf("%w: %s (%d)", errStandardNetwork, constants.NetworkName(s.NetworkID), s.NetworkID)
+	case s.Nodes < 1:
+		return errNoNodes
+	case s.FundedKeys < 1:
+		return errNoFundedKeys
+	case s.ValidatorDuration == 0:
+		return errZeroValidatorDuration
+	case s.DepositAmount > 0 && s.DepositDuration == 0:
+		return errZeroDepositDuration
+	case s.DepositDuration > math.MaxUint32:
+		return errDepositDurationTooBig
+	case s.CChainGenesis == "":
+		return errNoCChainGenesis
+	}
+	return nil
+}
+
+type nodeCredentials struct {
+	NodeKey  *secp256k1.PrivateKey
+	NodeID   ids.NodeID
+	OwnerKey *secp256k1.PrivateKey
+	// PEM encoded staking certificate and key
+	StakingCert []byte
+	StakingKey  []byte
+}
+
+// network contains generated genesis config and all key material required to run its nodes
+type network struct {
+	Config     *genesis.Config
+	Nodes      []nodeCredentials
+	FundedKeys []*secp256k1.PrivateKey
+}
+
+// buildNetwork generates keys and certificates for all nodes described by [spec]
+// and returns network with genesis config, that was validated with the same checks
+// that node applies to custom genesis configs.
+func buildNetwork(spec *networkSpec) (*network, error) {
+	if err := spec.Verify(); err != nil {
+		return nil, err
+	}
+
+	keyFactory := secp256k1.Factory{}
+	n := &network{
+		Nodes:      make([]nodeCredentials, spec.Nodes),
+		FundedKeys: make([]*secp256k1.PrivateKey, spec.FundedKeys),
+	}
+
+	for i := range n.Nodes {
+		nodeKey, nodeID := nodeid.GenerateCaminoNodeKeyAndID()
+		certBytes, keyBytes, err := staking.NewCertAndKeyBytesWithSecpKey(
+			decredSecp256k1.PrivKeyFromBytes(nodeKey.Bytes()),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't create staking certificate: %w", err)
+		}
+		ownerKey, err := keyFactory.NewPrivateKey()
+		if err != nil {
+			return nil, err
+		}
+		n.Nodes[i] = nodeCredentials{
+			NodeKey:     nodeKey,
+			NodeID:      nodeID,
+			OwnerKey:    ownerKey,
+			StakingCert: certBytes,
+			StakingKey:  keyBytes,
+		}
+	}
+
+	for i := range n.FundedKeys {
+		key, err := keyFactory.NewPrivateKey()
+		if err != nil {
+			return nil, err
+		}
+		n.FundedKeys[i] = key
+	}
+
+	n.Config = buildConfig(spec, n.Nodes, n.FundedKeys)
+
+	stakingCfg := genesis.GetStakingConfig(spec.NetworkID)
+	if err := genesis.ValidateConfig(n.Config, &stakingCfg); err != nil {
+		return nil, fmt.Errorf("genesis config validation failed: %w", err)
+	}
+	if _, _, err := genesis.FromConfig(n.Config); err != nil {
+		return nil, fmt.Errorf("couldn't build genesis from config: %w", err)
+	}
+
+	return n, nil
+}
+
+func buildConfig(spec *networkSpec, nodes []nodeCredentials, fundedKeys []*secp256k1.PrivateKey) *genesis.Config {
+	config := &genesis.Config{
+		NetworkID:     spec.NetworkID,
+		StartTime:     spec.StartTime,
+		CChainGenesis: spec.CChainGenesis,
+		Message:       spec.Message,
+		Camino: genesis.Camino{
+			VerifyNodeSignature: true,
+			LockModeBondDeposit: true,
+			InitialAdmin:        fundedKeys[0].Address(),
+			Allocations:         make([]genesis.CaminoAllocation, 0, len(nodes)+len(fundedKeys)),
+		},
+	}
+
+	if spec.DepositAmount > 0 {
+		config.Camino.DepositOffers = []genesis.DepositOffer{{
+			InterestRateNominator: spec.DepositInterestRateNominator,
+			Start:                 spec.StartTime,
+			End:                   spec.StartTime + 2*spec.DepositDuration,
+			MinAmount:             spec.DepositAmount,
+			MinDuration:           uint32(spec.DepositDuration),
+			MaxDuration:           uint32(spec.DepositDuration),
+			Memo:                  depositOfferMemo,
+		}}
+	}
+
+	for i, node := range nodes {
+		config.Camino.Allocations = append(config.Camino.Allocations, genesis.CaminoAllocation{
+			AVAXAddr: node.OwnerKey.Address(),
+			XAmount:  spec.XAmount,
+			AddressStates: genesis.AddressStates{
+				ConsortiumMember: true,
+				KYCVerified:      true,
+			},
+			PlatformAllocations: []genesis.PlatformAllocation{{
+				Amount:            spec.ValidatorAmount,
+				NodeID:            node.NodeID,
+				ValidatorDuration: spec.ValidatorDuration,
+				Memo:              "node" + strconv.Itoa(i+1),
+			}},
+		})
+	}
+
+	for _, key := range fundedKeys {
+		allocation := genesis.CaminoAllocation{
+			AVAXAddr: key.Address(),
+			XAmount:  spec.XAmount,
+		}
+		if spec.PAmount > 0 {
+			allocation.PlatformAllocations = append(allocation.PlatformAllocations, genesis.PlatformAllocation{
+				Amount: spec.PAmount,
+			})
+		}
+		if spec.DepositAmount > 0 {
+			allocation.PlatformAllocations = append(allocation.PlatformAllocations, genesis.PlatformAllocation{
+				Amount:           spec.DepositAmount,
+				DepositDuration:  spec.DepositDuration,
+				DepositOfferMemo: depositOfferMemo,
+			})
+		}
+		config.Camino.Allocations = append(config.Camino.Allocations, allocation)
+	}
+
+	return config
+}
+
+type nodeKeysJSON struct {
+	NodeID       string `json:"nodeID"`
+	NodeKey      string `json:"nodeKey"`
+	OwnerAddress string `json:"ownerAddress"`
+	OwnerKey     string `json:"ownerKey"`
+	StakingCert  string `json:"stakingCert"`
+	StakingKey   string `json:"stakingKey"`
+}
+
+type fundedKeyJSON struct {
+	Address    string `json:"address"`
+	PrivateKey string `json:"privateKey"`
+}
+
+type keysJSON struct {
+	Nodes      []nodeKeysJSON  `json:"nodes"`
+	FundedKeys []fundedKeyJSON `json:"fundedKeys"`
+}
+
+// write writes genesis json, staking certificates and keys of network [n] into [dir].
+// [dir] must not exist.
+func (n *network) write(dir string) error {
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		return fmt.Errorf("%w: %s", errOutputDirAlreadyExists, dir)
+	}
+
+	unparsedConfig, err := n.Config.Unparse()
+	if err != nil {
+		return fmt.Errorf("couldn't unparse genesis config: %w", err)
+	}
+	genesisBytes, err := json.MarshalIndent(unparsedConfig, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	hrp := constants.GetHRP(n.Config.NetworkID)
+	keys := keysJSON{
+		Nodes:      make([]nodeKeysJSON, len(n.Nodes)),
+		FundedKeys: make([]fundedKeyJSON, len(n.FundedKeys)),
+	}
+
+	stakingDir := filepath.Join(dir, stakingDirName)
+	if err := os.MkdirAll(stakingDir, perms.ReadWriteExecute); err != nil {
+		return fmt.Errorf("couldn't create output directory: %w", err)
+	}
+
+	for i, node := range n.Nodes {
+		name := "staker" + strconv.Itoa(i+1)
+		certPath := filepath.Join(stakingDir, name+".crt")
+		keyPath := filepath.Join(stakingDir, name+".key")
+		if err := os.WriteFile(certPath, node.StakingCert, perms.ReadOnly); err != nil {
+			return fmt.Errorf("couldn't write cert file: %w", err)
+		}
+		if err := os.WriteFile(keyPath, node.StakingKey, perms.ReadOnly); err != nil {
+			return fmt.Errorf("couldn't write key file: %w", err)
+		}
+
+		ownerAddr, err := address.Format(keysAddressChainID, hrp, node.OwnerKey.Address().Bytes())
+		if err != nil {
+			return err
+		}
+		keys.Nodes[i] = nodeKeysJSON{
+			NodeID:       node.NodeID.String(),
+			NodeKey:      node.NodeKey.String(),
+			OwnerAddress: ownerAddr,
+			OwnerKey:     node.OwnerKey.String(),
+			StakingCert:  certPath,
+			StakingKey:   keyPath,
+		}
+	}
+
+	for i, key := range n.FundedKeys {
+		addr, err := address.Format(keysAddressChainID, hrp, key.Address().Bytes())
+		if err != nil {
+			return err
+		}
+		keys.FundedKeys[i] = fundedKeyJSON{
+			Address:    addr,
+			PrivateKey: key.String(),
+		}
+	}
+
+	keysBytes, err := json.MarshalIndent(keys, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, genesisFileName), genesisBytes, perms.ReadWrite); err != nil {
+		return fmt.Errorf("couldn't write genesis file: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, keysFileName), keysBytes, perms.ReadOnly); err != nil {
+		return fmt.Errorf("couldn't write keys file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/units"
+)
+
+func testSpec() *networkSpec {
+	return &networkSpec{
+		NetworkID:                    1337,
+		StartTime:                    uint64(time.Now().Unix()),
+		Nodes:                        2,
+		ValidatorAmount:              genesis.LocalParams.MinValidatorStake,
+		ValidatorDuration:            uint64((365 * 24 * time.Hour).Seconds()),
+		FundedKeys:                   2,
+		XAmount:                      units.KiloAvax,
+		PAmount:                      units.KiloAvax,
+		DepositAmount:                units.KiloAvax,
+		DepositDuration:              uint64((30 * 24 * time.Hour).Seconds()),
+		DepositInterestRateNominator: 100_000,
+		CChainGenesis:                genesis.LocalConfig.CChainGenesis,
+	}
+}
+
+func TestNetworkSpecVerify(t *testing.T) {
+	tests := map[string]struct {
+		updateSpec  func(*networkSpec)
+		expectedErr error
+	}{
+		"OK": {
+			updateSpec: func(*networkSpec) {},
+		},
+		"Standard network": {
+			updateSpec: func(s *networkSpec) {
+				s.NetworkID = constants.KopernikusID
+			},
+			expectedErr: errStandardNetwork,
+		},
+		"Local network": {
+			updateSpec: func(s *networkSpec) {
+				s.NetworkID = constants.LocalID
+			},
+			expectedErr: errStandardNetwork,
+		},
+		"No nodes": {
+			updateSpec: func(s *networkSpec) {
+				s.Nodes = 0
+			},
+			expectedErr: errNoNodes,
+		},
+		"No funded keys": {
+			updateSpec: func(s *networkSpec) {
+				s.FundedKeys = 0
+			},
+			expectedErr: errNoFundedKeys,
+		},
+		"Zero validator duration": {
+			updateSpec: func(s *networkSpec) {
+				s.ValidatorDuration = 0
+			},
+			expectedErr: errZeroValidatorDuration,
+		},
+		"Zero deposit duration": {
+			updateSpec: func(s *networkSpec) {
+				s.DepositDuration = 0
+			},
+			expectedErr: errZeroDepositDuration,
+		},
+		"Too big deposit duration": {
+			updateSpec: func(s *networkSpec) {
+				s.DepositDuration = 1 << 32
+			},
+			expectedErr: errDepositDurationTooBig,
+		},
+		"No c-chain genesis": {
+			updateSpec: func(s *networkSpec) {
+				s.CChainGenesis = ""
+			},
+			expectedErr: errNoCChainGenesis,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			spec := testSpec()
+			tt.updateSpec(spec)
+			require.ErrorIs(t, spec.Verify(), tt.expectedErr)
+		})
+	}
+}
+
+func TestBuildNetwork(t *testing.T) {
+	require := require.New(t)
+
+	_, err := buildNetwork(&networkSpec{})
+	require.ErrorIs(err, errNoNodes)
+
+	invalidSpec := testSpec()
+	invalidSpec.StartTime = uint64(time.Now().Add(time.Hour).Unix())
+	_, err = buildNetwork(invalidSpec)
+	require.ErrorContains(err, "genesis config validation failed")
+
+	spec := testSpec()
+	network, err := buildNetwork(spec)
+	require.NoError(err)
+	require.Len(network.Nodes, spec.Nodes)
+	require.Len(network.FundedKeys, spec.FundedKeys)
+	require.Len(network.Config.Camino.Allocations, spec.Nodes+spec.FundedKeys)
+	require.Len(network.Config.Camino.DepositOffers, 1)
+	require.Equal(network.FundedKeys[0].Address(), network.Config.Camino.InitialAdmin)
+
+	for i, node := range network.Nodes {
+		cert, err := staking.LoadTLSCertFromBytes(node.StakingKey, node.StakingCert)
+		require.NoError(err)
+		nodeIDBytes, err := secp256k1.RecoverSecp256PublicKey(cert.Leaf)
+		require.NoError(err)
+		require.Equal(node.NodeID, ids.NodeID(node.NodeKey.Address()))
+		require.Equal(node.NodeID[:], nodeIDBytes)
+
+		allocation := network.Config.Camino.Allocations[i]
+		require.Equal(node.OwnerKey.Address(), allocation.AVAXAddr)
+		require.True(allocation.AddressStates.ConsortiumMember)
+		require.Equal(node.NodeID, allocation.PlatformAllocations[0].NodeID)
+	}
+
+	dir := filepath.Join(t.TempDir(), "network")
+	require.NoError(network.write(dir))
+	require.ErrorIs(network.write(dir), errOutputDirAlreadyExists)
+
+	genesisBytes, err := os.ReadFile(filepath.Join(dir, genesisFileName))
+	require.NoError(err)
+	unparsedConfig := genesis.UnparsedConfig{}
+	require.NoError(json.Unmarshal(genesisBytes, &unparsedConfig))
+	config, err := unparsedConfig.Parse()
+	require.NoError(err)
+	stakingCfg := genesis.GetStakingConfig(spec.NetworkID)
+	require.NoError(genesis.ValidateConfig(&config, &stakingCfg))
+
+	keysBytes, err := os.ReadFile(filepath.Join(dir, keysFileName))
+	require.NoError(err)
+	keys := keysJSON{}
+	require.NoError(json.Unmarshal(keysBytes, &keys))
+	require.Len(keys.Nodes, spec.Nodes)
+	require.Len(keys.FundedKeys, spec.FundedKeys)
+	for i, node := range keys.Nodes {
+		require.Equal(network.Nodes[i].NodeID.String(), node.NodeID)
+		_, err := staking.LoadTLSCertFromFiles(node.StakingKey, node.StakingCert)
+		require.NoError(err)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// camino-genesis generates genesis config for private camino test network
+// together with staking certificates and keys of its nodes and funded addresses.
+package main
+
+import (
+	"flag"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/utils/units"
+)
+
+func main() {
+	spec := networkSpec{}
+	var (
+		outputDir         string
+		cChainGenesisPath string
+		startTime         int64
+		networkID         uint64
+	)
+
+	flag.StringVar(&outputDir, "output-dir", "camino-network", "Directory to write genesis, staking certificates and keys into. Must not exist")
+	flag.StringVar(&cChainGenesisPath, "c-chain-genesis", "", "Path to c-chain genesis json. If empty, c-chain genesis of local network is used")
+	flag.Int64Var(&startTime, "start-time", time.Now().Unix(), "Genesis start time (unix seconds). Can't be in the future")
+	flag.Uint64Var(&networkID, "network-id", 1337, "Network ID. Can't be ID of standard network")
+	flag.IntVar(&spec.Nodes, "nodes", 5, "Number of validator nodes")
+	flag.Uint64Var(&spec.ValidatorAmount, "validator-amount", 0, "Validator bond amount in nCAM (default min validator stake of network)")
+	flag.Uint64Var(&spec.ValidatorDuration, "validator-duration", uint64((365 * 24 * time.Hour).Seconds()), "Genesis validators duration in seconds")
+	flag.IntVar(&spec.FundedKeys, "funded-keys", 1, "Number of funded non-validator keys. First one is used as initial admin")
+	flag.Uint64Var(&spec.XAmount, "x-amount", 1*units.MegaAvax, "X-chain amount in nCAM given to every generated address")
+	flag.Uint64Var(&spec.PAmount, "p-amount", 1*units.MegaAvax, "Unlocked P-chain amount in nCAM given to every funded key")
+	flag.Uint64Var(&spec.DepositAmount, "deposit-amount", 100*units.KiloAvax, "Deposited P-chain amount in nCAM given to every funded key. Zero disables deposits")
+	flag.Uint64Var(&spec.DepositDuration, "deposit-duration", uint64((365 * 24 * time.Hour).Seconds()), "Duration of genesis deposits in seconds")
+	flag.Uint64Var(&spec.DepositInterestRateNominator, "deposit-interest-rate", 100_000, "Deposit offer yearly interest rate, 1000000 is 100%")
+	flag.StringVar(&spec.Message, "message", "", "Genesis message")
+	flag.Parse()
+
+	if networkID > math.MaxUint32 {
+		log.Fatalf("network ID is too big: %d\n", networkID)
+	}
+	spec.NetworkID = uint32(networkID)
+	if spec.ValidatorAmount == 0 {
+		spec.ValidatorAmount = genesis.GetStakingConfig(spec.NetworkID).MinValidatorStake
+	}
+	if startTime < 0 {
+		log.Fatalf("start time can't be negative: %d\n", startTime)
+	}
+	spec.StartTime = uint64(startTime)
+
+	spec.CChainGenesis = genesis.LocalConfig.CChainGenesis
+	if cChainGenesisPath != "" {
+		cChainGenesisBytes, err := os.ReadFile(filepath.Clean(cChainGenesisPath))
+		if err != nil {
+			log.Fatalf("failed to read c-chain genesis: %s\n", err)
+		}
+		spec.CChainGenesis = string(cChainGenesisBytes)
+	}
+
+	network, err := buildNetwork(&spec)
+	if err != nil {
+		log.Fatalf("failed to build network: %s\n", err)
+	}
+
+	if err := network.write(outputDir); err != nil {
+		log.Fatalf("failed to write network: %s\n", err)
+	}
+
+	for i, node := range network.Nodes {
+		log.Printf("node %d: %s\n", i+1, node.NodeID)
+	}
+	log.Printf("wrote genesis for network %d into %s\n", spec.NetworkID, outputDir)
+}
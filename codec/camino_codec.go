@@ -10,6 +10,8 @@ type UpgradeVersionID uint64
 const (
 	UpgradeVersion0 UpgradeVersionID = UpgradeVersionID(UpgradePrefix)
 	UpgradeVersion1 UpgradeVersionID = UpgradeVersionID(UpgradePrefix | uint64(1))
+	UpgradeVersion2 UpgradeVersionID = UpgradeVersionID(UpgradePrefix | uint64(2))
+	UpgradeVersion3 UpgradeVersionID = UpgradeVersionID(UpgradePrefix | uint64(3))
 )
 
 func (id UpgradeVersionID) Version() uint16 {
@@ -24,6 +24,9 @@ type Camino struct {
 	DepositOffers            []DepositOffer     `json:"depositOffers"`
 	Allocations              []CaminoAllocation `json:"allocations"`
 	InitialMultisigAddresses []MultisigAlias    `json:"initialMultisigAddresses"`
+	TreasuryAdmin            ids.ShortID        `json:"treasuryAdmin"`
+	TreasurySpendLimit       uint64             `json:"treasurySpendLimit"`
+	TreasurySpendPeriod      uint64             `json:"treasurySpendPeriod"`
 }
 
 func (c Camino) Unparse(networkID uint32, starttime uint64) (UnparsedCamino, error) {
@@ -33,6 +36,8 @@ func (c Camino) Unparse(networkID uint32, starttime uint64) (UnparsedCamino, err
 		DepositOffers:            make([]UnparsedDepositOffer, len(c.DepositOffers)),
 		Allocations:              make([]UnparsedCaminoAllocation, len(c.Allocations)),
 		InitialMultisigAddresses: make([]UnparsedMultisigAlias, len(c.InitialMultisigAddresses)),
+		TreasurySpendLimit:       c.TreasurySpendLimit,
+		TreasurySpendPeriod:      c.TreasurySpendPeriod,
 	}
 
 	avaxAddr, err := address.Format(
@@ -45,6 +50,17 @@ func (c Camino) Unparse(networkID uint32, starttime uint64) (UnparsedCamino, err
 	}
 	uc.InitialAdmin = avaxAddr
 
+	if c.TreasuryAdmin != ids.ShortEmpty {
+		uc.TreasuryAdmin, err = address.Format(
+			configChainIDAlias,
+			constants.GetHRP(networkID),
+			c.TreasuryAdmin.Bytes(),
+		)
+		if err != nil {
+			return uc, err
+		}
+	}
+
 	for i, a := range c.Allocations {
 		ua, err := a.Unparse(networkID)
 		if err != nil {
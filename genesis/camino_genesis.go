@@ -230,6 +230,9 @@ func caminoArgFromConfig(config *Config) api.Camino {
 		VerifyNodeSignature: config.Camino.VerifyNodeSignature,
 		LockModeBondDeposit: config.Camino.LockModeBondDeposit,
 		InitialAdmin:        config.Camino.InitialAdmin,
+		TreasuryAdmin:       config.Camino.TreasuryAdmin,
+		TreasurySpendLimit:  config.Camino.TreasurySpendLimit,
+		TreasurySpendPeriod: config.Camino.TreasurySpendPeriod,
 	}
 }
 
@@ -14,7 +14,10 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 )
 
-var errCannotParseInitialAdmin = errors.New("cannot parse initialAdmin from genesis")
+var (
+	errCannotParseInitialAdmin  = errors.New("cannot parse initialAdmin from genesis")
+	errCannotParseTreasuryAdmin = errors.New("cannot parse treasuryAdmin from genesis")
+)
 
 type UnparsedCamino struct {
 	VerifyNodeSignature      bool                       `json:"verifyNodeSignature"`
@@ -23,6 +26,9 @@ type UnparsedCamino struct {
 	DepositOffers            []UnparsedDepositOffer     `json:"depositOffers"`
 	Allocations              []UnparsedCaminoAllocation `json:"allocations"`
 	InitialMultisigAddresses []UnparsedMultisigAlias    `json:"initialMultisigAddresses"`
+	TreasuryAdmin            string                     `json:"treasuryAdmin"`
+	TreasurySpendLimit       uint64                     `json:"treasurySpendLimit"`
+	TreasurySpendPeriod      uint64                     `json:"treasurySpendPeriod"`
 }
 
 func (uc UnparsedCamino) Parse(startTime uint64) (Camino, error) {
@@ -32,6 +38,8 @@ func (uc UnparsedCamino) Parse(startTime uint64) (Camino, error) {
 		DepositOffers:            make([]DepositOffer, len(uc.DepositOffers)),
 		Allocations:              make([]CaminoAllocation, len(uc.Allocations)),
 		InitialMultisigAddresses: make([]MultisigAlias, len(uc.InitialMultisigAddresses)),
+		TreasurySpendLimit:       uc.TreasurySpendLimit,
+		TreasurySpendPeriod:      uc.TreasurySpendPeriod,
 	}
 
 	_, _, avaxAddrBytes, err := address.Parse(uc.InitialAdmin)
@@ -44,6 +52,18 @@ func (uc UnparsedCamino) Parse(startTime uint64) (Camino, error) {
 	}
 	c.InitialAdmin = avaxAddr
 
+	// treasury admin is optional, treasury can't be spent without it
+	if uc.TreasuryAdmin != "" {
+		_, _, treasuryAdminBytes, err := address.Parse(uc.TreasuryAdmin)
+		if err != nil {
+			return c, fmt.Errorf("%w: %v", errCannotParseTreasuryAdmin, err)
+		}
+		c.TreasuryAdmin, err = ids.ToShortID(treasuryAdminBytes)
+		if err != nil {
+			return c, fmt.Errorf("%w: %v", errCannotParseTreasuryAdmin, err)
+		}
+	}
+
 	for i, udo := range uc.DepositOffers {
 		offer, err := udo.Parse(startTime)
 		if err != nil {
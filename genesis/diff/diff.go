@@ -0,0 +1,429 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package diff compares platform chain states that are produced by two genesis configs.
+package diff
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+const addressChainID = "P"
+
+var (
+	errWrongOutType   = errors.New("unexpected utxo output type")
+	errWrongOwnerType = errors.New("unexpected owner type")
+
+	addressStateBitNames = map[txs.AddressStateBit]string{
+		txs.AddressStateBitRoleAdmin:       "roleAdmin",
+		txs.AddressStateBitRoleKYC:         "roleKYC",
+		txs.AddressStateBitRoleOffersAdmin: "roleOffersAdmin",
+		txs.AddressStateBitKYCVerified:     "kycVerified",
+		txs.AddressStateBitKYCExpired:      "kycExpired",
+		txs.AddressStateBitConsortium:      "consortiumMember",
+		txs.AddressStateBitNodeDeferred:    "nodeDeferred",
+		txs.AddressStateBitOffersCreator:   "offersCreator",
+	}
+)
+
+// Change describes one changed entry. Before is empty for added entries,
+// After is empty for removed entries.
+type Change struct {
+	Key    string `json:"key"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// Report contains differences between platform chain genesis states, sorted by entry key.
+//
+// UTXOs are aggregated by owner and lock state, deposits are grouped by reward owner,
+// deposit offers are identified by memo. That way report doesn't depend on genesis tx IDs,
+// which change with any change of genesis block content.
+type Report struct {
+	Chain           []Change `json:"chain"`
+	UTXOs           []Change `json:"utxos"`
+	DepositOffers   []Change `json:"depositOffers"`
+	Deposits        []Change `json:"deposits"`
+	AddressStates   []Change `json:"addressStates"`
+	MultisigAliases []Change `json:"multisigAliases"`
+	Validators      []Change `json:"validators"`
+}
+
+// Diff validates genesis configs [before] and [after] with the same checks that node
+// applies to genesis configs, syncs platform chain genesis built from each of them
+// into memdb and returns differences between resulting states.
+func Diff(before, after *genesis.Config) (*Report, error) {
+	beforeEntries, err := configEntries(before)
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'before' genesis: %w", err)
+	}
+	afterEntries, err := configEntries(after)
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'after' genesis: %w", err)
+	}
+	return &Report{
+		Chain:           diffEntries(beforeEntries.chain, afterEntries.chain),
+		UTXOs:           diffEntries(beforeEntries.utxos, afterEntries.utxos),
+		DepositOffers:   diffEntries(beforeEntries.depositOffers, afterEntries.depositOffers),
+		Deposits:        diffEntries(beforeEntries.deposits, afterEntries.deposits),
+		AddressStates:   diffEntries(beforeEntries.addressStates, afterEntries.addressStates),
+		MultisigAliases: diffEntries(beforeEntries.multisigAliases, afterEntries.multisigAliases),
+		Validators:      diffEntries(beforeEntries.validators, afterEntries.validators),
+	}, nil
+}
+
+// IsEmpty returns true if there are no differences
+func (r *Report) IsEmpty() bool {
+	for _, section := range r.sections() {
+		if len(section.changes) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns human readable report. Added entries are marked with '+',
+// removed entries with '-' and modified entries with '~'.
+func (r *Report) String() string {
+	sb := strings.Builder{}
+	for _, section := range r.sections() {
+		if len(section.changes) == 0 {
+			continue
+		}
+		sb.WriteString(section.name)
+		sb.WriteString(":\n")
+		for _, change := range section.changes {
+			switch {
+			case change.Before == "":
+				fmt.Fprintf(&sb, "  + %s: %s\n", change.Key, change.After)
+			case change.After == "":
+				fmt.Fprintf(&sb, "  - %s: %s\n", change.Key, change.Before)
+			default:
+				fmt.Fprintf(&sb, "  ~ %s:\n      %s\n   -> %s\n", change.Key, change.Before, change.After)
+			}
+		}
+	}
+	return sb.String()
+}
+
+type reportSection struct {
+	name    string
+	changes []Change
+}
+
+func (r *Report) sections() []reportSection {
+	return []reportSection{
+		{"chain", r.Chain},
+		{"utxos", r.UTXOs},
+		{"deposit offers", r.DepositOffers},
+		{"deposits", r.Deposits},
+		{"address states", r.AddressStates},
+		{"multisig aliases", r.MultisigAliases},
+		{"validators", r.Validators},
+	}
+}
+
+func diffEntries(before, after map[string]string) []Change {
+	keys := maps.Keys(before)
+	for key := range after {
+		if _, ok := before[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	changes := []Change{}
+	for _, key := range keys {
+		beforeValue, afterValue := before[key], after[key]
+		if beforeValue != afterValue {
+			changes = append(changes, Change{
+				Key:    key,
+				Before: beforeValue,
+				After:  afterValue,
+			})
+		}
+	}
+	return changes
+}
+
+// stateEntries contains json-encoded state entries by their key
+type stateEntries struct {
+	chain           map[string]string
+	utxos           map[string]string
+	depositOffers   map[string]string
+	deposits        map[string]string
+	addressStates   map[string]string
+	multisigAliases map[string]string
+	validators      map[string]string
+}
+
+type utxoBalances struct {
+	Unlocked        uint64 `json:"unlocked,omitempty"`
+	Deposited       uint64 `json:"deposited,omitempty"`
+	Bonded          uint64 `json:"bonded,omitempty"`
+	DepositedBonded uint64 `json:"depositedBonded,omitempty"`
+}
+
+type depositEntry struct {
+	OfferMemo string `json:"offerMemo"`
+	Start     uint64 `json:"start"`
+	Duration  uint32 `json:"duration"`
+	Amount    uint64 `json:"amount"`
+}
+
+type multisigAliasEntry struct {
+	Threshold uint32   `json:"threshold"`
+	Owners    []string `json:"owners"`
+	Memo      string   `json:"memo"`
+	Nonce     uint64   `json:"nonce"`
+}
+
+type validatorEntry struct {
+	Owner     string `json:"owner,omitempty"`
+	Weight    uint64 `json:"weight"`
+	StartTime int64  `json:"startTime"`
+	EndTime   int64  `json:"endTime"`
+}
+
+func configEntries(config *genesis.Config) (*stateEntries, error) {
+	stakingCfg := genesis.GetStakingConfig(config.NetworkID)
+	if err := genesis.ValidateConfig(config, &stakingCfg); err != nil {
+		return nil, err
+	}
+	genesisBytes, _, err := genesis.FromConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := state.NewGenesisSnapshot(config.NetworkID, genesisBytes, stakingCfg.RewardConfig)
+	if err != nil {
+		return nil, err
+	}
+	if err := snapshot.Verify(); err != nil {
+		return nil, err
+	}
+	return snapshotEntries(constants.GetHRP(config.NetworkID), snapshot)
+}
+
+func snapshotEntries(hrp string, snapshot *state.GenesisSnapshot) (*stateEntries, error) {
+	entries := &stateEntries{
+		chain: map[string]string{
+			"timestamp":     strconv.FormatUint(snapshot.Timestamp, 10),
+			"currentSupply": strconv.FormatUint(snapshot.CurrentSupply, 10),
+		},
+		utxos:           make(map[string]string),
+		depositOffers:   make(map[string]string, len(snapshot.DepositOffers)),
+		deposits:        make(map[string]string),
+		addressStates:   make(map[string]string, len(snapshot.AddressStates)),
+		multisigAliases: make(map[string]string, len(snapshot.MultisigAliases)),
+		validators:      make(map[string]string, len(snapshot.Validators)),
+	}
+
+	// utxos
+
+	balances := map[string]*utxoBalances{}
+	for _, utxo := range snapshot.UTXOs {
+		out := utxo.Out
+		lockIDs := locked.IDsEmpty
+		if lockedOut, ok := out.(*locked.Out); ok {
+			out = lockedOut.TransferableOut
+			lockIDs = lockedOut.IDs
+		}
+		transferOut, ok := out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T", errWrongOutType, out)
+		}
+		owner, err := ownerKey(hrp, &transferOut.OutputOwners)
+		if err != nil {
+			return nil, err
+		}
+		ownerBalances, ok := balances[owner]
+		if !ok {
+			ownerBalances = &utxoBalances{}
+			balances[owner] = ownerBalances
+		}
+		balance := &ownerBalances.Unlocked
+		switch {
+		case lockIDs.DepositTxID != ids.Empty && lockIDs.BondTxID != ids.Empty:
+			balance = &ownerBalances.DepositedBonded
+		case lockIDs.DepositTxID != ids.Empty:
+			balance = &ownerBalances.Deposited
+		case lockIDs.BondTxID != ids.Empty:
+			balance = &ownerBalances.Bonded
+		}
+		*balance, err = math.Add64(*balance, transferOut.Amt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for owner, ownerBalances := range balances {
+		if err := setEntry(entries.utxos, owner, ownerBalances); err != nil {
+			return nil, err
+		}
+	}
+
+	// deposit offers
+
+	for _, offer := range snapshot.DepositOffers {
+		if err := setEntry(entries.depositOffers, string(offer.Memo), offer); err != nil {
+			return nil, err
+		}
+	}
+
+	// deposits
+
+	deposits := map[string][]depositEntry{}
+	for _, d := range snapshot.Deposits {
+		rewardOwner, ok := d.RewardOwner.(*secp256k1fx.OutputOwners)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T", errWrongOwnerType, d.RewardOwner)
+		}
+		owner, err := ownerKey(hrp, rewardOwner)
+		if err != nil {
+			return nil, err
+		}
+		// snapshot is verified, so offer must exist
+		offer := snapshot.DepositOffers[d.DepositOfferID]
+		deposits[owner] = append(deposits[owner], depositEntry{
+			OfferMemo: string(offer.Memo),
+			Start:     d.Start,
+			Duration:  d.Duration,
+			Amount:    d.Amount,
+		})
+	}
+	for owner, ownerDeposits := range deposits {
+		sort.Slice(ownerDeposits, func(i, j int) bool {
+			a, b := ownerDeposits[i], ownerDeposits[j]
+			switch {
+			case a.OfferMemo != b.OfferMemo:
+				return a.OfferMemo < b.OfferMemo
+			case a.Start != b.Start:
+				return a.Start < b.Start
+			case a.Duration != b.Duration:
+				return a.Duration < b.Duration
+			}
+			return a.Amount < b.Amount
+		})
+		if err := setEntry(entries.deposits, owner, ownerDeposits); err != nil {
+			return nil, err
+		}
+	}
+
+	// address states
+
+	for addr, addrState := range snapshot.AddressStates {
+		key, err := address.Format(addressChainID, hrp, addr.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		entries.addressStates[key] = addressStateString(addrState)
+	}
+
+	// multisig aliases
+
+	for aliasID, alias := range snapshot.MultisigAliases {
+		key, err := address.Format(addressChainID, hrp, aliasID.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		owners, ok := alias.Owners.(*secp256k1fx.OutputOwners)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T", errWrongOwnerType, alias.Owners)
+		}
+		entry := multisigAliasEntry{
+			Threshold: owners.Threshold,
+			Owners:    make([]string, len(owners.Addrs)),
+			Memo:      string(alias.Memo),
+			Nonce:     alias.Nonce,
+		}
+		for i, addr := range owners.Addrs {
+			entry.Owners[i], err = address.Format(addressChainID, hrp, addr.Bytes())
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := setEntry(entries.multisigAliases, key, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	// validators
+
+	for nodeID, staker := range snapshot.Validators {
+		entry := validatorEntry{
+			Weight:    staker.Weight,
+			StartTime: staker.StartTime.Unix(),
+			EndTime:   staker.EndTime.Unix(),
+		}
+		if owner, ok := snapshot.ValidatorOwners[nodeID]; ok {
+			var err error
+			entry.Owner, err = address.Format(addressChainID, hrp, owner.Bytes())
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := setEntry(entries.validators, nodeID.String(), entry); err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, nil
+}
+
+func setEntry(entries map[string]string, key string, value any) error {
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	entries[key] = string(valueBytes)
+	return nil
+}
+
+// ownerKey returns owner addresses and, if its not a simple single-address owner,
+// its threshold and locktime
+func ownerKey(hrp string, owner *secp256k1fx.OutputOwners) (string, error) {
+	addrs := make([]string, len(owner.Addrs))
+	for i, addr := range owner.Addrs {
+		var err error
+		addrs[i], err = address.Format(addressChainID, hrp, addr.Bytes())
+		if err != nil {
+			return "", err
+		}
+	}
+	key := strings.Join(addrs, ",")
+	if owner.Threshold != 1 || len(owner.Addrs) != 1 || owner.Locktime != 0 {
+		key = fmt.Sprintf("%s (threshold %d, locktime %d)", key, owner.Threshold, owner.Locktime)
+	}
+	return key, nil
+}
+
+func addressStateString(addrState txs.AddressState) string {
+	names := []string{}
+	for bit := txs.AddressStateBit(0); bit <= txs.AddressStateBitMax; bit++ {
+		if addrState&(txs.AddressState(1)<<bit) == 0 {
+			continue
+		}
+		name, ok := addressStateBitNames[bit]
+		if !ok {
+			name = "bit" + strconv.Itoa(int(bit))
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/units"
+)
+
+// copyConfig returns copy of [config] with copied camino allocations and multisig aliases
+func copyConfig(config *genesis.Config) *genesis.Config {
+	configCopy := *config
+	configCopy.Camino.Allocations = make([]genesis.CaminoAllocation, len(config.Camino.Allocations))
+	for i, allocation := range config.Camino.Allocations {
+		allocation.PlatformAllocations = append([]genesis.PlatformAllocation{}, allocation.PlatformAllocations...)
+		configCopy.Camino.Allocations[i] = allocation
+	}
+	configCopy.Camino.InitialMultisigAddresses = append([]genesis.MultisigAlias{}, config.Camino.InitialMultisigAddresses...)
+	configCopy.Camino.DepositOffers = append([]genesis.DepositOffer{}, config.Camino.DepositOffers...)
+	return &configCopy
+}
+
+func TestDiff(t *testing.T) {
+	config := genesis.GetConfig(constants.KopernikusID)
+	hrp := constants.GetHRP(config.NetworkID)
+
+	var depositedAllocationIndex, depositedPlatformAllocationIndex int
+	for i, allocation := range config.Camino.Allocations {
+		for j, platformAllocation := range allocation.PlatformAllocations {
+			if platformAllocation.DepositOfferMemo != "" && platformAllocation.NodeID == ids.EmptyNodeID {
+				depositedAllocationIndex, depositedPlatformAllocationIndex = i, j
+			}
+		}
+	}
+	newAddr := ids.ShortID{1}
+	newAddrStr, err := address.Format(addressChainID, hrp, newAddr.Bytes())
+	require.NoError(t, err)
+
+	depositedAllocation := config.Camino.Allocations[depositedAllocationIndex]
+	depositedAddr, err := address.Format(addressChainID, hrp, depositedAllocation.AVAXAddr.Bytes())
+	require.NoError(t, err)
+	removedAlias := config.Camino.InitialMultisigAddresses[0]
+	removedAliasAddr, err := address.Format(addressChainID, hrp, removedAlias.Alias.Bytes())
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		updateConfig func(*genesis.Config)
+		expectedErr  string
+		checkReport  func(*require.Assertions, *Report)
+	}{
+		"No changes": {
+			updateConfig: func(*genesis.Config) {},
+			checkReport: func(require *require.Assertions, report *Report) {
+				require.True(report.IsEmpty())
+				require.Empty(report.String())
+			},
+		},
+		"Deposit amount changed": {
+			updateConfig: func(config *genesis.Config) {
+				config.Camino.Allocations[depositedAllocationIndex].
+					PlatformAllocations[depositedPlatformAllocationIndex].Amount += units.Avax
+			},
+			checkReport: func(require *require.Assertions, report *Report) {
+				require.False(report.IsEmpty())
+				require.Len(report.Chain, 1)
+				require.Equal("currentSupply", report.Chain[0].Key)
+				require.Len(report.UTXOs, 1)
+				require.Equal(depositedAddr, report.UTXOs[0].Key)
+				require.NotEmpty(report.UTXOs[0].Before)
+				require.NotEmpty(report.UTXOs[0].After)
+				require.Len(report.Deposits, 1)
+				require.Equal(depositedAddr, report.Deposits[0].Key)
+				require.Empty(report.DepositOffers)
+				require.Empty(report.AddressStates)
+				require.Empty(report.MultisigAliases)
+				require.Empty(report.Validators)
+			},
+		},
+		"Multisig alias removed": {
+			updateConfig: func(config *genesis.Config) {
+				config.Camino.InitialMultisigAddresses = config.Camino.InitialMultisigAddresses[1:]
+			},
+			checkReport: func(require *require.Assertions, report *Report) {
+				require.Equal([]Change{{
+					Key:    removedAliasAddr,
+					Before: report.MultisigAliases[0].Before,
+				}}, report.MultisigAliases)
+				require.Contains(report.String(), "  - "+removedAliasAddr)
+				require.Empty(report.Chain)
+				require.Empty(report.UTXOs)
+				require.Empty(report.Deposits)
+			},
+		},
+		"Verified X-chain allocation added": {
+			updateConfig: func(config *genesis.Config) {
+				config.Camino.Allocations = append(config.Camino.Allocations, genesis.CaminoAllocation{
+					AVAXAddr:      newAddr,
+					XAmount:       units.Avax,
+					AddressStates: genesis.AddressStates{KYCVerified: true},
+				})
+			},
+			checkReport: func(require *require.Assertions, report *Report) {
+				require.Equal([]Change{{
+					Key:   newAddrStr,
+					After: "kycVerified",
+				}}, report.AddressStates)
+				require.Len(report.Chain, 1)
+				require.Equal("currentSupply", report.Chain[0].Key)
+				require.Empty(report.UTXOs)
+			},
+		},
+		"Deposit references missing offer": {
+			updateConfig: func(config *genesis.Config) {
+				config.Camino.Allocations[depositedAllocationIndex].
+					PlatformAllocations[depositedPlatformAllocationIndex].DepositOfferMemo = "missing offer"
+			},
+			expectedErr: "allocation deposit offer memo doesn't match any offer",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			after := copyConfig(config)
+			tt.updateConfig(after)
+
+			report, err := Diff(config, after)
+			if tt.expectedErr != "" {
+				require.ErrorContains(err, tt.expectedErr)
+				return
+			}
+			require.NoError(err)
+			tt.checkReport(require, report)
+		})
+	}
+}
@@ -4,8 +4,10 @@
 package multisig
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/utils/hashing"
@@ -13,13 +15,39 @@ import (
 	"github.com/ava-labs/avalanchego/vms/types"
 )
 
-// MaxMemoSize is the maximum number of bytes in the memo field
-const MaxMemoSize = 256
+const (
+	// MaxMemoSize is the maximum number of bytes in the memo field
+	MaxMemoSize = 256
+	// MaxSpendLimitPeriod is the maximum alias spend limit period in seconds (1 year)
+	MaxSpendLimitPeriod uint64 = 365 * 24 * 60 * 60
+)
+
+var (
+	errOwnersChangePolicyNotAllowed = errors.New("msig alias owners change threshold and delay require upgrade version 1")
+	errSpendLimitNotAllowed         = errors.New("msig alias spend limit requires upgrade version 2")
+	errSpendLimitPeriodTooBig       = errors.New("msig alias spend limit period is too big")
+	errInvalidSpendLimit            = errors.New("msig alias spend limit threshold, amount and period must be either all set or all zero")
+	ErrSpendLimitExceeded           = errors.New("msig alias spend limit exceeded")
+)
 
 type Alias struct {
-	ID     ids.ShortID         `serialize:"true" json:"id"`
-	Memo   types.JSONByteSlice `serialize:"true" json:"memo"`
-	Owners verify.State        `serialize:"true" json:"owners"`
+	UpgradeVersionID codec.UpgradeVersionID
+	ID               ids.ShortID         `serialize:"true" json:"id"`
+	Memo             types.JSONByteSlice `serialize:"true" json:"memo"`
+	Owners           verify.State        `serialize:"true" json:"owners"`
+	// Number of owners signatures required to change this alias, if it's higher than owners threshold.
+	// Zero means that owners threshold is used.
+	OwnersChangeThreshold uint32 `serialize:"true" json:"ownersChangeThreshold" upgradeVersion:"1"`
+	// Duration in seconds after which change of this alias takes effect. Pending change can be cancelled
+	// by alias owners during this time. Zero means that change takes effect immediately.
+	OwnersChangeDelay uint64 `serialize:"true" json:"ownersChangeDelay" upgradeVersion:"1"`
+	// Number of owners signatures, lower than owners threshold, which is enough to spend
+	// up to spend limit amount from this alias within spend limit period. Zero means no spend limit.
+	SpendLimitThreshold uint32 `serialize:"true" json:"spendLimitThreshold" upgradeVersion:"2"`
+	// Max amount that can be spent with spend limit threshold within rolling spend limit period
+	SpendLimitAmount uint64 `serialize:"true" json:"spendLimitAmount" upgradeVersion:"2"`
+	// Duration in seconds of rolling window, in which spend limit amount applies
+	SpendLimitPeriod uint64 `serialize:"true" json:"spendLimitPeriod" upgradeVersion:"2"`
 }
 
 type AliasWithNonce struct {
@@ -29,6 +57,15 @@ type AliasWithNonce struct {
 	Nonce uint64 `serialize:"true" json:"nonce"`
 }
 
+// AliasChange is a timelocked change of existing alias
+type AliasChange struct {
+	// Alias definition that will replace the current one
+	Alias `serialize:"true" json:"alias"`
+
+	// Unix time in seconds, when this change takes effect
+	EffectiveTime uint64 `serialize:"true" json:"effectiveTime"`
+}
+
 func (ma *Alias) InitCtx(ctx *snow.Context) {
 	ma.Owners.InitCtx(ctx)
 }
@@ -38,6 +75,23 @@ func (ma *Alias) Verify() error {
 		return fmt.Errorf("msig alias memo is larger (%d bytes) than max of %d bytes", len(ma.Memo), MaxMemoSize)
 	}
 
+	if ma.UpgradeVersionID.Version() == 0 && (ma.OwnersChangeThreshold != 0 || ma.OwnersChangeDelay != 0) {
+		return errOwnersChangePolicyNotAllowed
+	}
+
+	if ma.UpgradeVersionID.Version() < 2 && ma.HasSpendLimit() {
+		return errSpendLimitNotAllowed
+	}
+
+	if (ma.SpendLimitThreshold == 0) != (ma.SpendLimitAmount == 0) ||
+		(ma.SpendLimitThreshold == 0) != (ma.SpendLimitPeriod == 0) {
+		return errInvalidSpendLimit
+	}
+
+	if ma.SpendLimitPeriod > MaxSpendLimitPeriod {
+		return fmt.Errorf("%w: %d seconds, max is %d", errSpendLimitPeriodTooBig, ma.SpendLimitPeriod, MaxSpendLimitPeriod)
+	}
+
 	return ma.Owners.Verify()
 }
 
@@ -45,6 +99,52 @@ func (ma *Alias) VerifyState() error {
 	return ma.Verify()
 }
 
+// HasSpendLimit returns true if any of alias spend limit fields is set
+func (ma *Alias) HasSpendLimit() bool {
+	return ma.SpendLimitThreshold != 0 || ma.SpendLimitAmount != 0 || ma.SpendLimitPeriod != 0
+}
+
+// SpendLimitUsage is a history of spends made from alias with spend limit threshold,
+// which are still within alias spend limit period
+type SpendLimitUsage struct {
+	Spends []LimitedSpend `serialize:"true"`
+}
+
+type LimitedSpend struct {
+	// Unix time in seconds, when this spend was made
+	Time   uint64 `serialize:"true"`
+	Amount uint64 `serialize:"true"`
+}
+
+// Spend returns new usage with spends of [usage] that are still within [alias] spend limit period
+// at time [now] and with spend of [amount] added. Returns error, if total amount of these spends
+// exceeds alias spend limit amount. Nil [usage] is treated as empty.
+func (usage *SpendLimitUsage) Spend(alias *Alias, now, amount uint64) (*SpendLimitUsage, error) {
+	if amount > alias.SpendLimitAmount {
+		return nil, ErrSpendLimitExceeded
+	}
+
+	newUsage := &SpendLimitUsage{}
+	spentAmount := amount
+	if usage != nil {
+		newUsage.Spends = make([]LimitedSpend, 0, len(usage.Spends)+1)
+		for _, spend := range usage.Spends {
+			// spends made after [now] are kept, so that time going backwards won't reset usage
+			if spend.Time <= now && now-spend.Time >= alias.SpendLimitPeriod {
+				continue
+			}
+			if spend.Amount > alias.SpendLimitAmount-spentAmount {
+				return nil, ErrSpendLimitExceeded
+			}
+			spentAmount += spend.Amount
+			newUsage.Spends = append(newUsage.Spends, spend)
+		}
+	}
+
+	newUsage.Spends = append(newUsage.Spends, LimitedSpend{Time: now, Amount: amount})
+	return newUsage, nil
+}
+
 func ComputeAliasID(txID ids.ID) ids.ShortID {
 	return hashing.ComputeHash160Array(txID[:])
 }
@@ -4,8 +4,11 @@
 package multisig
 
 import (
+	"fmt"
+	"math"
 	"testing"
 
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
@@ -27,6 +30,83 @@ func TestVerify(t *testing.T) {
 			message:             "memo size should be lower than max memo size",
 			expectedErrorString: "msig alias memo is larger (257 bytes) than max of 256 bytes",
 		},
+		"OwnersChangePolicyWithoutUpgradeVersion": {
+			alias: Alias{
+				Owners:                &avax.TestVerifiable{},
+				ID:                    hashing.ComputeHash160Array(ids.Empty[:]),
+				OwnersChangeThreshold: 2,
+			},
+			message:             "owners change policy requires upgrade version",
+			expectedErrorString: errOwnersChangePolicyNotAllowed.Error(),
+		},
+		"OwnersChangePolicy": {
+			alias: Alias{
+				UpgradeVersionID:      codec.UpgradeVersion1,
+				Owners:                &avax.TestVerifiable{},
+				ID:                    hashing.ComputeHash160Array(ids.Empty[:]),
+				OwnersChangeThreshold: 2,
+				OwnersChangeDelay:     3600,
+			},
+			message: "owners change policy is allowed with upgrade version",
+		},
+		"SpendLimitWithoutUpgradeVersion": {
+			alias: Alias{
+				Owners:              &avax.TestVerifiable{},
+				ID:                  hashing.ComputeHash160Array(ids.Empty[:]),
+				SpendLimitThreshold: 1,
+				SpendLimitAmount:    100,
+				SpendLimitPeriod:    3600,
+			},
+			message:             "spend limit requires upgrade version",
+			expectedErrorString: errSpendLimitNotAllowed.Error(),
+		},
+		"SpendLimitWithUpgradeVersion1": {
+			alias: Alias{
+				UpgradeVersionID:    codec.UpgradeVersion1,
+				Owners:              &avax.TestVerifiable{},
+				ID:                  hashing.ComputeHash160Array(ids.Empty[:]),
+				SpendLimitThreshold: 1,
+				SpendLimitAmount:    100,
+				SpendLimitPeriod:    3600,
+			},
+			message:             "spend limit requires upgrade version 2",
+			expectedErrorString: errSpendLimitNotAllowed.Error(),
+		},
+		"SpendLimitPeriodTooBig": {
+			alias: Alias{
+				UpgradeVersionID:    codec.UpgradeVersion2,
+				Owners:              &avax.TestVerifiable{},
+				ID:                  hashing.ComputeHash160Array(ids.Empty[:]),
+				SpendLimitThreshold: 1,
+				SpendLimitAmount:    100,
+				SpendLimitPeriod:    MaxSpendLimitPeriod + 1,
+			},
+			message: "spend limit period must not exceed max period",
+			expectedErrorString: fmt.Sprintf("%s: %d seconds, max is %d",
+				errSpendLimitPeriodTooBig, MaxSpendLimitPeriod+1, MaxSpendLimitPeriod),
+		},
+		"PartialSpendLimit": {
+			alias: Alias{
+				UpgradeVersionID:    codec.UpgradeVersion2,
+				Owners:              &avax.TestVerifiable{},
+				ID:                  hashing.ComputeHash160Array(ids.Empty[:]),
+				SpendLimitThreshold: 1,
+				SpendLimitAmount:    100,
+			},
+			message:             "spend limit must have threshold, amount and period",
+			expectedErrorString: errInvalidSpendLimit.Error(),
+		},
+		"SpendLimit": {
+			alias: Alias{
+				UpgradeVersionID:    codec.UpgradeVersion2,
+				Owners:              &avax.TestVerifiable{},
+				ID:                  hashing.ComputeHash160Array(ids.Empty[:]),
+				SpendLimitThreshold: 1,
+				SpendLimitAmount:    100,
+				SpendLimitPeriod:    3600,
+			},
+			message: "spend limit is allowed with upgrade version",
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -40,3 +120,71 @@ func TestVerify(t *testing.T) {
 		})
 	}
 }
+
+func TestSpendLimitUsageSpend(t *testing.T) {
+	alias := &Alias{
+		SpendLimitThreshold: 1,
+		SpendLimitAmount:    100,
+		SpendLimitPeriod:    10,
+	}
+
+	tests := map[string]struct {
+		usage         *SpendLimitUsage
+		now           uint64
+		amount        uint64
+		expectedUsage *SpendLimitUsage
+		expectedErr   error
+	}{
+		"OK: no usage": {
+			now:           20,
+			amount:        100,
+			expectedUsage: &SpendLimitUsage{Spends: []LimitedSpend{{Time: 20, Amount: 100}}},
+		},
+		"OK: within limit": {
+			usage:  &SpendLimitUsage{Spends: []LimitedSpend{{Time: 11, Amount: 40}, {Time: 15, Amount: 20}}},
+			now:    20,
+			amount: 40,
+			expectedUsage: &SpendLimitUsage{Spends: []LimitedSpend{
+				{Time: 11, Amount: 40}, {Time: 15, Amount: 20}, {Time: 20, Amount: 40},
+			}},
+		},
+		"OK: expired spends are removed": {
+			usage:  &SpendLimitUsage{Spends: []LimitedSpend{{Time: 5, Amount: 80}, {Time: 10, Amount: 20}, {Time: 15, Amount: 20}}},
+			now:    20,
+			amount: 80,
+			expectedUsage: &SpendLimitUsage{Spends: []LimitedSpend{
+				{Time: 15, Amount: 20}, {Time: 20, Amount: 80},
+			}},
+		},
+		"Spend time close to max uint64 doesn't overflow": {
+			usage:       &SpendLimitUsage{Spends: []LimitedSpend{{Time: math.MaxUint64 - 5, Amount: 90}}},
+			now:         math.MaxUint64,
+			amount:      20,
+			expectedErr: ErrSpendLimitExceeded,
+		},
+		"Spends after now aren't expired": {
+			usage:       &SpendLimitUsage{Spends: []LimitedSpend{{Time: 50, Amount: 90}}},
+			now:         20,
+			amount:      20,
+			expectedErr: ErrSpendLimitExceeded,
+		},
+		"Limit exceeded": {
+			usage:       &SpendLimitUsage{Spends: []LimitedSpend{{Time: 11, Amount: 40}, {Time: 15, Amount: 20}}},
+			now:         20,
+			amount:      41,
+			expectedErr: ErrSpendLimitExceeded,
+		},
+		"Limit exceeded by single spend": {
+			now:         20,
+			amount:      101,
+			expectedErr: ErrSpendLimitExceeded,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			usage, err := tt.usage.Spend(alias, tt.now, tt.amount)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expectedUsage, usage)
+		})
+	}
+}
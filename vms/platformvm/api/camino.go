@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/formatting"
@@ -46,10 +47,13 @@ type Camino struct {
 	ValidatorConsortiumMembers []ids.ShortID          `json:"validatorConsortiumMembers"`
 	UTXODeposits               []UTXODeposit          `json:"utxoDeposits"`
 	MultisigAliases            []*multisig.Alias      `json:"multisigAliases"`
+	TreasuryAdmin              ids.ShortID            `json:"treasuryAdmin"`
+	TreasurySpendLimit         uint64                 `json:"treasurySpendLimit"`
+	TreasurySpendPeriod        uint64                 `json:"treasurySpendPeriod"`
 }
 
 func (c Camino) ParseToGenesis() genesis.Camino {
-	return genesis.Camino{
+	caminoGenesis := genesis.Camino{
 		VerifyNodeSignature: c.VerifyNodeSignature,
 		LockModeBondDeposit: c.LockModeBondDeposit,
 		InitialAdmin:        c.InitialAdmin,
@@ -57,6 +61,14 @@ func (c Camino) ParseToGenesis() genesis.Camino {
 		DepositOffers:       c.DepositOffers,
 		MultisigAliases:     c.MultisigAliases,
 	}
+	// treasury config is only serialized, if it's set, so older genesis bytes stay the same
+	if c.TreasuryAdmin != ids.ShortEmpty || c.TreasurySpendLimit != 0 || c.TreasurySpendPeriod != 0 {
+		caminoGenesis.UpgradeVersionID = codec.UpgradeVersion1
+		caminoGenesis.TreasuryAdmin = c.TreasuryAdmin
+		caminoGenesis.TreasurySpendLimit = c.TreasurySpendLimit
+		caminoGenesis.TreasurySpendPeriod = c.TreasurySpendPeriod
+	}
+	return caminoGenesis
 }
 
 // BuildGenesis build the genesis state of the Platform Chain (and thereby the Avalanche network.)
@@ -90,6 +90,24 @@ func caminoBuildBlock(
 		)
 	}
 
+	earlyFinishedProposalIDs, expiredProposalIDs, err := txexecutor.GetFinishedProposalIDs(parentState, timestamp)
+	if err != nil {
+		return nil, fmt.Errorf("could not find proposals to finish: %w", err)
+	}
+	if len(earlyFinishedProposalIDs) > 0 || len(expiredProposalIDs) > 0 {
+		finishProposalsTx, err := txBuilder.NewFinishProposalsTx(earlyFinishedProposalIDs, expiredProposalIDs)
+		if err != nil {
+			return nil, fmt.Errorf("could not build tx to finish proposals: %w", err)
+		}
+
+		return blocks.NewBanffStandardBlock(
+			timestamp,
+			parentID,
+			height,
+			[]*txs.Tx{finishProposalsTx},
+		)
+	}
+
 	return nil, nil
 }
 
@@ -416,6 +416,11 @@ func (v *verifier) standardBlock(
 	// Finally we process the transactions
 	funcs := make([]func(), 0, len(b.Transactions))
 	for _, tx := range b.Transactions {
+		if err := executor.VerifyBerlinPhaseOutputs(v.txExecutorBackend, blkState.timestamp, tx.Unsigned); err != nil {
+			v.MarkDropped(tx.ID(), err) // cache tx as dropped
+			return err
+		}
+
 		txExecutor := executor.CaminoStandardTxExecutor{
 			StandardTxExecutor: executor.StandardTxExecutor{
 				Backend: v.txExecutorBackend,
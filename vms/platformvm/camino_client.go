@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/rpc"
 )
 
@@ -16,6 +17,12 @@ type CaminoClient interface {
 
 	// GetMultisigAlias returns the alias definition of the given multisig address
 	GetMultisigAlias(ctx context.Context, multisigAddress string, options ...rpc.Option) (*GetMultisigAliasReply, error)
+
+	// GetBaseFee returns current base fee, which is burned by camino txs
+	GetBaseFee(ctx context.Context, options ...rpc.Option) (uint64, error)
+
+	// GetDeposits returns deposits with given deposit tx IDs
+	GetDeposits(ctx context.Context, depositTxIDs []ids.ID, options ...rpc.Option) (*GetDepositsReply, error)
 }
 
 func (c *client) GetConfiguration(ctx context.Context, options ...rpc.Option) (*GetConfigurationReply, error) {
@@ -31,3 +38,17 @@ func (c *client) GetMultisigAlias(ctx context.Context, multisigAddress string, o
 	}, res, options...)
 	return res, err
 }
+
+func (c *client) GetBaseFee(ctx context.Context, options ...rpc.Option) (uint64, error) {
+	res := &GetBaseFeeReply{}
+	err := c.requester.SendRequest(ctx, "platform.getBaseFee", struct{}{}, res, options...)
+	return uint64(res.BaseFee), err
+}
+
+func (c *client) GetDeposits(ctx context.Context, depositTxIDs []ids.ID, options ...rpc.Option) (*GetDepositsReply, error) {
+	res := &GetDepositsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getDeposits", &GetDepositsArgs{
+		DepositTxIDs: depositTxIDs,
+	}, res, options...)
+	return res, err
+}
@@ -8,20 +8,29 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/formatting"
 	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/keystore"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/builder"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/vms/types"
 	"go.uber.org/zap"
@@ -30,14 +39,25 @@ import (
 	platformapi "github.com/ava-labs/avalanchego/vms/platformvm/api"
 )
 
+// maxDepositSchedulePoints is the maximum number of points returned by GetDepositSchedule
+const maxDepositSchedulePoints = 1024
+
 var (
-	errInvalidChangeAddr      = "couldn't parse changeAddr: %w"
-	errCreateTx               = "couldn't create tx: %w"
-	errCreateTransferables    = errors.New("can't create transferables")
-	errSerializeTransferables = errors.New("can't serialize transferables")
-	errEncodeTransferables    = errors.New("can't encode transferables as string")
-	ErrWrongOwnerType         = errors.New("wrong owner type")
-	errSerializeOwners        = errors.New("can't serialize owners")
+	errInvalidChangeAddr         = "couldn't parse changeAddr: %w"
+	errCreateTx                  = "couldn't create tx: %w"
+	errCreateTransferables       = errors.New("can't create transferables")
+	errSerializeTransferables    = errors.New("can't serialize transferables")
+	errEncodeTransferables       = errors.New("can't encode transferables as string")
+	ErrWrongOwnerType            = errors.New("wrong owner type")
+	errSerializeOwners           = errors.New("can't serialize owners")
+	errNoAliasOwners             = errors.New("multisig alias owners are empty")
+	errZeroScheduleInterval      = errors.New("schedule interval is zero")
+	errTooManySchedulePoints     = errors.New("too many schedule points, interval must be bigger")
+	errDepositOfferInactive      = errors.New("deposit offer is inactive")
+	errDepositDurationNotInRange = errors.New("deposit duration isn't within deposit offer min and max durations")
+	errDepositTooSmall           = errors.New("deposit amount is less than deposit offer minimum amount")
+	errDepositTooBig             = errors.New("deposit amount or reward is bigger than deposit offer remaining limits")
+	errNoClaimableOwner          = errors.New("claimable owner is empty")
 )
 
 // CaminoService defines the API calls that can be made to the platform chain
@@ -51,6 +71,7 @@ type GetBalanceResponseV2 struct {
 	BondedOutputs          map[ids.ID]utilsjson.Uint64 `json:"bondedOutputs"`
 	DepositedOutputs       map[ids.ID]utilsjson.Uint64 `json:"depositedOutputs"`
 	DepositedBondedOutputs map[ids.ID]utilsjson.Uint64 `json:"bondedDepositedOutputs"`
+	VestingOutputs         map[ids.ID]utilsjson.Uint64 `json:"vestingOutputs"`
 	UTXOIDs                []*avax.UTXOID              `json:"utxoIDs"`
 }
 type GetBalanceResponseWrapper struct {
@@ -98,6 +119,7 @@ func (s *CaminoService) GetBalance(_ *http.Request, args *GetBalanceRequest, res
 	bondedOutputs := map[ids.ID]utilsjson.Uint64{}
 	depositedOutputs := map[ids.ID]utilsjson.Uint64{}
 	depositedBondedOutputs := map[ids.ID]utilsjson.Uint64{}
+	vestingOutputs := map[ids.ID]utilsjson.Uint64{}
 	balances := map[ids.ID]utilsjson.Uint64{}
 	var utxoIDs []*avax.UTXOID
 
@@ -123,6 +145,9 @@ utxoFor:
 				s.vm.ctx.Log.Warn("Unexpected utxo lock state")
 				continue utxoFor
 			}
+		case *locked.VestingOut:
+			vestingOutputs[assetID] = utilsjson.SafeAdd(vestingOutputs[assetID], utilsjson.Uint64(out.Amount()))
+			balances[assetID] = utilsjson.SafeAdd(balances[assetID], utilsjson.Uint64(out.Amount()))
 		default:
 			s.vm.ctx.Log.Warn("unexpected output type in UTXO",
 				zap.String("type", fmt.Sprintf("%T", out)),
@@ -133,7 +158,7 @@ utxoFor:
 		utxoIDs = append(utxoIDs, &utxo.UTXOID)
 	}
 
-	response.camino = GetBalanceResponseV2{balances, unlockedOutputs, bondedOutputs, depositedOutputs, depositedBondedOutputs, utxoIDs}
+	response.camino = GetBalanceResponseV2{balances, unlockedOutputs, bondedOutputs, depositedOutputs, depositedBondedOutputs, vestingOutputs, utxoIDs}
 	return nil
 }
 
@@ -295,8 +320,15 @@ func (s *CaminoService) SetAddressState(_ *http.Request, args *SetAddressStateAr
 	return nil
 }
 
+type GetAddressStatesReply struct {
+	States utilsjson.Uint64 `json:"states"`
+	// Unix time after which kyc verification expires, 0 if it never expires
+	KYCExpiration utilsjson.Uint64 `json:"kycExpiration"`
+}
+
 // GetAdressStates retrieves the state applied to an address (see setAddressState)
-func (s *CaminoService) GetAddressStates(_ *http.Request, args *api.JSONAddress, response *utilsjson.Uint64) error {
+// and expiration of its kyc verification
+func (s *CaminoService) GetAddressStates(_ *http.Request, args *api.JSONAddress, response *GetAddressStatesReply) error {
 	s.vm.ctx.Log.Debug("Platform: GetAddressStates called")
 
 	addr, err := avax.ParseServiceAddress(s.addrManager, args.Address)
@@ -309,7 +341,74 @@ func (s *CaminoService) GetAddressStates(_ *http.Request, args *api.JSONAddress,
 		return err
 	}
 
-	*response = utilsjson.Uint64(state)
+	kycExpiration, err := s.vm.state.GetKYCExpiration(addr)
+	if err != nil && err != database.ErrNotFound {
+		return err
+	}
+
+	response.States = utilsjson.Uint64(state)
+	response.KYCExpiration = utilsjson.Uint64(kycExpiration)
+
+	return nil
+}
+
+type GetAddressStateHistoryArgs struct {
+	Address string `json:"address"`
+	// Index of the first returned change
+	StartIndex utilsjson.Uint64 `json:"startIndex"`
+	// Max number of returned changes
+	Limit utilsjson.Uint32 `json:"limit"`
+}
+
+type APIAddressStateChange struct {
+	Height   utilsjson.Uint64    `json:"height"`
+	TxID     ids.ID              `json:"txID"`
+	Executor string              `json:"executor"`
+	Bit      txs.AddressStateBit `json:"bit"`
+	Remove   bool                `json:"remove"`
+}
+
+type GetAddressStateHistoryReply struct {
+	Changes []APIAddressStateChange `json:"changes"`
+	// Index that can be used as startIndex to fetch the next page
+	EndIndex utilsjson.Uint64 `json:"endIndex"`
+}
+
+// GetAddressStateHistory returns address state changes of an address in order of their acceptance
+func (s *CaminoService) GetAddressStateHistory(_ *http.Request, args *GetAddressStateHistoryArgs, response *GetAddressStateHistoryReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetAddressStateHistory called")
+
+	addr, err := avax.ParseServiceAddress(s.addrManager, args.Address)
+	if err != nil {
+		return err
+	}
+
+	limit := int(args.Limit)
+	if limit <= 0 || builder.MaxPageSize < limit {
+		limit = builder.MaxPageSize
+	}
+
+	changes, err := s.vm.state.GetAddressStateHistory(addr, uint64(args.StartIndex), limit)
+	if err != nil {
+		return err
+	}
+
+	response.Changes = make([]APIAddressStateChange, len(changes))
+	for i, change := range changes {
+		response.Changes[i] = APIAddressStateChange{
+			Height: utilsjson.Uint64(change.Height),
+			TxID:   change.TxID,
+			Bit:    change.Bit,
+			Remove: change.Remove,
+		}
+		if change.Executor != ids.ShortEmpty {
+			response.Changes[i].Executor, err = s.addrManager.FormatLocalAddress(change.Executor)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	response.EndIndex = args.StartIndex + utilsjson.Uint64(len(changes))
 
 	return nil
 }
@@ -317,6 +416,35 @@ func (s *CaminoService) GetAddressStates(_ *http.Request, args *api.JSONAddress,
 type GetMultisigAliasReply struct {
 	Memo types.JSONByteSlice `json:"memo"`
 	platformapi.Owner
+	APIMultisigAliasPolicy
+	PendingChange *APIMultisigAliasChange `json:"pendingChange,omitempty"`
+}
+
+// APIMultisigAliasChange is a timelocked change of multisig alias
+type APIMultisigAliasChange struct {
+	Memo types.JSONByteSlice `json:"memo"`
+	platformapi.Owner
+	APIMultisigAliasPolicy
+	EffectiveTime utilsjson.Uint64 `json:"effectiveTime"`
+}
+
+// APIMultisigAliasPolicy is optional owners change policy and spend limit of multisig alias
+type APIMultisigAliasPolicy struct {
+	OwnersChangeThreshold utilsjson.Uint32 `json:"ownersChangeThreshold"`
+	OwnersChangeDelay     utilsjson.Uint64 `json:"ownersChangeDelay"`
+	SpendLimitThreshold   utilsjson.Uint32 `json:"spendLimitThreshold"`
+	SpendLimitAmount      utilsjson.Uint64 `json:"spendLimitAmount"`
+	SpendLimitPeriod      utilsjson.Uint64 `json:"spendLimitPeriod"`
+}
+
+func apiMultisigAliasPolicy(alias *multisig.Alias) APIMultisigAliasPolicy {
+	return APIMultisigAliasPolicy{
+		OwnersChangeThreshold: utilsjson.Uint32(alias.OwnersChangeThreshold),
+		OwnersChangeDelay:     utilsjson.Uint64(alias.OwnersChangeDelay),
+		SpendLimitThreshold:   utilsjson.Uint32(alias.SpendLimitThreshold),
+		SpendLimitAmount:      utilsjson.Uint64(alias.SpendLimitAmount),
+		SpendLimitPeriod:      utilsjson.Uint64(alias.SpendLimitPeriod),
+	}
 }
 
 // GetMultisigAlias retrieves the owners and threshold for a given multisig alias
@@ -338,18 +466,239 @@ func (s *CaminoService) GetMultisigAlias(_ *http.Request, args *api.JSONAddress,
 	}
 
 	response.Memo = alias.Memo
-	response.Locktime = utilsjson.Uint64(owners.Locktime)
-	response.Threshold = utilsjson.Uint32(owners.Threshold)
-	response.Addresses = make([]string, len(owners.Addrs))
+	response.APIMultisigAliasPolicy = apiMultisigAliasPolicy(&alias.Alias)
+	if err := s.setAPIOwner(&response.Owner, owners); err != nil {
+		return err
+	}
+
+	change, err := s.vm.state.GetMultisigAliasChange(addr)
+	switch {
+	case err == database.ErrNotFound:
+		return nil
+	case err != nil:
+		return err
+	}
+	changeOwners, ok := change.Owners.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return ErrWrongOwnerType
+	}
+
+	response.PendingChange = &APIMultisigAliasChange{
+		Memo:                   change.Memo,
+		APIMultisigAliasPolicy: apiMultisigAliasPolicy(&change.Alias),
+		EffectiveTime:          utilsjson.Uint64(change.EffectiveTime),
+	}
+	return s.setAPIOwner(&response.PendingChange.Owner, changeOwners)
+}
+
+func (s *CaminoService) setAPIOwner(apiOwner *platformapi.Owner, owners *secp256k1fx.OutputOwners) error {
+	apiOwner.Locktime = utilsjson.Uint64(owners.Locktime)
+	apiOwner.Threshold = utilsjson.Uint32(owners.Threshold)
+	apiOwner.Addresses = make([]string, len(owners.Addrs))
 
 	for index, addr := range owners.Addrs {
 		addrString, err := s.addrManager.FormatLocalAddress(addr)
 		if err != nil {
 			return err
 		}
-		response.Addresses[index] = addrString
+		apiOwner.Addresses[index] = addrString
+	}
+	return nil
+}
+
+type GetMultisigAliasesForAddressReply struct {
+	Aliases []string `json:"aliases"`
+}
+
+// GetMultisigAliasesForAddress returns all multisig aliases, which given address can sign for:
+// aliases that have this address as their owner and aliases that are owned by such aliases (nested).
+func (s *CaminoService) GetMultisigAliasesForAddress(_ *http.Request, args *api.JSONAddress, response *GetMultisigAliasesForAddressReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetMultisigAliasesForAddress called")
+
+	addr, err := avax.ParseServiceAddress(s.addrManager, args.Address)
+	if err != nil {
+		return err
+	}
+
+	visited := set.Set[ids.ShortID]{addr: struct{}{}}
+	addrsToVisit := []ids.ShortID{addr}
+	response.Aliases = []string{}
+	for len(addrsToVisit) > 0 {
+		aliasIDs, err := s.vm.state.GetMultisigAliasesForAddress(addrsToVisit[0])
+		if err != nil {
+			return err
+		}
+		addrsToVisit = addrsToVisit[1:]
+
+		for _, aliasID := range aliasIDs {
+			if visited.Contains(aliasID) {
+				continue
+			}
+			visited.Add(aliasID)
+			addrsToVisit = append(addrsToVisit, aliasID)
+
+			aliasAddr, err := s.addrManager.FormatLocalAddress(aliasID)
+			if err != nil {
+				return err
+			}
+			response.Aliases = append(response.Aliases, aliasAddr)
+		}
+	}
+	return nil
+}
+
+type CreateMultisigAliasArgs struct {
+	api.UserPass
+	api.JSONFromAddrs
+
+	Change platformapi.Owner   `json:"change"`
+	Owners platformapi.Owner   `json:"owners"`
+	Memo   types.JSONByteSlice `json:"memo"`
+	APIMultisigAliasPolicy
+}
+
+// CreateMultisigAlias issues a MultisigAliasTx creating new multisig alias
+func (s *CaminoService) CreateMultisigAlias(_ *http.Request, args *CreateMultisigAliasArgs, reply *api.JSONTxID) error {
+	s.vm.ctx.Log.Debug("Platform: CreateMultisigAlias called")
+
+	return s.issueMultisigAliasTx(
+		&args.UserPass,
+		&args.JSONFromAddrs,
+		&args.Change,
+		ids.ShortEmpty,
+		&args.Owners,
+		args.Memo,
+		&args.APIMultisigAliasPolicy,
+		reply,
+	)
+}
+
+type UpdateMultisigAliasArgs struct {
+	api.UserPass
+	api.JSONFromAddrs
+
+	Change platformapi.Owner   `json:"change"`
+	Alias  string              `json:"alias"`
+	Owners platformapi.Owner   `json:"owners"`
+	Memo   types.JSONByteSlice `json:"memo"`
+	APIMultisigAliasPolicy
+}
+
+// UpdateMultisigAlias issues a MultisigAliasTx updating owners and memo of existing multisig alias.
+// If alias has owners change delay, update will take effect only after this delay.
+func (s *CaminoService) UpdateMultisigAlias(_ *http.Request, args *UpdateMultisigAliasArgs, reply *api.JSONTxID) error {
+	s.vm.ctx.Log.Debug("Platform: UpdateMultisigAlias called")
+
+	aliasAddr, err := avax.ParseServiceAddress(s.addrManager, args.Alias)
+	if err != nil {
+		return fmt.Errorf("couldn't parse alias: %w", err)
+	}
+
+	return s.issueMultisigAliasTx(
+		&args.UserPass,
+		&args.JSONFromAddrs,
+		&args.Change,
+		aliasAddr,
+		&args.Owners,
+		args.Memo,
+		&args.APIMultisigAliasPolicy,
+		reply,
+	)
+}
+
+type CancelMultisigAliasChangeArgs struct {
+	api.UserPass
+	api.JSONFromAddrs
+
+	Change platformapi.Owner `json:"change"`
+	Alias  string            `json:"alias"`
+}
+
+// CancelMultisigAliasChange issues a MultisigAliasTx cancelling pending timelocked change of multisig alias
+func (s *CaminoService) CancelMultisigAliasChange(_ *http.Request, args *CancelMultisigAliasChangeArgs, reply *api.JSONTxID) error {
+	s.vm.ctx.Log.Debug("Platform: CancelMultisigAliasChange called")
+
+	aliasAddr, err := avax.ParseServiceAddress(s.addrManager, args.Alias)
+	if err != nil {
+		return fmt.Errorf("couldn't parse alias: %w", err)
+	}
+
+	privKeys, err := s.getKeystoreKeys(&args.UserPass, &args.JSONFromAddrs)
+	if err != nil {
+		return err
+	}
+
+	change, err := s.secpOwnerFromAPI(&args.Change)
+	if err != nil {
+		return fmt.Errorf(errInvalidChangeAddr, err)
+	}
+
+	tx, err := s.vm.txBuilder.NewCancelMultisigAliasChangeTx(aliasAddr, privKeys, change)
+	if err != nil {
+		return fmt.Errorf(errCreateTx, err)
+	}
+
+	reply.TxID = tx.ID()
+
+	return s.vm.Builder.AddUnverifiedTx(tx)
+}
+
+func (s *CaminoService) issueMultisigAliasTx(
+	userPass *api.UserPass,
+	from *api.JSONFromAddrs,
+	apiChange *platformapi.Owner,
+	aliasID ids.ShortID,
+	apiOwners *platformapi.Owner,
+	memo types.JSONByteSlice,
+	policy *APIMultisigAliasPolicy,
+	reply *api.JSONTxID,
+) error {
+	privKeys, err := s.getKeystoreKeys(userPass, from)
+	if err != nil {
+		return err
+	}
+
+	change, err := s.secpOwnerFromAPI(apiChange)
+	if err != nil {
+		return fmt.Errorf(errInvalidChangeAddr, err)
+	}
+
+	owners, err := s.secpOwnerFromAPI(apiOwners)
+	if err != nil {
+		return fmt.Errorf("couldn't parse owners: %w", err)
+	}
+	if owners == nil {
+		return errNoAliasOwners
+	}
+
+	alias := &multisig.Alias{
+		ID:                    aliasID,
+		Memo:                  memo,
+		Owners:                owners,
+		OwnersChangeThreshold: uint32(policy.OwnersChangeThreshold),
+		OwnersChangeDelay:     uint64(policy.OwnersChangeDelay),
+		SpendLimitThreshold:   uint32(policy.SpendLimitThreshold),
+		SpendLimitAmount:      uint64(policy.SpendLimitAmount),
+		SpendLimitPeriod:      uint64(policy.SpendLimitPeriod),
+	}
+	switch {
+	case alias.HasSpendLimit():
+		alias.UpgradeVersionID = codec.UpgradeVersion2
+	case alias.OwnersChangeThreshold != 0 || alias.OwnersChangeDelay != 0:
+		alias.UpgradeVersionID = codec.UpgradeVersion1
+	}
+
+	// Create the transaction
+	tx, err := s.vm.txBuilder.NewMultisigAliasTx(alias, privKeys, change)
+	if err != nil {
+		return fmt.Errorf(errCreateTx, err)
 	}
 
+	reply.TxID = tx.ID()
+
+	if err = s.vm.Builder.AddUnverifiedTx(tx); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -493,6 +842,56 @@ func (s *CaminoService) RegisterNode(_ *http.Request, args *RegisterNodeArgs, re
 	return nil
 }
 
+type RotateValidatorNodeArgs struct {
+	api.UserPass
+	api.JSONFromAddrs
+
+	Change           platformapi.Owner `json:"change"`
+	OldNodeID        ids.NodeID        `json:"oldNodeID"`
+	NewNodeID        ids.NodeID        `json:"newNodeID"`
+	NodeOwnerAddress string            `json:"nodeOwnerAddress"`
+}
+
+// RotateValidatorNode issues an RotateValidatorNodeTx
+func (s *CaminoService) RotateValidatorNode(_ *http.Request, args *RotateValidatorNodeArgs, reply *api.JSONTxID) error {
+	s.vm.ctx.Log.Debug("Platform: RotateValidatorNode called")
+
+	privKeys, err := s.getKeystoreKeys(&args.UserPass, &args.JSONFromAddrs)
+	if err != nil {
+		return err
+	}
+
+	change, err := s.secpOwnerFromAPI(&args.Change)
+	if err != nil {
+		return fmt.Errorf(errInvalidChangeAddr, err)
+	}
+
+	// Parse the node owner address.
+	nodeOwnerAddress, err := avax.ParseServiceAddress(s.addrManager, args.NodeOwnerAddress)
+	if err != nil {
+		return fmt.Errorf("couldn't parse nodeOwnerAddress: %w", err)
+	}
+
+	// Create the transaction
+	tx, err := s.vm.txBuilder.NewRotateValidatorNodeTx(
+		args.OldNodeID,
+		args.NewNodeID,
+		nodeOwnerAddress,
+		privKeys,
+		change,
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't create tx: %w", err)
+	}
+
+	reply.TxID = tx.ID()
+
+	if err = s.vm.Builder.AddUnverifiedTx(tx); err != nil {
+		return err
+	}
+	return nil
+}
+
 type ClaimedAmount struct {
 	DepositTxID    ids.ID            `json:"depositTxID"`
 	ClaimableOwner platformapi.Owner `json:"claimableOwner"`
@@ -570,17 +969,18 @@ func (s *CaminoService) Claim(_ *http.Request, args *ClaimArgs, reply *api.JSONT
 	return nil
 }
 
-type TransferArgs struct {
+type TransferDepositArgs struct {
 	api.UserPass
 	api.JSONFromAddrs
-	Change     platformapi.Owner `json:"change"`
-	TransferTo platformapi.Owner `json:"transferTo"`
-	Amount     utilsjson.Uint64  `json:"amount"`
+
+	DepositTxID ids.ID            `json:"depositTxID"`
+	RewardOwner platformapi.Owner `json:"rewardOwner"`
+	Change      platformapi.Owner `json:"change"`
 }
 
-// Transfer issues an BaseTx
-func (s *CaminoService) Transfer(_ *http.Request, args *TransferArgs, reply *api.JSONTxID) error {
-	s.vm.ctx.Log.Debug("Platform: Transfer called")
+// TransferDeposit issues a TransferDepositTx. If reward owner is empty, current deposit reward owner will be used.
+func (s *CaminoService) TransferDeposit(_ *http.Request, args *TransferDepositArgs, reply *api.JSONTxID) error {
+	s.vm.ctx.Log.Debug("Platform: TransferDeposit called")
 
 	privKeys, err := s.getKeystoreKeys(&args.UserPass, &args.JSONFromAddrs)
 	if err != nil {
@@ -592,64 +992,236 @@ func (s *CaminoService) Transfer(_ *http.Request, args *TransferArgs, reply *api
 		return fmt.Errorf(errInvalidChangeAddr, err)
 	}
 
-	transferTo, err := s.secpOwnerFromAPI(&args.TransferTo)
+	rewardOwner, err := s.secpOwnerFromAPI(&args.RewardOwner)
 	if err != nil {
 		return err
 	}
 
 	// Create the transaction
-	tx, err := s.vm.txBuilder.NewBaseTx(
-		uint64(args.Amount),
-		transferTo,
+	tx, err := s.vm.txBuilder.NewTransferDepositTx(
+		args.DepositTxID,
+		rewardOwner,
 		privKeys,
 		change,
 	)
 	if err != nil {
-		return fmt.Errorf("couldn't create tx: %w", err)
+		return fmt.Errorf(errCreateTx, err)
 	}
 
 	reply.TxID = tx.ID()
 
 	if err := s.vm.Builder.AddUnverifiedTx(tx); err != nil {
-		return fmt.Errorf("couldn't create tx: %w", err)
+		return err
 	}
-
 	return nil
 }
 
-func (s *CaminoService) GetRegisteredShortIDLink(_ *http.Request, args *api.JSONAddress, response *api.JSONAddress) error {
-	s.vm.ctx.Log.Debug("Platform: GetRegisteredShortIDLink called")
+type SetRewardRestakeArgs struct {
+	api.UserPass
+	api.JSONFromAddrs
 
-	var id ids.ShortID
-	isNodeID := false
-	if nodeID, err := ids.NodeIDFromString(args.Address); err == nil {
-		id = ids.ShortID(nodeID)
-		isNodeID = true
-	} else {
-		id, err = avax.ParseServiceAddress(s.addrManager, args.Address)
-		if err != nil {
-			return err
-		}
+	ClaimableOwner  platformapi.Owner `json:"claimableOwner"`
+	DepositOfferID  ids.ID            `json:"depositOfferID"`
+	DepositDuration uint32            `json:"depositDuration"`
+	Change          platformapi.Owner `json:"change"`
+}
+
+// SetRewardRestake issues a SetRewardRestakeTx. Empty deposit offer id disables restaking.
+func (s *CaminoService) SetRewardRestake(_ *http.Request, args *SetRewardRestakeArgs, reply *api.JSONTxID) error {
+	s.vm.ctx.Log.Debug("Platform: SetRewardRestake called")
+
+	privKeys, err := s.getKeystoreKeys(&args.UserPass, &args.JSONFromAddrs)
+	if err != nil {
+		return err
 	}
 
-	link, err := s.vm.state.GetShortIDLink(id, state.ShortLinkKeyRegisterNode)
+	change, err := s.secpOwnerFromAPI(&args.Change)
+	if err != nil {
+		return fmt.Errorf(errInvalidChangeAddr, err)
+	}
+
+	claimableOwner, err := s.secpOwnerFromAPI(&args.ClaimableOwner)
 	if err != nil {
 		return err
 	}
+	if claimableOwner == nil {
+		return errNoClaimableOwner
+	}
 
-	if isNodeID {
-		response.Address, err = s.addrManager.FormatLocalAddress(link)
-		if err != nil {
-			return err
-		}
-	} else {
-		response.Address = ids.NodeID(link).String()
+	// Create the transaction
+	tx, err := s.vm.txBuilder.NewSetRewardRestakeTx(
+		claimableOwner,
+		args.DepositOfferID,
+		args.DepositDuration,
+		privKeys,
+		change,
+	)
+	if err != nil {
+		return fmt.Errorf(errCreateTx, err)
+	}
+
+	reply.TxID = tx.ID()
+
+	if err := s.vm.Builder.AddUnverifiedTx(tx); err != nil {
+		return err
 	}
 	return nil
 }
 
-type APIClaimable struct {
-	RewardOwner           platformapi.Owner `json:"rewardOwner"`
+type SetSubnetValidatorRequirementsArgs struct {
+	api.UserPass
+	api.JSONFromAddrs
+
+	SubnetID                ids.ID            `json:"subnetID"`
+	RequireConsortiumMember bool              `json:"requireConsortiumMember"`
+	RequireKYCVerified      bool              `json:"requireKYCVerified"`
+	Change                  platformapi.Owner `json:"change"`
+}
+
+// SetSubnetValidatorRequirements issues a SetSubnetValidatorRequirementsTx.
+// Subnet must not be transformed yet.
+func (s *CaminoService) SetSubnetValidatorRequirements(_ *http.Request, args *SetSubnetValidatorRequirementsArgs, reply *api.JSONTxID) error {
+	s.vm.ctx.Log.Debug("Platform: SetSubnetValidatorRequirements called")
+
+	privKeys, err := s.getKeystoreKeys(&args.UserPass, &args.JSONFromAddrs)
+	if err != nil {
+		return err
+	}
+
+	change, err := s.secpOwnerFromAPI(&args.Change)
+	if err != nil {
+		return fmt.Errorf(errInvalidChangeAddr, err)
+	}
+
+	// Create the transaction
+	tx, err := s.vm.txBuilder.NewSetSubnetValidatorRequirementsTx(
+		args.SubnetID,
+		args.RequireConsortiumMember,
+		args.RequireKYCVerified,
+		privKeys,
+		change,
+	)
+	if err != nil {
+		return fmt.Errorf(errCreateTx, err)
+	}
+
+	reply.TxID = tx.ID()
+
+	if err := s.vm.Builder.AddUnverifiedTx(tx); err != nil {
+		return err
+	}
+	return nil
+}
+
+type TransferArgs struct {
+	api.UserPass
+	api.JSONFromAddrs
+	Change     platformapi.Owner `json:"change"`
+	TransferTo platformapi.Owner `json:"transferTo"`
+	Amount     utilsjson.Uint64  `json:"amount"`
+	// If set, transferred amount will be released according to this schedule
+	VestingSchedule *APIVestingSchedule `json:"vestingSchedule"`
+}
+
+// APIVestingSchedule is release schedule of transferred amount.
+// Nothing is released before start + cliff, everything is released at start + duration.
+// If step isn't zero, amount is released in tranches every step seconds, otherwise linearly.
+type APIVestingSchedule struct {
+	Start    utilsjson.Uint64 `json:"start"`
+	Cliff    utilsjson.Uint64 `json:"cliff"`
+	Duration utilsjson.Uint64 `json:"duration"`
+	Step     utilsjson.Uint64 `json:"step"`
+}
+
+// Transfer issues an BaseTx
+func (s *CaminoService) Transfer(_ *http.Request, args *TransferArgs, reply *api.JSONTxID) error {
+	s.vm.ctx.Log.Debug("Platform: Transfer called")
+
+	privKeys, err := s.getKeystoreKeys(&args.UserPass, &args.JSONFromAddrs)
+	if err != nil {
+		return err
+	}
+
+	change, err := s.secpOwnerFromAPI(&args.Change)
+	if err != nil {
+		return fmt.Errorf(errInvalidChangeAddr, err)
+	}
+
+	transferTo, err := s.secpOwnerFromAPI(&args.TransferTo)
+	if err != nil {
+		return err
+	}
+
+	// Create the transaction
+	var tx *txs.Tx
+	if args.VestingSchedule != nil {
+		tx, err = s.vm.txBuilder.NewVestingTx(
+			uint64(args.Amount),
+			transferTo,
+			&locked.VestingSchedule{
+				Start:       uint64(args.VestingSchedule.Start),
+				Cliff:       uint64(args.VestingSchedule.Cliff),
+				Duration:    uint64(args.VestingSchedule.Duration),
+				Step:        uint64(args.VestingSchedule.Step),
+				TotalAmount: uint64(args.Amount),
+			},
+			privKeys,
+			change,
+		)
+	} else {
+		tx, err = s.vm.txBuilder.NewBaseTx(
+			uint64(args.Amount),
+			transferTo,
+			privKeys,
+			change,
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("couldn't create tx: %w", err)
+	}
+
+	reply.TxID = tx.ID()
+
+	if err := s.vm.Builder.AddUnverifiedTx(tx); err != nil {
+		return fmt.Errorf("couldn't create tx: %w", err)
+	}
+
+	return nil
+}
+
+func (s *CaminoService) GetRegisteredShortIDLink(_ *http.Request, args *api.JSONAddress, response *api.JSONAddress) error {
+	s.vm.ctx.Log.Debug("Platform: GetRegisteredShortIDLink called")
+
+	var id ids.ShortID
+	isNodeID := false
+	if nodeID, err := ids.NodeIDFromString(args.Address); err == nil {
+		id = ids.ShortID(nodeID)
+		isNodeID = true
+	} else {
+		id, err = avax.ParseServiceAddress(s.addrManager, args.Address)
+		if err != nil {
+			return err
+		}
+	}
+
+	link, err := s.vm.state.GetShortIDLink(id, state.ShortLinkKeyRegisterNode)
+	if err != nil {
+		return err
+	}
+
+	if isNodeID {
+		response.Address, err = s.addrManager.FormatLocalAddress(link)
+		if err != nil {
+			return err
+		}
+	} else {
+		response.Address = ids.NodeID(link).String()
+	}
+	return nil
+}
+
+type APIClaimable struct {
+	RewardOwner           platformapi.Owner `json:"rewardOwner"`
 	ValidatorRewards      utilsjson.Uint64  `json:"validatorRewards"`
 	ExpiredDepositRewards utilsjson.Uint64  `json:"expiredDepositRewards"`
 }
@@ -695,6 +1267,318 @@ func (s *CaminoService) GetClaimables(_ *http.Request, args *GetClaimablesArgs,
 	return nil
 }
 
+type GetRewardRestakeSettingArgs struct {
+	ClaimableOwner platformapi.Owner `json:"claimableOwner"`
+}
+
+type GetRewardRestakeSettingReply struct {
+	DepositOfferID  ids.ID `json:"depositOfferID"`
+	DepositDuration uint32 `json:"depositDuration"`
+}
+
+// GetRewardRestakeSetting returns deposit offer and duration, that are used to restake
+// validator rewards of given claimable owner. Empty deposit offer id means that restaking is disabled.
+func (s *CaminoService) GetRewardRestakeSetting(_ *http.Request, args *GetRewardRestakeSettingArgs, response *GetRewardRestakeSettingReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetRewardRestakeSetting called")
+
+	claimableOwner, err := s.secpOwnerFromAPI(&args.ClaimableOwner)
+	if err != nil {
+		return err
+	}
+	if claimableOwner == nil {
+		return errNoClaimableOwner
+	}
+
+	ownerID, err := txs.GetOwnerID(claimableOwner)
+	if err != nil {
+		return err
+	}
+
+	setting, err := s.vm.state.GetRewardRestakeSetting(ownerID)
+	if err == database.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	response.DepositOfferID = setting.DepositOfferID
+	response.DepositDuration = setting.DepositDuration
+	return nil
+}
+
+type GetSubnetValidatorRequirementsArgs struct {
+	SubnetID ids.ID `json:"subnetID"`
+}
+
+type GetSubnetValidatorRequirementsReply struct {
+	RequireConsortiumMember bool `json:"requireConsortiumMember"`
+	RequireKYCVerified      bool `json:"requireKYCVerified"`
+}
+
+// GetSubnetValidatorRequirements returns requirements for node owners of validators of given subnet.
+func (s *CaminoService) GetSubnetValidatorRequirements(_ *http.Request, args *GetSubnetValidatorRequirementsArgs, response *GetSubnetValidatorRequirementsReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetSubnetValidatorRequirements called")
+
+	requirements, err := s.vm.state.GetSubnetValidatorRequirements(args.SubnetID)
+	if err == database.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	response.RequireConsortiumMember = requirements.RequireConsortiumMember
+	response.RequireKYCVerified = requirements.RequireKYCVerified
+	return nil
+}
+
+type GetDeferredValidatorsArgs struct {
+	// If not empty, only deferred validators with this node IDs are returned
+	NodeIDs []ids.NodeID `json:"nodeIDs"`
+}
+
+type APIDeferredValidator struct {
+	TxID      ids.ID           `json:"txID"`
+	NodeID    ids.NodeID       `json:"nodeID"`
+	StartTime utilsjson.Uint64 `json:"startTime"`
+	EndTime   utilsjson.Uint64 `json:"endTime"`
+	Weight    utilsjson.Uint64 `json:"weight"`
+	// Chain time when validator was deferred
+	DeferredAt utilsjson.Uint64 `json:"deferredAt"`
+	// Address that deferred validator
+	DeferredBy string `json:"deferredBy"`
+	// ID of tx that deferred validator
+	DeferTxID ids.ID `json:"deferTxID"`
+	// Memo of tx that deferred validator
+	Reason string `json:"reason"`
+	// Seconds left till the end of validator staking period
+	RemainingStakeTime utilsjson.Uint64 `json:"remainingStakeTime"`
+}
+
+type GetDeferredValidatorsReply struct {
+	Validators []APIDeferredValidator `json:"validators"`
+}
+
+// GetDeferredValidators returns deferred primary network validators with information
+// about when, by whom and why they were deferred.
+func (s *CaminoService) GetDeferredValidators(_ *http.Request, args *GetDeferredValidatorsArgs, response *GetDeferredValidatorsReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetDeferredValidators called")
+
+	nodeIDs := set.NewSet[ids.NodeID](len(args.NodeIDs))
+	nodeIDs.Add(args.NodeIDs...)
+
+	chainTime := s.vm.state.GetTimestamp()
+
+	deferredStakerIterator, err := s.vm.state.GetDeferredStakerIterator()
+	if err != nil {
+		return err
+	}
+	defer deferredStakerIterator.Release()
+
+	response.Validators = []APIDeferredValidator{}
+	for deferredStakerIterator.Next() {
+		staker := deferredStakerIterator.Value()
+		if nodeIDs.Len() > 0 && !nodeIDs.Contains(staker.NodeID) {
+			continue
+		}
+
+		validator := APIDeferredValidator{
+			TxID:      staker.TxID,
+			NodeID:    staker.NodeID,
+			StartTime: utilsjson.Uint64(staker.StartTime.Unix()),
+			EndTime:   utilsjson.Uint64(staker.EndTime.Unix()),
+			Weight:    utilsjson.Uint64(staker.Weight),
+		}
+		if staker.EndTime.After(chainTime) {
+			validator.RemainingStakeTime = utilsjson.Uint64(staker.EndTime.Sub(chainTime) / time.Second)
+		}
+
+		// validators deferred before deferral info was introduced don't have it
+		info, err := s.vm.state.GetDeferredValidatorInfo(staker.TxID)
+		switch err {
+		case nil:
+			validator.DeferredAt = utilsjson.Uint64(info.Timestamp)
+			validator.DeferTxID = info.TxID
+			if info.Executor != ids.ShortEmpty {
+				validator.DeferredBy, err = s.addrManager.FormatLocalAddress(info.Executor)
+				if err != nil {
+					return err
+				}
+			}
+			deferTx, _, err := s.vm.state.GetTx(info.TxID)
+			if err != nil {
+				return fmt.Errorf("couldn't get deferring tx %s: %w", info.TxID, err)
+			}
+			if addressStateTx, ok := deferTx.Unsigned.(*txs.AddressStateTx); ok {
+				validator.Reason = string(addressStateTx.Memo)
+			}
+		case database.ErrNotFound:
+		default:
+			return err
+		}
+
+		response.Validators = append(response.Validators, validator)
+	}
+	return nil
+}
+
+type SpendTreasuryArgs struct {
+	api.UserPass
+	api.JSONFromAddrs
+	Change platformapi.Owner `json:"change"`
+	// Owner of spent treasury tokens
+	To     platformapi.Owner `json:"to"`
+	Amount utilsjson.Uint64  `json:"amount"`
+	// Reference to off-chain decision that allows this spend
+	Reference types.JSONByteSlice `json:"reference"`
+}
+
+// SpendTreasury issues a TreasurySpendTx. Keystore user must control treasury admin.
+func (s *CaminoService) SpendTreasury(_ *http.Request, args *SpendTreasuryArgs, reply *api.JSONTxID) error {
+	s.vm.ctx.Log.Debug("Platform: SpendTreasury called")
+
+	privKeys, err := s.getKeystoreKeys(&args.UserPass, &args.JSONFromAddrs)
+	if err != nil {
+		return err
+	}
+
+	change, err := s.secpOwnerFromAPI(&args.Change)
+	if err != nil {
+		return fmt.Errorf(errInvalidChangeAddr, err)
+	}
+
+	to, err := s.secpOwnerFromAPI(&args.To)
+	if err != nil {
+		return err
+	}
+
+	// Create the transaction
+	tx, err := s.vm.txBuilder.NewTreasurySpendTx(
+		uint64(args.Amount),
+		to,
+		args.Reference,
+		privKeys,
+		change,
+	)
+	if err != nil {
+		return fmt.Errorf(errCreateTx, err)
+	}
+
+	reply.TxID = tx.ID()
+
+	if err := s.vm.Builder.AddUnverifiedTx(tx); err != nil {
+		return err
+	}
+	return nil
+}
+
+type GetTreasuryBalanceReply struct {
+	// Amount of unlocked tokens owned by treasury
+	Balance utilsjson.Uint64 `json:"balance"`
+	// Amount spent from treasury during current spending period
+	SpentInPeriod utilsjson.Uint64 `json:"spentInPeriod"`
+	// Max amount that can be spent from treasury during one spending period, zero means no limit
+	SpendLimit utilsjson.Uint64 `json:"spendLimit"`
+	// Unix time in seconds when current spending period started
+	PeriodStart utilsjson.Uint64 `json:"periodStart"`
+	// Duration of spending period in seconds
+	Period utilsjson.Uint64 `json:"period"`
+	// Address of treasury admin multisig alias
+	Admin string `json:"admin"`
+}
+
+// GetTreasuryBalance returns treasury balance and spending limits
+func (s *CaminoService) GetTreasuryBalance(_ *http.Request, _ *struct{}, response *GetTreasuryBalanceReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetTreasuryBalance called")
+
+	caminoConfig, err := s.vm.state.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	treasuryAddrs := set.NewSet[ids.ShortID](1)
+	treasuryAddrs.Add(treasury.Addr)
+	utxos, err := avax.GetAllUTXOs(s.vm.state, treasuryAddrs)
+	if err != nil {
+		return fmt.Errorf("couldn't get treasury UTXO set: %w", err)
+	}
+
+	for _, utxo := range utxos {
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok || utxo.AssetID() != s.vm.ctx.AVAXAssetID || !out.OutputOwners.Equals(treasury.Owner) {
+			continue
+		}
+		response.Balance = utilsjson.SafeAdd(response.Balance, utilsjson.Uint64(out.Amt))
+	}
+
+	periodStart := caminoConfig.TreasurySpendPeriodStart(uint64(s.vm.state.GetTimestamp().Unix()))
+	spentInPeriod, err := s.vm.state.GetTreasurySpentAmount(periodStart)
+	if err != nil {
+		return err
+	}
+
+	response.SpentInPeriod = utilsjson.Uint64(spentInPeriod)
+	response.SpendLimit = utilsjson.Uint64(caminoConfig.TreasurySpendLimit)
+	response.PeriodStart = utilsjson.Uint64(periodStart)
+	response.Period = utilsjson.Uint64(caminoConfig.TreasurySpendPeriod)
+	if caminoConfig.TreasuryAdmin != ids.ShortEmpty {
+		response.Admin, err = s.addrManager.FormatLocalAddress(caminoConfig.TreasuryAdmin)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type GetTreasurySpendsArgs struct {
+	// Index of the first returned spend
+	StartIndex utilsjson.Uint64 `json:"startIndex"`
+	// Max number of returned spends
+	Limit utilsjson.Uint32 `json:"limit"`
+}
+
+type APITreasurySpend struct {
+	Height    utilsjson.Uint64    `json:"height"`
+	TxID      ids.ID              `json:"txID"`
+	Timestamp utilsjson.Uint64    `json:"timestamp"`
+	Amount    utilsjson.Uint64    `json:"amount"`
+	Reference types.JSONByteSlice `json:"reference"`
+}
+
+type GetTreasurySpendsReply struct {
+	Spends []APITreasurySpend `json:"spends"`
+	// Index that can be used as startIndex to fetch the next page
+	EndIndex utilsjson.Uint64 `json:"endIndex"`
+}
+
+// GetTreasurySpends returns treasury spends in order of their acceptance
+func (s *CaminoService) GetTreasurySpends(_ *http.Request, args *GetTreasurySpendsArgs, response *GetTreasurySpendsReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetTreasurySpends called")
+
+	limit := int(args.Limit)
+	if limit <= 0 || builder.MaxPageSize < limit {
+		limit = builder.MaxPageSize
+	}
+
+	spends, err := s.vm.state.GetTreasurySpends(uint64(args.StartIndex), limit)
+	if err != nil {
+		return err
+	}
+
+	response.Spends = make([]APITreasurySpend, len(spends))
+	for i, spend := range spends {
+		response.Spends[i] = APITreasurySpend{
+			Height:    utilsjson.Uint64(spend.Height),
+			TxID:      spend.TxID,
+			Timestamp: utilsjson.Uint64(spend.Timestamp),
+			Amount:    utilsjson.Uint64(spend.Amount),
+			Reference: spend.Reference,
+		}
+	}
+	response.EndIndex = args.StartIndex + utilsjson.Uint64(len(spends))
+
+	return nil
+}
+
 type APIDeposit struct {
 	DepositTxID         ids.ID            `json:"depositTxID"`
 	DepositOfferID      ids.ID            `json:"depositOfferID"`
@@ -734,7 +1618,9 @@ type GetDepositsArgs struct {
 type GetDepositsReply struct {
 	Deposits         []*APIDeposit      `json:"deposits"`
 	AvailableRewards []utilsjson.Uint64 `json:"availableRewards"`
-	Timestamp        utilsjson.Uint64   `json:"timestamp"`
+	// Penalties for force-unlocking deposits at [Timestamp]. Zero, if deposit can't be force-unlocked.
+	EarlyUnlockPenalties []utilsjson.Uint64 `json:"earlyUnlockPenalties"`
+	Timestamp            utilsjson.Uint64   `json:"timestamp"`
 }
 
 // GetDeposits returns deposits by IDs
@@ -743,6 +1629,7 @@ func (s *CaminoService) GetDeposits(_ *http.Request, args *GetDepositsArgs, repl
 	timestamp := s.vm.clock.Unix()
 	reply.Deposits = make([]*APIDeposit, len(args.DepositTxIDs))
 	reply.AvailableRewards = make([]utilsjson.Uint64, len(args.DepositTxIDs))
+	reply.EarlyUnlockPenalties = make([]utilsjson.Uint64, len(args.DepositTxIDs))
 	reply.Timestamp = utilsjson.Uint64(timestamp)
 	for i := range args.DepositTxIDs {
 		deposit, err := s.vm.state.GetDeposit(args.DepositTxIDs[i])
@@ -758,6 +1645,105 @@ func (s *CaminoService) GetDeposits(_ *http.Request, args *GetDepositsArgs, repl
 			return err
 		}
 		reply.AvailableRewards[i] = utilsjson.Uint64(deposit.ClaimableReward(offer, timestamp))
+		if offer.EarlyUnlockAllowed && !deposit.IsExpired(timestamp) {
+			reply.EarlyUnlockPenalties[i] = utilsjson.Uint64(deposit.EarlyUnlockPenalty(offer, timestamp))
+		}
+	}
+	return nil
+}
+
+type GetDepositScheduleArgs struct {
+	// ID of existing deposit. If empty, schedule is calculated for hypothetical deposit
+	DepositTxID ids.ID `json:"depositTxID"`
+	// Hypothetical deposit params
+	DepositOfferID ids.ID           `json:"depositOfferID"`
+	Amount         utilsjson.Uint64 `json:"amount"`
+	Duration       uint32           `json:"duration"`
+	Start          utilsjson.Uint64 `json:"start"` // Unix time in seconds, current chain time if zero
+	// Seconds between schedule points
+	Interval utilsjson.Uint64 `json:"interval"`
+}
+
+type APIDepositSchedulePoint struct {
+	Timestamp        utilsjson.Uint64 `json:"timestamp"`
+	ClaimableReward  utilsjson.Uint64 `json:"claimableReward"`
+	UnlockableAmount utilsjson.Uint64 `json:"unlockableAmount"`
+}
+
+type GetDepositScheduleReply struct {
+	TotalReward utilsjson.Uint64           `json:"totalReward"`
+	Schedule    []*APIDepositSchedulePoint `json:"schedule"`
+}
+
+// GetDepositSchedule returns claimable reward and unlockable amount of existing or hypothetical deposit
+// from current chain time (or hypothetical deposit start) with given interval till deposit end time
+func (s *CaminoService) GetDepositSchedule(_ *http.Request, args *GetDepositScheduleArgs, reply *GetDepositScheduleReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetDepositSchedule called")
+
+	if args.Interval == 0 {
+		return errZeroScheduleInterval
+	}
+
+	var (
+		dep   *deposit.Deposit
+		offer *deposit.Offer
+		err   error
+		from  = uint64(s.vm.state.GetTimestamp().Unix())
+	)
+
+	if args.DepositTxID != ids.Empty {
+		dep, err = s.vm.state.GetDeposit(args.DepositTxID)
+		if err != nil {
+			return fmt.Errorf("could't get deposit from state: %w", err)
+		}
+		offer, err = s.vm.state.GetDepositOffer(dep.DepositOfferID)
+		if err != nil {
+			return err
+		}
+		from = math.Max(from, dep.Start)
+		from = math.Min(from, uint64(dep.EndTime().Unix()))
+	} else {
+		if args.Start != 0 {
+			from = uint64(args.Start)
+		}
+		offer, err = s.vm.state.GetDepositOffer(args.DepositOfferID)
+		if err != nil {
+			return err
+		}
+		dep = &deposit.Deposit{
+			DepositOfferID: args.DepositOfferID,
+			Start:          from,
+			Duration:       args.Duration,
+			Amount:         uint64(args.Amount),
+		}
+		// same checks as for deposit tx
+		switch {
+		case !offer.IsActiveAt(from):
+			return errDepositOfferInactive
+		case dep.Duration < offer.MinDuration || dep.Duration > offer.MaxDuration:
+			return errDepositDurationNotInRange
+		case dep.Amount < offer.MinAmount:
+			return errDepositTooSmall
+		case offer.TotalMaxAmount > 0 && dep.Amount > offer.RemainingAmount():
+			return errDepositTooBig
+		case offer.TotalMaxRewardAmount > 0 && dep.TotalReward(offer) > offer.RemainingReward():
+			return errDepositTooBig
+		}
+	}
+
+	if (uint64(dep.EndTime().Unix())-from)/uint64(args.Interval) >= maxDepositSchedulePoints {
+		return errTooManySchedulePoints
+	}
+
+	reply.TotalReward = utilsjson.Uint64(dep.TotalReward(offer))
+	schedule := dep.Schedule(offer, from, uint64(args.Interval))
+	reply.Schedule = make([]*APIDepositSchedulePoint, len(schedule))
+	for i, point := range schedule {
+		reply.Schedule[i] = &APIDepositSchedulePoint{
+			Timestamp:        utilsjson.Uint64(point.Timestamp),
+			ClaimableReward:  utilsjson.Uint64(point.ClaimableReward),
+			UnlockableAmount: utilsjson.Uint64(point.UnlockableAmount),
+		}
 	}
 	return nil
 }
@@ -959,6 +1945,11 @@ type APIDepositOffer struct {
 	TotalMaxRewardAmount    utilsjson.Uint64    `json:"totalMaxRewardAmount"`    // Maximum amount that can be rewarded for all deposits created with this offer in total
 	RewardedAmount          utilsjson.Uint64    `json:"rewardedAmount"`          // Amount that was already rewarded (including potential rewards) for deposits created with this offer
 	OwnerAddress            ids.ShortID         `json:"ownerAddress"`            // Address that can sign deposit-creator permission
+	RequiredAddressState    utilsjson.Uint64    `json:"requiredAddressState"`    // Bitmask of address states, that deposit creator must have
+	MaxAddressAmount        utilsjson.Uint64    `json:"maxAddressAmount"`        // Maximum amount that can be deposited with this offer by one deposit creator in total (across all its deposits)
+	AllowlistRoot           ids.ID              `json:"allowlistRoot"`           // Merkle root of addresses, that are allowed to create deposits with this offer
+	EarlyUnlockAllowed      bool                `json:"earlyUnlockAllowed"`      // If true, deposits created with this offer can be force-unlocked before their unlock period with penalty
+	EarlyUnlockPenalty      utilsjson.Uint64    `json:"earlyUnlockPenalty"`      // Penalty nominator for force-unlocking not yet unlockable deposited tokens, denominator is 1_000_000
 }
 
 type GetAllDepositOffersArgs struct {
@@ -988,6 +1979,85 @@ func (s *CaminoService) GetAllDepositOffers(_ *http.Request, args *GetAllDeposit
 	return nil
 }
 
+type GetDepositOfferStatsArgs struct {
+	// If empty, stats of all deposit offers are returned
+	OfferIDs []ids.ID `json:"offerIDs"`
+}
+
+type APIDepositOfferStats struct {
+	OfferID ids.ID `json:"offerID"`
+	// Number of active deposits created with this offer
+	ActiveDeposits utilsjson.Uint64 `json:"activeDeposits"`
+	// Amount that is still locked in active deposits
+	DepositedAmount utilsjson.Uint64 `json:"depositedAmount"`
+	// Total reward committed to active deposits, including already claimed reward
+	RewardAmount utilsjson.Uint64 `json:"rewardAmount"`
+	// Reward that was already claimed from active deposits
+	ClaimedRewardAmount utilsjson.Uint64 `json:"claimedRewardAmount"`
+	// Max amount that still can be deposited with this offer. Zero, if offer isn't limited
+	RemainingAmount utilsjson.Uint64 `json:"remainingAmount"`
+	// Max reward that still can be committed to new deposits of this offer. Zero, if offer isn't limited
+	RemainingReward utilsjson.Uint64 `json:"remainingReward"`
+}
+
+type GetDepositOfferStatsReply struct {
+	Stats []APIDepositOfferStats `json:"stats"`
+}
+
+// GetDepositOfferStats returns stats of deposits created with given deposit offers
+// and how much can still be deposited or rewarded with them.
+func (s *CaminoService) GetDepositOfferStats(_ *http.Request, args *GetDepositOfferStatsArgs, response *GetDepositOfferStatsReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetDepositOfferStats called")
+
+	var offers []*deposit.Offer
+	if len(args.OfferIDs) == 0 {
+		allOffers, err := s.vm.state.GetAllDepositOffers()
+		if err != nil {
+			return err
+		}
+		offers = allOffers
+		sort.Slice(offers, func(i, j int) bool {
+			return offers[i].ID.Less(offers[j].ID)
+		})
+	} else {
+		offers = make([]*deposit.Offer, len(args.OfferIDs))
+		for i, offerID := range args.OfferIDs {
+			offer, err := s.vm.state.GetDepositOffer(offerID)
+			if err != nil {
+				return fmt.Errorf("couldn't get deposit offer %s: %w", offerID, err)
+			}
+			offers[i] = offer
+		}
+	}
+
+	response.Stats = make([]APIDepositOfferStats, len(offers))
+	for i, offer := range offers {
+		stats, err := s.vm.state.GetDepositOfferStats(offer.ID)
+		if err != nil {
+			return err
+		}
+
+		apiStats := APIDepositOfferStats{
+			OfferID:             offer.ID,
+			ActiveDeposits:      utilsjson.Uint64(stats.ActiveDeposits),
+			DepositedAmount:     utilsjson.Uint64(stats.DepositedAmount),
+			RewardAmount:        utilsjson.Uint64(stats.RewardAmount),
+			ClaimedRewardAmount: utilsjson.Uint64(stats.ClaimedRewardAmount),
+		}
+		switch {
+		case offer.TotalMaxAmount > 0:
+			apiStats.RemainingAmount = utilsjson.Uint64(offer.RemainingAmount())
+			apiStats.RemainingReward = utilsjson.Uint64(offer.MaxRemainingRewardByTotalMaxAmount())
+		case offer.TotalMaxRewardAmount > 0:
+			apiStats.RemainingAmount = utilsjson.Uint64(offer.MaxRemainingAmountByReward())
+			apiStats.RemainingReward = utilsjson.Uint64(offer.RemainingReward())
+		}
+		response.Stats[i] = apiStats
+	}
+
+	return nil
+}
+
 func apiOfferFromOffer(offer *deposit.Offer) *APIDepositOffer {
 	return &APIDepositOffer{
 		UpgradeVersion:          offer.UpgradeVersionID.Version(),
@@ -1007,18 +2077,238 @@ func apiOfferFromOffer(offer *deposit.Offer) *APIDepositOffer {
 		TotalMaxRewardAmount:    utilsjson.Uint64(offer.TotalMaxRewardAmount),
 		RewardedAmount:          utilsjson.Uint64(offer.RewardedAmount),
 		OwnerAddress:            offer.OwnerAddress,
+		RequiredAddressState:    utilsjson.Uint64(offer.RequiredAddressState),
+		MaxAddressAmount:        utilsjson.Uint64(offer.MaxAddressAmount),
+		AllowlistRoot:           offer.AllowlistRoot,
+		EarlyUnlockAllowed:      offer.EarlyUnlockAllowed,
+		EarlyUnlockPenalty:      utilsjson.Uint64(offer.EarlyUnlockPenalty),
+	}
+}
+
+func offerFromAPIOffer(apiOffer *APIDepositOffer) *deposit.Offer {
+	return &deposit.Offer{
+		UpgradeVersionID:        codec.BuildUpgradeVersionID(apiOffer.UpgradeVersion),
+		InterestRateNominator:   uint64(apiOffer.InterestRateNominator),
+		Start:                   uint64(apiOffer.Start),
+		End:                     uint64(apiOffer.End),
+		MinAmount:               uint64(apiOffer.MinAmount),
+		TotalMaxAmount:          uint64(apiOffer.TotalMaxAmount),
+		MinDuration:             apiOffer.MinDuration,
+		MaxDuration:             apiOffer.MaxDuration,
+		UnlockPeriodDuration:    apiOffer.UnlockPeriodDuration,
+		NoRewardsPeriodDuration: apiOffer.NoRewardsPeriodDuration,
+		Memo:                    apiOffer.Memo,
+		Flags:                   deposit.OfferFlag(apiOffer.Flags),
+		TotalMaxRewardAmount:    uint64(apiOffer.TotalMaxRewardAmount),
+		OwnerAddress:            apiOffer.OwnerAddress,
+		RequiredAddressState:    uint64(apiOffer.RequiredAddressState),
+		MaxAddressAmount:        uint64(apiOffer.MaxAddressAmount),
+		AllowlistRoot:           apiOffer.AllowlistRoot,
+		EarlyUnlockAllowed:      apiOffer.EarlyUnlockAllowed,
+		EarlyUnlockPenalty:      uint64(apiOffer.EarlyUnlockPenalty),
+	}
+}
+
+type AddDepositOfferArgs struct {
+	api.UserPass
+	api.JSONFromAddrs
+
+	Change                     platformapi.Owner `json:"change"`
+	DepositOffer               APIDepositOffer   `json:"depositOffer"`
+	DepositOfferCreatorAddress string            `json:"depositOfferCreatorAddress"`
+}
+
+// AddDepositOffer issues an AddDepositOfferTx. Offer id, deposited amount and rewarded amount are ignored.
+func (s *CaminoService) AddDepositOffer(_ *http.Request, args *AddDepositOfferArgs, reply *api.JSONTxID) error {
+	s.vm.ctx.Log.Debug("Platform: AddDepositOffer called")
+
+	privKeys, err := s.getKeystoreKeys(&args.UserPass, &args.JSONFromAddrs)
+	if err != nil {
+		return err
+	}
+
+	change, err := s.secpOwnerFromAPI(&args.Change)
+	if err != nil {
+		return fmt.Errorf(errInvalidChangeAddr, err)
 	}
+
+	// Parse the offer creator address.
+	offerCreatorAddress, err := avax.ParseServiceAddress(s.addrManager, args.DepositOfferCreatorAddress)
+	if err != nil {
+		return fmt.Errorf("couldn't parse depositOfferCreatorAddress: %w", err)
+	}
+
+	// Create the transaction
+	tx, err := s.vm.txBuilder.NewAddDepositOfferTx(
+		offerFromAPIOffer(&args.DepositOffer),
+		offerCreatorAddress,
+		privKeys,
+		change,
+	)
+	if err != nil {
+		return fmt.Errorf(errCreateTx, err)
+	}
+
+	reply.TxID = tx.ID()
+
+	if err = s.vm.Builder.AddUnverifiedTx(tx); err != nil {
+		return err
+	}
+	return nil
+}
+
+type UpdateDepositOfferArgs struct {
+	api.UserPass
+	api.JSONFromAddrs
+
+	Change                     platformapi.Owner `json:"change"`
+	DepositOfferID             ids.ID            `json:"depositOfferID"`
+	Locked                     bool              `json:"locked"`
+	End                        utilsjson.Uint64  `json:"end"`
+	TotalMaxAmount             utilsjson.Uint64  `json:"totalMaxAmount"`
+	TotalMaxRewardAmount       utilsjson.Uint64  `json:"totalMaxRewardAmount"`
+	DepositOfferUpdaterAddress string            `json:"depositOfferUpdaterAddress"`
+}
+
+// UpdateDepositOffer issues an UpdateDepositOfferTx.
+func (s *CaminoService) UpdateDepositOffer(_ *http.Request, args *UpdateDepositOfferArgs, reply *api.JSONTxID) error {
+	s.vm.ctx.Log.Debug("Platform: UpdateDepositOffer called")
+
+	privKeys, err := s.getKeystoreKeys(&args.UserPass, &args.JSONFromAddrs)
+	if err != nil {
+		return err
+	}
+
+	change, err := s.secpOwnerFromAPI(&args.Change)
+	if err != nil {
+		return fmt.Errorf(errInvalidChangeAddr, err)
+	}
+
+	// Parse the offer updater address.
+	offerUpdaterAddress, err := avax.ParseServiceAddress(s.addrManager, args.DepositOfferUpdaterAddress)
+	if err != nil {
+		return fmt.Errorf("couldn't parse depositOfferUpdaterAddress: %w", err)
+	}
+
+	flags := deposit.OfferFlagNone
+	if args.Locked {
+		flags = deposit.OfferFlagLocked
+	}
+
+	// Create the transaction
+	tx, err := s.vm.txBuilder.NewUpdateDepositOfferTx(
+		args.DepositOfferID,
+		flags,
+		uint64(args.End),
+		uint64(args.TotalMaxAmount),
+		uint64(args.TotalMaxRewardAmount),
+		offerUpdaterAddress,
+		privKeys,
+		change,
+	)
+	if err != nil {
+		return fmt.Errorf(errCreateTx, err)
+	}
+
+	reply.TxID = tx.ID()
+
+	if err = s.vm.Builder.AddUnverifiedTx(tx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// IssueTx issues a tx. Partially signed tx will be issued only if it has all required signatures,
+// otherwise error with missing signers of each incomplete credential will be returned.
+func (s *CaminoService) IssueTx(req *http.Request, args *api.FormattedTx, response *api.JSONTxID) error {
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+	if !txs.IsPartiallySignedTx(txBytes) {
+		return s.Service.IssueTx(req, args, response)
+	}
+
+	s.vm.ctx.Log.Debug("Platform: IssueTx called with partially signed tx")
+
+	ptx, err := txs.ParsePartiallySignedTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	missingSigners, err := ptx.MissingSigners()
+	if err != nil {
+		return fmt.Errorf("couldn't verify partially signed tx: %w", err)
+	}
+	if len(missingSigners) > 0 {
+		return s.missingSignersError(missingSigners)
+	}
+
+	tx, err := ptx.SignedTx()
+	if err != nil {
+		return fmt.Errorf("couldn't create signed tx: %w", err)
+	}
+	if err := s.vm.Builder.AddUnverifiedTx(tx); err != nil {
+		return fmt.Errorf("couldn't issue tx: %w", err)
+	}
+
+	response.TxID = tx.ID()
+	return nil
+}
+
+func (s *CaminoService) missingSignersError(missingSigners map[int][]ids.ShortID) error {
+	credIndices := make([]int, 0, len(missingSigners))
+	for credIndex := range missingSigners {
+		credIndices = append(credIndices, credIndex)
+	}
+	sort.Ints(credIndices)
+
+	report := make([]string, len(credIndices))
+	for i, credIndex := range credIndices {
+		addrs := make([]string, len(missingSigners[credIndex]))
+		for j, addr := range missingSigners[credIndex] {
+			addrStr, err := s.addrManager.FormatLocalAddress(addr)
+			if err != nil {
+				return err
+			}
+			addrs[j] = addrStr
+		}
+		report[i] = fmt.Sprintf("credential %d: [%s]", credIndex, strings.Join(addrs, ", "))
+	}
+	return fmt.Errorf("%w: %s", txs.ErrMissingSigners, strings.Join(report, "; "))
+}
+
+type GetBaseFeeReply struct {
+	BaseFee utilsjson.Uint64 `json:"baseFee"`
+}
+
+// GetBaseFee returns current base fee, which is burned by camino txs.
+// Base fee could be changed by dao proposal, so it can differ from static tx fee.
+func (s *CaminoService) GetBaseFee(_ *http.Request, _ *struct{}, response *GetBaseFeeReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetBaseFee called")
+
+	baseFee, err := s.vm.state.GetBaseFee()
+	if err != nil {
+		return err
+	}
+	response.BaseFee = utilsjson.Uint64(baseFee)
+	return nil
 }
 
 type GetUpgradePhasesReply struct {
 	AthensPhase utilsjson.Uint32 `json:"athensPhase"`
+	BerlinPhase utilsjson.Uint32 `json:"berlinPhase"`
 }
 
 func (s *CaminoService) GetUpgradePhases(_ *http.Request, _ *struct{}, response *GetUpgradePhasesReply) error {
 	s.vm.ctx.Log.Debug("Platform: GetUpgradePhases called")
 
-	if s.vm.Config.IsAthensPhaseActivated(s.vm.state.GetTimestamp()) {
+	chainTime := s.vm.state.GetTimestamp()
+	if s.vm.Config.IsAthensPhaseActivated(chainTime) {
 		response.AthensPhase = 1
 	}
+	if s.vm.Config.IsBerlinPhaseActivated(chainTime) {
+		response.BerlinPhase = 1
+	}
 	return nil
 }
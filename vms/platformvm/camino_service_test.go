@@ -7,9 +7,13 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	json_api "github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/api/keystore"
+	"github.com/ava-labs/avalanchego/cache"
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/manager"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
@@ -19,11 +23,17 @@ import (
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/version"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/platformvm/api"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/stretchr/testify/require"
+
+	platformapi "github.com/ava-labs/avalanchego/vms/platformvm/api"
 )
 
 // Test method GetBalance in CaminoService
@@ -186,6 +196,9 @@ func defaultCaminoService(t *testing.T, camino api.Camino, utxos []api.UTXO) *Ca
 		Service: Service{
 			vm:          vm,
 			addrManager: avax.NewAddressManager(vm.ctx),
+			stakerAttributesCache: &cache.LRU[ids.ID, *stakerAttributes]{
+				Size: stakerAttributesCacheSize,
+			},
 		},
 	}
 }
@@ -419,3 +432,568 @@ func TestSpend(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "0x00000000000100000000000000000000000100000001fceda8f90fcb5d30614b99d79fc4baa2930776262dcf0a4e", spendReply.Owners)
 }
+
+func TestGetDepositSchedule(t *testing.T) {
+	chainTime := uint64(1000)
+	offer := &deposit.Offer{
+		ID:                    ids.ID{1},
+		InterestRateNominator: 100_000, // 10% per year
+		Start:                 0,
+		End:                   2000,
+		MinAmount:             1,
+		MinDuration:           10,
+		MaxDuration:           100,
+		UnlockPeriodDuration:  10,
+	}
+	depositTxID := ids.ID{2}
+	existingDeposit := &deposit.Deposit{
+		DepositOfferID: offer.ID,
+		Start:          chainTime - 50,
+		Duration:       100,
+		Amount:         365 * 24 * 60 * 60 * 1000,
+		RewardOwner:    &secp256k1fx.OutputOwners{},
+	}
+	rewardPerSecond := uint64(100)
+
+	tests := map[string]struct {
+		args          *GetDepositScheduleArgs
+		expectedReply *GetDepositScheduleReply
+		expectedErr   error
+	}{
+		"OK: existing deposit": {
+			args: &GetDepositScheduleArgs{
+				DepositTxID: depositTxID,
+				Interval:    30,
+			},
+			expectedReply: &GetDepositScheduleReply{
+				TotalReward: json.Uint64(100 * rewardPerSecond),
+				Schedule: []*APIDepositSchedulePoint{
+					{Timestamp: 1000, ClaimableReward: json.Uint64(50 * rewardPerSecond)},
+					{Timestamp: 1030, ClaimableReward: json.Uint64(80 * rewardPerSecond)},
+					{
+						Timestamp:        1050,
+						ClaimableReward:  json.Uint64(100 * rewardPerSecond),
+						UnlockableAmount: json.Uint64(existingDeposit.Amount),
+					},
+				},
+			},
+		},
+		"OK: hypothetical deposit": {
+			args: &GetDepositScheduleArgs{
+				DepositOfferID: offer.ID,
+				Amount:         json.Uint64(existingDeposit.Amount),
+				Duration:       20,
+				Start:          1500,
+				Interval:       15,
+			},
+			expectedReply: &GetDepositScheduleReply{
+				TotalReward: json.Uint64(20 * rewardPerSecond),
+				Schedule: []*APIDepositSchedulePoint{
+					{Timestamp: 1500},
+					{
+						Timestamp:        1515,
+						ClaimableReward:  json.Uint64(15 * rewardPerSecond),
+						UnlockableAmount: json.Uint64(existingDeposit.Amount / 2),
+					},
+					{
+						Timestamp:        1520,
+						ClaimableReward:  json.Uint64(20 * rewardPerSecond),
+						UnlockableAmount: json.Uint64(existingDeposit.Amount),
+					},
+				},
+			},
+		},
+		"Fail: hypothetical deposit duration is too big": {
+			args: &GetDepositScheduleArgs{
+				DepositOfferID: offer.ID,
+				Amount:         1,
+				Duration:       101,
+				Interval:       1,
+			},
+			expectedReply: &GetDepositScheduleReply{},
+			expectedErr:   errDepositDurationNotInRange,
+		},
+		"Fail: hypothetical deposit offer is inactive": {
+			args: &GetDepositScheduleArgs{
+				DepositOfferID: offer.ID,
+				Amount:         1,
+				Duration:       10,
+				Start:          2001,
+				Interval:       1,
+			},
+			expectedReply: &GetDepositScheduleReply{},
+			expectedErr:   errDepositOfferInactive,
+		},
+		"Fail: zero interval": {
+			args:          &GetDepositScheduleArgs{DepositTxID: depositTxID},
+			expectedReply: &GetDepositScheduleReply{},
+			expectedErr:   errZeroScheduleInterval,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			service := defaultCaminoService(t, api.Camino{}, []api.UTXO{})
+			service.vm.state.SetTimestamp(time.Unix(int64(chainTime), 0))
+			service.vm.state.SetDepositOffer(offer)
+			service.vm.state.AddDeposit(depositTxID, existingDeposit)
+
+			reply := &GetDepositScheduleReply{}
+			err := service.GetDepositSchedule(nil, tt.args, reply)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expectedReply, reply)
+		})
+	}
+}
+
+func TestGetAddressStateHistory(t *testing.T) {
+	address := ids.ShortID{1}
+	executor := ids.ShortID{2}
+	changes := []*state.AddressStateChange{
+		{TxID: ids.ID{1}, Executor: executor, Bit: txs.AddressStateBitConsortium},
+		{TxID: ids.ID{2}, Bit: txs.AddressStateBitKYCVerified},
+		{TxID: ids.ID{3}, Executor: executor, Bit: txs.AddressStateBitConsortium, Remove: true},
+	}
+
+	service := defaultCaminoService(t, api.Camino{}, []api.UTXO{})
+	for _, change := range changes {
+		service.vm.state.AddAddressStateChange(address, change)
+	}
+	addressStr, err := service.addrManager.FormatLocalAddress(address)
+	require.NoError(t, err)
+	executorStr, err := service.addrManager.FormatLocalAddress(executor)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		args          *GetAddressStateHistoryArgs
+		expectedReply *GetAddressStateHistoryReply
+	}{
+		"OK: first page": {
+			args: &GetAddressStateHistoryArgs{Address: addressStr, Limit: 2},
+			expectedReply: &GetAddressStateHistoryReply{
+				Changes: []APIAddressStateChange{
+					{TxID: ids.ID{1}, Executor: executorStr, Bit: txs.AddressStateBitConsortium},
+					{TxID: ids.ID{2}, Bit: txs.AddressStateBitKYCVerified},
+				},
+				EndIndex: 2,
+			},
+		},
+		"OK: last page": {
+			args: &GetAddressStateHistoryArgs{Address: addressStr, StartIndex: 2, Limit: 2},
+			expectedReply: &GetAddressStateHistoryReply{
+				Changes: []APIAddressStateChange{
+					{TxID: ids.ID{3}, Executor: executorStr, Bit: txs.AddressStateBitConsortium, Remove: true},
+				},
+				EndIndex: 3,
+			},
+		},
+		"OK: no more changes": {
+			args: &GetAddressStateHistoryArgs{Address: addressStr, StartIndex: 3},
+			expectedReply: &GetAddressStateHistoryReply{
+				Changes:  []APIAddressStateChange{},
+				EndIndex: 3,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			reply := &GetAddressStateHistoryReply{}
+			require.NoError(t, service.GetAddressStateHistory(nil, tt.args, reply))
+			require.Equal(t, tt.expectedReply, reply)
+		})
+	}
+}
+
+func TestGetMultisigAlias(t *testing.T) {
+	service := defaultCaminoService(t, api.Camino{}, []api.UTXO{})
+
+	ownerAddr := ids.ShortID{11}
+	newOwnerAddr := ids.ShortID{12}
+	ownerAddrStr, err := service.addrManager.FormatLocalAddress(ownerAddr)
+	require.NoError(t, err)
+	newOwnerAddrStr, err := service.addrManager.FormatLocalAddress(newOwnerAddr)
+	require.NoError(t, err)
+
+	alias := &multisig.AliasWithNonce{Alias: multisig.Alias{
+		UpgradeVersionID: codec.UpgradeVersion1,
+		ID:               ids.ShortID{1},
+		Memo:             []byte("memo"),
+		Owners: &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ownerAddr},
+		},
+		OwnersChangeThreshold: 1,
+		OwnersChangeDelay:     100,
+	}}
+	aliasWithPendingChange := &multisig.AliasWithNonce{Alias: alias.Alias}
+	aliasWithPendingChange.ID = ids.ShortID{2}
+	service.vm.state.SetMultisigAlias(alias)
+	service.vm.state.SetMultisigAlias(aliasWithPendingChange)
+	service.vm.state.SetMultisigAliasChange(aliasWithPendingChange.ID, &multisig.AliasChange{
+		Alias: multisig.Alias{
+			ID:   aliasWithPendingChange.ID,
+			Memo: []byte("new memo"),
+			Owners: &secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{newOwnerAddr},
+			},
+		},
+		EffectiveTime: 200,
+	})
+
+	aliasStr, err := service.addrManager.FormatLocalAddress(alias.ID)
+	require.NoError(t, err)
+	aliasWithPendingChangeStr, err := service.addrManager.FormatLocalAddress(aliasWithPendingChange.ID)
+	require.NoError(t, err)
+
+	expectedAliasReply := GetMultisigAliasReply{
+		Memo: alias.Memo,
+		Owner: platformapi.Owner{
+			Threshold: 1,
+			Addresses: []string{ownerAddrStr},
+		},
+		APIMultisigAliasPolicy: APIMultisigAliasPolicy{
+			OwnersChangeThreshold: 1,
+			OwnersChangeDelay:     100,
+		},
+	}
+	expectedAliasWithPendingChangeReply := expectedAliasReply
+	expectedAliasWithPendingChangeReply.PendingChange = &APIMultisigAliasChange{
+		Memo: []byte("new memo"),
+		Owner: platformapi.Owner{
+			Threshold: 1,
+			Addresses: []string{newOwnerAddrStr},
+		},
+		EffectiveTime: 200,
+	}
+
+	tests := map[string]struct {
+		alias         string
+		expectedReply *GetMultisigAliasReply
+	}{
+		"OK": {
+			alias:         aliasStr,
+			expectedReply: &expectedAliasReply,
+		},
+		"OK: alias with pending change": {
+			alias:         aliasWithPendingChangeStr,
+			expectedReply: &expectedAliasWithPendingChangeReply,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			reply := &GetMultisigAliasReply{}
+			require.NoError(t, service.GetMultisigAlias(nil, &json_api.JSONAddress{Address: tt.alias}, reply))
+			require.Equal(t, tt.expectedReply, reply)
+		})
+	}
+}
+
+func TestGetMultisigAliasesForAddress(t *testing.T) {
+	service := defaultCaminoService(t, api.Camino{}, []api.UTXO{})
+
+	ownerAddr := ids.ShortID{11}
+	otherAddr := ids.ShortID{12}
+	newAlias := func(aliasID ids.ShortID, owners ...ids.ShortID) *multisig.AliasWithNonce {
+		return &multisig.AliasWithNonce{Alias: multisig.Alias{
+			ID:     aliasID,
+			Owners: &secp256k1fx.OutputOwners{Threshold: 1, Addrs: owners},
+		}}
+	}
+	alias1 := newAlias(ids.ShortID{1}, ownerAddr, otherAddr)
+	alias2 := newAlias(ids.ShortID{2}, otherAddr)
+	alias3 := newAlias(ids.ShortID{3}, alias1.ID, otherAddr)
+	alias5ID := ids.ShortID{5}
+	alias4 := newAlias(ids.ShortID{4}, alias3.ID, alias5ID)
+	alias5 := newAlias(alias5ID, alias4.ID) // cycle: alias4 and alias5 own each other
+	for _, alias := range []*multisig.AliasWithNonce{alias1, alias2, alias3, alias4, alias5} {
+		service.vm.state.SetMultisigAlias(alias)
+	}
+
+	formatAddrs := func(addrs ...ids.ShortID) []string {
+		addrStrs := make([]string, len(addrs))
+		for i, addr := range addrs {
+			addrStr, err := service.addrManager.FormatLocalAddress(addr)
+			require.NoError(t, err)
+			addrStrs[i] = addrStr
+		}
+		return addrStrs
+	}
+
+	tests := map[string]struct {
+		address         ids.ShortID
+		expectedAliases []string
+	}{
+		"OK: nested aliases": {
+			address:         ownerAddr,
+			expectedAliases: formatAddrs(alias1.ID, alias3.ID, alias4.ID, alias5.ID),
+		},
+		"OK: nested alias": {
+			address:         alias4.ID,
+			expectedAliases: formatAddrs(alias5.ID),
+		},
+		"OK: no aliases": {
+			address:         ids.ShortID{13},
+			expectedAliases: []string{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			reply := &GetMultisigAliasesForAddressReply{}
+			require.NoError(t, service.GetMultisigAliasesForAddress(nil, &json_api.JSONAddress{
+				Address: formatAddrs(tt.address)[0],
+			}, reply))
+			require.Equal(t, tt.expectedAliases, reply.Aliases)
+		})
+	}
+}
+
+func TestGetRewardRestakeSetting(t *testing.T) {
+	owner := &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{1}}}
+	otherOwner := &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{2}}}
+	setting := &state.RewardRestakeSetting{DepositOfferID: ids.ID{1}, DepositDuration: 100}
+
+	service := defaultCaminoService(t, api.Camino{}, []api.UTXO{})
+	ownerID, err := txs.GetOwnerID(owner)
+	require.NoError(t, err)
+	service.vm.state.SetRewardRestakeSetting(ownerID, setting)
+
+	apiOwner, err := service.apiOwnerFromSECP(owner)
+	require.NoError(t, err)
+	apiOtherOwner, err := service.apiOwnerFromSECP(otherOwner)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		args          *GetRewardRestakeSettingArgs
+		expectedReply *GetRewardRestakeSettingReply
+		expectedErr   error
+	}{
+		"OK": {
+			args: &GetRewardRestakeSettingArgs{ClaimableOwner: *apiOwner},
+			expectedReply: &GetRewardRestakeSettingReply{
+				DepositOfferID:  setting.DepositOfferID,
+				DepositDuration: setting.DepositDuration,
+			},
+		},
+		"OK: no setting": {
+			args:          &GetRewardRestakeSettingArgs{ClaimableOwner: *apiOtherOwner},
+			expectedReply: &GetRewardRestakeSettingReply{},
+		},
+		"Fail: empty owner": {
+			args:          &GetRewardRestakeSettingArgs{},
+			expectedReply: &GetRewardRestakeSettingReply{},
+			expectedErr:   errNoClaimableOwner,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			reply := &GetRewardRestakeSettingReply{}
+			require.ErrorIs(t, service.GetRewardRestakeSetting(nil, tt.args, reply), tt.expectedErr)
+			require.Equal(t, tt.expectedReply, reply)
+		})
+	}
+}
+
+func TestGetBaseFee(t *testing.T) {
+	service := defaultCaminoService(t, api.Camino{}, []api.UTXO{})
+
+	reply := &GetBaseFeeReply{}
+	require.NoError(t, service.GetBaseFee(nil, nil, reply))
+	require.Equal(t, json.Uint64(service.vm.Config.TxFee), reply.BaseFee)
+
+	service.vm.state.SetBaseFee(service.vm.Config.TxFee + 1)
+
+	reply = &GetBaseFeeReply{}
+	require.NoError(t, service.GetBaseFee(nil, nil, reply))
+	require.Equal(t, json.Uint64(service.vm.Config.TxFee+1), reply.BaseFee)
+}
+
+func TestGetCurrentValidatorsWithCaminoDelegator(t *testing.T) {
+	service := defaultCaminoService(t, api.Camino{LockModeBondDeposit: true}, []api.UTXO{})
+
+	currentStakerIterator, err := service.vm.state.GetCurrentStakerIterator()
+	require.NoError(t, err)
+	require.True(t, currentStakerIterator.Next())
+	validator := currentStakerIterator.Value()
+	currentStakerIterator.Release()
+
+	rewardOwner := &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{1}}}
+	delegatorTx, err := txs.NewSigned(&txs.CaminoAddDelegatorTx{AddDelegatorTx: txs.AddDelegatorTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    service.vm.ctx.NetworkID,
+			BlockchainID: service.vm.ctx.ChainID,
+		}},
+		Validator: txs.Validator{
+			NodeID: validator.NodeID,
+			Start:  uint64(validator.StartTime.Unix()),
+			End:    uint64(validator.EndTime.Unix()),
+			Wght:   100,
+		},
+		DelegationRewardsOwner: rewardOwner,
+	}}, txs.Codec, nil)
+	require.NoError(t, err)
+	delegator, err := state.NewCurrentStaker(delegatorTx.ID(), delegatorTx.Unsigned.(*txs.CaminoAddDelegatorTx), 10)
+	require.NoError(t, err)
+	service.vm.state.AddTx(delegatorTx, status.Committed)
+	service.vm.state.PutCurrentDelegator(delegator)
+
+	reply := GetCurrentValidatorsReply{}
+	require.NoError(t, service.GetCurrentValidators(nil, &GetCurrentValidatorsArgs{
+		SubnetID: constants.PrimaryNetworkID,
+		NodeIDs:  []ids.NodeID{validator.NodeID},
+	}, &reply))
+	require.Len(t, reply.Validators, 1)
+
+	vdr, ok := reply.Validators[0].(platformapi.PermissionlessValidator)
+	require.True(t, ok)
+	require.Equal(t, json.Uint64(1), *vdr.DelegatorCount)
+	require.Equal(t, json.Uint64(100), *vdr.DelegatorWeight)
+	require.Len(t, *vdr.Delegators, 1)
+
+	apiDelegator := (*vdr.Delegators)[0]
+	require.Equal(t, delegatorTx.ID(), apiDelegator.TxID)
+	require.Equal(t, json.Uint64(10), *apiDelegator.PotentialReward)
+	apiRewardOwner, err := service.apiOwnerFromSECP(rewardOwner)
+	require.NoError(t, err)
+	require.Equal(t, apiRewardOwner.Addresses, apiDelegator.RewardOwner.Addresses)
+}
+
+func TestGetDeferredValidators(t *testing.T) {
+	service := defaultCaminoService(t, api.Camino{LockModeBondDeposit: true}, []api.UTXO{})
+	executor := ids.ShortID{1}
+	executorStr, err := service.addrManager.FormatLocalAddress(executor)
+	require.NoError(t, err)
+
+	currentStakerIterator, err := service.vm.state.GetCurrentStakerIterator()
+	require.NoError(t, err)
+	require.True(t, currentStakerIterator.Next())
+	validator := currentStakerIterator.Value()
+	currentStakerIterator.Release()
+
+	deferTx, err := txs.NewSigned(&txs.AddressStateTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    service.vm.ctx.NetworkID,
+			BlockchainID: service.vm.ctx.ChainID,
+			Memo:         []byte("node is offline"),
+		}},
+		Address: ids.ShortID{2},
+		State:   txs.AddressStateBitNodeDeferred,
+	}, txs.Codec, nil)
+	require.NoError(t, err)
+	chainTime := service.vm.state.GetTimestamp()
+	service.vm.state.AddTx(deferTx, status.Committed)
+	service.vm.state.DeleteCurrentValidator(validator)
+	service.vm.state.PutDeferredValidator(validator)
+	service.vm.state.SetDeferredValidatorInfo(validator.TxID, &state.DeferredValidatorInfo{
+		TxID:      deferTx.ID(),
+		Executor:  executor,
+		Timestamp: uint64(chainTime.Unix()),
+	})
+
+	expectedValidator := APIDeferredValidator{
+		TxID:               validator.TxID,
+		NodeID:             validator.NodeID,
+		StartTime:          json.Uint64(validator.StartTime.Unix()),
+		EndTime:            json.Uint64(validator.EndTime.Unix()),
+		Weight:             json.Uint64(validator.Weight),
+		DeferredAt:         json.Uint64(chainTime.Unix()),
+		DeferredBy:         executorStr,
+		DeferTxID:          deferTx.ID(),
+		Reason:             "node is offline",
+		RemainingStakeTime: json.Uint64(validator.EndTime.Sub(chainTime) / time.Second),
+	}
+
+	tests := map[string]struct {
+		args          *GetDeferredValidatorsArgs
+		expectedReply *GetDeferredValidatorsReply
+	}{
+		"OK": {
+			args:          &GetDeferredValidatorsArgs{},
+			expectedReply: &GetDeferredValidatorsReply{Validators: []APIDeferredValidator{expectedValidator}},
+		},
+		"OK: filtered by nodeID": {
+			args:          &GetDeferredValidatorsArgs{NodeIDs: []ids.NodeID{validator.NodeID}},
+			expectedReply: &GetDeferredValidatorsReply{Validators: []APIDeferredValidator{expectedValidator}},
+		},
+		"OK: no deferred validators with given nodeID": {
+			args:          &GetDeferredValidatorsArgs{NodeIDs: []ids.NodeID{{1}}},
+			expectedReply: &GetDeferredValidatorsReply{Validators: []APIDeferredValidator{}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			reply := &GetDeferredValidatorsReply{}
+			require.NoError(t, service.GetDeferredValidators(nil, tt.args, reply))
+			require.Equal(t, tt.expectedReply, reply)
+		})
+	}
+}
+
+func TestGetDepositOfferStats(t *testing.T) {
+	service := defaultCaminoService(t, api.Camino{LockModeBondDeposit: true}, []api.UTXO{})
+	chainTime := uint64(service.vm.state.GetTimestamp().Unix())
+
+	unlimitedOffer := &deposit.Offer{
+		ID:                    ids.ID{1},
+		InterestRateNominator: 1_000_000 * 365 * 24 * 60 * 60,
+		End:                   chainTime + 1000,
+		MaxDuration:           100,
+	}
+	limitedOffer := &deposit.Offer{
+		UpgradeVersionID:      codec.UpgradeVersion1,
+		ID:                    ids.ID{2},
+		InterestRateNominator: 1_000_000 * 365 * 24 * 60 * 60,
+		End:                   chainTime + 1000,
+		MaxDuration:           100,
+		TotalMaxAmount:        1000,
+		DepositedAmount:       100,
+	}
+	service.vm.state.SetDepositOffer(unlimitedOffer)
+	service.vm.state.SetDepositOffer(limitedOffer)
+	rewardOwner := &secp256k1fx.OutputOwners{Addrs: []ids.ShortID{{1}}}
+	service.vm.state.AddDeposit(ids.ID{1, 1}, &deposit.Deposit{
+		DepositOfferID:      limitedOffer.ID,
+		Start:               chainTime,
+		Duration:            100,
+		Amount:              100,
+		UnlockedAmount:      10,
+		ClaimedRewardAmount: 5,
+		RewardOwner:         rewardOwner,
+	})
+	require.NoError(t, service.vm.state.Commit())
+
+	unlimitedOfferStats := APIDepositOfferStats{OfferID: unlimitedOffer.ID}
+	limitedOfferStats := APIDepositOfferStats{
+		OfferID:             limitedOffer.ID,
+		ActiveDeposits:      1,
+		DepositedAmount:     90,
+		RewardAmount:        100 * 100,
+		ClaimedRewardAmount: 5,
+		RemainingAmount:     900,
+		RemainingReward:     900 * 100,
+	}
+
+	tests := map[string]struct {
+		args          *GetDepositOfferStatsArgs
+		expectedReply *GetDepositOfferStatsReply
+		expectedErr   error
+	}{
+		"OK": {
+			args: &GetDepositOfferStatsArgs{OfferIDs: []ids.ID{limitedOffer.ID, unlimitedOffer.ID}},
+			expectedReply: &GetDepositOfferStatsReply{
+				Stats: []APIDepositOfferStats{limitedOfferStats, unlimitedOfferStats},
+			},
+		},
+		"Fail: offer doesn't exist": {
+			args:          &GetDepositOfferStatsArgs{OfferIDs: []ids.ID{{3}}},
+			expectedReply: &GetDepositOfferStatsReply{},
+			expectedErr:   database.ErrNotFound,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			reply := &GetDepositOfferStatsReply{}
+			require.ErrorIs(t, service.GetDepositOfferStats(nil, tt.args, reply), tt.expectedErr)
+			require.Equal(t, tt.expectedReply, reply)
+		})
+	}
+}
@@ -23,11 +23,13 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/utxo"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 
 	smcon "github.com/ava-labs/avalanchego/snow/consensus/snowman"
@@ -229,6 +231,14 @@ func TestRemoveDeferredValidator(t *testing.T) {
 	ownerState, _ = vm.state.GetAddressStates(consortiumMemberKey.Address())
 	require.Equal(ownerState, txs.AddressStateConsortiumMember)
 
+	// Verify that deferred state removal is recorded in address state history
+	history, err := vm.state.GetAddressStateHistory(consortiumMemberKey.Address(), 0, 10)
+	require.NoError(err)
+	require.Len(history, 3) // consortium member set, deferred set, deferred removed
+	require.Equal(txID, history[2].TxID)
+	require.Equal(txs.AddressStateBitNodeDeferred, history[2].Bit)
+	require.True(history[2].Remove)
+
 	timestamp := vm.state.GetTimestamp()
 	require.Equal(endTime.Unix(), timestamp.Unix())
 }
@@ -528,6 +538,76 @@ func TestDepositsAutoUnlock(t *testing.T) {
 	require.ErrorIs(err, database.ErrNotFound)
 }
 
+func TestRestakedDepositAutoUnlock(t *testing.T) {
+	require := require.New(t)
+
+	_, rewardOwnerAddr, rewardOwner := generateKeyAndOwner(t)
+	ownerID, err := txs.GetOwnerID(rewardOwner)
+	require.NoError(err)
+
+	depositOffer := &deposit.Offer{
+		End:                   uint64(defaultGenesisTime.Unix() + 365*24*60*60 + 1),
+		MinAmount:             1,
+		MaxDuration:           100,
+		InterestRateNominator: 1_000_000 * 365 * 24 * 60 * 60, // 100% per year
+	}
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+		DepositOffers:       []*deposit.Offer{depositOffer},
+	}
+	require.NoError(genesis.SetDepositOfferID(caminoGenesisConf.DepositOffers[0]))
+
+	vm := newCaminoVM(caminoGenesisConf, []api.UTXO{})
+	vm.ctx.Lock.Lock()
+	defer func() { require.NoError(vm.Shutdown(context.Background())) }() //nolint:lint
+
+	// Add deposit the same way as validator reward restake does:
+	// deposit id is derived from rewards import tx id and there is no tx with that id
+	restakedDepositID := ids.GenerateTestID().Prefix(0)
+	restakedDeposit := &deposit.Deposit{
+		DepositOfferID: depositOffer.ID,
+		Duration:       depositOffer.MaxDuration,
+		Amount:         10000,
+		Start:          uint64(vm.state.GetTimestamp().Unix()),
+		RewardOwner:    &rewardOwner,
+	}
+	vm.state.AddDeposit(restakedDepositID, restakedDeposit)
+	require.NoError(utxo.ProduceLocked(vm.state, restakedDepositID, []*avax.TransferableOutput{{
+		Asset: avax.Asset{ID: vm.ctx.AVAXAssetID},
+		Out: &locked.Out{
+			IDs: locked.IDs{DepositTxID: locked.ThisTxID},
+			TransferableOut: &secp256k1fx.TransferOutput{
+				Amt:          restakedDeposit.Amount,
+				OutputOwners: rewardOwner,
+			},
+		},
+	}}, locked.StateDeposited))
+	require.NoError(vm.state.Commit())
+	_, _, err = vm.state.GetTx(restakedDepositID)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	// Fast-forward clock to time for deposit to be unlocked
+	vm.clock.Set(restakedDeposit.EndTime())
+	blk := buildAndAcceptBlock(t, vm, nil)
+	require.Len(blk.Txs(), 1)
+	_, ok := blk.Txs()[0].Unsigned.(*txs.UnlockDepositTx)
+	require.True(ok)
+
+	// Verify that the deposit is unlocked and its reward is claimable
+	_, err = vm.state.GetDeposit(restakedDepositID)
+	require.ErrorIs(err, database.ErrNotFound)
+	claimable, err := vm.state.GetClaimable(ownerID)
+	require.NoError(err)
+	require.Equal(&state.Claimable{
+		Owner:                &rewardOwner,
+		ExpiredDepositReward: restakedDeposit.TotalReward(depositOffer),
+	}, claimable)
+	require.Equal(restakedDeposit.Amount, getUnlockedBalance(t, vm.state, rewardOwnerAddr))
+	_, err = vm.state.GetNextToUnlockDepositTime(nil)
+	require.ErrorIs(err, database.ErrNotFound)
+}
+
 func buildAndAcceptBlock(t *testing.T, vm *VM, tx *txs.Tx) blocks.Block {
 	if tx != nil {
 		require.NoError(t, vm.Builder.AddUnverifiedTx(tx))
@@ -112,6 +112,9 @@ type Config struct {
 	// Time of the Athens Phase network upgrade
 	AthensPhaseTime time.Time
 
+	// Time of the Berlin Phase network upgrade
+	BerlinPhaseTime time.Time
+
 	// Subnet ID --> Minimum portion of the subnet's stake this node must be
 	// connected to in order to report healthy.
 	// [constants.PrimaryNetworkID] is always a key in this map.
@@ -149,6 +152,10 @@ func (c *Config) IsAthensPhaseActivated(timestamp time.Time) bool {
 	return !timestamp.Before(c.AthensPhaseTime)
 }
 
+func (c *Config) IsBerlinPhaseActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.BerlinPhaseTime)
+}
+
 func (c *Config) GetCreateBlockchainTxFee(timestamp time.Time) uint64 {
 	if c.IsApricotPhase3Activated(timestamp) {
 		return c.CreateBlockchainTxFee
@@ -0,0 +1,117 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+const baseFeeProposalMaxOptionsCount = 3
+
+var (
+	_ Proposal      = (*BaseFeeProposal)(nil)
+	_ ProposalState = (*BaseFeeProposalState)(nil)
+
+	errZeroFee            = errors.New("base fee option is zero")
+	errWrongOptionsCount  = errors.New("wrong options count")
+	errNotUniqueFeeOption = errors.New("base fee options are not unique")
+)
+
+// BaseFeeProposal is a proposal to change base fee, that is burned by camino transactions
+type BaseFeeProposal struct {
+	Options []uint64 `serialize:"true" json:"options"` // New base fee options
+	Start   uint64   `serialize:"true" json:"start"`   // Start time of proposal
+	End     uint64   `serialize:"true" json:"end"`     // End time of proposal
+}
+
+func (p *BaseFeeProposal) StartTime() time.Time {
+	return time.Unix(int64(p.Start), 0)
+}
+
+func (p *BaseFeeProposal) EndTime() time.Time {
+	return time.Unix(int64(p.End), 0)
+}
+
+func (p *BaseFeeProposal) Verify() error {
+	switch {
+	case len(p.Options) == 0 || len(p.Options) > baseFeeProposalMaxOptionsCount:
+		return errWrongOptionsCount
+	case p.Start >= p.End:
+		return errEndNotAfterStart
+	}
+
+	unique := set.NewSet[uint64](len(p.Options))
+	for _, fee := range p.Options {
+		if fee == 0 {
+			return errZeroFee
+		}
+		if unique.Contains(fee) {
+			return errNotUniqueFeeOption
+		}
+		unique.Add(fee)
+	}
+	return nil
+}
+
+func (p *BaseFeeProposal) CreateProposalState(allowedVoters []ids.ShortID) ProposalState {
+	return &BaseFeeProposalState{
+		SimpleVoteOptions: newSimpleVoteOptions(p.Options, allowedVoters),
+		Start:             p.Start,
+		End:               p.End,
+	}
+}
+
+func (p *BaseFeeProposal) Visit(visitor VerifierVisitor) error {
+	return visitor.BaseFeeProposal(p)
+}
+
+type BaseFeeProposalState struct {
+	SimpleVoteOptions[uint64] `serialize:"true"`
+
+	Start uint64 `serialize:"true"`
+	End   uint64 `serialize:"true"`
+}
+
+func (p *BaseFeeProposalState) EndTime() time.Time {
+	return time.Unix(int64(p.End), 0)
+}
+
+func (p *BaseFeeProposalState) IsActiveAt(time time.Time) bool {
+	return isActiveAt(p.Start, p.End, time)
+}
+
+func (p *BaseFeeProposalState) CanBeFinished() bool {
+	return p.canBeFinished()
+}
+
+func (p *BaseFeeProposalState) IsSuccessful() bool {
+	_, decided := p.mostVotedOption()
+	return decided
+}
+
+// Fee returns base fee option with most votes
+func (p *BaseFeeProposalState) Fee() uint64 {
+	mostVotedIndex, _ := p.mostVotedOption()
+	return p.Options[mostVotedIndex].Value
+}
+
+func (p *BaseFeeProposalState) AddVote(voterAddress ids.ShortID, vote Vote) (ProposalState, error) {
+	newOptions, err := p.addVote(voterAddress, vote)
+	if err != nil {
+		return nil, err
+	}
+	return &BaseFeeProposalState{
+		SimpleVoteOptions: newOptions,
+		Start:             p.Start,
+		End:               p.End,
+	}, nil
+}
+
+func (p *BaseFeeProposalState) Visit(visitor ExecutorVisitor) error {
+	return visitor.BaseFeeProposal(p)
+}
@@ -0,0 +1,185 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+var (
+	_ Proposal      = (*AddMemberProposal)(nil)
+	_ ProposalState = (*AddMemberProposalState)(nil)
+	_ Proposal      = (*ExcludeMemberProposal)(nil)
+	_ ProposalState = (*ExcludeMemberProposalState)(nil)
+
+	// Options of member proposals: accept (index 0) or reject (index 1)
+	memberProposalOptions = []bool{true, false}
+
+	errEmptyMemberAddress = errors.New("member address is empty")
+)
+
+// AddMemberProposal is a proposal to admit new consortium member
+type AddMemberProposal struct {
+	ApplicantAddress ids.ShortID `serialize:"true" json:"applicantAddress"` // Address that will become consortium member
+	Start            uint64      `serialize:"true" json:"start"`            // Start time of proposal
+	End              uint64      `serialize:"true" json:"end"`              // End time of proposal
+}
+
+func (p *AddMemberProposal) StartTime() time.Time {
+	return time.Unix(int64(p.Start), 0)
+}
+
+func (p *AddMemberProposal) EndTime() time.Time {
+	return time.Unix(int64(p.End), 0)
+}
+
+func (p *AddMemberProposal) Verify() error {
+	switch {
+	case p.Start >= p.End:
+		return errEndNotAfterStart
+	case p.ApplicantAddress == ids.ShortEmpty:
+		return errEmptyMemberAddress
+	}
+	return nil
+}
+
+func (p *AddMemberProposal) CreateProposalState(allowedVoters []ids.ShortID) ProposalState {
+	return &AddMemberProposalState{
+		SimpleVoteOptions: newSimpleVoteOptions(memberProposalOptions, allowedVoters),
+		ApplicantAddress:  p.ApplicantAddress,
+		Start:             p.Start,
+		End:               p.End,
+	}
+}
+
+func (p *AddMemberProposal) Visit(visitor VerifierVisitor) error {
+	return visitor.AddMemberProposal(p)
+}
+
+type AddMemberProposalState struct {
+	SimpleVoteOptions[bool] `serialize:"true"`
+
+	ApplicantAddress ids.ShortID `serialize:"true"`
+	Start            uint64      `serialize:"true"`
+	End              uint64      `serialize:"true"`
+}
+
+func (p *AddMemberProposalState) EndTime() time.Time {
+	return time.Unix(int64(p.End), 0)
+}
+
+func (p *AddMemberProposalState) IsActiveAt(time time.Time) bool {
+	return isActiveAt(p.Start, p.End, time)
+}
+
+func (p *AddMemberProposalState) CanBeFinished() bool {
+	return p.canBeFinished()
+}
+
+// IsSuccessful returns true, if more than half of allowed voters accepted applicant
+func (p *AddMemberProposalState) IsSuccessful() bool {
+	mostVotedIndex, decided := p.mostVotedOption()
+	return decided && p.Options[mostVotedIndex].Value
+}
+
+func (p *AddMemberProposalState) AddVote(voterAddress ids.ShortID, vote Vote) (ProposalState, error) {
+	newOptions, err := p.addVote(voterAddress, vote)
+	if err != nil {
+		return nil, err
+	}
+	return &AddMemberProposalState{
+		SimpleVoteOptions: newOptions,
+		ApplicantAddress:  p.ApplicantAddress,
+		Start:             p.Start,
+		End:               p.End,
+	}, nil
+}
+
+func (p *AddMemberProposalState) Visit(visitor ExecutorVisitor) error {
+	return visitor.AddMemberProposal(p)
+}
+
+// ExcludeMemberProposal is a proposal to exclude existing consortium member
+type ExcludeMemberProposal struct {
+	MemberAddress ids.ShortID `serialize:"true" json:"memberAddress"` // Address of consortium member that will be excluded
+	Start         uint64      `serialize:"true" json:"start"`         // Start time of proposal
+	End           uint64      `serialize:"true" json:"end"`           // End time of proposal
+}
+
+func (p *ExcludeMemberProposal) StartTime() time.Time {
+	return time.Unix(int64(p.Start), 0)
+}
+
+func (p *ExcludeMemberProposal) EndTime() time.Time {
+	return time.Unix(int64(p.End), 0)
+}
+
+func (p *ExcludeMemberProposal) Verify() error {
+	switch {
+	case p.Start >= p.End:
+		return errEndNotAfterStart
+	case p.MemberAddress == ids.ShortEmpty:
+		return errEmptyMemberAddress
+	}
+	return nil
+}
+
+func (p *ExcludeMemberProposal) CreateProposalState(allowedVoters []ids.ShortID) ProposalState {
+	return &ExcludeMemberProposalState{
+		SimpleVoteOptions: newSimpleVoteOptions(memberProposalOptions, allowedVoters),
+		MemberAddress:     p.MemberAddress,
+		Start:             p.Start,
+		End:               p.End,
+	}
+}
+
+func (p *ExcludeMemberProposal) Visit(visitor VerifierVisitor) error {
+	return visitor.ExcludeMemberProposal(p)
+}
+
+type ExcludeMemberProposalState struct {
+	SimpleVoteOptions[bool] `serialize:"true"`
+
+	MemberAddress ids.ShortID `serialize:"true"`
+	Start         uint64      `serialize:"true"`
+	End           uint64      `serialize:"true"`
+}
+
+func (p *ExcludeMemberProposalState) EndTime() time.Time {
+	return time.Unix(int64(p.End), 0)
+}
+
+func (p *ExcludeMemberProposalState) IsActiveAt(time time.Time) bool {
+	return isActiveAt(p.Start, p.End, time)
+}
+
+func (p *ExcludeMemberProposalState) CanBeFinished() bool {
+	return p.canBeFinished()
+}
+
+// IsSuccessful returns true, if more than half of allowed voters accepted exclusion
+func (p *ExcludeMemberProposalState) IsSuccessful() bool {
+	mostVotedIndex, decided := p.mostVotedOption()
+	return decided && p.Options[mostVotedIndex].Value
+}
+
+func (p *ExcludeMemberProposalState) AddVote(voterAddress ids.ShortID, vote Vote) (ProposalState, error) {
+	newOptions, err := p.addVote(voterAddress, vote)
+	if err != nil {
+		return nil, err
+	}
+	return &ExcludeMemberProposalState{
+		SimpleVoteOptions: newOptions,
+		MemberAddress:     p.MemberAddress,
+		Start:             p.Start,
+		End:               p.End,
+	}, nil
+}
+
+func (p *ExcludeMemberProposalState) Visit(visitor ExecutorVisitor) error {
+	return visitor.ExcludeMemberProposal(p)
+}
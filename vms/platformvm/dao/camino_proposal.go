@@ -0,0 +1,153 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package dao
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+)
+
+var (
+	errEndNotAfterStart = errors.New("proposal end-time is not after start-time")
+	errNotAllowedToVote = errors.New("this address isn't allowed to vote on this proposal or has already voted")
+	errWrongOptionIndex = errors.New("wrong vote option index")
+	errWrongVoteType    = errors.New("wrong vote type")
+)
+
+type Proposal interface {
+	verify.Verifiable
+
+	StartTime() time.Time
+	EndTime() time.Time
+	// CreateProposalState returns new proposal state, that will be stored in chain state.
+	// [allowedVoters] must be sorted and unique.
+	CreateProposalState(allowedVoters []ids.ShortID) ProposalState
+	Visit(VerifierVisitor) error
+}
+
+type ProposalState interface {
+	EndTime() time.Time
+	IsActiveAt(time time.Time) bool
+	// CanBeFinished returns true, if proposal outcome is already known and won't change with more votes
+	CanBeFinished() bool
+	// IsSuccessful returns true, if proposal reached decision that must be applied
+	IsSuccessful() bool
+	// AddVote returns new proposal state with added vote. Original proposal state isn't modified.
+	AddVote(voterAddress ids.ShortID, vote Vote) (ProposalState, error)
+	Visit(ExecutorVisitor) error
+}
+
+// VerifierVisitor is used to verify proposal against chain state when proposal is added
+type VerifierVisitor interface {
+	BaseFeeProposal(*BaseFeeProposal) error
+	AddMemberProposal(*AddMemberProposal) error
+	ExcludeMemberProposal(*ExcludeMemberProposal) error
+	ReinstateValidatorProposal(*ReinstateValidatorProposal) error
+	TreasuryConfigProposal(*TreasuryConfigProposal) error
+}
+
+// ExecutorVisitor is used to apply successful proposal outcome to chain state
+type ExecutorVisitor interface {
+	BaseFeeProposal(*BaseFeeProposalState) error
+	AddMemberProposal(*AddMemberProposalState) error
+	ExcludeMemberProposal(*ExcludeMemberProposalState) error
+	ReinstateValidatorProposal(*ReinstateValidatorProposalState) error
+	TreasuryConfigProposal(*TreasuryConfigProposalState) error
+}
+
+// SimpleVoteOption is proposal option that can be chosen with SimpleVote
+type SimpleVoteOption[T any] struct {
+	Value  T      `serialize:"true" json:"value"`  // Value that will be applied, if this option will win
+	Weight uint32 `serialize:"true" json:"weight"` // How much votes this option got
+}
+
+// SimpleVoteOptions holds proposal options and voters, that are allowed to vote on them
+type SimpleVoteOptions[T any] struct {
+	Options            []SimpleVoteOption[T] `serialize:"true" json:"options"`
+	AllowedVoters      []ids.ShortID         `serialize:"true" json:"allowedVoters"`      // Sorted addresses that are allowed to vote and haven't voted yet
+	TotalAllowedVoters uint32                `serialize:"true" json:"totalAllowedVoters"` // Number of addresses that were allowed to vote, when proposal was created
+}
+
+func newSimpleVoteOptions[T any](values []T, allowedVoters []ids.ShortID) SimpleVoteOptions[T] {
+	options := make([]SimpleVoteOption[T], len(values))
+	for i := range values {
+		options[i].Value = values[i]
+	}
+	voters := make([]ids.ShortID, len(allowedVoters))
+	copy(voters, allowedVoters)
+	utils.Sort(voters)
+	return SimpleVoteOptions[T]{
+		Options:            options,
+		AllowedVoters:      voters,
+		TotalAllowedVoters: uint32(len(allowedVoters)),
+	}
+}
+
+// addVote returns copy of options with added vote.
+func (o *SimpleVoteOptions[T]) addVote(voterAddress ids.ShortID, vote Vote) (SimpleVoteOptions[T], error) {
+	simpleVote, ok := vote.(*SimpleVote)
+	if !ok {
+		return SimpleVoteOptions[T]{}, errWrongVoteType
+	}
+	if int(simpleVote.OptionIndex) >= len(o.Options) {
+		return SimpleVoteOptions[T]{}, errWrongOptionIndex
+	}
+
+	voterIndex := sort.Search(len(o.AllowedVoters), func(i int) bool {
+		return !o.AllowedVoters[i].Less(voterAddress)
+	})
+	if voterIndex == len(o.AllowedVoters) || o.AllowedVoters[voterIndex] != voterAddress {
+		return SimpleVoteOptions[T]{}, errNotAllowedToVote
+	}
+
+	newOptions := SimpleVoteOptions[T]{
+		Options:            make([]SimpleVoteOption[T], len(o.Options)),
+		AllowedVoters:      make([]ids.ShortID, 0, len(o.AllowedVoters)-1),
+		TotalAllowedVoters: o.TotalAllowedVoters,
+	}
+	copy(newOptions.Options, o.Options)
+	newOptions.AllowedVoters = append(newOptions.AllowedVoters, o.AllowedVoters[:voterIndex]...)
+	newOptions.AllowedVoters = append(newOptions.AllowedVoters, o.AllowedVoters[voterIndex+1:]...)
+	newOptions.Options[simpleVote.OptionIndex].Weight++
+	return newOptions, nil
+}
+
+// mostVotedOption returns index of option with most votes and true,
+// if this option got votes from more than half of all allowed voters.
+func (o *SimpleVoteOptions[T]) mostVotedOption() (int, bool) {
+	mostVotedIndex := 0
+	for i := range o.Options {
+		if o.Options[i].Weight > o.Options[mostVotedIndex].Weight {
+			mostVotedIndex = i
+		}
+	}
+	return mostVotedIndex, len(o.Options) > 0 && o.Options[mostVotedIndex].Weight > o.TotalAllowedVoters/2
+}
+
+func (o *SimpleVoteOptions[T]) canBeFinished() bool {
+	_, decided := o.mostVotedOption()
+	return decided || len(o.AllowedVoters) == 0
+}
+
+type SimpleVote struct {
+	OptionIndex uint32 `serialize:"true" json:"optionIndex"` // Index of voted option
+}
+
+func (*SimpleVote) Verify() error {
+	return nil
+}
+
+type Vote interface {
+	verify.Verifiable
+}
+
+func isActiveAt(start, end uint64, time time.Time) bool {
+	timestamp := uint64(time.Unix())
+	return start <= timestamp && timestamp < end
+}
@@ -0,0 +1,221 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/stretchr/testify/require"
+)
+
+type dummyVote struct{}
+
+func (*dummyVote) Verify() error { return nil }
+
+func TestSimpleVoteOptionsAddVote(t *testing.T) {
+	voter1 := ids.ShortID{1}
+	voter2 := ids.ShortID{2}
+	voter3 := ids.ShortID{3}
+
+	tests := map[string]struct {
+		options         *SimpleVoteOptions[uint64]
+		voterAddress    ids.ShortID
+		vote            Vote
+		expectedOptions SimpleVoteOptions[uint64]
+		expectedErr     error
+	}{
+		"Wrong vote type": {
+			options:      &SimpleVoteOptions[uint64]{},
+			voterAddress: voter1,
+			vote:         &dummyVote{},
+			expectedErr:  errWrongVoteType,
+		},
+		"Wrong option index": {
+			options: &SimpleVoteOptions[uint64]{
+				Options:            []SimpleVoteOption[uint64]{{Value: 1}},
+				AllowedVoters:      []ids.ShortID{voter1},
+				TotalAllowedVoters: 1,
+			},
+			voterAddress: voter1,
+			vote:         &SimpleVote{OptionIndex: 1},
+			expectedErr:  errWrongOptionIndex,
+		},
+		"Not allowed to vote": {
+			options: &SimpleVoteOptions[uint64]{
+				Options:            []SimpleVoteOption[uint64]{{Value: 1}},
+				AllowedVoters:      []ids.ShortID{voter1, voter3},
+				TotalAllowedVoters: 3,
+			},
+			voterAddress: voter2,
+			vote:         &SimpleVote{OptionIndex: 0},
+			expectedErr:  errNotAllowedToVote,
+		},
+		"OK": {
+			options: &SimpleVoteOptions[uint64]{
+				Options:            []SimpleVoteOption[uint64]{{Value: 1}, {Value: 2, Weight: 1}},
+				AllowedVoters:      []ids.ShortID{voter1, voter2, voter3},
+				TotalAllowedVoters: 4,
+			},
+			voterAddress: voter2,
+			vote:         &SimpleVote{OptionIndex: 1},
+			expectedOptions: SimpleVoteOptions[uint64]{
+				Options:            []SimpleVoteOption[uint64]{{Value: 1}, {Value: 2, Weight: 2}},
+				AllowedVoters:      []ids.ShortID{voter1, voter3},
+				TotalAllowedVoters: 4,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			originalOptions := *tt.options
+			newOptions, err := tt.options.addVote(tt.voterAddress, tt.vote)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expectedOptions, newOptions)
+			require.Equal(t, originalOptions, *tt.options) // original options must not be modified
+		})
+	}
+}
+
+func TestBaseFeeProposalVerify(t *testing.T) {
+	tests := map[string]struct {
+		proposal    *BaseFeeProposal
+		expectedErr error
+	}{
+		"No options": {
+			proposal:    &BaseFeeProposal{Start: 1, End: 2},
+			expectedErr: errWrongOptionsCount,
+		},
+		"Too many options": {
+			proposal:    &BaseFeeProposal{Start: 1, End: 2, Options: []uint64{1, 2, 3, 4}},
+			expectedErr: errWrongOptionsCount,
+		},
+		"End-time is equal to start-time": {
+			proposal:    &BaseFeeProposal{Start: 2, End: 2, Options: []uint64{1}},
+			expectedErr: errEndNotAfterStart,
+		},
+		"Zero fee option": {
+			proposal:    &BaseFeeProposal{Start: 1, End: 2, Options: []uint64{1, 0}},
+			expectedErr: errZeroFee,
+		},
+		"Not unique fee option": {
+			proposal:    &BaseFeeProposal{Start: 1, End: 2, Options: []uint64{1, 2, 1}},
+			expectedErr: errNotUniqueFeeOption,
+		},
+		"OK": {
+			proposal: &BaseFeeProposal{Start: 1, End: 2, Options: []uint64{1, 2, 3}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.proposal.Verify(), tt.expectedErr)
+		})
+	}
+}
+
+func TestBaseFeeProposalState(t *testing.T) {
+	voters := []ids.ShortID{{3}, {1}, {2}} // not sorted on purpose
+	proposal := (&BaseFeeProposal{Start: 10, End: 20, Options: []uint64{100, 200}}).CreateProposalState(voters)
+
+	require.False(t, proposal.IsActiveAt(time.Unix(9, 0)))
+	require.True(t, proposal.IsActiveAt(time.Unix(10, 0)))
+	require.False(t, proposal.IsActiveAt(time.Unix(20, 0)))
+	require.False(t, proposal.CanBeFinished())
+	require.False(t, proposal.IsSuccessful())
+
+	proposal, err := proposal.AddVote(voters[0], &SimpleVote{OptionIndex: 1})
+	require.NoError(t, err)
+	require.False(t, proposal.CanBeFinished())
+	require.False(t, proposal.IsSuccessful())
+
+	_, err = proposal.AddVote(voters[0], &SimpleVote{OptionIndex: 1})
+	require.ErrorIs(t, err, errNotAllowedToVote)
+
+	proposal, err = proposal.AddVote(voters[2], &SimpleVote{OptionIndex: 1})
+	require.NoError(t, err)
+	require.True(t, proposal.CanBeFinished())
+	require.True(t, proposal.IsSuccessful())
+	require.Equal(t, uint64(200), proposal.(*BaseFeeProposalState).Fee())
+}
+
+func TestMemberProposalStateIsSuccessful(t *testing.T) {
+	voters := []ids.ShortID{{1}, {2}, {3}}
+	proposal := (&ExcludeMemberProposal{Start: 10, End: 20, MemberAddress: ids.ShortID{4}}).CreateProposalState(voters)
+
+	proposal, err := proposal.AddVote(voters[0], &SimpleVote{OptionIndex: 1})
+	require.NoError(t, err)
+	proposal, err = proposal.AddVote(voters[1], &SimpleVote{OptionIndex: 1})
+	require.NoError(t, err)
+	require.True(t, proposal.CanBeFinished())
+	require.False(t, proposal.IsSuccessful()) // majority rejected exclusion
+
+	proposal = (&AddMemberProposal{Start: 10, End: 20, ApplicantAddress: ids.ShortID{4}}).CreateProposalState(voters)
+	for _, voter := range voters {
+		proposal, err = proposal.AddVote(voter, &SimpleVote{OptionIndex: 0})
+		require.NoError(t, err)
+	}
+	require.True(t, proposal.CanBeFinished())
+	require.True(t, proposal.IsSuccessful())
+}
+
+func TestReinstateValidatorProposal(t *testing.T) {
+	require.ErrorIs(t, (&ReinstateValidatorProposal{Start: 2, End: 2, NodeOwnerAddress: ids.ShortID{1}}).Verify(), errEndNotAfterStart)
+	require.ErrorIs(t, (&ReinstateValidatorProposal{Start: 1, End: 2}).Verify(), errEmptyMemberAddress)
+	require.NoError(t, (&ReinstateValidatorProposal{Start: 1, End: 2, NodeOwnerAddress: ids.ShortID{1}}).Verify())
+
+	voters := []ids.ShortID{{1}, {2}, {3}}
+	proposal := (&ReinstateValidatorProposal{Start: 10, End: 20, NodeOwnerAddress: ids.ShortID{4}}).CreateProposalState(voters)
+
+	proposal, err := proposal.AddVote(voters[0], &SimpleVote{OptionIndex: 0})
+	require.NoError(t, err)
+	require.False(t, proposal.CanBeFinished())
+	require.False(t, proposal.IsSuccessful())
+
+	proposal, err = proposal.AddVote(voters[1], &SimpleVote{OptionIndex: 0})
+	require.NoError(t, err)
+	require.True(t, proposal.CanBeFinished())
+	require.True(t, proposal.IsSuccessful())
+}
+
+func TestTreasuryConfigProposal(t *testing.T) {
+	require.ErrorIs(t, (&TreasuryConfigProposal{Start: 2, End: 2, Admin: ids.ShortID{1}}).Verify(), errEndNotAfterStart)
+	require.NoError(t, (&TreasuryConfigProposal{Start: 1, End: 2}).Verify())
+
+	voters := []ids.ShortID{{1}, {2}, {3}}
+	proposal := (&TreasuryConfigProposal{
+		Start:       10,
+		End:         20,
+		Admin:       ids.ShortID{4},
+		SpendLimit:  100,
+		SpendPeriod: 1000,
+	}).CreateProposalState(voters)
+
+	proposal, err := proposal.AddVote(voters[0], &SimpleVote{OptionIndex: 1})
+	require.NoError(t, err)
+	require.False(t, proposal.CanBeFinished())
+	require.False(t, proposal.IsSuccessful())
+
+	proposal, err = proposal.AddVote(voters[1], &SimpleVote{OptionIndex: 0})
+	require.NoError(t, err)
+	require.False(t, proposal.CanBeFinished())
+	require.False(t, proposal.IsSuccessful())
+
+	proposal, err = proposal.AddVote(voters[2], &SimpleVote{OptionIndex: 0})
+	require.NoError(t, err)
+	require.True(t, proposal.CanBeFinished())
+	require.True(t, proposal.IsSuccessful())
+	require.Equal(t, &TreasuryConfigProposalState{
+		SimpleVoteOptions: SimpleVoteOptions[bool]{
+			Options:            []SimpleVoteOption[bool]{{Value: true, Weight: 2}, {Value: false, Weight: 1}},
+			AllowedVoters:      []ids.ShortID{},
+			TotalAllowedVoters: 3,
+		},
+		Admin:       ids.ShortID{4},
+		SpendLimit:  100,
+		SpendPeriod: 1000,
+		Start:       10,
+		End:         20,
+	}, proposal)
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package dao
+
+import (
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+var (
+	_ Proposal      = (*ReinstateValidatorProposal)(nil)
+	_ ProposalState = (*ReinstateValidatorProposalState)(nil)
+
+	// Options of reinstate validator proposal: accept (index 0) or reject (index 1)
+	reinstateValidatorProposalOptions = []bool{true, false}
+)
+
+// ReinstateValidatorProposal is a request of consortium member to reactivate its deferred validator
+type ReinstateValidatorProposal struct {
+	NodeOwnerAddress ids.ShortID `serialize:"true" json:"nodeOwnerAddress"` // Consortium member, which deferred validator will be reactivated
+	Start            uint64      `serialize:"true" json:"start"`            // Start time of proposal
+	End              uint64      `serialize:"true" json:"end"`              // End time of proposal
+}
+
+func (p *ReinstateValidatorProposal) StartTime() time.Time {
+	return time.Unix(int64(p.Start), 0)
+}
+
+func (p *ReinstateValidatorProposal) EndTime() time.Time {
+	return time.Unix(int64(p.End), 0)
+}
+
+func (p *ReinstateValidatorProposal) Verify() error {
+	switch {
+	case p.Start >= p.End:
+		return errEndNotAfterStart
+	case p.NodeOwnerAddress == ids.ShortEmpty:
+		return errEmptyMemberAddress
+	}
+	return nil
+}
+
+func (p *ReinstateValidatorProposal) CreateProposalState(allowedVoters []ids.ShortID) ProposalState {
+	return &ReinstateValidatorProposalState{
+		SimpleVoteOptions: newSimpleVoteOptions(reinstateValidatorProposalOptions, allowedVoters),
+		NodeOwnerAddress:  p.NodeOwnerAddress,
+		Start:             p.Start,
+		End:               p.End,
+	}
+}
+
+func (p *ReinstateValidatorProposal) Visit(visitor VerifierVisitor) error {
+	return visitor.ReinstateValidatorProposal(p)
+}
+
+type ReinstateValidatorProposalState struct {
+	SimpleVoteOptions[bool] `serialize:"true"`
+
+	NodeOwnerAddress ids.ShortID `serialize:"true"`
+	Start            uint64      `serialize:"true"`
+	End              uint64      `serialize:"true"`
+}
+
+func (p *ReinstateValidatorProposalState) EndTime() time.Time {
+	return time.Unix(int64(p.End), 0)
+}
+
+func (p *ReinstateValidatorProposalState) IsActiveAt(time time.Time) bool {
+	return isActiveAt(p.Start, p.End, time)
+}
+
+func (p *ReinstateValidatorProposalState) CanBeFinished() bool {
+	return p.canBeFinished()
+}
+
+// IsSuccessful returns true, if more than half of allowed voters accepted reinstatement
+func (p *ReinstateValidatorProposalState) IsSuccessful() bool {
+	mostVotedIndex, decided := p.mostVotedOption()
+	return decided && p.Options[mostVotedIndex].Value
+}
+
+func (p *ReinstateValidatorProposalState) AddVote(voterAddress ids.ShortID, vote Vote) (ProposalState, error) {
+	newOptions, err := p.addVote(voterAddress, vote)
+	if err != nil {
+		return nil, err
+	}
+	return &ReinstateValidatorProposalState{
+		SimpleVoteOptions: newOptions,
+		NodeOwnerAddress:  p.NodeOwnerAddress,
+		Start:             p.Start,
+		End:               p.End,
+	}, nil
+}
+
+func (p *ReinstateValidatorProposalState) Visit(visitor ExecutorVisitor) error {
+	return visitor.ReinstateValidatorProposal(p)
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package dao
+
+import (
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+var (
+	_ Proposal      = (*TreasuryConfigProposal)(nil)
+	_ ProposalState = (*TreasuryConfigProposalState)(nil)
+
+	// Options of treasury config proposal: accept (index 0) or reject (index 1)
+	treasuryConfigProposalOptions = []bool{true, false}
+)
+
+// TreasuryConfigProposal is a proposal to change treasury admin, spend limit and spend period.
+// Treasury config is initially set in genesis, this proposal allows to change it on running network.
+type TreasuryConfigProposal struct {
+	Admin       ids.ShortID `serialize:"true" json:"admin"`       // New treasury admin msig alias, empty admin disables treasury spending
+	SpendLimit  uint64      `serialize:"true" json:"spendLimit"`  // New max amount that can be spent during one spend period, zero means no limit
+	SpendPeriod uint64      `serialize:"true" json:"spendPeriod"` // New duration of spend period in seconds
+	Start       uint64      `serialize:"true" json:"start"`       // Start time of proposal
+	End         uint64      `serialize:"true" json:"end"`         // End time of proposal
+}
+
+func (p *TreasuryConfigProposal) StartTime() time.Time {
+	return time.Unix(int64(p.Start), 0)
+}
+
+func (p *TreasuryConfigProposal) EndTime() time.Time {
+	return time.Unix(int64(p.End), 0)
+}
+
+func (p *TreasuryConfigProposal) Verify() error {
+	if p.Start >= p.End {
+		return errEndNotAfterStart
+	}
+	return nil
+}
+
+func (p *TreasuryConfigProposal) CreateProposalState(allowedVoters []ids.ShortID) ProposalState {
+	return &TreasuryConfigProposalState{
+		SimpleVoteOptions: newSimpleVoteOptions(treasuryConfigProposalOptions, allowedVoters),
+		Admin:             p.Admin,
+		SpendLimit:        p.SpendLimit,
+		SpendPeriod:       p.SpendPeriod,
+		Start:             p.Start,
+		End:               p.End,
+	}
+}
+
+func (p *TreasuryConfigProposal) Visit(visitor VerifierVisitor) error {
+	return visitor.TreasuryConfigProposal(p)
+}
+
+type TreasuryConfigProposalState struct {
+	SimpleVoteOptions[bool] `serialize:"true"`
+
+	Admin       ids.ShortID `serialize:"true"`
+	SpendLimit  uint64      `serialize:"true"`
+	SpendPeriod uint64      `serialize:"true"`
+	Start       uint64      `serialize:"true"`
+	End         uint64      `serialize:"true"`
+}
+
+func (p *TreasuryConfigProposalState) EndTime() time.Time {
+	return time.Unix(int64(p.End), 0)
+}
+
+func (p *TreasuryConfigProposalState) IsActiveAt(time time.Time) bool {
+	return isActiveAt(p.Start, p.End, time)
+}
+
+func (p *TreasuryConfigProposalState) CanBeFinished() bool {
+	return p.canBeFinished()
+}
+
+// IsSuccessful returns true, if more than half of allowed voters accepted new treasury config
+func (p *TreasuryConfigProposalState) IsSuccessful() bool {
+	mostVotedIndex, decided := p.mostVotedOption()
+	return decided && p.Options[mostVotedIndex].Value
+}
+
+func (p *TreasuryConfigProposalState) AddVote(voterAddress ids.ShortID, vote Vote) (ProposalState, error) {
+	newOptions, err := p.addVote(voterAddress, vote)
+	if err != nil {
+		return nil, err
+	}
+	return &TreasuryConfigProposalState{
+		SimpleVoteOptions: newOptions,
+		Admin:             p.Admin,
+		SpendLimit:        p.SpendLimit,
+		SpendPeriod:       p.SpendPeriod,
+		Start:             p.Start,
+		End:               p.End,
+	}, nil
+}
+
+func (p *TreasuryConfigProposalState) Visit(visitor ExecutorVisitor) error {
+	return visitor.TreasuryConfigProposal(p)
+}
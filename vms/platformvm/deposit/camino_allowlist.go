@@ -0,0 +1,125 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package deposit
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+)
+
+// MaxAllowlistProofLength is the max depth of allowlist merkle tree.
+const MaxAllowlistProofLength = 32
+
+var (
+	errEmptyAllowlist     = errors.New("allowlist is empty")
+	errNotInAllowlist     = errors.New("address isn't in allowlist")
+	errAllowlistNotUnique = errors.New("allowlist addresses aren't unique")
+	errAllowlistTooBig    = errors.New("allowlist is too big")
+)
+
+// Allowlist merkle tree is built from sorted unique addresses. Leafs are address hashes,
+// nodes are hashes of concatenation of their sorted children. Node without pair is moved
+// to the next level as is.
+
+// AllowlistRoot returns merkle root of allowlist with given [addresses].
+func AllowlistRoot(addresses []ids.ShortID) (ids.ID, error) {
+	levels, err := allowlistTree(addresses)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return levels[len(levels)-1][0], nil
+}
+
+// AllowlistProof returns merkle proof of [address] inclusion into allowlist
+// with given [addresses].
+func AllowlistProof(addresses []ids.ShortID, address ids.ShortID) ([]ids.ID, error) {
+	levels, err := allowlistTree(addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	leaf := allowlistLeaf(address)
+	index := -1
+	for i, node := range levels[0] {
+		if node == leaf {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, errNotInAllowlist
+	}
+
+	proof := []ids.ID{}
+	for _, level := range levels[:len(levels)-1] {
+		if sibling := index ^ 1; sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		index /= 2
+	}
+	return proof, nil
+}
+
+// VerifyAllowlistProof returns true, if [proof] proves inclusion of [address]
+// into allowlist with merkle [root].
+func VerifyAllowlistProof(root ids.ID, address ids.ShortID, proof []ids.ID) bool {
+	if len(proof) > MaxAllowlistProofLength {
+		return false
+	}
+	node := allowlistLeaf(address)
+	for _, sibling := range proof {
+		node = allowlistNode(node, sibling)
+	}
+	return node == root
+}
+
+func allowlistTree(addresses []ids.ShortID) ([][]ids.ID, error) {
+	switch {
+	case len(addresses) == 0:
+		return nil, errEmptyAllowlist
+	case !utils.IsSortedAndUniqueSortable(addresses):
+		return nil, errAllowlistNotUnique
+	}
+
+	level := make([]ids.ID, len(addresses))
+	for i, address := range addresses {
+		level[i] = allowlistLeaf(address)
+	}
+
+	levels := [][]ids.ID{level}
+	for len(level) > 1 {
+		if len(levels) > MaxAllowlistProofLength {
+			return nil, errAllowlistTooBig
+		}
+		nextLevel := make([]ids.ID, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				nextLevel = append(nextLevel, level[i])
+				continue
+			}
+			nextLevel = append(nextLevel, allowlistNode(level[i], level[i+1]))
+		}
+		levels = append(levels, nextLevel)
+		level = nextLevel
+	}
+	return levels, nil
+}
+
+func allowlistLeaf(address ids.ShortID) ids.ID {
+	return hashing.ComputeHash256Array(address[:])
+}
+
+func allowlistNode(left, right ids.ID) ids.ID {
+	if bytes.Compare(left[:], right[:]) > 0 {
+		left, right = right, left
+	}
+	nodeBytes := make([]byte, len(left)+len(right))
+	copy(nodeBytes, left[:])
+	copy(nodeBytes[len(left):], right[:])
+	return hashing.ComputeHash256Array(nodeBytes)
+}
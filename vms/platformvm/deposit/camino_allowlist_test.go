@@ -0,0 +1,56 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package deposit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func TestAllowlistProof(t *testing.T) {
+	tests := map[string]struct {
+		addresses   []ids.ShortID
+		expectedErr error
+	}{
+		"Empty allowlist": {
+			expectedErr: errEmptyAllowlist,
+		},
+		"Not sorted allowlist": {
+			addresses:   []ids.ShortID{{2}, {1}},
+			expectedErr: errAllowlistNotUnique,
+		},
+		"OK: one address": {
+			addresses: []ids.ShortID{{1}},
+		},
+		"OK: even number of addresses": {
+			addresses: []ids.ShortID{{1}, {2}, {3}, {4}},
+		},
+		"OK: odd number of addresses": {
+			addresses: []ids.ShortID{{1}, {2}, {3}, {4}, {5}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			root, err := AllowlistRoot(tt.addresses)
+			require.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+
+			for _, address := range tt.addresses {
+				proof, err := AllowlistProof(tt.addresses, address)
+				require.NoError(t, err)
+				require.True(t, VerifyAllowlistProof(root, address, proof))
+				require.False(t, VerifyAllowlistProof(ids.ID{1}, address, proof))
+			}
+
+			_, err = AllowlistProof(tt.addresses, ids.ShortID{100})
+			require.ErrorIs(t, err, errNotInAllowlist)
+			require.False(t, VerifyAllowlistProof(root, ids.ShortID{100}, []ids.ID{}))
+		})
+	}
+}
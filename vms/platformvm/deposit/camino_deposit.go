@@ -70,6 +70,23 @@ func (deposit *Deposit) UnlockableAmount(offer *Offer, unlockTime uint64) uint64
 	return bigTotalUnlockableAmount.Uint64() - deposit.UnlockedAmount
 }
 
+// Returns penalty amount for force-unlocking all remaining tokens of [deposit] at [unlockTime] (seconds).
+// Penalty is only applied to tokens that aren't unlockable yet.
+//
+// Precondition: all args are valid in conjunction.
+func (deposit *Deposit) EarlyUnlockPenalty(offer *Offer, unlockTime uint64) uint64 {
+	notUnlockableAmount := deposit.Amount - deposit.UnlockedAmount - deposit.UnlockableAmount(offer, unlockTime)
+
+	bigPenaltyAmount := (&big.Int{}).SetUint64(notUnlockableAmount)
+	bigPenaltyNominator := (&big.Int{}).SetUint64(offer.EarlyUnlockPenalty)
+
+	// penaltyAmount := notUnlockableAmount * offer.EarlyUnlockPenalty / EarlyUnlockPenaltyDenominator
+	bigPenaltyAmount.Mul(bigPenaltyAmount, bigPenaltyNominator)
+	bigPenaltyAmount.Div(bigPenaltyAmount, bigEarlyUnlockPenaltyDenominator)
+
+	return bigPenaltyAmount.Uint64()
+}
+
 // Returns amount of tokens that can be claimed as reward for [deposit] at [claimetime] (seconds).
 //
 // Precondition: all args are valid in conjunction.
@@ -118,3 +135,32 @@ func (deposit *Deposit) TotalReward(offer *Offer) uint64 {
 
 	return bigTotalRewardAmount.Uint64()
 }
+
+// SchedulePoint is a state of deposit rewards and unlocks at some point of time.
+type SchedulePoint struct {
+	Timestamp        uint64 // Unix time in seconds
+	ClaimableReward  uint64 // Reward that can be claimed at this time
+	UnlockableAmount uint64 // Amount that can be unlocked at this time
+}
+
+// Returns claimable reward and unlockable amount of [deposit] at [from] (seconds)
+// and then each [interval] seconds till deposit end time, which is always the last point.
+//
+// Precondition: all args are valid in conjunction, interval > 0 and from isn't after deposit end time.
+func (deposit *Deposit) Schedule(offer *Offer, from, interval uint64) []SchedulePoint {
+	end := deposit.Start + uint64(deposit.Duration)
+	schedule := make([]SchedulePoint, 0, (end-from)/interval+1)
+	for timestamp := from; ; timestamp += interval {
+		if timestamp >= end || timestamp < from { // timestamp < from in case of overflow
+			timestamp = end
+		}
+		schedule = append(schedule, SchedulePoint{
+			Timestamp:        timestamp,
+			ClaimableReward:  deposit.ClaimableReward(offer, timestamp),
+			UnlockableAmount: deposit.UnlockableAmount(offer, timestamp),
+		})
+		if timestamp == end {
+			return schedule
+		}
+	}
+}
@@ -20,10 +20,13 @@ const (
 	interestRateBase               = 365 * 24 * 60 * 60
 	interestRateDenominator        = 1_000_000 * interestRateBase
 	OfferMinDepositAmount   uint64 = 1 * units.MilliAvax
+
+	EarlyUnlockPenaltyDenominator = 1_000_000
 )
 
 var (
-	bigInterestRateDenominator = (&big.Int{}).SetInt64(interestRateDenominator)
+	bigInterestRateDenominator       = (&big.Int{}).SetInt64(interestRateDenominator)
+	bigEarlyUnlockPenaltyDenominator = (&big.Int{}).SetInt64(EarlyUnlockPenaltyDenominator)
 
 	errWrongLimitValues           = errors.New("can only use either TotalMaxAmount or TotalMaxRewardAmount")
 	errDepositedMoreThanMaxAmount = errors.New("offer deposited amount is more than offer total max amount")
@@ -31,6 +34,9 @@ var (
 	errMinAmountTooSmall          = errors.New("offer minAmount is too small")
 	errMinAmountTooBig            = errors.New("offer minAmount is too big")
 	errWrongRewardValues          = errors.New("offer interest rate and total max reward amount must both be zero or not zero")
+	errMaxAddressAmountTooSmall   = errors.New("offer maxAddressAmount is less than minAmount")
+	errEarlyUnlockPenaltyTooBig   = errors.New("offer earlyUnlockPenaltyNominator is bigger than denominator")
+	errEarlyUnlockNotAllowed      = errors.New("offer earlyUnlockPenaltyNominator is set, but early unlock isn't allowed")
 )
 
 type OfferFlag uint64
@@ -59,6 +65,11 @@ type Offer struct {
 	TotalMaxRewardAmount    uint64              `serialize:"true" json:"totalMaxRewardAmount" upgradeVersion:"1"` // Maximum amount that can be rewarded for all deposits created with this offer in total
 	RewardedAmount          uint64              `serialize:"true" json:"rewardedAmount"       upgradeVersion:"1"` // Amount that was already rewarded (including potential rewards) for deposits created with this offer
 	OwnerAddress            ids.ShortID         `serialize:"true" json:"ownerAddress"         upgradeVersion:"1"` // Address that can sign deposit-creator permission
+	RequiredAddressState    uint64              `serialize:"true" json:"requiredAddressState" upgradeVersion:"2"` // Bitmask of address states, that deposit creator must have
+	MaxAddressAmount        uint64              `serialize:"true" json:"maxAddressAmount"     upgradeVersion:"2"` // Maximum amount that can be deposited with this offer by one deposit creator in total (across all its deposits)
+	AllowlistRoot           ids.ID              `serialize:"true" json:"allowlistRoot"        upgradeVersion:"2"` // Merkle root of addresses, that are allowed to create deposits with this offer
+	EarlyUnlockAllowed      bool                `serialize:"true" json:"earlyUnlockAllowed"   upgradeVersion:"2"` // If true, deposits created with this offer can be force-unlocked before their unlock period with penalty
+	EarlyUnlockPenalty      uint64              `serialize:"true" json:"earlyUnlockPenalty"   upgradeVersion:"2"` // notUnlockableAmount * (earlyUnlockPenalty / EarlyUnlockPenaltyDenominator) == penalty for force-unlocking deposit before the end of its unlock period
 }
 
 // Time when this offer becomes active
@@ -106,6 +117,11 @@ func (o *Offer) MaxRemainingRewardByTotalMaxAmount() uint64 {
 	return dummyDeposit.TotalReward(o)
 }
 
+// Returns true, if deposit creator must match any of this offer eligibility rules.
+func (o *Offer) HasEligibilityRules() bool {
+	return o.RequiredAddressState != 0 || o.MaxAddressAmount != 0 || o.AllowlistRoot != ids.Empty
+}
+
 func (o *Offer) IsActiveAt(timestamp uint64) bool {
 	return o.Start <= timestamp && timestamp <= o.End && o.Flags&OfferFlagLocked == 0
 }
@@ -178,6 +194,17 @@ func (o *Offer) Verify() error {
 		}
 	}
 
+	if o.UpgradeVersionID.Version() > 1 {
+		switch {
+		case o.MaxAddressAmount != 0 && o.MaxAddressAmount < o.MinAmount:
+			return errMaxAddressAmountTooSmall
+		case o.EarlyUnlockPenalty > EarlyUnlockPenaltyDenominator:
+			return errEarlyUnlockPenaltyTooBig
+		case o.EarlyUnlockPenalty != 0 && !o.EarlyUnlockAllowed:
+			return errEarlyUnlockNotAllowed
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,66 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package deposit
+
+import (
+	"github.com/ava-labs/avalanchego/utils/math"
+)
+
+// OfferStats aggregates active (not yet removed) deposits created with one offer
+type OfferStats struct {
+	ActiveDeposits      uint64 // Number of active deposits
+	DepositedAmount     uint64 // Amount that is still locked in active deposits
+	RewardAmount        uint64 // Total reward committed to active deposits, including already claimed reward
+	ClaimedRewardAmount uint64 // Reward that was already claimed from active deposits
+}
+
+// Add adds [deposit] created with [offer] to stats
+func (s *OfferStats) Add(deposit *Deposit, offer *Offer) error {
+	activeDeposits, err := math.Add64(s.ActiveDeposits, 1)
+	if err != nil {
+		return err
+	}
+	depositedAmount, err := math.Add64(s.DepositedAmount, deposit.Amount-deposit.UnlockedAmount)
+	if err != nil {
+		return err
+	}
+	rewardAmount, err := math.Add64(s.RewardAmount, deposit.TotalReward(offer))
+	if err != nil {
+		return err
+	}
+	claimedRewardAmount, err := math.Add64(s.ClaimedRewardAmount, deposit.ClaimedRewardAmount)
+	if err != nil {
+		return err
+	}
+	s.ActiveDeposits = activeDeposits
+	s.DepositedAmount = depositedAmount
+	s.RewardAmount = rewardAmount
+	s.ClaimedRewardAmount = claimedRewardAmount
+	return nil
+}
+
+// Remove removes [deposit] created with [offer] from stats
+func (s *OfferStats) Remove(deposit *Deposit, offer *Offer) error {
+	activeDeposits, err := math.Sub(s.ActiveDeposits, 1)
+	if err != nil {
+		return err
+	}
+	depositedAmount, err := math.Sub(s.DepositedAmount, deposit.Amount-deposit.UnlockedAmount)
+	if err != nil {
+		return err
+	}
+	rewardAmount, err := math.Sub(s.RewardAmount, deposit.TotalReward(offer))
+	if err != nil {
+		return err
+	}
+	claimedRewardAmount, err := math.Sub(s.ClaimedRewardAmount, deposit.ClaimedRewardAmount)
+	if err != nil {
+		return err
+	}
+	s.ActiveDeposits = activeDeposits
+	s.DepositedAmount = depositedAmount
+	s.RewardAmount = rewardAmount
+	s.ClaimedRewardAmount = claimedRewardAmount
+	return nil
+}
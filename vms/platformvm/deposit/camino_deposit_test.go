@@ -48,3 +48,102 @@ func TestTotalReward(t *testing.T) {
 		})
 	}
 }
+
+func TestSchedule(t *testing.T) {
+	offer := &Offer{
+		InterestRateNominator:   100_000, // 10% per year
+		NoRewardsPeriodDuration: 20,
+		UnlockPeriodDuration:    40,
+	}
+	deposit := &Deposit{
+		Start:    100,
+		Duration: 100,
+		Amount:   interestRateBase * 1000,
+	}
+	rewardPerSecond := deposit.Amount / 10 / interestRateBase
+
+	tests := map[string]struct {
+		deposit          *Deposit
+		from             uint64
+		interval         uint64
+		expectedSchedule []SchedulePoint
+	}{
+		"From deposit start": {
+			deposit:  deposit,
+			from:     100,
+			interval: 30,
+			expectedSchedule: []SchedulePoint{
+				{Timestamp: 100},
+				{Timestamp: 130, ClaimableReward: 30 * rewardPerSecond},
+				{Timestamp: 160, ClaimableReward: 60 * rewardPerSecond},
+				// unlock period started at 160, no rewards period started at 180
+				{Timestamp: 190, ClaimableReward: 80 * rewardPerSecond, UnlockableAmount: deposit.Amount * 30 / 40},
+				{Timestamp: 200, ClaimableReward: 80 * rewardPerSecond, UnlockableAmount: deposit.Amount},
+			},
+		},
+		"Partially claimed and unlocked": {
+			deposit: &Deposit{
+				Start:               deposit.Start,
+				Duration:            deposit.Duration,
+				Amount:              deposit.Amount,
+				ClaimedRewardAmount: 70 * rewardPerSecond,
+				UnlockedAmount:      deposit.Amount / 2,
+			},
+			from:     180,
+			interval: 100,
+			expectedSchedule: []SchedulePoint{
+				{Timestamp: 180, ClaimableReward: 10 * rewardPerSecond},
+				{Timestamp: 200, ClaimableReward: 10 * rewardPerSecond, UnlockableAmount: deposit.Amount / 2},
+			},
+		},
+		"From deposit end": {
+			deposit:  deposit,
+			from:     200,
+			interval: 1,
+			expectedSchedule: []SchedulePoint{
+				{Timestamp: 200, ClaimableReward: 80 * rewardPerSecond, UnlockableAmount: deposit.Amount},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.expectedSchedule, tt.deposit.Schedule(offer, tt.from, tt.interval))
+		})
+	}
+}
+
+func TestEarlyUnlockPenalty(t *testing.T) {
+	offer := &Offer{
+		UnlockPeriodDuration: 40,
+		EarlyUnlockPenalty:   EarlyUnlockPenaltyDenominator / 10, // 10%
+	}
+	deposit := &Deposit{
+		Start:          100,
+		Duration:       100,
+		Amount:         1000,
+		UnlockedAmount: 100,
+	}
+
+	tests := map[string]struct {
+		unlockTime      uint64
+		expectedPenalty uint64
+	}{
+		"Before unlock period": {
+			unlockTime:      150,
+			expectedPenalty: 90, // (1000 - 100) * 10%
+		},
+		"Middle of unlock period": {
+			unlockTime:      180,
+			expectedPenalty: 50, // (1000 - 500) * 10%
+		},
+		"Deposit end": {
+			unlockTime:      200,
+			expectedPenalty: 0,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.expectedPenalty, deposit.EarlyUnlockPenalty(offer, tt.unlockTime))
+		})
+	}
+}
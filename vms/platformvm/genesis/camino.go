@@ -6,6 +6,7 @@ package genesis
 import (
 	"time"
 
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
@@ -15,6 +16,7 @@ import (
 
 // Camino genesis args
 type Camino struct {
+	UpgradeVersionID         codec.UpgradeVersionID
 	VerifyNodeSignature      bool                     `serialize:"true"`
 	LockModeBondDeposit      bool                     `serialize:"true"`
 	InitialAdmin             ids.ShortID              `serialize:"true"`
@@ -23,6 +25,9 @@ type Camino struct {
 	Blocks                   []*Block                 `serialize:"true"` // arranged in a block order
 	ConsortiumMembersNodeIDs []ConsortiumMemberNodeID `serialize:"true"`
 	MultisigAliases          []*multisig.Alias        `serialize:"true"`
+	TreasuryAdmin            ids.ShortID              `serialize:"true" upgradeVersion:"1"`
+	TreasurySpendLimit       uint64                   `serialize:"true" upgradeVersion:"1"`
+	TreasurySpendPeriod      uint64                   `serialize:"true" upgradeVersion:"1"`
 }
 
 func (c *Camino) Init() error {
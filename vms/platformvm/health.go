@@ -18,7 +18,10 @@ import (
 
 const fallbackMinPercentConnected = 0.8
 
-var errNotEnoughStake = errors.New("not connected to enough stake")
+var (
+	errNotEnoughStake    = errors.New("not connected to enough stake")
+	errLocalNodeDeferred = errors.New("local node is deferred")
+)
 
 func (vm *VM) HealthCheck(context.Context) (interface{}, error) {
 	// Returns nil if this node is connected to > alpha percent of the Primary Network's stake
@@ -44,6 +47,27 @@ func (vm *VM) HealthCheck(context.Context) (interface{}, error) {
 		return nil, fmt.Errorf("couldn't get current local validator: %w", err)
 	}
 
+	localDeferredValidator, err := vm.state.GetDeferredValidator(
+		constants.PrimaryNetworkID,
+		vm.ctx.NodeID,
+	)
+	switch err {
+	case nil:
+		details["primary-deferred"] = 1
+		deferredInfo, err := vm.state.GetDeferredValidatorInfo(localDeferredValidator.TxID)
+		switch err {
+		case nil:
+			details["primary-deferredAt"] = float64(deferredInfo.Timestamp)
+		case database.ErrNotFound:
+		default:
+			return nil, fmt.Errorf("couldn't get local deferred validator info: %w", err)
+		}
+	case database.ErrNotFound:
+		localDeferredValidator = nil
+	default:
+		return nil, fmt.Errorf("couldn't get deferred local validator: %w", err)
+	}
+
 	primaryMinPercentConnected, ok := vm.MinPercentConnectedStakeHealthy[constants.PrimaryNetworkID]
 	if !ok {
 		// This should never happen according to the comment for
@@ -104,11 +128,46 @@ func (vm *VM) HealthCheck(context.Context) (interface{}, error) {
 		}
 	}
 
-	if len(errorReasons) == 0 || !vm.StakingEnabled {
+	if len(errorReasons) == 0 && localDeferredValidator == nil || !vm.StakingEnabled {
 		return details, nil
 	}
-	return details, fmt.Errorf("platform layer is unhealthy err: %w, details: %s",
-		errNotEnoughStake,
-		strings.Join(errorReasons, ", "),
-	)
+
+	unhealthyErrs := healthErrors{}
+	if len(errorReasons) > 0 {
+		unhealthyErrs = append(unhealthyErrs, fmt.Errorf("platform layer is unhealthy err: %w, details: %s",
+			errNotEnoughStake,
+			strings.Join(errorReasons, ", "),
+		))
+	}
+	if localDeferredValidator != nil {
+		// deferred validator isn't validating and isn't receiving rewards,
+		// until admin or consortium vote reinstates it
+		unhealthyErrs = append(unhealthyErrs, fmt.Errorf(
+			"%w, its staking period ends at %s",
+			errLocalNodeDeferred,
+			localDeferredValidator.EndTime,
+		))
+	}
+	return details, unhealthyErrs
+}
+
+// healthErrors combines multiple health check errors,
+// errors.Is returns true for any of them
+type healthErrors []error
+
+func (errs healthErrors) Error() string {
+	errStrs := make([]string, len(errs))
+	for i, err := range errs {
+		errStrs[i] = err.Error()
+	}
+	return strings.Join(errStrs, "; ")
+}
+
+func (errs healthErrors) Is(target error) bool {
+	for _, err := range errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
 }
@@ -12,7 +12,9 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/version"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 )
 
 const defaultMinConnectedStake = 0.8
@@ -108,3 +110,38 @@ func TestHealthCheckSubnet(t *testing.T) {
 		})
 	}
 }
+
+func TestHealthCheckDeferredLocalNode(t *testing.T) {
+	require := require.New(t)
+
+	vm, _, _ := defaultVM()
+	vm.ctx.Lock.Lock()
+
+	defer func() {
+		require.NoError(vm.Shutdown(context.Background()))
+		vm.ctx.Lock.Unlock()
+	}()
+	genesisState, _ := defaultGenesis()
+	for _, validator := range genesisState.Validators {
+		err := vm.Connected(context.Background(), validator.NodeID, version.CurrentApp)
+		require.NoError(err)
+	}
+	_, err := vm.HealthCheck(context.Background())
+	require.NoError(err)
+
+	vm.ctx.NodeID = genesisState.Validators[0].NodeID
+	localValidator, err := vm.state.GetCurrentValidator(constants.PrimaryNetworkID, vm.ctx.NodeID)
+	require.NoError(err)
+	vm.state.DeleteCurrentValidator(localValidator)
+	vm.state.PutDeferredValidator(localValidator)
+	vm.state.SetDeferredValidatorInfo(localValidator.TxID, &state.DeferredValidatorInfo{Timestamp: 100})
+
+	details, err := vm.HealthCheck(context.Background())
+	require.ErrorIs(err, errLocalNodeDeferred)
+	require.NotErrorIs(err, errNotEnoughStake)
+	require.Equal(map[string]float64{
+		"primary-percentConnected": 1,
+		"primary-deferred":         1,
+		"primary-deferredAt":       100,
+	}, details)
+}
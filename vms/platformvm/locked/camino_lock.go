@@ -161,7 +161,8 @@ func (out *Out) Addresses() [][]byte {
 }
 
 func (out *Out) Verify() error {
-	if _, nested := out.TransferableOut.(*Out); nested {
+	switch out.TransferableOut.(type) {
+	case *Out, *VestingOut:
 		return errNestedLocks
 	}
 	return out.TransferableOut.Verify()
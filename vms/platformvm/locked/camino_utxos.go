@@ -0,0 +1,101 @@
+// Copyright (C) 2022-2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package locked
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+)
+
+type innerSortUTXOs struct {
+	utxos          []*avax.UTXO
+	allowedAssetID ids.ID
+	lockState      State
+}
+
+func (sort *innerSortUTXOs) Less(i, j int) bool {
+	iUTXO := sort.utxos[i]
+	jUTXO := sort.utxos[j]
+
+	if iUTXO.AssetID() == sort.allowedAssetID && jUTXO.AssetID() != sort.allowedAssetID {
+		return true
+	}
+
+	iOut := iUTXO.Out
+	iLockIDs := &IDsEmpty
+	if lockedOut, ok := iOut.(*Out); ok {
+		iOut = lockedOut.TransferableOut
+		iLockIDs = &lockedOut.IDs
+	}
+
+	jOut := jUTXO.Out
+	jLockIDs := &IDsEmpty
+	if lockedOut, ok := jOut.(*Out); ok {
+		jOut = lockedOut.TransferableOut
+		jLockIDs = &lockedOut.IDs
+	}
+
+	if sort.lockState == StateUnlocked {
+		// Sort all locks last
+		iEmpty := *iLockIDs == IDsEmpty
+		if iEmpty != (*jLockIDs == IDsEmpty) {
+			return iEmpty
+		}
+	} else {
+		iLockTxID := &iLockIDs.DepositTxID
+		jLockTxID := &jLockIDs.DepositTxID
+		iOtherLockTxID := &iLockIDs.BondTxID
+		jOtherLockTxID := &jLockIDs.BondTxID
+		if sort.lockState == StateBonded {
+			iLockTxID = &iLockIDs.BondTxID
+			jLockTxID = &jLockIDs.BondTxID
+			iOtherLockTxID = &iLockIDs.DepositTxID
+			jOtherLockTxID = &jLockIDs.DepositTxID
+		}
+
+		if *iLockTxID == ids.Empty && *jLockTxID != ids.Empty {
+			return true
+		} else if *iLockTxID != ids.Empty && *jLockTxID == ids.Empty {
+			return false
+		}
+
+		switch bytes.Compare(iOtherLockTxID[:], jOtherLockTxID[:]) {
+		case -1:
+			return false
+		case 1:
+			return true
+		}
+	}
+
+	iAmount := uint64(0)
+	if amounter, ok := iOut.(avax.Amounter); ok {
+		iAmount = amounter.Amount()
+	}
+
+	jAmount := uint64(0)
+	if amounter, ok := jOut.(avax.Amounter); ok {
+		jAmount = amounter.Amount()
+	}
+
+	return iAmount < jAmount
+}
+
+func (sort *innerSortUTXOs) Len() int {
+	return len(sort.utxos)
+}
+
+func (sort *innerSortUTXOs) Swap(i, j int) {
+	u := sort.utxos
+	u[j], u[i] = u[i], u[j]
+}
+
+// SortUTXOs sorts utxos, so that utxos with [allowedAssetID] go first and,
+// depending on [lockState], utxos that can be locked with it go before others.
+// Utxos with the same lock state are sorted by amount.
+func SortUTXOs(utxos []*avax.UTXO, allowedAssetID ids.ID, lockState State) {
+	sort.Sort(&innerSortUTXOs{utxos: utxos, allowedAssetID: allowedAssetID, lockState: lockState})
+}
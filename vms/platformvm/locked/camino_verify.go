@@ -17,7 +17,7 @@ var (
 
 // Verifies that [ins] and [outs] have allowed types depending on [lockModeBonding].
 // If lockModeBonding is true, than ins and outs can't be stakeable types.
-// If lockModeBonding is false, than ins and outs can't be locked types.
+// If lockModeBonding is false, than ins and outs can't be locked or vesting types.
 func VerifyLockMode(
 	ins []*avax.TransferableInput,
 	outs []*avax.TransferableOutput,
@@ -70,7 +70,8 @@ func VerifyLockMode(
 			out = outerOut.TransferableOut
 		}
 
-		if _, ok := out.(*Out); ok {
+		switch out.(type) {
+		case *Out, *VestingOut:
 			return ErrWrongOutType
 		}
 	}
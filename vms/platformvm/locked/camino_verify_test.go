@@ -59,6 +59,14 @@ func TestVerifyLockMode(t *testing.T) {
 			lockModeDepositBonding: false,
 			expectedErr:            ErrWrongOutType,
 		},
+		"fail (lockModeDepositBonding false): vesting output": {
+			ins: []*avax.TransferableInput{},
+			outs: []*avax.TransferableOutput{{
+				Out: &VestingOut{TransferableOut: &secp256k1fx.TransferOutput{}},
+			}},
+			lockModeDepositBonding: false,
+			expectedErr:            ErrWrongOutType,
+		},
 		"fail (lockModeDepositBonding true): wrong input type": {
 			ins: []*avax.TransferableInput{
 				generateTestStakeableIn(),
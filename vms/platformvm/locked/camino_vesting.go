@@ -0,0 +1,129 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package locked
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+)
+
+var (
+	errZeroVestingDuration  = errors.New("vesting duration is zero")
+	errZeroVestingAmount    = errors.New("vesting total amount is zero")
+	errCliffAfterVestingEnd = errors.New("vesting cliff is longer than vesting duration")
+	errStepTooBig           = errors.New("vesting step is longer than vesting duration")
+	errVestingEndOverflow   = errors.New("vesting end overflows")
+	errVestingAmountTooBig  = errors.New("vesting out amount is bigger than schedule total amount")
+	errNestedVesting        = errors.New("vesting out can't wrap locked or stakeable out")
+)
+
+// VestingSchedule describes release of [TotalAmount] tokens over time.
+// Nothing is released before [Start] + [Cliff]. After that, tokens are released
+// linearly over [Duration] seconds since [Start], or in equal tranches every
+// [Step] seconds, if [Step] isn't zero. Everything is released at [Start] + [Duration].
+type VestingSchedule struct {
+	// Unix time in seconds when vesting starts
+	Start uint64 `serialize:"true" json:"start"`
+	// Duration in seconds since [Start] during which nothing is released
+	Cliff uint64 `serialize:"true" json:"cliff"`
+	// Duration in seconds since [Start] after which everything is released
+	Duration uint64 `serialize:"true" json:"duration"`
+	// Duration in seconds of one release tranche, zero means linear release
+	Step uint64 `serialize:"true" json:"step"`
+	// Total amount of tokens released by this schedule
+	TotalAmount uint64 `serialize:"true" json:"totalAmount"`
+}
+
+func (schedule *VestingSchedule) Verify() error {
+	switch {
+	case schedule.Duration == 0:
+		return errZeroVestingDuration
+	case schedule.TotalAmount == 0:
+		return errZeroVestingAmount
+	case schedule.Cliff > schedule.Duration:
+		return errCliffAfterVestingEnd
+	case schedule.Step > schedule.Duration:
+		return errStepTooBig
+	}
+	if _, err := math.Add64(schedule.Start, schedule.Duration); err != nil {
+		return errVestingEndOverflow
+	}
+	return nil
+}
+
+// End returns unix time in seconds when all tokens are released.
+func (schedule *VestingSchedule) End() uint64 {
+	return schedule.Start + schedule.Duration
+}
+
+// VestedAmount returns amount of tokens released by this schedule at [timestamp].
+func (schedule *VestingSchedule) VestedAmount(timestamp uint64) uint64 {
+	switch {
+	case timestamp < schedule.Start || timestamp-schedule.Start < schedule.Cliff:
+		return 0
+	case timestamp >= schedule.End():
+		return schedule.TotalAmount
+	}
+
+	elapsed := timestamp - schedule.Start
+	if schedule.Step != 0 {
+		elapsed -= elapsed % schedule.Step
+	}
+
+	vested := new(big.Int).SetUint64(schedule.TotalAmount)
+	vested.Mul(vested, new(big.Int).SetUint64(elapsed))
+	vested.Div(vested, new(big.Int).SetUint64(schedule.Duration))
+	return vested.Uint64()
+}
+
+// VestingOut is an output, which tokens can only be spent after they are
+// released by its vesting schedule. Amount of out can be less than schedule total amount,
+// if some of already released tokens were spent.
+type VestingOut struct {
+	Schedule             VestingSchedule `serialize:"true" json:"vestingSchedule"`
+	avax.TransferableOut `serialize:"true" json:"output"`
+}
+
+func (out *VestingOut) Addresses() [][]byte {
+	if addressable, ok := out.TransferableOut.(avax.Addressable); ok {
+		return addressable.Addresses()
+	}
+	return nil
+}
+
+func (out *VestingOut) Verify() error {
+	switch out.TransferableOut.(type) {
+	case *Out, *VestingOut, *stakeable.LockOut:
+		return errNestedVesting
+	}
+	if err := out.Schedule.Verify(); err != nil {
+		return err
+	}
+	if err := out.TransferableOut.Verify(); err != nil {
+		return err
+	}
+	if out.Amount() > out.Schedule.TotalAmount {
+		return errVestingAmountTooBig
+	}
+	return nil
+}
+
+// UnvestedAmount returns amount of this out tokens that are not yet released at [timestamp].
+func (out *VestingOut) UnvestedAmount(timestamp uint64) uint64 {
+	return math.Min(out.Amount(), out.Schedule.TotalAmount-out.Schedule.VestedAmount(timestamp))
+}
+
+// Verifies that [outs] don't contain vesting outs.
+func VerifyNoVesting(outs []*avax.TransferableOutput) error {
+	for _, output := range outs {
+		if _, ok := output.Out.(*VestingOut); ok {
+			return ErrWrongOutType
+		}
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package locked
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestVestingScheduleVestedAmount(t *testing.T) {
+	tests := map[string]struct {
+		schedule       VestingSchedule
+		timestamp      uint64
+		expectedAmount uint64
+	}{
+		"Before start": {
+			schedule:       VestingSchedule{Start: 100, Duration: 100, TotalAmount: 1000},
+			timestamp:      99,
+			expectedAmount: 0,
+		},
+		"Before cliff": {
+			schedule:       VestingSchedule{Start: 100, Cliff: 50, Duration: 100, TotalAmount: 1000},
+			timestamp:      149,
+			expectedAmount: 0,
+		},
+		"At cliff": {
+			schedule:       VestingSchedule{Start: 100, Cliff: 50, Duration: 100, TotalAmount: 1000},
+			timestamp:      150,
+			expectedAmount: 500,
+		},
+		"Linear": {
+			schedule:       VestingSchedule{Start: 100, Duration: 100, TotalAmount: 1000},
+			timestamp:      133,
+			expectedAmount: 330,
+		},
+		"Stepped": {
+			schedule:       VestingSchedule{Start: 100, Duration: 100, Step: 25, TotalAmount: 1000},
+			timestamp:      174,
+			expectedAmount: 500,
+		},
+		"At end": {
+			schedule:       VestingSchedule{Start: 100, Duration: 100, Step: 30, TotalAmount: 1000},
+			timestamp:      200,
+			expectedAmount: 1000,
+		},
+		"Big amount": {
+			schedule:       VestingSchedule{Start: 100, Duration: 100, TotalAmount: math.MaxUint64},
+			timestamp:      150,
+			expectedAmount: math.MaxUint64 / 2,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.expectedAmount, tt.schedule.VestedAmount(tt.timestamp))
+		})
+	}
+}
+
+func TestVestingOutVerify(t *testing.T) {
+	owner := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{1}}}
+	schedule := VestingSchedule{Start: 100, Cliff: 10, Duration: 100, Step: 10, TotalAmount: 1000}
+
+	tests := map[string]struct {
+		out         *VestingOut
+		expectedErr error
+	}{
+		"Nested locked out": {
+			out: &VestingOut{
+				Schedule:        schedule,
+				TransferableOut: &Out{TransferableOut: &secp256k1fx.TransferOutput{Amt: 1, OutputOwners: owner}},
+			},
+			expectedErr: errNestedVesting,
+		},
+		"Nested stakeable out": {
+			out: &VestingOut{
+				Schedule:        schedule,
+				TransferableOut: &stakeable.LockOut{TransferableOut: &secp256k1fx.TransferOutput{Amt: 1, OutputOwners: owner}},
+			},
+			expectedErr: errNestedVesting,
+		},
+		"Zero duration": {
+			out: &VestingOut{
+				Schedule:        VestingSchedule{Start: 100, TotalAmount: 1000},
+				TransferableOut: &secp256k1fx.TransferOutput{Amt: 1, OutputOwners: owner},
+			},
+			expectedErr: errZeroVestingDuration,
+		},
+		"Zero total amount": {
+			out: &VestingOut{
+				Schedule:        VestingSchedule{Start: 100, Duration: 100},
+				TransferableOut: &secp256k1fx.TransferOutput{Amt: 1, OutputOwners: owner},
+			},
+			expectedErr: errZeroVestingAmount,
+		},
+		"Cliff after end": {
+			out: &VestingOut{
+				Schedule:        VestingSchedule{Start: 100, Cliff: 101, Duration: 100, TotalAmount: 1000},
+				TransferableOut: &secp256k1fx.TransferOutput{Amt: 1, OutputOwners: owner},
+			},
+			expectedErr: errCliffAfterVestingEnd,
+		},
+		"Step bigger than duration": {
+			out: &VestingOut{
+				Schedule:        VestingSchedule{Start: 100, Duration: 100, Step: 101, TotalAmount: 1000},
+				TransferableOut: &secp256k1fx.TransferOutput{Amt: 1, OutputOwners: owner},
+			},
+			expectedErr: errStepTooBig,
+		},
+		"End overflow": {
+			out: &VestingOut{
+				Schedule:        VestingSchedule{Start: math.MaxUint64, Duration: 100, TotalAmount: 1000},
+				TransferableOut: &secp256k1fx.TransferOutput{Amt: 1, OutputOwners: owner},
+			},
+			expectedErr: errVestingEndOverflow,
+		},
+		"Amount bigger than total amount": {
+			out: &VestingOut{
+				Schedule:        schedule,
+				TransferableOut: &secp256k1fx.TransferOutput{Amt: 1001, OutputOwners: owner},
+			},
+			expectedErr: errVestingAmountTooBig,
+		},
+		"OK": {
+			out: &VestingOut{
+				Schedule:        schedule,
+				TransferableOut: &secp256k1fx.TransferOutput{Amt: 1000, OutputOwners: owner},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.out.Verify(), tt.expectedErr)
+		})
+	}
+}
+
+func TestVestingOutUnvestedAmount(t *testing.T) {
+	schedule := VestingSchedule{Start: 100, Duration: 100, Step: 10, TotalAmount: 1000}
+	out := &VestingOut{
+		Schedule:        schedule,
+		TransferableOut: &secp256k1fx.TransferOutput{Amt: 600},
+	}
+	require.Equal(t, uint64(600), out.UnvestedAmount(100))
+	require.Equal(t, uint64(600), out.UnvestedAmount(140))
+	require.Equal(t, uint64(500), out.UnvestedAmount(159))
+	require.Equal(t, uint64(0), out.UnvestedAmount(200))
+}
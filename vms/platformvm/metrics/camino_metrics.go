@@ -0,0 +1,89 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+)
+
+const depositOfferIDLabel = "offerID"
+
+type depositOfferMetrics struct {
+	activeDeposits      *prometheus.GaugeVec
+	depositedAmount     *prometheus.GaugeVec
+	rewardAmount        *prometheus.GaugeVec
+	claimedRewardAmount *prometheus.GaugeVec
+	remainingAmount     *prometheus.GaugeVec
+	remainingReward     *prometheus.GaugeVec
+}
+
+func newDepositOfferMetrics(namespace string, registerer prometheus.Registerer) (*depositOfferMetrics, error) {
+	m := &depositOfferMetrics{
+		activeDeposits: newDepositOfferGauge(namespace, "active_deposits",
+			"Number of active deposits created with deposit offer"),
+		depositedAmount: newDepositOfferGauge(namespace, "deposited_amount",
+			"Amount (in nCAM) that is still locked in active deposits created with deposit offer"),
+		rewardAmount: newDepositOfferGauge(namespace, "reward_amount",
+			"Total reward (in nCAM) committed to active deposits created with deposit offer"),
+		claimedRewardAmount: newDepositOfferGauge(namespace, "claimed_reward_amount",
+			"Reward (in nCAM) already claimed from active deposits created with deposit offer"),
+		remainingAmount: newDepositOfferGauge(namespace, "remaining_amount",
+			"Max amount (in nCAM) that still can be deposited with limited deposit offer"),
+		remainingReward: newDepositOfferGauge(namespace, "remaining_reward",
+			"Max reward (in nCAM) that still can be committed to new deposits of limited deposit offer"),
+	}
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		registerer.Register(m.activeDeposits),
+		registerer.Register(m.depositedAmount),
+		registerer.Register(m.rewardAmount),
+		registerer.Register(m.claimedRewardAmount),
+		registerer.Register(m.remainingAmount),
+		registerer.Register(m.remainingReward),
+	)
+	return m, errs.Err
+}
+
+func newDepositOfferGauge(namespace, name, help string) *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "deposit_offer_" + name,
+			Help:      help,
+		},
+		[]string{depositOfferIDLabel},
+	)
+}
+
+func (m *metrics) SetDepositOfferStats(offer *deposit.Offer, stats *deposit.OfferStats) {
+	offerID := offer.ID.String()
+	m.depositOfferMetrics.activeDeposits.WithLabelValues(offerID).Set(float64(stats.ActiveDeposits))
+	m.depositOfferMetrics.depositedAmount.WithLabelValues(offerID).Set(float64(stats.DepositedAmount))
+	m.depositOfferMetrics.rewardAmount.WithLabelValues(offerID).Set(float64(stats.RewardAmount))
+	m.depositOfferMetrics.claimedRewardAmount.WithLabelValues(offerID).Set(float64(stats.ClaimedRewardAmount))
+
+	switch {
+	case offer.TotalMaxAmount > 0:
+		m.depositOfferMetrics.remainingAmount.WithLabelValues(offerID).Set(float64(offer.RemainingAmount()))
+		m.depositOfferMetrics.remainingReward.WithLabelValues(offerID).Set(float64(offer.MaxRemainingRewardByTotalMaxAmount()))
+	case offer.TotalMaxRewardAmount > 0:
+		m.depositOfferMetrics.remainingAmount.WithLabelValues(offerID).Set(float64(offer.MaxRemainingAmountByReward()))
+		m.depositOfferMetrics.remainingReward.WithLabelValues(offerID).Set(float64(offer.RemainingReward()))
+	}
+}
+
+func (m *metrics) RemoveDepositOfferStats(offerID ids.ID) {
+	offerIDStr := offerID.String()
+	m.depositOfferMetrics.activeDeposits.DeleteLabelValues(offerIDStr)
+	m.depositOfferMetrics.depositedAmount.DeleteLabelValues(offerIDStr)
+	m.depositOfferMetrics.rewardAmount.DeleteLabelValues(offerIDStr)
+	m.depositOfferMetrics.claimedRewardAmount.DeleteLabelValues(offerIDStr)
+	m.depositOfferMetrics.remainingAmount.DeleteLabelValues(offerIDStr)
+	m.depositOfferMetrics.remainingReward.DeleteLabelValues(offerIDStr)
+}
@@ -21,7 +21,16 @@ type caminoTxMetrics struct {
 	numRewardsImportTxs,
 	numBaseTxs,
 	numMultisigAliasTxs,
-	numAddDepositOfferTxs prometheus.Counter
+	numAddDepositOfferTxs,
+	numAddProposalTxs,
+	numAddVoteTxs,
+	numFinishProposalsTxs,
+	numUpdateDepositOfferTxs,
+	numTransferDepositTxs,
+	numSetRewardRestakeTxs,
+	numSetSubnetValidatorRequirementsTxs,
+	numTreasurySpendTxs,
+	numRotateValidatorNodeTxs prometheus.Counter
 }
 
 func newCaminoTxMetrics(
@@ -37,15 +46,24 @@ func newCaminoTxMetrics(
 	m := &caminoTxMetrics{
 		txMetrics: *txm,
 		// Camino specific tx metrics
-		numAddressStateTxs:    newTxMetric(namespace, "add_address_state", registerer, &errs),
-		numDepositTxs:         newTxMetric(namespace, "deposit", registerer, &errs),
-		numUnlockDepositTxs:   newTxMetric(namespace, "unlock_deposit", registerer, &errs),
-		numClaimTxs:           newTxMetric(namespace, "claim", registerer, &errs),
-		numRegisterNodeTxs:    newTxMetric(namespace, "register_node", registerer, &errs),
-		numRewardsImportTxs:   newTxMetric(namespace, "rewards_import", registerer, &errs),
-		numBaseTxs:            newTxMetric(namespace, "base", registerer, &errs),
-		numMultisigAliasTxs:   newTxMetric(namespace, "multisig_alias", registerer, &errs),
-		numAddDepositOfferTxs: newTxMetric(namespace, "add_deposit_offer", registerer, &errs),
+		numAddressStateTxs:                   newTxMetric(namespace, "add_address_state", registerer, &errs),
+		numDepositTxs:                        newTxMetric(namespace, "deposit", registerer, &errs),
+		numUnlockDepositTxs:                  newTxMetric(namespace, "unlock_deposit", registerer, &errs),
+		numClaimTxs:                          newTxMetric(namespace, "claim", registerer, &errs),
+		numRegisterNodeTxs:                   newTxMetric(namespace, "register_node", registerer, &errs),
+		numRewardsImportTxs:                  newTxMetric(namespace, "rewards_import", registerer, &errs),
+		numBaseTxs:                           newTxMetric(namespace, "base", registerer, &errs),
+		numMultisigAliasTxs:                  newTxMetric(namespace, "multisig_alias", registerer, &errs),
+		numAddDepositOfferTxs:                newTxMetric(namespace, "add_deposit_offer", registerer, &errs),
+		numAddProposalTxs:                    newTxMetric(namespace, "add_proposal", registerer, &errs),
+		numAddVoteTxs:                        newTxMetric(namespace, "add_vote", registerer, &errs),
+		numFinishProposalsTxs:                newTxMetric(namespace, "finish_proposals", registerer, &errs),
+		numUpdateDepositOfferTxs:             newTxMetric(namespace, "update_deposit_offer", registerer, &errs),
+		numTransferDepositTxs:                newTxMetric(namespace, "transfer_deposit", registerer, &errs),
+		numSetRewardRestakeTxs:               newTxMetric(namespace, "set_reward_restake", registerer, &errs),
+		numSetSubnetValidatorRequirementsTxs: newTxMetric(namespace, "set_subnet_validator_requirements", registerer, &errs),
+		numTreasurySpendTxs:                  newTxMetric(namespace, "treasury_spend", registerer, &errs),
+		numRotateValidatorNodeTxs:            newTxMetric(namespace, "rotate_validator_node", registerer, &errs),
 	}
 	return m, errs.Err
 }
@@ -88,6 +106,42 @@ func (*txMetrics) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
 	return nil
 }
 
+func (*txMetrics) AddProposalTx(*txs.AddProposalTx) error {
+	return nil
+}
+
+func (*txMetrics) AddVoteTx(*txs.AddVoteTx) error {
+	return nil
+}
+
+func (*txMetrics) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return nil
+}
+
+func (*txMetrics) UpdateDepositOfferTx(*txs.UpdateDepositOfferTx) error {
+	return nil
+}
+
+func (*txMetrics) TransferDepositTx(*txs.TransferDepositTx) error {
+	return nil
+}
+
+func (*txMetrics) SetRewardRestakeTx(*txs.SetRewardRestakeTx) error {
+	return nil
+}
+
+func (*txMetrics) SetSubnetValidatorRequirementsTx(*txs.SetSubnetValidatorRequirementsTx) error {
+	return nil
+}
+
+func (*txMetrics) TreasurySpendTx(*txs.TreasurySpendTx) error {
+	return nil
+}
+
+func (*txMetrics) RotateValidatorNodeTx(*txs.RotateValidatorNodeTx) error {
+	return nil
+}
+
 // camino metrics
 
 func (m *caminoTxMetrics) AddressStateTx(*txs.AddressStateTx) error {
@@ -134,3 +188,48 @@ func (m *caminoTxMetrics) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
 	m.numAddDepositOfferTxs.Inc()
 	return nil
 }
+
+func (m *caminoTxMetrics) AddProposalTx(*txs.AddProposalTx) error {
+	m.numAddProposalTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) AddVoteTx(*txs.AddVoteTx) error {
+	m.numAddVoteTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	m.numFinishProposalsTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) UpdateDepositOfferTx(*txs.UpdateDepositOfferTx) error {
+	m.numUpdateDepositOfferTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) TransferDepositTx(*txs.TransferDepositTx) error {
+	m.numTransferDepositTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) SetRewardRestakeTx(*txs.SetRewardRestakeTx) error {
+	m.numSetRewardRestakeTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) SetSubnetValidatorRequirementsTx(*txs.SetSubnetValidatorRequirementsTx) error {
+	m.numSetSubnetValidatorRequirementsTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) TreasurySpendTx(*txs.TreasurySpendTx) error {
+	m.numTreasurySpendTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) RotateValidatorNodeTx(*txs.RotateValidatorNodeTx) error {
+	m.numRotateValidatorNodeTxs.Inc()
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 )
 
 var _ Metrics = (*metrics)(nil)
@@ -48,6 +49,10 @@ type Metrics interface {
 	// Mark that this node is connected to this percent of the Primary Network's
 	// stake.
 	SetPercentConnected(percent float64)
+	// Mark stats of active deposits created with this deposit offer.
+	SetDepositOfferStats(offer *deposit.Offer, stats *deposit.OfferStats)
+	// Mark that this deposit offer is no longer active.
+	RemoveDepositOfferStats(offerID ids.ID)
 }
 
 func New(
@@ -56,8 +61,12 @@ func New(
 	trackedSubnets set.Set[ids.ID],
 ) (Metrics, error) {
 	blockMetrics, err := newBlockMetrics(namespace, registerer)
+	errs := wrappers.Errs{Err: err}
+	depositOfferMetrics, err := newDepositOfferMetrics(namespace, registerer)
+	errs.Add(err)
 	m := &metrics{
-		blockMetrics: blockMetrics,
+		blockMetrics:        blockMetrics,
+		depositOfferMetrics: depositOfferMetrics,
 
 		percentConnected: prometheus.NewGauge(prometheus.GaugeOpts{
 			Namespace: namespace,
@@ -129,7 +138,6 @@ func New(
 		}),
 	}
 
-	errs := wrappers.Errs{Err: err}
 	apiRequestMetrics, err := metric.NewAPIInterceptor(namespace, registerer)
 	m.APIInterceptor = apiRequestMetrics
 	errs.Add(
@@ -162,7 +170,8 @@ func New(
 type metrics struct {
 	metric.APIInterceptor
 
-	blockMetrics *blockMetrics
+	blockMetrics        *blockMetrics
+	depositOfferMetrics *depositOfferMetrics
 
 	percentConnected       prometheus.Gauge
 	subnetPercentConnected *prometheus.GaugeVec
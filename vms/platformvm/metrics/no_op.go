@@ -11,6 +11,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 )
 
 var Noop Metrics = noopMetrics{}
@@ -50,3 +51,7 @@ func (noopMetrics) SetTimeUntilSubnetUnstake(ids.ID, time.Duration) {}
 func (noopMetrics) SetSubnetPercentConnected(ids.ID, float64) {}
 
 func (noopMetrics) SetPercentConnected(float64) {}
+
+func (noopMetrics) SetDepositOfferStats(*deposit.Offer, *deposit.OfferStats) {}
+
+func (noopMetrics) RemoveDepositOfferStats(ids.ID) {}
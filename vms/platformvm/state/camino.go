@@ -23,6 +23,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
@@ -37,19 +38,38 @@ const (
 	shortLinksCacheSize   = 1024
 	msigOwnersCacheSize   = 16_384
 	claimablesCacheSize   = 1024
+	proposalsCacheSize    = 1024
 )
 
 var (
 	_ CaminoState = (*caminoState)(nil)
 
-	caminoPrefix              = []byte("camino")
-	addressStatePrefix        = []byte("addressState")
-	depositOffersPrefix       = []byte("depositOffers")
-	depositsPrefix            = []byte("deposits")
-	depositIDsByEndtimePrefix = []byte("depositIDsByEndtime")
-	multisigOwnersPrefix      = []byte("multisigOwners")
-	shortLinksPrefix          = []byte("shortLinks")
-	claimablesPrefix          = []byte("claimables")
+	caminoPrefix                       = []byte("camino")
+	addressStatePrefix                 = []byte("addressState")
+	depositOffersPrefix                = []byte("depositOffers")
+	depositOfferAddressAmountsPrefix   = []byte("depositOfferAddressAmounts")
+	depositsPrefix                     = []byte("deposits")
+	depositIDsByEndtimePrefix          = []byte("depositIDsByEndtime")
+	multisigOwnersPrefix               = []byte("multisigOwners")
+	multisigAliasChangesPrefix         = []byte("multisigAliasChanges")
+	multisigAliasIDsByOwnerPrefix      = []byte("multisigAliasIDsByOwner")
+	multisigAliasIDsByChangeTimePrefix = []byte("multisigAliasIDsByChangeTime")
+	multisigAliasSpendUsagesPrefix     = []byte("multisigAliasSpendUsages")
+	shortLinksPrefix                   = []byte("shortLinks")
+	claimablesPrefix                   = []byte("claimables")
+	rewardRestakeSettingsPrefix        = []byte("rewardRestakeSettings")
+	subnetValidatorRequirementsPrefix  = []byte("subnetValidatorRequirements")
+	proposalsPrefix                    = []byte("proposals")
+	proposalIDsByEndtimePrefix         = []byte("proposalIDsByEndtime")
+	proposalIDsToFinishPrefix          = []byte("proposalIDsToFinish")
+	kycExpirationsPrefix               = []byte("kycExpirations")
+	kycAddressesByExpirationPrefix     = []byte("kycAddressesByExpiration")
+	addressStateHistoryPrefix          = []byte("addressStateHistory")
+	addressStateHistoryLengthPrefix    = []byte("addressStateHistoryLength")
+	treasurySpentAmountsPrefix         = []byte("treasurySpentAmounts")
+	treasurySpendsPrefix               = []byte("treasurySpends")
+	rotatedValidatorNodeIDsPrefix      = []byte("rotatedValidatorNodeIDs")
+	deferredValidatorInfosPrefix       = []byte("deferredValidatorInfos")
 
 	// Used for prefixing the validatorsDB
 	deferredPrefix = []byte("deferred")
@@ -57,6 +77,12 @@ var (
 	nodeSignatureKey                 = []byte("nodeSignature")
 	depositBondModeKey               = []byte("depositBondMode")
 	notDistributedValidatorRewardKey = []byte("notDistributedValidatorReward")
+	baseFeeKey                       = []byte("baseFee")
+	treasuryAdminKey                 = []byte("treasuryAdmin")
+	treasurySpendLimitKey            = []byte("treasurySpendLimit")
+	treasurySpendPeriodKey           = []byte("treasurySpendPeriod")
+	treasurySpendsCountKey           = []byte("treasurySpendsCount")
+	multisigAliasOwnersIndexedKey    = []byte("multisigAliasOwnersIndexed")
 
 	errWrongTxType      = errors.New("unexpected tx type")
 	errNonExistingOffer = errors.New("deposit offer doesn't exist")
@@ -72,12 +98,25 @@ type CaminoDiff interface {
 
 	SetAddressStates(ids.ShortID, txs.AddressState)
 	GetAddressStates(ids.ShortID) (txs.AddressState, error)
+	GetAddressesWithStates(txs.AddressState) ([]ids.ShortID, error)
+	// change should never be nil
+	AddAddressStateChange(address ids.ShortID, change *AddressStateChange)
+
+	// KYC expirations
+
+	// zero expiration removes address kyc expiration
+	SetKYCExpiration(address ids.ShortID, expiration uint64)
+	GetKYCExpiration(address ids.ShortID) (uint64, error)
+	GetNextKYCExpirationTime(removedAddresses set.Set[ids.ShortID]) (time.Time, error)
+	GetNextToExpireKYCAddressesAndTime(removedAddresses set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error)
 
 	// Deposit offers
 
 	SetDepositOffer(offer *deposit.Offer)
 	GetDepositOffer(offerID ids.ID) (*deposit.Offer, error)
 	GetAllDepositOffers() ([]*deposit.Offer, error)
+	SetDepositOfferAddressAmount(offerID ids.ID, address ids.ShortID, amount uint64)
+	GetDepositOfferAddressAmount(offerID ids.ID, address ids.ShortID) (uint64, error)
 
 	// Deposits
 
@@ -96,6 +135,20 @@ type CaminoDiff interface {
 	GetMultisigAlias(ids.ShortID) (*multisig.AliasWithNonce, error)
 	SetMultisigAlias(*multisig.AliasWithNonce)
 
+	// Multisig aliases timelocked changes
+
+	// nil change removes alias pending change
+	SetMultisigAliasChange(aliasID ids.ShortID, change *multisig.AliasChange)
+	GetMultisigAliasChange(aliasID ids.ShortID) (*multisig.AliasChange, error)
+	GetNextMultisigAliasChangeTime(removedAliasIDs set.Set[ids.ShortID]) (time.Time, error)
+	GetNextToApplyMultisigAliasChangesAndTime(removedAliasIDs set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error)
+
+	// Multisig aliases spend limit usage
+
+	// nil usage removes alias spend limit usage
+	SetMultisigAliasSpendLimitUsage(aliasID ids.ShortID, usage *multisig.SpendLimitUsage)
+	GetMultisigAliasSpendLimitUsage(aliasID ids.ShortID) (*multisig.SpendLimitUsage, error)
+
 	// ShortIDsLink
 
 	SetShortIDLink(id ids.ShortID, key ShortLinkKey, link *ids.ShortID)
@@ -105,15 +158,54 @@ type CaminoDiff interface {
 
 	SetClaimable(ownerID ids.ID, claimable *Claimable)
 	GetClaimable(ownerID ids.ID) (*Claimable, error)
+	SetRewardRestakeSetting(ownerID ids.ID, setting *RewardRestakeSetting)
+	GetRewardRestakeSetting(ownerID ids.ID) (*RewardRestakeSetting, error)
 	SetNotDistributedValidatorReward(reward uint64)
 	GetNotDistributedValidatorReward() (uint64, error)
 
+	// Subnet validator requirements
+
+	SetSubnetValidatorRequirements(subnetID ids.ID, requirements *SubnetValidatorRequirements)
+	GetSubnetValidatorRequirements(subnetID ids.ID) (*SubnetValidatorRequirements, error)
+
 	// Deferred validator set
 
 	GetDeferredValidator(subnetID ids.ID, nodeID ids.NodeID) (*Staker, error)
 	PutDeferredValidator(staker *Staker)
 	DeleteDeferredValidator(staker *Staker)
 	GetDeferredStakerIterator() (StakerIterator, error)
+	// Nil [info] removes it
+	SetDeferredValidatorInfo(validatorTxID ids.ID, info *DeferredValidatorInfo)
+	GetDeferredValidatorInfo(validatorTxID ids.ID) (*DeferredValidatorInfo, error)
+
+	// DAO proposals
+
+	// proposal should never be nil
+	AddProposal(proposalID ids.ID, proposal dao.ProposalState)
+	// proposal start and end time should never be modified, proposal should never be nil
+	ModifyProposal(proposalID ids.ID, proposal dao.ProposalState)
+	// proposal start and end time should never be modified, proposal should never be nil
+	RemoveProposal(proposalID ids.ID, proposal dao.ProposalState)
+	GetProposal(proposalID ids.ID) (dao.ProposalState, error)
+	AddProposalIDToFinish(proposalID ids.ID)
+	RemoveProposalIDToFinish(proposalID ids.ID)
+	GetProposalIDsToFinish() ([]ids.ID, error)
+	GetNextProposalExpirationTime(removedProposalIDs set.Set[ids.ID]) (time.Time, error)
+	GetNextToExpireProposalIDsAndTime(removedProposalIDs set.Set[ids.ID]) ([]ids.ID, time.Time, error)
+
+	// Base fee
+
+	SetBaseFee(baseFee uint64)
+	GetBaseFee() (uint64, error)
+
+	// Treasury
+
+	// Overrides treasury admin, spend limit and spend period of camino config
+	SetTreasuryConfig(admin ids.ShortID, spendLimit, spendPeriod uint64)
+	SetTreasurySpentAmount(periodStart, amount uint64)
+	GetTreasurySpentAmount(periodStart uint64) (uint64, error)
+	// spend should never be nil
+	AddTreasurySpend(spend *TreasurySpend)
 }
 
 // For state and diff
@@ -132,24 +224,59 @@ type CaminoState interface {
 	CaminoConfig() *CaminoConfig
 	SyncGenesis(*state, *genesis.State) error
 	Load(*state) error
-	Write() error
+	Write(height uint64) error
 	Close() error
+	GetAddressStateHistory(address ids.ShortID, startIndex uint64, limit int) ([]*AddressStateChange, error)
+	GetTreasurySpends(startIndex uint64, limit int) ([]*TreasurySpend, error)
+	GetDepositOfferStats(offerID ids.ID) (*deposit.OfferStats, error)
+	GetMultisigAliasesForAddress(address ids.ShortID) ([]ids.ShortID, error)
+
+	loadRotatedValidatorNodeID(staker *Staker) error
+	putRotatedValidatorNodeID(txID ids.ID, nodeID ids.NodeID) error
 }
 
 type CaminoConfig struct {
 	VerifyNodeSignature bool
 	LockModeBondDeposit bool
+	// Msig alias, which is allowed to spend treasury funds. Empty means that treasury can't be spent
+	TreasuryAdmin ids.ShortID
+	// Max amount that can be spent from treasury during one spending period. Zero means no limit
+	TreasurySpendLimit uint64
+	// Duration of treasury spending period in seconds
+	TreasurySpendPeriod uint64
+}
+
+// TreasurySpendPeriodStart returns start of treasury spending period, which contains [timestamp].
+func (c *CaminoConfig) TreasurySpendPeriodStart(timestamp uint64) uint64 {
+	if c.TreasurySpendPeriod == 0 {
+		return 0
+	}
+	return timestamp - timestamp%c.TreasurySpendPeriod
 }
 
 type caminoDiff struct {
 	deferredStakerDiffs                   diffStakers
 	modifiedAddressStates                 map[ids.ShortID]txs.AddressState
+	modifiedKYCExpirations                map[ids.ShortID]uint64
+	addedAddressStateChanges              map[ids.ShortID][]*AddressStateChange
 	modifiedDepositOffers                 map[ids.ID]*deposit.Offer
+	modifiedDepositOfferAddressAmounts    map[offerAddressKey]uint64
 	modifiedDeposits                      map[ids.ID]*depositDiff
 	modifiedMultisigAliases               map[ids.ShortID]*multisig.AliasWithNonce
+	modifiedMultisigAliasChanges          map[ids.ShortID]*multisig.AliasChange
+	modifiedMultisigAliasSpendUsages      map[ids.ShortID]*multisig.SpendLimitUsage
 	modifiedShortLinks                    map[ids.ID]*ids.ShortID
 	modifiedClaimables                    map[ids.ID]*Claimable
+	modifiedRewardRestakeSettings         map[ids.ID]*RewardRestakeSetting
+	modifiedSubnetValidatorRequirements   map[ids.ID]*SubnetValidatorRequirements
+	modifiedDeferredValidatorInfos        map[ids.ID]*DeferredValidatorInfo
 	modifiedNotDistributedValidatorReward *uint64
+	modifiedProposals                     map[ids.ID]*proposalDiff
+	modifiedProposalIDsToFinish           map[ids.ID]bool
+	modifiedBaseFee                       *uint64
+	modifiedTreasuryConfig                *treasuryConfig
+	modifiedTreasurySpentAmounts          map[uint64]uint64
+	addedTreasurySpends                   []*TreasurySpend
 }
 
 type caminoState struct {
@@ -159,19 +286,38 @@ type caminoState struct {
 	genesisSynced       bool
 	verifyNodeSignature bool
 	lockModeBondDeposit bool
+	treasuryAdmin       ids.ShortID
+	treasurySpendLimit  uint64
+	treasurySpendPeriod uint64
 
 	// Deferred Stakers
 	deferredStakers       *baseStakers
 	deferredValidatorsDB  database.Database
 	deferredValidatorList linkeddb.LinkedDB
 
+	// Deferred validator infos
+	deferredValidatorInfosDB database.Database
+
+	// Rotated validators
+	rotatedValidatorNodeIDsDB database.Database
+
 	// Address State
 	addressStateCache cache.Cacher[ids.ShortID, txs.AddressState]
 	addressStateDB    database.Database
 
+	// Address state history
+	addressStateHistoryDB       database.Database
+	addressStateHistoryLengthDB database.Database
+
+	// KYC expirations
+	kycExpirationsDB           database.Database
+	kycAddressesByExpirationDB database.Database
+
 	// Deposit offers
-	depositOffers   map[ids.ID]*deposit.Offer
-	depositOffersDB database.Database
+	depositOffers                map[ids.ID]*deposit.Offer
+	depositOfferStats            map[ids.ID]*deposit.OfferStats
+	depositOffersDB              database.Database
+	depositOfferAddressAmountsDB database.Database
 
 	// Deposits
 	depositsNextToUnlockTime *time.Time
@@ -183,6 +329,15 @@ type caminoState struct {
 	// MSIG aliases
 	multisigAliasesCache cache.Cacher[ids.ShortID, *multisig.AliasWithNonce]
 	multisigAliasesDB    database.Database
+	// owner address + alias ID -> nil
+	multisigAliasIDsByOwnerDB database.Database
+
+	// MSIG aliases timelocked changes
+	multisigAliasChangesDB         database.Database
+	multisigAliasIDsByChangeTimeDB database.Database
+
+	// MSIG aliases spend limit usage
+	multisigAliasSpendUsagesDB database.Database
 
 	// ShortIDs link
 	shortLinksCache cache.Cacher[ids.ID, *ids.ShortID]
@@ -192,16 +347,46 @@ type caminoState struct {
 	notDistributedValidatorReward uint64
 	claimablesDB                  database.Database
 	claimablesCache               cache.Cacher[ids.ID, *Claimable]
+	rewardRestakeSettingsDB       database.Database
+
+	// Subnet validator requirements
+	subnetValidatorRequirementsDB database.Database
+
+	// DAO proposals
+	proposalsNextExpirationTime *time.Time
+	proposalsNextToExpireIDs    []ids.ID
+	proposalsCache              cache.Cacher[ids.ID, dao.ProposalState]
+	proposalsDB                 database.Database
+	proposalIDsByEndtimeDB      database.Database
+	proposalIDsToFinishDB       database.Database
+
+	// Base fee
+	baseFee *uint64
+
+	// Treasury
+	treasurySpentAmountsDB database.Database
+	treasurySpendsDB       database.Database
 }
 
 func newCaminoDiff() *caminoDiff {
 	return &caminoDiff{
-		modifiedAddressStates:   make(map[ids.ShortID]txs.AddressState),
-		modifiedDepositOffers:   make(map[ids.ID]*deposit.Offer),
-		modifiedDeposits:        make(map[ids.ID]*depositDiff),
-		modifiedMultisigAliases: make(map[ids.ShortID]*multisig.AliasWithNonce),
-		modifiedShortLinks:      make(map[ids.ID]*ids.ShortID),
-		modifiedClaimables:      make(map[ids.ID]*Claimable),
+		modifiedAddressStates:               make(map[ids.ShortID]txs.AddressState),
+		modifiedKYCExpirations:              make(map[ids.ShortID]uint64),
+		addedAddressStateChanges:            make(map[ids.ShortID][]*AddressStateChange),
+		modifiedDepositOffers:               make(map[ids.ID]*deposit.Offer),
+		modifiedDepositOfferAddressAmounts:  make(map[offerAddressKey]uint64),
+		modifiedDeposits:                    make(map[ids.ID]*depositDiff),
+		modifiedMultisigAliases:             make(map[ids.ShortID]*multisig.AliasWithNonce),
+		modifiedMultisigAliasChanges:        make(map[ids.ShortID]*multisig.AliasChange),
+		modifiedMultisigAliasSpendUsages:    make(map[ids.ShortID]*multisig.SpendLimitUsage),
+		modifiedShortLinks:                  make(map[ids.ID]*ids.ShortID),
+		modifiedClaimables:                  make(map[ids.ID]*Claimable),
+		modifiedRewardRestakeSettings:       make(map[ids.ID]*RewardRestakeSetting),
+		modifiedSubnetValidatorRequirements: make(map[ids.ID]*SubnetValidatorRequirements),
+		modifiedDeferredValidatorInfos:      make(map[ids.ID]*DeferredValidatorInfo),
+		modifiedProposals:                   make(map[ids.ID]*proposalDiff),
+		modifiedProposalIDsToFinish:         make(map[ids.ID]bool),
+		modifiedTreasurySpentAmounts:        make(map[uint64]uint64),
 	}
 }
 
@@ -251,6 +436,15 @@ func newCaminoState(baseDB, validatorsDB database.Database, metricsReg prometheu
 		return nil, err
 	}
 
+	proposalsCache, err := metercacher.New[ids.ID, dao.ProposalState](
+		"proposals_cache",
+		metricsReg,
+		&cache.LRU[ids.ID, dao.ProposalState]{Size: proposalsCacheSize},
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	deferredValidatorsDB := prefixdb.New(deferredPrefix, validatorsDB)
 
 	return &caminoState{
@@ -258,9 +452,19 @@ func newCaminoState(baseDB, validatorsDB database.Database, metricsReg prometheu
 		addressStateDB:    prefixdb.New(addressStatePrefix, baseDB),
 		addressStateCache: addressStateCache,
 
+		// Address state history
+		addressStateHistoryDB:       prefixdb.New(addressStateHistoryPrefix, baseDB),
+		addressStateHistoryLengthDB: prefixdb.New(addressStateHistoryLengthPrefix, baseDB),
+
+		// KYC expirations
+		kycExpirationsDB:           prefixdb.New(kycExpirationsPrefix, baseDB),
+		kycAddressesByExpirationDB: prefixdb.New(kycAddressesByExpirationPrefix, baseDB),
+
 		// Deposit offers
-		depositOffers:   make(map[ids.ID]*deposit.Offer),
-		depositOffersDB: prefixdb.New(depositOffersPrefix, baseDB),
+		depositOffers:                make(map[ids.ID]*deposit.Offer),
+		depositOfferStats:            make(map[ids.ID]*deposit.OfferStats),
+		depositOffersDB:              prefixdb.New(depositOffersPrefix, baseDB),
+		depositOfferAddressAmountsDB: prefixdb.New(depositOfferAddressAmountsPrefix, baseDB),
 
 		// Deposits
 		depositsCache:         depositsCache,
@@ -268,22 +472,50 @@ func newCaminoState(baseDB, validatorsDB database.Database, metricsReg prometheu
 		depositIDsByEndtimeDB: prefixdb.New(depositIDsByEndtimePrefix, baseDB),
 
 		// Multisig Owners
-		multisigAliasesCache: multisigOwnersCache,
-		multisigAliasesDB:    prefixdb.New(multisigOwnersPrefix, baseDB),
+		multisigAliasesCache:      multisigOwnersCache,
+		multisigAliasesDB:         prefixdb.New(multisigOwnersPrefix, baseDB),
+		multisigAliasIDsByOwnerDB: prefixdb.New(multisigAliasIDsByOwnerPrefix, baseDB),
+
+		// Multisig aliases timelocked changes
+		multisigAliasChangesDB:         prefixdb.New(multisigAliasChangesPrefix, baseDB),
+		multisigAliasIDsByChangeTimeDB: prefixdb.New(multisigAliasIDsByChangeTimePrefix, baseDB),
+
+		// Multisig aliases spend limit usage
+		multisigAliasSpendUsagesDB: prefixdb.New(multisigAliasSpendUsagesPrefix, baseDB),
 
 		// Short links
 		shortLinksCache: shortLinksCache,
 		shortLinksDB:    prefixdb.New(shortLinksPrefix, baseDB),
 
 		//  Claimable & rewards
-		claimablesCache: claimablesCache,
-		claimablesDB:    prefixdb.New(claimablesPrefix, baseDB),
+		claimablesCache:         claimablesCache,
+		claimablesDB:            prefixdb.New(claimablesPrefix, baseDB),
+		rewardRestakeSettingsDB: prefixdb.New(rewardRestakeSettingsPrefix, baseDB),
+
+		// Subnet validator requirements
+		subnetValidatorRequirementsDB: prefixdb.New(subnetValidatorRequirementsPrefix, baseDB),
+
+		// DAO proposals
+		proposalsCache:         proposalsCache,
+		proposalsDB:            prefixdb.New(proposalsPrefix, baseDB),
+		proposalIDsByEndtimeDB: prefixdb.New(proposalIDsByEndtimePrefix, baseDB),
+		proposalIDsToFinishDB:  prefixdb.New(proposalIDsToFinishPrefix, baseDB),
+
+		// Treasury
+		treasurySpentAmountsDB: prefixdb.New(treasurySpentAmountsPrefix, baseDB),
+		treasurySpendsDB:       prefixdb.New(treasurySpendsPrefix, baseDB),
 
 		// Deferred Stakers
 		deferredStakers:       newBaseStakers(),
 		deferredValidatorsDB:  deferredValidatorsDB,
 		deferredValidatorList: linkeddb.NewDefault(deferredValidatorsDB),
 
+		// Deferred validator infos
+		deferredValidatorInfosDB: prefixdb.New(deferredValidatorInfosPrefix, validatorsDB),
+
+		// Rotated validators
+		rotatedValidatorNodeIDsDB: prefixdb.New(rotatedValidatorNodeIDsPrefix, validatorsDB),
+
 		caminoDB:   prefixdb.New(caminoPrefix, baseDB),
 		caminoDiff: newCaminoDiff(),
 	}, nil
@@ -291,10 +523,15 @@ func newCaminoState(baseDB, validatorsDB database.Database, metricsReg prometheu
 
 // Return current genesis args
 func (cs *caminoState) CaminoConfig() *CaminoConfig {
-	return &CaminoConfig{
+	caminoConfig := &CaminoConfig{
 		VerifyNodeSignature: cs.verifyNodeSignature,
 		LockModeBondDeposit: cs.lockModeBondDeposit,
+		TreasuryAdmin:       cs.treasuryAdmin,
+		TreasurySpendLimit:  cs.treasurySpendLimit,
+		TreasurySpendPeriod: cs.treasurySpendPeriod,
 	}
+	cs.modifiedTreasuryConfig.apply(caminoConfig)
+	return caminoConfig
 }
 
 // Extract camino tag from genesis
@@ -302,6 +539,9 @@ func (cs *caminoState) SyncGenesis(s *state, g *genesis.State) error {
 	cs.genesisSynced = true
 	cs.lockModeBondDeposit = g.Camino.LockModeBondDeposit
 	cs.verifyNodeSignature = g.Camino.VerifyNodeSignature
+	cs.treasuryAdmin = g.Camino.TreasuryAdmin
+	cs.treasurySpendLimit = g.Camino.TreasurySpendLimit
+	cs.treasurySpendPeriod = g.Camino.TreasurySpendPeriod
 
 	if cs.lockModeBondDeposit {
 		// overwriting initial supply because state.SyncGenesis
@@ -489,31 +729,54 @@ func (cs *caminoState) Load(s *state) error {
 
 	errs := wrappers.Errs{}
 	errs.Add(
+		cs.loadTreasuryConfig(),
 		cs.loadDepositOffers(),
 		cs.loadDeposits(),
+		cs.loadDepositOfferStats(), // must be called after loadDepositOffers
 		cs.loadValidatorRewards(),
 		cs.loadDeferredValidators(s),
+		cs.loadProposals(),
+		cs.loadBaseFee(),
+		cs.indexMultisigAliasOwners(),
 	)
 	return errs.Err
 }
 
-func (cs *caminoState) Write() error {
+func (cs *caminoState) Write(height uint64) error {
 	errs := wrappers.Errs{}
 	// Write the singletons (only once after sync)
 	if cs.genesisSynced {
 		errs.Add(
 			database.PutBool(cs.caminoDB, nodeSignatureKey, cs.verifyNodeSignature),
 			database.PutBool(cs.caminoDB, depositBondModeKey, cs.lockModeBondDeposit),
+			cs.caminoDB.Put(treasuryAdminKey, cs.treasuryAdmin[:]),
+			database.PutUInt64(cs.caminoDB, treasurySpendLimitKey, cs.treasurySpendLimit),
+			database.PutUInt64(cs.caminoDB, treasurySpendPeriodKey, cs.treasurySpendPeriod),
+			database.PutBool(cs.caminoDB, multisigAliasOwnersIndexedKey, true),
 		)
 	}
 	errs.Add(
 		cs.writeAddressStates(),
+		cs.writeAddressStateHistory(height),
+		cs.writeKYCExpirations(),
 		cs.writeDepositOffers(),
+		cs.writeDepositOfferAddressAmounts(),
+		cs.updateDepositOfferStats(), // must be called before writeDeposits
 		cs.writeDeposits(),
 		cs.writeMultisigAliases(),
+		cs.writeMultisigAliasChanges(),
+		cs.writeMultisigAliasSpendUsages(),
 		cs.writeShortLinks(),
 		cs.writeClaimableAndValidatorRewards(),
+		cs.writeRewardRestakeSettings(),
+		cs.writeSubnetValidatorRequirements(),
 		cs.writeDeferredStakers(),
+		cs.writeDeferredValidatorInfos(),
+		cs.writeProposals(),
+		cs.writeBaseFee(),
+		cs.writeTreasuryConfig(),
+		cs.writeTreasurySpentAmounts(),
+		cs.writeTreasurySpends(height),
 	)
 	return errs.Err
 }
@@ -523,13 +786,31 @@ func (cs *caminoState) Close() error {
 	errs.Add(
 		cs.caminoDB.Close(),
 		cs.addressStateDB.Close(),
+		cs.addressStateHistoryDB.Close(),
+		cs.addressStateHistoryLengthDB.Close(),
+		cs.kycExpirationsDB.Close(),
+		cs.kycAddressesByExpirationDB.Close(),
 		cs.depositOffersDB.Close(),
+		cs.depositOfferAddressAmountsDB.Close(),
 		cs.depositsDB.Close(),
 		cs.depositIDsByEndtimeDB.Close(),
 		cs.multisigAliasesDB.Close(),
+		cs.multisigAliasIDsByOwnerDB.Close(),
+		cs.multisigAliasChangesDB.Close(),
+		cs.multisigAliasIDsByChangeTimeDB.Close(),
+		cs.multisigAliasSpendUsagesDB.Close(),
 		cs.shortLinksDB.Close(),
 		cs.claimablesDB.Close(),
+		cs.rewardRestakeSettingsDB.Close(),
+		cs.subnetValidatorRequirementsDB.Close(),
 		cs.deferredValidatorsDB.Close(),
+		cs.proposalsDB.Close(),
+		cs.proposalIDsByEndtimeDB.Close(),
+		cs.proposalIDsToFinishDB.Close(),
+		cs.treasurySpentAmountsDB.Close(),
+		cs.treasurySpendsDB.Close(),
+		cs.rotatedValidatorNodeIDsDB.Close(),
+		cs.deferredValidatorInfosDB.Close(),
 	)
 	return errs.Err
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 )
 
@@ -58,3 +59,35 @@ func (cs *caminoState) writeAddressStates() error {
 	}
 	return nil
 }
+
+// Returns sorted addresses, which address state has all [states] bits set
+func (cs *caminoState) GetAddressesWithStates(states txs.AddressState) ([]ids.ShortID, error) {
+	addresses := []ids.ShortID{}
+	for address, addressStates := range cs.modifiedAddressStates {
+		if addressStates&states == states {
+			addresses = append(addresses, address)
+		}
+	}
+
+	addressStateIterator := cs.addressStateDB.NewIterator()
+	defer addressStateIterator.Release()
+	for addressStateIterator.Next() {
+		address, err := ids.ToShortID(addressStateIterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := cs.modifiedAddressStates[address]; ok {
+			continue
+		}
+		addressStates := txs.AddressState(binary.LittleEndian.Uint64(addressStateIterator.Value()))
+		if addressStates&states == states {
+			addresses = append(addresses, address)
+		}
+	}
+	if err := addressStateIterator.Error(); err != nil {
+		return nil, err
+	}
+
+	utils.Sort(addresses)
+	return addresses, nil
+}
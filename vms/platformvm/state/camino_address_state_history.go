@@ -0,0 +1,108 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+// AddressStateChange is a record of single address state bit change
+type AddressStateChange struct {
+	// Height of block that accepted this change, set when state is written
+	Height uint64 `serialize:"true"`
+	// ID of tx that made this change
+	TxID ids.ID `serialize:"true"`
+	// Address that made this change. Empty, if it's not known
+	Executor ids.ShortID `serialize:"true"`
+	// Changed bit
+	Bit txs.AddressStateBit `serialize:"true"`
+	// Whether bit was removed or set
+	Remove bool `serialize:"true"`
+}
+
+func (cs *caminoState) AddAddressStateChange(address ids.ShortID, change *AddressStateChange) {
+	cs.addedAddressStateChanges[address] = append(cs.addedAddressStateChanges[address], change)
+}
+
+// Returns at most [limit] address state changes of [address] in order of their
+// acceptance, starting from change with [startIndex]. Changes that aren't written
+// yet are also included, but their height isn't set.
+func (cs *caminoState) GetAddressStateHistory(address ids.ShortID, startIndex uint64, limit int) ([]*AddressStateChange, error) {
+	historyLength, err := database.GetUInt64(cs.addressStateHistoryLengthDB, address[:])
+	if err != nil && err != database.ErrNotFound {
+		return nil, err
+	}
+
+	var changes []*AddressStateChange
+	if startIndex < historyLength {
+		historyIterator := cs.addressStateHistoryDB.NewIteratorWithStartAndPrefix(
+			addressStateChangeKey(address, startIndex),
+			address[:],
+		)
+		defer historyIterator.Release()
+
+		for len(changes) < limit && historyIterator.Next() {
+			change := &AddressStateChange{}
+			if _, err := blocks.GenesisCodec.Unmarshal(historyIterator.Value(), change); err != nil {
+				return nil, err
+			}
+			changes = append(changes, change)
+		}
+
+		if err := historyIterator.Error(); err != nil {
+			return nil, err
+		}
+	}
+
+	addedChanges := cs.addedAddressStateChanges[address]
+	for i := uint64(0); i < uint64(len(addedChanges)) && len(changes) < limit; i++ {
+		if historyLength+i >= startIndex {
+			changes = append(changes, addedChanges[i])
+		}
+	}
+
+	return changes, nil
+}
+
+func (cs *caminoState) writeAddressStateHistory(height uint64) error {
+	for address, addedChanges := range cs.addedAddressStateChanges {
+		delete(cs.addedAddressStateChanges, address)
+
+		historyLength, err := database.GetUInt64(cs.addressStateHistoryLengthDB, address[:])
+		if err != nil && err != database.ErrNotFound {
+			return err
+		}
+
+		for _, addedChange := range addedChanges {
+			change := *addedChange
+			change.Height = height
+			changeBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, &change)
+			if err != nil {
+				return fmt.Errorf("failed to serialize address state change: %w", err)
+			}
+			if err := cs.addressStateHistoryDB.Put(addressStateChangeKey(address, historyLength), changeBytes); err != nil {
+				return err
+			}
+			historyLength++
+		}
+
+		if err := database.PutUInt64(cs.addressStateHistoryLengthDB, address[:], historyLength); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addressStateChangeKey(address ids.ShortID, index uint64) []byte {
+	key := make([]byte, len(address)+8)
+	copy(key, address[:])
+	binary.BigEndian.PutUint64(key[len(address):], index)
+	return key
+}
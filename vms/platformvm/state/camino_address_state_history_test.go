@@ -0,0 +1,81 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+func TestWriteAndGetAddressStateHistory(t *testing.T) {
+	address1 := ids.ShortID{1}
+	address2 := ids.ShortID{2}
+	change1 := &AddressStateChange{TxID: ids.ID{1}, Executor: ids.ShortID{11}, Bit: txs.AddressStateBitRoleAdmin}
+	change2 := &AddressStateChange{TxID: ids.ID{2}, Executor: ids.ShortID{12}, Bit: txs.AddressStateBitConsortium}
+	change3 := &AddressStateChange{TxID: ids.ID{3}, Executor: ids.ShortID{13}, Bit: txs.AddressStateBitRoleAdmin, Remove: true}
+	change4 := &AddressStateChange{TxID: ids.ID{4}, Executor: ids.ShortID{14}, Bit: txs.AddressStateBitKYCVerified}
+
+	caminoState := &caminoState{
+		caminoDiff: &caminoDiff{
+			addedAddressStateChanges: map[ids.ShortID][]*AddressStateChange{},
+		},
+		addressStateHistoryDB:       memdb.New(),
+		addressStateHistoryLengthDB: memdb.New(),
+	}
+
+	history, err := caminoState.GetAddressStateHistory(address1, 0, 10)
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	caminoState.AddAddressStateChange(address1, change1)
+	caminoState.AddAddressStateChange(address1, change2)
+	caminoState.AddAddressStateChange(address2, change4)
+
+	// not written yet
+	history, err = caminoState.GetAddressStateHistory(address1, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []*AddressStateChange{change1, change2}, history)
+
+	require.NoError(t, caminoState.writeAddressStateHistory(5))
+	require.Empty(t, caminoState.addedAddressStateChanges)
+
+	caminoState.AddAddressStateChange(address1, change3)
+
+	writtenChange1 := *change1
+	writtenChange1.Height = 5
+	writtenChange2 := *change2
+	writtenChange2.Height = 5
+
+	history, err = caminoState.GetAddressStateHistory(address1, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []*AddressStateChange{&writtenChange1, &writtenChange2, change3}, history)
+
+	require.NoError(t, caminoState.writeAddressStateHistory(7))
+
+	writtenChange3 := *change3
+	writtenChange3.Height = 7
+	writtenChange4 := *change4
+	writtenChange4.Height = 5
+
+	history, err = caminoState.GetAddressStateHistory(address1, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, []*AddressStateChange{&writtenChange2}, history)
+
+	history, err = caminoState.GetAddressStateHistory(address1, 1, 10)
+	require.NoError(t, err)
+	require.Equal(t, []*AddressStateChange{&writtenChange2, &writtenChange3}, history)
+
+	history, err = caminoState.GetAddressStateHistory(address1, 3, 10)
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	history, err = caminoState.GetAddressStateHistory(address2, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []*AddressStateChange{&writtenChange4}, history)
+}
@@ -56,6 +56,38 @@ func (cs *caminoState) GetClaimable(ownerID ids.ID) (*Claimable, error) {
 	return claimable, nil
 }
 
+// RewardRestakeSetting defines deposit offer and duration that will be used to
+// automatically deposit validator rewards of claimable owner
+type RewardRestakeSetting struct {
+	DepositOfferID  ids.ID `serialize:"true"`
+	DepositDuration uint32 `serialize:"true"`
+}
+
+// Sets reward restake setting of claimable owner. Nil [setting] removes it.
+func (cs *caminoState) SetRewardRestakeSetting(ownerID ids.ID, setting *RewardRestakeSetting) {
+	cs.modifiedRewardRestakeSettings[ownerID] = setting
+}
+
+func (cs *caminoState) GetRewardRestakeSetting(ownerID ids.ID) (*RewardRestakeSetting, error) {
+	if setting, ok := cs.modifiedRewardRestakeSettings[ownerID]; ok {
+		if setting == nil {
+			return nil, database.ErrNotFound
+		}
+		return setting, nil
+	}
+
+	settingBytes, err := cs.rewardRestakeSettingsDB.Get(ownerID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	setting := &RewardRestakeSetting{}
+	if _, err := blocks.GenesisCodec.Unmarshal(settingBytes, setting); err != nil {
+		return nil, err
+	}
+	return setting, nil
+}
+
 func (cs *caminoState) SetNotDistributedValidatorReward(reward uint64) {
 	cs.modifiedNotDistributedValidatorReward = &reward
 }
@@ -101,6 +133,26 @@ func (cs *caminoState) writeClaimableAndValidatorRewards() error {
 	return nil
 }
 
+func (cs *caminoState) writeRewardRestakeSettings() error {
+	for ownerID, setting := range cs.modifiedRewardRestakeSettings {
+		delete(cs.modifiedRewardRestakeSettings, ownerID)
+		if setting == nil {
+			if err := cs.rewardRestakeSettingsDB.Delete(ownerID[:]); err != nil {
+				return err
+			}
+			continue
+		}
+		settingBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, setting)
+		if err != nil {
+			return fmt.Errorf("failed to serialize reward restake setting: %w", err)
+		}
+		if err := cs.rewardRestakeSettingsDB.Put(ownerID[:], settingBytes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (cs *caminoState) loadValidatorRewards() error {
 	notDistributedValidatorReward, err := database.GetUInt64(cs.caminoDB, notDistributedValidatorRewardKey)
 	if err == database.ErrNotFound {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
@@ -431,3 +432,49 @@ func TestWriteClaimableAndValidatorRewards(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteAndGetRewardRestakeSetting(t *testing.T) {
+	claimableOwnerID1 := ids.ID{1}
+	claimableOwnerID2 := ids.ID{2}
+	setting1 := &RewardRestakeSetting{DepositOfferID: ids.ID{11}, DepositDuration: 100}
+	setting2 := &RewardRestakeSetting{DepositOfferID: ids.ID{12}, DepositDuration: 200}
+
+	caminoState := &caminoState{
+		caminoDiff: &caminoDiff{
+			modifiedRewardRestakeSettings: map[ids.ID]*RewardRestakeSetting{},
+		},
+		rewardRestakeSettingsDB: memdb.New(),
+	}
+
+	_, err := caminoState.GetRewardRestakeSetting(claimableOwnerID1)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	caminoState.SetRewardRestakeSetting(claimableOwnerID1, setting1)
+	caminoState.SetRewardRestakeSetting(claimableOwnerID2, setting2)
+
+	// not written yet
+	setting, err := caminoState.GetRewardRestakeSetting(claimableOwnerID1)
+	require.NoError(t, err)
+	require.Equal(t, setting1, setting)
+
+	require.NoError(t, caminoState.writeRewardRestakeSettings())
+	require.Empty(t, caminoState.modifiedRewardRestakeSettings)
+
+	setting, err = caminoState.GetRewardRestakeSetting(claimableOwnerID2)
+	require.NoError(t, err)
+	require.Equal(t, setting2, setting)
+
+	caminoState.SetRewardRestakeSetting(claimableOwnerID1, nil)
+
+	_, err = caminoState.GetRewardRestakeSetting(claimableOwnerID1)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	require.NoError(t, caminoState.writeRewardRestakeSettings())
+
+	_, err = caminoState.GetRewardRestakeSetting(claimableOwnerID1)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	setting, err = caminoState.GetRewardRestakeSetting(claimableOwnerID2)
+	require.NoError(t, err)
+	require.Equal(t, setting2, setting)
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+)
+
+// DeferredValidatorInfo describes when and by whom validator was deferred
+type DeferredValidatorInfo struct {
+	// ID of tx that deferred validator, its memo can contain deferral reason
+	TxID ids.ID `serialize:"true"`
+	// Address that deferred validator. Empty, if it's not known
+	Executor ids.ShortID `serialize:"true"`
+	// Chain time in unix seconds when validator was deferred
+	Timestamp uint64 `serialize:"true"`
+}
+
+// Sets info of deferred validator with [validatorTxID]. Nil [info] removes it.
+func (cs *caminoState) SetDeferredValidatorInfo(validatorTxID ids.ID, info *DeferredValidatorInfo) {
+	cs.modifiedDeferredValidatorInfos[validatorTxID] = info
+}
+
+func (cs *caminoState) GetDeferredValidatorInfo(validatorTxID ids.ID) (*DeferredValidatorInfo, error) {
+	if info, ok := cs.modifiedDeferredValidatorInfos[validatorTxID]; ok {
+		if info == nil {
+			return nil, database.ErrNotFound
+		}
+		return info, nil
+	}
+
+	infoBytes, err := cs.deferredValidatorInfosDB.Get(validatorTxID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	info := &DeferredValidatorInfo{}
+	if _, err := blocks.GenesisCodec.Unmarshal(infoBytes, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (cs *caminoState) writeDeferredValidatorInfos() error {
+	for validatorTxID, info := range cs.modifiedDeferredValidatorInfos {
+		delete(cs.modifiedDeferredValidatorInfos, validatorTxID)
+		if info == nil {
+			if err := cs.deferredValidatorInfosDB.Delete(validatorTxID[:]); err != nil {
+				return err
+			}
+			continue
+		}
+		infoBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, info)
+		if err != nil {
+			return fmt.Errorf("failed to serialize deferred validator info: %w", err)
+		}
+		if err := cs.deferredValidatorInfosDB.Put(validatorTxID[:], infoBytes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
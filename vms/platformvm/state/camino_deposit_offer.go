@@ -97,3 +97,48 @@ func (cs *caminoState) writeDepositOffers() error {
 	}
 	return nil
 }
+
+type offerAddressKey struct {
+	offerID ids.ID
+	address ids.ShortID
+}
+
+func (k *offerAddressKey) bytes() []byte {
+	keyBytes := make([]byte, len(k.offerID)+len(k.address))
+	copy(keyBytes, k.offerID[:])
+	copy(keyBytes[len(k.offerID):], k.address[:])
+	return keyBytes
+}
+
+// Sets total amount deposited by [address] with deposit offer [offerID].
+func (cs *caminoState) SetDepositOfferAddressAmount(offerID ids.ID, address ids.ShortID, amount uint64) {
+	cs.modifiedDepositOfferAddressAmounts[offerAddressKey{offerID: offerID, address: address}] = amount
+}
+
+// Returns total amount deposited by [address] with deposit offer [offerID] or 0, if there are no such deposits.
+func (cs *caminoState) GetDepositOfferAddressAmount(offerID ids.ID, address ids.ShortID) (uint64, error) {
+	key := offerAddressKey{offerID: offerID, address: address}
+	if amount, ok := cs.modifiedDepositOfferAddressAmounts[key]; ok {
+		return amount, nil
+	}
+
+	amount, err := database.GetUInt64(cs.depositOfferAddressAmountsDB, key.bytes())
+	if err == database.ErrNotFound {
+		return 0, nil
+	}
+	return amount, err
+}
+
+func (cs *caminoState) writeDepositOfferAddressAmounts() error {
+	for key, amount := range cs.modifiedDepositOfferAddressAmounts {
+		delete(cs.modifiedDepositOfferAddressAmounts, key)
+		if amount == 0 {
+			if err := cs.depositOfferAddressAmountsDB.Delete(key.bytes()); err != nil {
+				return err
+			}
+		} else if err := database.PutUInt64(cs.depositOfferAddressAmountsDB, key.bytes(), amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+)
+
+// Deposit offer stats aren't persisted. They are calculated from all existing deposits on load
+// and updated with modified deposits on write, so they only reflect committed state.
+
+// GetDepositOfferStats returns stats of active deposits created with offer [offerID].
+func (cs *caminoState) GetDepositOfferStats(offerID ids.ID) (*deposit.OfferStats, error) {
+	if _, err := cs.GetDepositOffer(offerID); err != nil {
+		return nil, err
+	}
+	stats := &deposit.OfferStats{}
+	if offerStats, ok := cs.depositOfferStats[offerID]; ok {
+		*stats = *offerStats
+	}
+	return stats, nil
+}
+
+func (cs *caminoState) loadDepositOfferStats() error {
+	cs.depositOfferStats = make(map[ids.ID]*deposit.OfferStats)
+
+	depositsIt := cs.depositsDB.NewIterator()
+	defer depositsIt.Release()
+	for depositsIt.Next() {
+		d := &deposit.Deposit{}
+		if _, err := blocks.GenesisCodec.Unmarshal(depositsIt.Value(), d); err != nil {
+			return err
+		}
+		if err := cs.addToDepositOfferStats(d); err != nil {
+			return err
+		}
+	}
+
+	return depositsIt.Error()
+}
+
+// Must be called before writeDeposits, because it reads previous deposit values from db.
+func (cs *caminoState) updateDepositOfferStats() error {
+	for depositTxID, depositDiff := range cs.modifiedDeposits {
+		oldDepositBytes, err := cs.depositsDB.Get(depositTxID[:])
+		switch err {
+		case nil:
+			oldDeposit := &deposit.Deposit{}
+			if _, err := blocks.GenesisCodec.Unmarshal(oldDepositBytes, oldDeposit); err != nil {
+				return err
+			}
+			if err := cs.removeFromDepositOfferStats(oldDeposit); err != nil {
+				return err
+			}
+		case database.ErrNotFound:
+		default:
+			return err
+		}
+
+		if !depositDiff.removed {
+			if err := cs.addToDepositOfferStats(depositDiff.Deposit); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (cs *caminoState) addToDepositOfferStats(d *deposit.Deposit) error {
+	offer, err := cs.GetDepositOffer(d.DepositOfferID)
+	if err != nil {
+		return fmt.Errorf("failed to get offer %s of deposit: %w", d.DepositOfferID, err)
+	}
+	stats, ok := cs.depositOfferStats[d.DepositOfferID]
+	if !ok {
+		stats = &deposit.OfferStats{}
+		cs.depositOfferStats[d.DepositOfferID] = stats
+	}
+	return stats.Add(d, offer)
+}
+
+func (cs *caminoState) removeFromDepositOfferStats(d *deposit.Deposit) error {
+	offer, err := cs.GetDepositOffer(d.DepositOfferID)
+	if err != nil {
+		return fmt.Errorf("failed to get offer %s of deposit: %w", d.DepositOfferID, err)
+	}
+	stats, ok := cs.depositOfferStats[d.DepositOfferID]
+	if !ok {
+		return fmt.Errorf("deposit offer %s has no stats", d.DepositOfferID)
+	}
+	return stats.Remove(d, offer)
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/cache"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestUpdateAndLoadDepositOfferStats(t *testing.T) {
+	offer := &deposit.Offer{
+		ID:                    ids.ID{1},
+		InterestRateNominator: 1_000_000 * 365 * 24 * 60 * 60,
+		MaxDuration:           100,
+	}
+	rewardOwner := &secp256k1fx.OutputOwners{Addrs: []ids.ShortID{{1}}}
+	depositTxID1 := ids.ID{1, 1}
+	depositTxID2 := ids.ID{1, 2}
+	deposit1 := &deposit.Deposit{DepositOfferID: offer.ID, Duration: 100, Amount: 100, RewardOwner: rewardOwner}
+	deposit2 := &deposit.Deposit{DepositOfferID: offer.ID, Duration: 50, Amount: 50, RewardOwner: rewardOwner}
+
+	caminoState := &caminoState{
+		caminoDiff: &caminoDiff{
+			modifiedDepositOffers: map[ids.ID]*deposit.Offer{},
+			modifiedDeposits:      map[ids.ID]*depositDiff{},
+		},
+		depositOffers:         map[ids.ID]*deposit.Offer{offer.ID: offer},
+		depositOfferStats:     map[ids.ID]*deposit.OfferStats{},
+		depositsCache:         &cache.LRU[ids.ID, *deposit.Deposit]{Size: 10},
+		depositsDB:            memdb.New(),
+		depositIDsByEndtimeDB: memdb.New(),
+	}
+	write := func() {
+		require.NoError(t, caminoState.updateDepositOfferStats())
+		require.NoError(t, caminoState.writeDeposits())
+	}
+
+	_, err := caminoState.GetDepositOfferStats(ids.ID{2})
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	stats, err := caminoState.GetDepositOfferStats(offer.ID)
+	require.NoError(t, err)
+	require.Equal(t, &deposit.OfferStats{}, stats)
+
+	// new deposits
+	caminoState.AddDeposit(depositTxID1, deposit1)
+	caminoState.AddDeposit(depositTxID2, deposit2)
+	write()
+
+	stats, err = caminoState.GetDepositOfferStats(offer.ID)
+	require.NoError(t, err)
+	require.Equal(t, &deposit.OfferStats{
+		ActiveDeposits:  2,
+		DepositedAmount: 150,
+		RewardAmount:    100*100 + 50*50,
+	}, stats)
+
+	// partial unlock and claim
+	modifiedDeposit1 := *deposit1
+	modifiedDeposit1.UnlockedAmount = 40
+	modifiedDeposit1.ClaimedRewardAmount = 5
+	caminoState.ModifyDeposit(depositTxID1, &modifiedDeposit1)
+	write()
+
+	stats, err = caminoState.GetDepositOfferStats(offer.ID)
+	require.NoError(t, err)
+	require.Equal(t, &deposit.OfferStats{
+		ActiveDeposits:      2,
+		DepositedAmount:     110,
+		RewardAmount:        100*100 + 50*50,
+		ClaimedRewardAmount: 5,
+	}, stats)
+
+	// removed deposit
+	caminoState.RemoveDeposit(depositTxID2, deposit2)
+	write()
+
+	expectedStats := &deposit.OfferStats{
+		ActiveDeposits:      1,
+		DepositedAmount:     60,
+		RewardAmount:        100 * 100,
+		ClaimedRewardAmount: 5,
+	}
+	stats, err = caminoState.GetDepositOfferStats(offer.ID)
+	require.NoError(t, err)
+	require.Equal(t, expectedStats, stats)
+
+	// stats are recalculated from deposits on load
+	caminoState.depositOfferStats = nil
+	require.NoError(t, caminoState.loadDepositOfferStats())
+	stats, err = caminoState.GetDepositOfferStats(offer.ID)
+	require.NoError(t, err)
+	require.Equal(t, expectedStats, stats)
+}
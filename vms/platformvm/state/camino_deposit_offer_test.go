@@ -9,6 +9,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
@@ -487,3 +488,46 @@ func TestLoadDepositOffers(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteAndGetDepositOfferAddressAmount(t *testing.T) {
+	offerID := ids.ID{1}
+	address1 := ids.ShortID{1}
+	address2 := ids.ShortID{2}
+
+	caminoState := &caminoState{
+		caminoDiff: &caminoDiff{
+			modifiedDepositOfferAddressAmounts: map[offerAddressKey]uint64{},
+		},
+		depositOfferAddressAmountsDB: memdb.New(),
+	}
+
+	amount, err := caminoState.GetDepositOfferAddressAmount(offerID, address1)
+	require.NoError(t, err)
+	require.Zero(t, amount)
+
+	caminoState.SetDepositOfferAddressAmount(offerID, address1, 10)
+	caminoState.SetDepositOfferAddressAmount(offerID, address2, 20)
+
+	// not written yet
+	amount, err = caminoState.GetDepositOfferAddressAmount(offerID, address1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), amount)
+
+	require.NoError(t, caminoState.writeDepositOfferAddressAmounts())
+	require.Empty(t, caminoState.modifiedDepositOfferAddressAmounts)
+
+	amount, err = caminoState.GetDepositOfferAddressAmount(offerID, address2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), amount)
+
+	amount, err = caminoState.GetDepositOfferAddressAmount(ids.ID{2}, address2)
+	require.NoError(t, err)
+	require.Zero(t, amount)
+
+	caminoState.SetDepositOfferAddressAmount(offerID, address1, 0)
+	require.NoError(t, caminoState.writeDepositOfferAddressAmounts())
+
+	amount, err = caminoState.GetDepositOfferAddressAmount(offerID, address1)
+	require.NoError(t, err)
+	require.Zero(t, amount)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -91,7 +92,14 @@ func (d *diff) CaminoConfig() (*CaminoConfig, error) {
 	if !ok {
 		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
 	}
-	return parentState.CaminoConfig()
+	caminoConfig, err := parentState.CaminoConfig()
+	if err != nil || d.caminoDiff.modifiedTreasuryConfig == nil {
+		return caminoConfig, err
+	}
+
+	caminoConfigCopy := *caminoConfig
+	d.caminoDiff.modifiedTreasuryConfig.apply(&caminoConfigCopy)
+	return &caminoConfigCopy, nil
 }
 
 func (d *diff) SetAddressStates(address ids.ShortID, states txs.AddressState) {
@@ -111,6 +119,61 @@ func (d *diff) GetAddressStates(address ids.ShortID) (txs.AddressState, error) {
 	return parentState.GetAddressStates(address)
 }
 
+func (d *diff) AddAddressStateChange(address ids.ShortID, change *AddressStateChange) {
+	d.caminoDiff.addedAddressStateChanges[address] = append(d.caminoDiff.addedAddressStateChanges[address], change)
+}
+
+func (d *diff) SetKYCExpiration(address ids.ShortID, expiration uint64) {
+	d.caminoDiff.modifiedKYCExpirations[address] = expiration
+}
+
+func (d *diff) GetKYCExpiration(address ids.ShortID) (uint64, error) {
+	if expiration, ok := d.caminoDiff.modifiedKYCExpirations[address]; ok {
+		if expiration == 0 {
+			return 0, database.ErrNotFound
+		}
+		return expiration, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetKYCExpiration(address)
+}
+
+func (d *diff) GetNextKYCExpirationTime(removedAddresses set.Set[ids.ShortID]) (time.Time, error) {
+	_, nextExpirationTime, err := d.GetNextToExpireKYCAddressesAndTime(removedAddresses)
+	return nextExpirationTime, err
+}
+
+func (d *diff) GetNextToExpireKYCAddressesAndTime(removedAddresses set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, time.Time{}, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	for address := range d.caminoDiff.modifiedKYCExpirations {
+		removedAddresses.Add(address)
+	}
+
+	addresses, nextExpirationTime, err := parentState.GetNextToExpireKYCAddressesAndTime(removedAddresses)
+	if err != nil && err != database.ErrNotFound {
+		return nil, time.Time{}, err
+	}
+
+	addresses, nextExpiration := mergeNextToExpireKYCAddresses(
+		addresses,
+		uint64(nextExpirationTime.Unix()),
+		d.caminoDiff.modifiedKYCExpirations,
+	)
+	if len(addresses) == 0 {
+		return nil, mockable.MaxTime, database.ErrNotFound
+	}
+	return addresses, time.Unix(int64(nextExpiration), 0), nil
+}
+
 func (d *diff) SetDepositOffer(offer *deposit.Offer) {
 	d.caminoDiff.modifiedDepositOffers[offer.ID] = offer
 }
@@ -159,6 +222,23 @@ func (d *diff) GetAllDepositOffers() ([]*deposit.Offer, error) {
 	return offers, nil
 }
 
+func (d *diff) SetDepositOfferAddressAmount(offerID ids.ID, address ids.ShortID, amount uint64) {
+	d.caminoDiff.modifiedDepositOfferAddressAmounts[offerAddressKey{offerID: offerID, address: address}] = amount
+}
+
+func (d *diff) GetDepositOfferAddressAmount(offerID ids.ID, address ids.ShortID) (uint64, error) {
+	if amount, ok := d.caminoDiff.modifiedDepositOfferAddressAmounts[offerAddressKey{offerID: offerID, address: address}]; ok {
+		return amount, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetDepositOfferAddressAmount(offerID, address)
+}
+
 func (d *diff) AddDeposit(depositTxID ids.ID, deposit *deposit.Deposit) {
 	d.caminoDiff.modifiedDeposits[depositTxID] = &depositDiff{Deposit: deposit, added: true}
 }
@@ -292,6 +372,77 @@ func (d *diff) GetMultisigAlias(alias ids.ShortID) (*multisig.AliasWithNonce, er
 	return parentState.GetMultisigAlias(alias)
 }
 
+func (d *diff) SetMultisigAliasChange(aliasID ids.ShortID, change *multisig.AliasChange) {
+	d.caminoDiff.modifiedMultisigAliasChanges[aliasID] = change
+}
+
+func (d *diff) GetMultisigAliasChange(aliasID ids.ShortID) (*multisig.AliasChange, error) {
+	if change, ok := d.caminoDiff.modifiedMultisigAliasChanges[aliasID]; ok {
+		if change == nil {
+			return nil, database.ErrNotFound
+		}
+		return change, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetMultisigAliasChange(aliasID)
+}
+
+func (d *diff) GetNextMultisigAliasChangeTime(removedAliasIDs set.Set[ids.ShortID]) (time.Time, error) {
+	_, nextChangeTime, err := d.GetNextToApplyMultisigAliasChangesAndTime(removedAliasIDs)
+	return nextChangeTime, err
+}
+
+func (d *diff) GetNextToApplyMultisigAliasChangesAndTime(removedAliasIDs set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, time.Time{}, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	for aliasID := range d.caminoDiff.modifiedMultisigAliasChanges {
+		removedAliasIDs.Add(aliasID)
+	}
+
+	aliasIDs, nextChangeTime, err := parentState.GetNextToApplyMultisigAliasChangesAndTime(removedAliasIDs)
+	if err != nil && err != database.ErrNotFound {
+		return nil, time.Time{}, err
+	}
+
+	aliasIDs, nextChange := mergeNextToApplyMultisigAliasChanges(
+		aliasIDs,
+		uint64(nextChangeTime.Unix()),
+		d.caminoDiff.modifiedMultisigAliasChanges,
+	)
+	if len(aliasIDs) == 0 {
+		return nil, mockable.MaxTime, database.ErrNotFound
+	}
+	return aliasIDs, time.Unix(int64(nextChange), 0), nil
+}
+
+func (d *diff) SetMultisigAliasSpendLimitUsage(aliasID ids.ShortID, usage *multisig.SpendLimitUsage) {
+	d.caminoDiff.modifiedMultisigAliasSpendUsages[aliasID] = usage
+}
+
+func (d *diff) GetMultisigAliasSpendLimitUsage(aliasID ids.ShortID) (*multisig.SpendLimitUsage, error) {
+	if usage, ok := d.caminoDiff.modifiedMultisigAliasSpendUsages[aliasID]; ok {
+		if usage == nil {
+			return nil, database.ErrNotFound
+		}
+		return usage, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetMultisigAliasSpendLimitUsage(aliasID)
+}
+
 func (d *diff) SetShortIDLink(id ids.ShortID, key ShortLinkKey, link *ids.ShortID) {
 	d.caminoDiff.modifiedShortLinks[toShortLinkKey(id, key)] = link
 }
@@ -332,6 +483,66 @@ func (d *diff) GetClaimable(ownerID ids.ID) (*Claimable, error) {
 	return parentState.GetClaimable(ownerID)
 }
 
+func (d *diff) SetRewardRestakeSetting(ownerID ids.ID, setting *RewardRestakeSetting) {
+	d.caminoDiff.modifiedRewardRestakeSettings[ownerID] = setting
+}
+
+func (d *diff) GetRewardRestakeSetting(ownerID ids.ID) (*RewardRestakeSetting, error) {
+	if setting, ok := d.caminoDiff.modifiedRewardRestakeSettings[ownerID]; ok {
+		if setting == nil {
+			return nil, database.ErrNotFound
+		}
+		return setting, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetRewardRestakeSetting(ownerID)
+}
+
+func (d *diff) SetSubnetValidatorRequirements(subnetID ids.ID, requirements *SubnetValidatorRequirements) {
+	d.caminoDiff.modifiedSubnetValidatorRequirements[subnetID] = requirements
+}
+
+func (d *diff) GetSubnetValidatorRequirements(subnetID ids.ID) (*SubnetValidatorRequirements, error) {
+	if requirements, ok := d.caminoDiff.modifiedSubnetValidatorRequirements[subnetID]; ok {
+		if requirements == nil {
+			return nil, database.ErrNotFound
+		}
+		return requirements, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetSubnetValidatorRequirements(subnetID)
+}
+
+func (d *diff) SetDeferredValidatorInfo(validatorTxID ids.ID, info *DeferredValidatorInfo) {
+	d.caminoDiff.modifiedDeferredValidatorInfos[validatorTxID] = info
+}
+
+func (d *diff) GetDeferredValidatorInfo(validatorTxID ids.ID) (*DeferredValidatorInfo, error) {
+	if info, ok := d.caminoDiff.modifiedDeferredValidatorInfos[validatorTxID]; ok {
+		if info == nil {
+			return nil, database.ErrNotFound
+		}
+		return info, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetDeferredValidatorInfo(validatorTxID)
+}
+
 func (d *diff) SetNotDistributedValidatorReward(reward uint64) {
 	d.caminoDiff.modifiedNotDistributedValidatorReward = &reward
 }
@@ -390,6 +601,232 @@ func (d *diff) GetDeferredStakerIterator() (StakerIterator, error) {
 	return d.caminoDiff.deferredStakerDiffs.GetStakerIterator(parentIterator), nil
 }
 
+func (d *diff) GetAddressesWithStates(states txs.AddressState) ([]ids.ShortID, error) {
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	parentAddresses, err := parentState.GetAddressesWithStates(states)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]ids.ShortID, 0, len(parentAddresses))
+	for _, address := range parentAddresses {
+		if _, ok := d.caminoDiff.modifiedAddressStates[address]; !ok {
+			addresses = append(addresses, address)
+		}
+	}
+
+	for address, addressStates := range d.caminoDiff.modifiedAddressStates {
+		if addressStates&states == states {
+			addresses = append(addresses, address)
+		}
+	}
+
+	utils.Sort(addresses)
+	return addresses, nil
+}
+
+func (d *diff) AddProposal(proposalID ids.ID, proposal dao.ProposalState) {
+	d.caminoDiff.modifiedProposals[proposalID] = &proposalDiff{Proposal: proposal, added: true}
+}
+
+func (d *diff) ModifyProposal(proposalID ids.ID, proposal dao.ProposalState) {
+	// proposal could be added and modified in the same diff
+	oldProposalDiff, ok := d.caminoDiff.modifiedProposals[proposalID]
+	d.caminoDiff.modifiedProposals[proposalID] = &proposalDiff{Proposal: proposal, added: ok && oldProposalDiff.added}
+}
+
+func (d *diff) RemoveProposal(proposalID ids.ID, proposal dao.ProposalState) {
+	d.caminoDiff.modifiedProposals[proposalID] = &proposalDiff{Proposal: proposal, removed: true}
+}
+
+func (d *diff) GetProposal(proposalID ids.ID) (dao.ProposalState, error) {
+	if proposalDiff, ok := d.caminoDiff.modifiedProposals[proposalID]; ok {
+		if proposalDiff.removed {
+			return nil, database.ErrNotFound
+		}
+		return proposalDiff.Proposal, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetProposal(proposalID)
+}
+
+func (d *diff) AddProposalIDToFinish(proposalID ids.ID) {
+	d.caminoDiff.modifiedProposalIDsToFinish[proposalID] = true
+}
+
+func (d *diff) RemoveProposalIDToFinish(proposalID ids.ID) {
+	d.caminoDiff.modifiedProposalIDsToFinish[proposalID] = false
+}
+
+func (d *diff) GetProposalIDsToFinish() ([]ids.ID, error) {
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	parentProposalIDsToFinish, err := parentState.GetProposalIDsToFinish()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(d.caminoDiff.modifiedProposalIDsToFinish) == 0 {
+		return parentProposalIDsToFinish, nil
+	}
+
+	proposalIDsToFinish := make([]ids.ID, 0, len(parentProposalIDsToFinish))
+	for _, proposalID := range parentProposalIDsToFinish {
+		if _, ok := d.caminoDiff.modifiedProposalIDsToFinish[proposalID]; !ok {
+			proposalIDsToFinish = append(proposalIDsToFinish, proposalID)
+		}
+	}
+
+	for proposalID, added := range d.caminoDiff.modifiedProposalIDsToFinish {
+		if added {
+			proposalIDsToFinish = append(proposalIDsToFinish, proposalID)
+		}
+	}
+
+	utils.Sort(proposalIDsToFinish)
+	return proposalIDsToFinish, nil
+}
+
+func (d *diff) GetNextProposalExpirationTime(removedProposalIDs set.Set[ids.ID]) (time.Time, error) {
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	for proposalID, proposalDiff := range d.caminoDiff.modifiedProposals {
+		if proposalDiff.removed {
+			removedProposalIDs.Add(proposalID)
+		}
+	}
+
+	nextExpirationTime, err := parentState.GetNextProposalExpirationTime(removedProposalIDs)
+	if err != nil && err != database.ErrNotFound {
+		return time.Time{}, err
+	}
+
+	// calculating earliest expiration time from added proposals and parent expiration time
+	for proposalID, proposalDiff := range d.caminoDiff.modifiedProposals {
+		if proposalEndtime := proposalDiff.Proposal.EndTime(); proposalDiff.added &&
+			proposalEndtime.Before(nextExpirationTime) && !removedProposalIDs.Contains(proposalID) {
+			nextExpirationTime = proposalEndtime
+		}
+	}
+
+	// no proposals
+	if nextExpirationTime.Equal(mockable.MaxTime) {
+		return mockable.MaxTime, database.ErrNotFound
+	}
+
+	return nextExpirationTime, nil
+}
+
+func (d *diff) GetNextToExpireProposalIDsAndTime(removedProposalIDs set.Set[ids.ID]) ([]ids.ID, time.Time, error) {
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, time.Time{}, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	for proposalID, proposalDiff := range d.caminoDiff.modifiedProposals {
+		if proposalDiff.removed {
+			removedProposalIDs.Add(proposalID)
+		}
+	}
+
+	parentNextProposalIDs, parentNextExpirationTime, err := parentState.GetNextToExpireProposalIDsAndTime(removedProposalIDs)
+	if err != nil && err != database.ErrNotFound {
+		return nil, time.Time{}, err
+	}
+
+	nextExpirationTime := parentNextExpirationTime
+	for proposalID, proposalDiff := range d.caminoDiff.modifiedProposals {
+		if proposalEndtime := proposalDiff.Proposal.EndTime(); proposalDiff.added &&
+			proposalEndtime.Before(nextExpirationTime) && !removedProposalIDs.Contains(proposalID) {
+			nextExpirationTime = proposalEndtime
+		}
+	}
+
+	var nextProposalIDs []ids.ID
+	if nextExpirationTime.Equal(parentNextExpirationTime) {
+		nextProposalIDs = parentNextProposalIDs
+	}
+
+	needSort := false // proposalIDs from parent are already sorted
+	for proposalID, proposalDiff := range d.caminoDiff.modifiedProposals {
+		if proposalDiff.added && proposalDiff.Proposal.EndTime().Equal(nextExpirationTime) && !removedProposalIDs.Contains(proposalID) {
+			nextProposalIDs = append(nextProposalIDs, proposalID)
+			needSort = true
+		}
+	}
+
+	if len(nextProposalIDs) == 0 {
+		return nil, mockable.MaxTime, database.ErrNotFound
+	}
+
+	if needSort {
+		utils.Sort(nextProposalIDs)
+	}
+
+	return nextProposalIDs, nextExpirationTime, nil
+}
+
+func (d *diff) SetBaseFee(baseFee uint64) {
+	d.caminoDiff.modifiedBaseFee = &baseFee
+}
+
+func (d *diff) GetBaseFee() (uint64, error) {
+	if d.caminoDiff.modifiedBaseFee != nil {
+		return *d.caminoDiff.modifiedBaseFee, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetBaseFee()
+}
+
+func (d *diff) SetTreasuryConfig(admin ids.ShortID, spendLimit, spendPeriod uint64) {
+	d.caminoDiff.modifiedTreasuryConfig = &treasuryConfig{
+		admin:       admin,
+		spendLimit:  spendLimit,
+		spendPeriod: spendPeriod,
+	}
+}
+
+func (d *diff) SetTreasurySpentAmount(periodStart, amount uint64) {
+	d.caminoDiff.modifiedTreasurySpentAmounts[periodStart] = amount
+}
+
+func (d *diff) GetTreasurySpentAmount(periodStart uint64) (uint64, error) {
+	if amount, ok := d.caminoDiff.modifiedTreasurySpentAmounts[periodStart]; ok {
+		return amount, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetTreasurySpentAmount(periodStart)
+}
+
+func (d *diff) AddTreasurySpend(spend *TreasurySpend) {
+	d.caminoDiff.addedTreasurySpends = append(d.caminoDiff.addedTreasurySpends, spend)
+}
+
 // Finally apply all changes
 func (d *diff) ApplyCaminoState(baseState State) {
 	if d.caminoDiff.modifiedNotDistributedValidatorReward != nil {
@@ -400,10 +837,24 @@ func (d *diff) ApplyCaminoState(baseState State) {
 		baseState.SetAddressStates(k, v)
 	}
 
+	for address, expiration := range d.caminoDiff.modifiedKYCExpirations {
+		baseState.SetKYCExpiration(address, expiration)
+	}
+
+	for address, changes := range d.caminoDiff.addedAddressStateChanges {
+		for _, change := range changes {
+			baseState.AddAddressStateChange(address, change)
+		}
+	}
+
 	for _, depositOffer := range d.caminoDiff.modifiedDepositOffers {
 		baseState.SetDepositOffer(depositOffer)
 	}
 
+	for key, amount := range d.caminoDiff.modifiedDepositOfferAddressAmounts {
+		baseState.SetDepositOfferAddressAmount(key.offerID, key.address, amount)
+	}
+
 	for depositTxID, depositDiff := range d.caminoDiff.modifiedDeposits {
 		switch {
 		case depositDiff.added:
@@ -419,6 +870,14 @@ func (d *diff) ApplyCaminoState(baseState State) {
 		baseState.SetMultisigAlias(v)
 	}
 
+	for aliasID, change := range d.caminoDiff.modifiedMultisigAliasChanges {
+		baseState.SetMultisigAliasChange(aliasID, change)
+	}
+
+	for aliasID, usage := range d.caminoDiff.modifiedMultisigAliasSpendUsages {
+		baseState.SetMultisigAliasSpendLimitUsage(aliasID, usage)
+	}
+
 	for fullKey, link := range d.caminoDiff.modifiedShortLinks {
 		id, key := fromShortLinkKey(fullKey)
 		baseState.SetShortIDLink(id, key, link)
@@ -428,6 +887,53 @@ func (d *diff) ApplyCaminoState(baseState State) {
 		baseState.SetClaimable(ownerID, claimable)
 	}
 
+	for ownerID, setting := range d.caminoDiff.modifiedRewardRestakeSettings {
+		baseState.SetRewardRestakeSetting(ownerID, setting)
+	}
+
+	for subnetID, requirements := range d.caminoDiff.modifiedSubnetValidatorRequirements {
+		baseState.SetSubnetValidatorRequirements(subnetID, requirements)
+	}
+
+	for validatorTxID, info := range d.caminoDiff.modifiedDeferredValidatorInfos {
+		baseState.SetDeferredValidatorInfo(validatorTxID, info)
+	}
+
+	for proposalID, proposalDiff := range d.caminoDiff.modifiedProposals {
+		switch {
+		case proposalDiff.added:
+			baseState.AddProposal(proposalID, proposalDiff.Proposal)
+		case proposalDiff.removed:
+			baseState.RemoveProposal(proposalID, proposalDiff.Proposal)
+		default:
+			baseState.ModifyProposal(proposalID, proposalDiff.Proposal)
+		}
+	}
+
+	for proposalID, added := range d.caminoDiff.modifiedProposalIDsToFinish {
+		if added {
+			baseState.AddProposalIDToFinish(proposalID)
+		} else {
+			baseState.RemoveProposalIDToFinish(proposalID)
+		}
+	}
+
+	if d.caminoDiff.modifiedBaseFee != nil {
+		baseState.SetBaseFee(*d.caminoDiff.modifiedBaseFee)
+	}
+
+	if config := d.caminoDiff.modifiedTreasuryConfig; config != nil {
+		baseState.SetTreasuryConfig(config.admin, config.spendLimit, config.spendPeriod)
+	}
+
+	for periodStart, amount := range d.caminoDiff.modifiedTreasurySpentAmounts {
+		baseState.SetTreasurySpentAmount(periodStart, amount)
+	}
+
+	for _, spend := range d.caminoDiff.addedTreasurySpends {
+		baseState.AddTreasurySpend(spend)
+	}
+
 	for _, validatorDiffs := range d.caminoDiff.deferredStakerDiffs.validatorDiffs {
 		for _, validatorDiff := range validatorDiffs {
 			switch validatorDiff.validatorStatus {
@@ -1976,16 +1976,51 @@ func TestDiffCaminoConfig(t *testing.T) {
 				return &diff{
 					stateVersions: newMockStateVersions(c, parentStateID, parentState),
 					parentID:      parentStateID,
+					caminoDiff:    &caminoDiff{},
 				}
 			},
 			expectedDiff: func(actualDiff *diff) *diff {
 				return &diff{
 					stateVersions: actualDiff.stateVersions,
 					parentID:      actualDiff.parentID,
+					caminoDiff:    &caminoDiff{},
 				}
 			},
 			expectedCaminoConfig: &CaminoConfig{VerifyNodeSignature: true},
 		},
+		"OK: modified treasury config": {
+			diff: func(c *gomock.Controller) *diff {
+				parentState := NewMockChain(c)
+				parentState.EXPECT().CaminoConfig().Return(&CaminoConfig{
+					VerifyNodeSignature: true,
+					TreasuryAdmin:       ids.ShortID{1},
+					TreasurySpendLimit:  10,
+					TreasurySpendPeriod: 100,
+				}, nil)
+				return &diff{
+					stateVersions: newMockStateVersions(c, parentStateID, parentState),
+					parentID:      parentStateID,
+					caminoDiff: &caminoDiff{
+						modifiedTreasuryConfig: &treasuryConfig{admin: ids.ShortID{2}, spendLimit: 20, spendPeriod: 200},
+					},
+				}
+			},
+			expectedDiff: func(actualDiff *diff) *diff {
+				return &diff{
+					stateVersions: actualDiff.stateVersions,
+					parentID:      actualDiff.parentID,
+					caminoDiff: &caminoDiff{
+						modifiedTreasuryConfig: &treasuryConfig{admin: ids.ShortID{2}, spendLimit: 20, spendPeriod: 200},
+					},
+				}
+			},
+			expectedCaminoConfig: &CaminoConfig{
+				VerifyNodeSignature: true,
+				TreasuryAdmin:       ids.ShortID{2},
+				TreasurySpendLimit:  20,
+				TreasurySpendPeriod: 200,
+			},
+		},
 		"Fail: parent errored": {
 			diff: func(c *gomock.Controller) *diff {
 				parentState := NewMockChain(c)
@@ -1993,12 +2028,14 @@ func TestDiffCaminoConfig(t *testing.T) {
 				return &diff{
 					stateVersions: newMockStateVersions(c, parentStateID, parentState),
 					parentID:      parentStateID,
+					caminoDiff:    &caminoDiff{},
 				}
 			},
 			expectedDiff: func(actualDiff *diff) *diff {
 				return &diff{
 					stateVersions: actualDiff.stateVersions,
 					parentID:      actualDiff.parentID,
+					caminoDiff:    &caminoDiff{},
 				}
 			},
 			expectedErr: testErr,
@@ -2147,6 +2184,92 @@ func TestDiffGetAddressStates(t *testing.T) {
 	}
 }
 
+func TestDiffGetNextToExpireKYCAddressesAndTime(t *testing.T) {
+	parentStateID := ids.GenerateTestID()
+	address1 := ids.ShortID{1}
+	address2 := ids.ShortID{2}
+	address3 := ids.ShortID{3}
+	testErr := errors.New("test err")
+
+	tests := map[string]struct {
+		diff                       func(*gomock.Controller) *diff
+		expectedAddresses          []ids.ShortID
+		expectedNextExpirationTime time.Time
+		expectedErr                error
+	}{
+		"Fail: parent errored": {
+			diff: func(c *gomock.Controller) *diff {
+				parentState := NewMockChain(c)
+				parentState.EXPECT().GetNextToExpireKYCAddressesAndTime(nil).Return(nil, time.Time{}, testErr)
+				return &diff{
+					stateVersions: newMockStateVersions(c, parentStateID, parentState),
+					parentID:      parentStateID,
+					caminoDiff:    &caminoDiff{},
+				}
+			},
+			expectedErr: testErr,
+		},
+		"Fail: parent expiration removed": {
+			diff: func(c *gomock.Controller) *diff {
+				parentState := NewMockChain(c)
+				parentState.EXPECT().GetNextToExpireKYCAddressesAndTime(set.Set[ids.ShortID]{address1: struct{}{}}).
+					Return(nil, mockable.MaxTime, database.ErrNotFound)
+				return &diff{
+					stateVersions: newMockStateVersions(c, parentStateID, parentState),
+					parentID:      parentStateID,
+					caminoDiff: &caminoDiff{
+						modifiedKYCExpirations: map[ids.ShortID]uint64{address1: 0},
+					},
+				}
+			},
+			expectedNextExpirationTime: mockable.MaxTime,
+			expectedErr:                database.ErrNotFound,
+		},
+		"OK: earlier expiration in diff": {
+			diff: func(c *gomock.Controller) *diff {
+				parentState := NewMockChain(c)
+				parentState.EXPECT().GetNextToExpireKYCAddressesAndTime(set.Set[ids.ShortID]{address2: struct{}{}}).
+					Return([]ids.ShortID{address3}, time.Unix(20, 0), nil)
+				return &diff{
+					stateVersions: newMockStateVersions(c, parentStateID, parentState),
+					parentID:      parentStateID,
+					caminoDiff: &caminoDiff{
+						modifiedKYCExpirations: map[ids.ShortID]uint64{address2: 10},
+					},
+				}
+			},
+			expectedAddresses:          []ids.ShortID{address2},
+			expectedNextExpirationTime: time.Unix(10, 0),
+		},
+		"OK: same expiration in diff and parent": {
+			diff: func(c *gomock.Controller) *diff {
+				parentState := NewMockChain(c)
+				parentState.EXPECT().GetNextToExpireKYCAddressesAndTime(set.Set[ids.ShortID]{address1: struct{}{}, address2: struct{}{}}).
+					Return([]ids.ShortID{address3}, time.Unix(20, 0), nil)
+				return &diff{
+					stateVersions: newMockStateVersions(c, parentStateID, parentState),
+					parentID:      parentStateID,
+					caminoDiff: &caminoDiff{
+						modifiedKYCExpirations: map[ids.ShortID]uint64{address1: 20, address2: 30},
+					},
+				}
+			},
+			expectedAddresses:          []ids.ShortID{address1, address3},
+			expectedNextExpirationTime: time.Unix(20, 0),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addresses, nextExpirationTime, err := tt.diff(ctrl).GetNextToExpireKYCAddressesAndTime(nil)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expectedAddresses, addresses)
+			require.Equal(t, tt.expectedNextExpirationTime, nextExpirationTime)
+		})
+	}
+}
+
 func TestDiffSetDepositOffer(t *testing.T) {
 	offer1 := &deposit.Offer{ID: ids.ID{12}}
 
@@ -3059,10 +3182,21 @@ func TestDiffApplyCaminoState(t *testing.T) {
 					{1}: 101,
 					{2}: 0,
 				},
+				modifiedKYCExpirations: map[ids.ShortID]uint64{
+					{1}: 1001,
+					{2}: 0,
+				},
+				addedAddressStateChanges: map[ids.ShortID][]*AddressStateChange{
+					{1}: {{TxID: ids.ID{1}}, {TxID: ids.ID{2}}},
+				},
 				modifiedDepositOffers: map[ids.ID]*deposit.Offer{
 					{3}: {ID: ids.ID{3}},
 					{4}: nil,
 				},
+				modifiedDepositOfferAddressAmounts: map[offerAddressKey]uint64{
+					{offerID: ids.ID{3}, address: ids.ShortID{1}}: 103,
+					{offerID: ids.ID{3}, address: ids.ShortID{2}}: 0,
+				},
 				modifiedDeposits: map[ids.ID]*depositDiff{
 					{5}: {Deposit: &deposit.Deposit{Amount: 105}},
 					{6}: {Deposit: &deposit.Deposit{Amount: 106}, added: true},
@@ -3080,6 +3214,21 @@ func TestDiffApplyCaminoState(t *testing.T) {
 					{12}: {ValidatorReward: 112},
 					{13}: nil,
 				},
+				modifiedRewardRestakeSettings: map[ids.ID]*RewardRestakeSetting{
+					{14}: {DepositOfferID: ids.ID{114}, DepositDuration: 214},
+					{15}: nil,
+				},
+				modifiedSubnetValidatorRequirements: map[ids.ID]*SubnetValidatorRequirements{
+					{16}: {RequireConsortiumMember: true, RequireKYCVerified: true},
+					{17}: nil,
+				},
+				modifiedTreasuryConfig: &treasuryConfig{admin: ids.ShortID{18}, spendLimit: 218, spendPeriod: 318},
+				modifiedTreasurySpentAmounts: map[uint64]uint64{
+					100: 118,
+				},
+				addedTreasurySpends: []*TreasurySpend{
+					{TxID: ids.ID{19}, Amount: 119},
+				},
 				modifiedNotDistributedValidatorReward: &reward,
 				deferredStakerDiffs:                   diffStakers{},
 			}},
@@ -3089,9 +3238,20 @@ func TestDiffApplyCaminoState(t *testing.T) {
 				for k, v := range d.caminoDiff.modifiedAddressStates {
 					s.EXPECT().SetAddressStates(k, v)
 				}
+				for address, expiration := range d.caminoDiff.modifiedKYCExpirations {
+					s.EXPECT().SetKYCExpiration(address, expiration)
+				}
+				for address, changes := range d.caminoDiff.addedAddressStateChanges {
+					for _, change := range changes {
+						s.EXPECT().AddAddressStateChange(address, change)
+					}
+				}
 				for _, depositOffer := range d.caminoDiff.modifiedDepositOffers {
 					s.EXPECT().SetDepositOffer(depositOffer)
 				}
+				for key, amount := range d.caminoDiff.modifiedDepositOfferAddressAmounts {
+					s.EXPECT().SetDepositOfferAddressAmount(key.offerID, key.address, amount)
+				}
 				for depositTxID, depositDiff := range d.caminoDiff.modifiedDeposits {
 					switch {
 					case depositDiff.added:
@@ -3112,6 +3272,19 @@ func TestDiffApplyCaminoState(t *testing.T) {
 				for ownerID, claimable := range d.caminoDiff.modifiedClaimables {
 					s.EXPECT().SetClaimable(ownerID, claimable)
 				}
+				for ownerID, setting := range d.caminoDiff.modifiedRewardRestakeSettings {
+					s.EXPECT().SetRewardRestakeSetting(ownerID, setting)
+				}
+				for subnetID, requirements := range d.caminoDiff.modifiedSubnetValidatorRequirements {
+					s.EXPECT().SetSubnetValidatorRequirements(subnetID, requirements)
+				}
+				s.EXPECT().SetTreasuryConfig(ids.ShortID{18}, uint64(218), uint64(318))
+				for periodStart, amount := range d.caminoDiff.modifiedTreasurySpentAmounts {
+					s.EXPECT().SetTreasurySpentAmount(periodStart, amount)
+				}
+				for _, spend := range d.caminoDiff.addedTreasurySpends {
+					s.EXPECT().AddTreasurySpend(spend)
+				}
 				for _, validatorDiffs := range d.caminoDiff.deferredStakerDiffs.validatorDiffs {
 					for _, validatorDiff := range validatorDiffs {
 						switch validatorDiff.validatorStatus {
@@ -3129,10 +3302,21 @@ func TestDiffApplyCaminoState(t *testing.T) {
 					{1}: 101,
 					{2}: 0,
 				},
+				modifiedKYCExpirations: map[ids.ShortID]uint64{
+					{1}: 1001,
+					{2}: 0,
+				},
+				addedAddressStateChanges: map[ids.ShortID][]*AddressStateChange{
+					{1}: {{TxID: ids.ID{1}}, {TxID: ids.ID{2}}},
+				},
 				modifiedDepositOffers: map[ids.ID]*deposit.Offer{
 					{3}: {ID: ids.ID{3}},
 					{4}: nil,
 				},
+				modifiedDepositOfferAddressAmounts: map[offerAddressKey]uint64{
+					{offerID: ids.ID{3}, address: ids.ShortID{1}}: 103,
+					{offerID: ids.ID{3}, address: ids.ShortID{2}}: 0,
+				},
 				modifiedDeposits: map[ids.ID]*depositDiff{
 					{5}: {Deposit: &deposit.Deposit{Amount: 105}},
 					{6}: {Deposit: &deposit.Deposit{Amount: 106}, added: true},
@@ -3150,6 +3334,21 @@ func TestDiffApplyCaminoState(t *testing.T) {
 					{12}: {ValidatorReward: 112},
 					{13}: nil,
 				},
+				modifiedRewardRestakeSettings: map[ids.ID]*RewardRestakeSetting{
+					{14}: {DepositOfferID: ids.ID{114}, DepositDuration: 214},
+					{15}: nil,
+				},
+				modifiedSubnetValidatorRequirements: map[ids.ID]*SubnetValidatorRequirements{
+					{16}: {RequireConsortiumMember: true, RequireKYCVerified: true},
+					{17}: nil,
+				},
+				modifiedTreasuryConfig: &treasuryConfig{admin: ids.ShortID{18}, spendLimit: 218, spendPeriod: 318},
+				modifiedTreasurySpentAmounts: map[uint64]uint64{
+					100: 118,
+				},
+				addedTreasurySpends: []*TreasurySpend{
+					{TxID: ids.ID{19}, Amount: 119},
+				},
 				deferredStakerDiffs:                   diffStakers{},
 				modifiedNotDistributedValidatorReward: &reward,
 			}},
@@ -0,0 +1,259 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+var (
+	errSnapshotMissingDeposit   = errors.New("deposited utxo references missing deposit")
+	errSnapshotMissingValidator = errors.New("bonded utxo references missing validator")
+	errSnapshotMissingOffer     = errors.New("deposit references missing deposit offer")
+	errSnapshotDepositAmount    = errors.New("deposit amount doesn't match amount of its deposited utxos")
+)
+
+// GenesisSnapshot is platform chain state right after genesis was synced into empty db.
+type GenesisSnapshot struct {
+	Timestamp       uint64
+	CurrentSupply   uint64
+	UTXOs           map[ids.ID]*avax.UTXO
+	DepositOffers   map[ids.ID]*deposit.Offer
+	Deposits        map[ids.ID]*deposit.Deposit
+	AddressStates   map[ids.ShortID]txs.AddressState
+	MultisigAliases map[ids.ShortID]*multisig.AliasWithNonce
+	// Current primary network validators by nodeID
+	Validators map[ids.NodeID]*Staker
+	// Consortium member addresses, that registered validators nodes
+	ValidatorOwners map[ids.NodeID]ids.ShortID
+}
+
+// NewGenesisSnapshot syncs platform chain [genesisBytes] into memdb
+// and reads resulting state.
+func NewGenesisSnapshot(networkID uint32, genesisBytes []byte, rewardCfg reward.Config) (*GenesisSnapshot, error) {
+	genesisState, err := genesis.ParseState(genesisBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	vdrs := validators.NewManager()
+	_ = vdrs.Add(constants.PrimaryNetworkID, validators.NewSet())
+
+	s, err := new(
+		memdb.New(),
+		metrics.Noop,
+		&config.Config{Validators: vdrs},
+		&snow.Context{
+			NetworkID: networkID,
+			Log:       logging.NoLog{},
+		},
+		prometheus.NewRegistry(),
+		reward.NewCalculator(rewardCfg),
+		&utils.Atomic[bool]{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = s.Close()
+	}()
+
+	if err := s.sync(genesisBytes); err != nil {
+		return nil, err
+	}
+
+	currentSupply, err := s.GetCurrentSupply(constants.PrimaryNetworkID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &GenesisSnapshot{
+		Timestamp:       uint64(s.GetTimestamp().Unix()),
+		CurrentSupply:   currentSupply,
+		UTXOs:           make(map[ids.ID]*avax.UTXO, len(genesisState.UTXOs)),
+		DepositOffers:   make(map[ids.ID]*deposit.Offer),
+		Deposits:        make(map[ids.ID]*deposit.Deposit),
+		AddressStates:   make(map[ids.ShortID]txs.AddressState),
+		MultisigAliases: make(map[ids.ShortID]*multisig.AliasWithNonce),
+		Validators:      make(map[ids.NodeID]*Staker),
+		ValidatorOwners: make(map[ids.NodeID]ids.ShortID),
+	}
+
+	// utxo state can't be iterated, so we're reading utxos that genesis should've created
+	for _, genesisUTXO := range genesisState.UTXOs {
+		utxoID := genesisUTXO.InputID()
+		utxo, err := s.GetUTXO(utxoID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get genesis utxo %s: %w", utxoID, err)
+		}
+		snapshot.UTXOs[utxoID] = utxo
+	}
+
+	offers, err := s.GetAllDepositOffers()
+	if err != nil {
+		return nil, err
+	}
+	for _, offer := range offers {
+		snapshot.DepositOffers[offer.ID] = offer
+	}
+
+	cs, ok := s.caminoState.(*caminoState)
+	if !ok {
+		return nil, fmt.Errorf("unexpected camino state type %T", s.caminoState)
+	}
+	if err := readGenesisSnapshotDB(cs.depositsDB, func(key, value []byte) error {
+		depositTxID, err := ids.ToID(key)
+		if err != nil {
+			return err
+		}
+		d := &deposit.Deposit{}
+		if _, err := blocks.GenesisCodec.Unmarshal(value, d); err != nil {
+			return err
+		}
+		snapshot.Deposits[depositTxID] = d
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := readGenesisSnapshotDB(cs.addressStateDB, func(key, _ []byte) error {
+		addr, err := ids.ToShortID(key)
+		if err != nil {
+			return err
+		}
+		addrState, err := cs.GetAddressStates(addr)
+		if err != nil {
+			return err
+		}
+		snapshot.AddressStates[addr] = addrState
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := readGenesisSnapshotDB(cs.multisigAliasesDB, func(key, _ []byte) error {
+		aliasID, err := ids.ToShortID(key)
+		if err != nil {
+			return err
+		}
+		alias, err := cs.GetMultisigAlias(aliasID)
+		if err != nil {
+			return err
+		}
+		snapshot.MultisigAliases[aliasID] = alias
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	stakerIterator, err := s.GetCurrentStakerIterator()
+	if err != nil {
+		return nil, err
+	}
+	defer stakerIterator.Release()
+	for stakerIterator.Next() {
+		staker := stakerIterator.Value()
+		if staker.SubnetID != constants.PrimaryNetworkID || staker.Priority != txs.PrimaryNetworkValidatorCurrentPriority {
+			continue
+		}
+		snapshot.Validators[staker.NodeID] = staker
+		owner, err := cs.GetShortIDLink(ids.ShortID(staker.NodeID), ShortLinkKeyRegisterNode)
+		switch {
+		case err == database.ErrNotFound:
+		case err != nil:
+			return nil, err
+		default:
+			snapshot.ValidatorOwners[staker.NodeID] = owner
+		}
+	}
+
+	return snapshot, nil
+}
+
+func readGenesisSnapshotDB(db database.Database, read func(key, value []byte) error) error {
+	it := db.NewIterator()
+	defer it.Release()
+	for it.Next() {
+		if err := read(it.Key(), it.Value()); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// Verify returns an error, if snapshot state has inconsistent
+// references between utxos, deposits, deposit offers and validators.
+func (s *GenesisSnapshot) Verify() error {
+	validatorTxIDs := make(map[ids.ID]struct{}, len(s.Validators))
+	for _, staker := range s.Validators {
+		validatorTxIDs[staker.TxID] = struct{}{}
+	}
+
+	// iterating in sorted order to always report the same error
+	utxoIDs := maps.Keys(s.UTXOs)
+	utils.Sort(utxoIDs)
+	depositTxIDs := maps.Keys(s.Deposits)
+	utils.Sort(depositTxIDs)
+
+	depositedAmounts := make(map[ids.ID]uint64, len(s.Deposits))
+	for _, utxoID := range utxoIDs {
+		lockedOut, ok := s.UTXOs[utxoID].Out.(*locked.Out)
+		if !ok {
+			continue
+		}
+		if depositTxID := lockedOut.DepositTxID; depositTxID != ids.Empty {
+			if _, ok := s.Deposits[depositTxID]; !ok {
+				return fmt.Errorf("%w: utxo %s, deposit %s", errSnapshotMissingDeposit, utxoID, depositTxID)
+			}
+			depositedAmount, err := math.Add64(depositedAmounts[depositTxID], lockedOut.Amount())
+			if err != nil {
+				return err
+			}
+			depositedAmounts[depositTxID] = depositedAmount
+		}
+		if bondTxID := lockedOut.BondTxID; bondTxID != ids.Empty {
+			if _, ok := validatorTxIDs[bondTxID]; !ok {
+				return fmt.Errorf("%w: utxo %s, validator tx %s", errSnapshotMissingValidator, utxoID, bondTxID)
+			}
+		}
+	}
+
+	for _, depositTxID := range depositTxIDs {
+		d := s.Deposits[depositTxID]
+		if _, ok := s.DepositOffers[d.DepositOfferID]; !ok {
+			return fmt.Errorf("%w: deposit %s, offer %s", errSnapshotMissingOffer, depositTxID, d.DepositOfferID)
+		}
+		if depositedAmounts[depositTxID] != d.Amount {
+			return fmt.Errorf("%w: deposit %s has amount %d, but its utxos have %d",
+				errSnapshotDepositAmount, depositTxID, d.Amount, depositedAmounts[depositTxID])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestGenesisSnapshotVerify(t *testing.T) {
+	owner := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{1}}}
+	offer := &deposit.Offer{ID: ids.ID{1}}
+	validatorTxID := ids.ID{2}
+	depositTxID := ids.ID{3}
+
+	tests := map[string]struct {
+		snapshot    func() *GenesisSnapshot
+		expectedErr error
+	}{
+		"OK": {
+			snapshot: func() *GenesisSnapshot {
+				return &GenesisSnapshot{
+					UTXOs: map[ids.ID]*avax.UTXO{
+						{4}: generateTestUTXO(ids.ID{4}, ids.Empty, 10, owner, ids.Empty, ids.Empty),
+						{5}: generateTestUTXO(ids.ID{5}, ids.Empty, 7, owner, depositTxID, validatorTxID),
+						{6}: generateTestUTXO(ids.ID{6}, ids.Empty, 3, owner, depositTxID, ids.Empty),
+					},
+					DepositOffers: map[ids.ID]*deposit.Offer{offer.ID: offer},
+					Deposits: map[ids.ID]*deposit.Deposit{
+						depositTxID: {DepositOfferID: offer.ID, Amount: 10},
+					},
+					Validators: map[ids.NodeID]*Staker{
+						{1}: {TxID: validatorTxID},
+					},
+				}
+			},
+		},
+		"Deposited utxo references missing deposit": {
+			snapshot: func() *GenesisSnapshot {
+				return &GenesisSnapshot{
+					UTXOs: map[ids.ID]*avax.UTXO{
+						{4}: generateTestUTXO(ids.ID{4}, ids.Empty, 10, owner, depositTxID, ids.Empty),
+					},
+				}
+			},
+			expectedErr: errSnapshotMissingDeposit,
+		},
+		"Bonded utxo references missing validator": {
+			snapshot: func() *GenesisSnapshot {
+				return &GenesisSnapshot{
+					UTXOs: map[ids.ID]*avax.UTXO{
+						{4}: generateTestUTXO(ids.ID{4}, ids.Empty, 10, owner, ids.Empty, validatorTxID),
+					},
+				}
+			},
+			expectedErr: errSnapshotMissingValidator,
+		},
+		"Deposit references missing offer": {
+			snapshot: func() *GenesisSnapshot {
+				return &GenesisSnapshot{
+					UTXOs: map[ids.ID]*avax.UTXO{
+						{4}: generateTestUTXO(ids.ID{4}, ids.Empty, 10, owner, depositTxID, ids.Empty),
+					},
+					Deposits: map[ids.ID]*deposit.Deposit{
+						depositTxID: {DepositOfferID: offer.ID, Amount: 10},
+					},
+				}
+			},
+			expectedErr: errSnapshotMissingOffer,
+		},
+		"Deposit amount doesn't match deposited utxos": {
+			snapshot: func() *GenesisSnapshot {
+				return &GenesisSnapshot{
+					UTXOs: map[ids.ID]*avax.UTXO{
+						{4}: generateTestUTXO(ids.ID{4}, ids.Empty, 9, owner, depositTxID, ids.Empty),
+					},
+					DepositOffers: map[ids.ID]*deposit.Offer{offer.ID: offer},
+					Deposits: map[ids.ID]*deposit.Deposit{
+						depositTxID: {DepositOfferID: offer.ID, Amount: 10},
+					},
+				}
+			},
+			expectedErr: errSnapshotDepositAmount,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.snapshot().Verify(), tt.expectedErr)
+		})
+	}
+}
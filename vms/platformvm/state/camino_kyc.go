@@ -0,0 +1,171 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+// Sets KYC expiration unix time of address. Zero [expiration] removes it.
+func (cs *caminoState) SetKYCExpiration(address ids.ShortID, expiration uint64) {
+	cs.modifiedKYCExpirations[address] = expiration
+}
+
+func (cs *caminoState) GetKYCExpiration(address ids.ShortID) (uint64, error) {
+	if expiration, ok := cs.modifiedKYCExpirations[address]; ok {
+		if expiration == 0 {
+			return 0, database.ErrNotFound
+		}
+		return expiration, nil
+	}
+	return database.GetUInt64(cs.kycExpirationsDB, address[:])
+}
+
+func (cs *caminoState) GetNextKYCExpirationTime(removedAddresses set.Set[ids.ShortID]) (time.Time, error) {
+	_, nextExpirationTime, err := cs.GetNextToExpireKYCAddressesAndTime(removedAddresses)
+	return nextExpirationTime, err
+}
+
+func (cs *caminoState) GetNextToExpireKYCAddressesAndTime(removedAddresses set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	for address := range cs.modifiedKYCExpirations {
+		removedAddresses.Add(address)
+	}
+
+	addresses, nextExpiration, err := cs.getNextToExpireKYCAddressesAndTimeFromDB(removedAddresses)
+	if err != nil && err != database.ErrNotFound {
+		return nil, time.Time{}, err
+	}
+
+	addresses, nextExpiration = mergeNextToExpireKYCAddresses(
+		addresses,
+		nextExpiration,
+		cs.modifiedKYCExpirations,
+	)
+	if len(addresses) == 0 {
+		return nil, mockable.MaxTime, database.ErrNotFound
+	}
+	return addresses, time.Unix(int64(nextExpiration), 0), nil
+}
+
+func (cs *caminoState) writeKYCExpirations() error {
+	for address, expiration := range cs.modifiedKYCExpirations {
+		delete(cs.modifiedKYCExpirations, address)
+
+		oldExpiration, err := database.GetUInt64(cs.kycExpirationsDB, address[:])
+		switch {
+		case err == nil:
+			if err := cs.kycAddressesByExpirationDB.Delete(kycExpirationToKey(address, oldExpiration)); err != nil {
+				return err
+			}
+		case err != database.ErrNotFound:
+			return err
+		}
+
+		if expiration == 0 {
+			if err := cs.kycExpirationsDB.Delete(address[:]); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := database.PutUInt64(cs.kycExpirationsDB, address[:], expiration); err != nil {
+			return err
+		}
+		if err := cs.kycAddressesByExpirationDB.Put(kycExpirationToKey(address, expiration), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cs *caminoState) getNextToExpireKYCAddressesAndTimeFromDB(removedAddresses set.Set[ids.ShortID]) ([]ids.ShortID, uint64, error) {
+	kycIterator := cs.kycAddressesByExpirationDB.NewIterator()
+	defer kycIterator.Release()
+
+	var nextAddresses []ids.ShortID
+	nextExpiration := uint64(math.MaxUint64)
+
+	for kycIterator.Next() {
+		address, expiration, err := bytesToKYCAddressAndExpiration(kycIterator.Key())
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if removedAddresses.Contains(address) {
+			continue
+		}
+
+		// we expect values to be sorted by expiration in ascending order
+		if expiration > nextExpiration {
+			break
+		}
+		nextExpiration = expiration
+		nextAddresses = append(nextAddresses, address)
+	}
+
+	if err := kycIterator.Error(); err != nil {
+		return nil, 0, err
+	}
+
+	if len(nextAddresses) == 0 {
+		return nil, 0, database.ErrNotFound
+	}
+
+	return nextAddresses, nextExpiration, nil
+}
+
+// mergeNextToExpireKYCAddresses returns earliest expiration and sorted addresses
+// with this expiration from [addresses] with [expiration] and [modifiedExpirations].
+// Addresses from [modifiedExpirations] with zero expiration are ignored.
+// If there are no addresses, [addresses] must be empty.
+func mergeNextToExpireKYCAddresses(
+	addresses []ids.ShortID,
+	expiration uint64,
+	modifiedExpirations map[ids.ShortID]uint64,
+) ([]ids.ShortID, uint64) {
+	if len(addresses) == 0 {
+		expiration = math.MaxUint64
+	}
+
+	needSort := false // addresses from db or parent are already sorted
+	for address, modifiedExpiration := range modifiedExpirations {
+		switch {
+		case modifiedExpiration == 0 || modifiedExpiration > expiration:
+			continue
+		case modifiedExpiration < expiration:
+			expiration = modifiedExpiration
+			addresses = nil
+		}
+		addresses = append(addresses, address)
+		needSort = true
+	}
+
+	if needSort {
+		utils.Sort(addresses)
+	}
+	return addresses, expiration
+}
+
+func kycExpirationToKey(address ids.ShortID, expiration uint64) []byte {
+	key := make([]byte, 8+len(address))
+	binary.BigEndian.PutUint64(key, expiration)
+	copy(key[8:], address[:])
+	return key
+}
+
+func bytesToKYCAddressAndExpiration(key []byte) (ids.ShortID, uint64, error) {
+	address, err := ids.ToShortID(key[8:])
+	if err != nil {
+		return ids.ShortEmpty, 0, err
+	}
+	return address, binary.BigEndian.Uint64(key[:8]), nil
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+func TestWriteAndGetKYCExpirations(t *testing.T) {
+	address1 := ids.ShortID{1}
+	address2 := ids.ShortID{2}
+	address3 := ids.ShortID{3}
+
+	caminoState := &caminoState{
+		caminoDiff: &caminoDiff{
+			modifiedKYCExpirations: map[ids.ShortID]uint64{},
+		},
+		kycExpirationsDB:           memdb.New(),
+		kycAddressesByExpirationDB: memdb.New(),
+	}
+
+	_, _, err := caminoState.GetNextToExpireKYCAddressesAndTime(nil)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	caminoState.SetKYCExpiration(address3, 30)
+	caminoState.SetKYCExpiration(address2, 20)
+	caminoState.SetKYCExpiration(address1, 20)
+
+	// not written yet
+	addresses, nextExpirationTime, err := caminoState.GetNextToExpireKYCAddressesAndTime(nil)
+	require.NoError(t, err)
+	require.Equal(t, []ids.ShortID{address1, address2}, addresses)
+	require.Equal(t, time.Unix(20, 0), nextExpirationTime)
+
+	require.NoError(t, caminoState.writeKYCExpirations())
+	require.Empty(t, caminoState.modifiedKYCExpirations)
+
+	addresses, nextExpirationTime, err = caminoState.GetNextToExpireKYCAddressesAndTime(nil)
+	require.NoError(t, err)
+	require.Equal(t, []ids.ShortID{address1, address2}, addresses)
+	require.Equal(t, time.Unix(20, 0), nextExpirationTime)
+
+	addresses, nextExpirationTime, err = caminoState.GetNextToExpireKYCAddressesAndTime(set.Set[ids.ShortID]{address1: struct{}{}})
+	require.NoError(t, err)
+	require.Equal(t, []ids.ShortID{address2}, addresses)
+	require.Equal(t, time.Unix(20, 0), nextExpirationTime)
+
+	expiration, err := caminoState.GetKYCExpiration(address3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), expiration)
+
+	// moving address2 expiration later and removing address1 expiration
+	caminoState.SetKYCExpiration(address1, 0)
+	caminoState.SetKYCExpiration(address2, 40)
+
+	_, err = caminoState.GetKYCExpiration(address1)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	addresses, nextExpirationTime, err = caminoState.GetNextToExpireKYCAddressesAndTime(nil)
+	require.NoError(t, err)
+	require.Equal(t, []ids.ShortID{address3}, addresses)
+	require.Equal(t, time.Unix(30, 0), nextExpirationTime)
+
+	require.NoError(t, caminoState.writeKYCExpirations())
+
+	_, err = caminoState.GetKYCExpiration(address1)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	nextExpirationTime, err = caminoState.GetNextKYCExpirationTime(nil)
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(30, 0), nextExpirationTime)
+
+	caminoState.SetKYCExpiration(address3, 0)
+	require.NoError(t, caminoState.writeKYCExpirations())
+
+	addresses, nextExpirationTime, err = caminoState.GetNextToExpireKYCAddressesAndTime(nil)
+	require.NoError(t, err)
+	require.Equal(t, []ids.ShortID{address2}, addresses)
+	require.Equal(t, time.Unix(40, 0), nextExpirationTime)
+
+	caminoState.SetKYCExpiration(address2, 0)
+	require.NoError(t, caminoState.writeKYCExpirations())
+
+	nextExpirationTime, err = caminoState.GetNextKYCExpirationTime(nil)
+	require.ErrorIs(t, err, database.ErrNotFound)
+	require.Equal(t, mockable.MaxTime, nextExpirationTime)
+}
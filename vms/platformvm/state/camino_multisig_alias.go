@@ -6,18 +6,28 @@ package state
 import (
 	"fmt"
 
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/vms/types"
 )
 
 type msigAlias struct {
-	Memo   types.JSONByteSlice `serialize:"true"`
-	Owners verify.State        `serialize:"true"`
-	Nonce  uint64              `serialize:"true"`
+	UpgradeVersionID      codec.UpgradeVersionID
+	Memo                  types.JSONByteSlice `serialize:"true"`
+	Owners                verify.State        `serialize:"true"`
+	Nonce                 uint64              `serialize:"true"`
+	OwnersChangeThreshold uint32              `serialize:"true" upgradeVersion:"1"`
+	OwnersChangeDelay     uint64              `serialize:"true" upgradeVersion:"1"`
+	SpendLimitThreshold   uint32              `serialize:"true" upgradeVersion:"2"`
+	SpendLimitAmount      uint64              `serialize:"true" upgradeVersion:"2"`
+	SpendLimitPeriod      uint64              `serialize:"true" upgradeVersion:"2"`
 }
 
 func (cs *caminoState) SetMultisigAlias(ma *multisig.AliasWithNonce) {
@@ -55,9 +65,15 @@ func (cs *caminoState) GetMultisigAlias(id ids.ShortID) (*multisig.AliasWithNonc
 
 	msigAlias := &multisig.AliasWithNonce{
 		Alias: multisig.Alias{
-			ID:     id,
-			Memo:   dbMultisigAlias.Memo,
-			Owners: dbMultisigAlias.Owners,
+			UpgradeVersionID:      dbMultisigAlias.UpgradeVersionID,
+			ID:                    id,
+			Memo:                  dbMultisigAlias.Memo,
+			Owners:                dbMultisigAlias.Owners,
+			OwnersChangeThreshold: dbMultisigAlias.OwnersChangeThreshold,
+			OwnersChangeDelay:     dbMultisigAlias.OwnersChangeDelay,
+			SpendLimitThreshold:   dbMultisigAlias.SpendLimitThreshold,
+			SpendLimitAmount:      dbMultisigAlias.SpendLimitAmount,
+			SpendLimitPeriod:      dbMultisigAlias.SpendLimitPeriod,
 		},
 		Nonce: dbMultisigAlias.Nonce,
 	}
@@ -66,18 +82,128 @@ func (cs *caminoState) GetMultisigAlias(id ids.ShortID) (*multisig.AliasWithNonc
 	return msigAlias, nil
 }
 
+// GetMultisigAliasesForAddress returns sorted IDs of aliases, which have [address] as one of their owners.
+// Aliases, which are owned by such aliases, aren't included.
+func (cs *caminoState) GetMultisigAliasesForAddress(address ids.ShortID) ([]ids.ShortID, error) {
+	aliasIDs := set.Set[ids.ShortID]{}
+
+	aliasIDsIterator := cs.multisigAliasIDsByOwnerDB.NewIteratorWithPrefix(address[:])
+	defer aliasIDsIterator.Release()
+	for aliasIDsIterator.Next() {
+		aliasID, err := ids.ToShortID(aliasIDsIterator.Key()[len(address):])
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := cs.modifiedMultisigAliases[aliasID]; !ok {
+			aliasIDs.Add(aliasID)
+		}
+	}
+	if err := aliasIDsIterator.Error(); err != nil {
+		return nil, err
+	}
+
+	for aliasID, alias := range cs.modifiedMultisigAliases {
+		if alias == nil {
+			continue
+		}
+		for _, ownerAddr := range aliasOwnerAddrs(alias.Owners) {
+			if ownerAddr == address {
+				aliasIDs.Add(aliasID)
+				break
+			}
+		}
+	}
+
+	aliasIDsList := aliasIDs.List()
+	utils.Sort(aliasIDsList)
+	return aliasIDsList, nil
+}
+
+// Must be called before alias is written, because it reads previous alias owners from db.
+func (cs *caminoState) updateMultisigAliasOwnersIndex(aliasID ids.ShortID, alias *multisig.AliasWithNonce) error {
+	oldAliasBytes, err := cs.multisigAliasesDB.Get(aliasID[:])
+	switch {
+	case err == nil:
+		oldAlias := &msigAlias{}
+		if _, err := blocks.GenesisCodec.Unmarshal(oldAliasBytes, oldAlias); err != nil {
+			return err
+		}
+		for _, ownerAddr := range aliasOwnerAddrs(oldAlias.Owners) {
+			if err := cs.multisigAliasIDsByOwnerDB.Delete(multisigAliasOwnerKey(ownerAddr, aliasID)); err != nil {
+				return err
+			}
+		}
+	case err != database.ErrNotFound:
+		return err
+	}
+
+	if alias == nil {
+		return nil
+	}
+	for _, ownerAddr := range aliasOwnerAddrs(alias.Owners) {
+		if err := cs.multisigAliasIDsByOwnerDB.Put(multisigAliasOwnerKey(ownerAddr, aliasID), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexMultisigAliasOwners builds aliases owners index for aliases, that were written before this index existed.
+func (cs *caminoState) indexMultisigAliasOwners() error {
+	indexed, err := database.GetBool(cs.caminoDB, multisigAliasOwnersIndexedKey)
+	switch {
+	case err == database.ErrNotFound:
+	case err != nil:
+		return err
+	case indexed:
+		return nil
+	}
+
+	aliasesIterator := cs.multisigAliasesDB.NewIterator()
+	defer aliasesIterator.Release()
+	for aliasesIterator.Next() {
+		aliasID, err := ids.ToShortID(aliasesIterator.Key())
+		if err != nil {
+			return err
+		}
+		alias := &msigAlias{}
+		if _, err := blocks.GenesisCodec.Unmarshal(aliasesIterator.Value(), alias); err != nil {
+			return err
+		}
+		for _, ownerAddr := range aliasOwnerAddrs(alias.Owners) {
+			if err := cs.multisigAliasIDsByOwnerDB.Put(multisigAliasOwnerKey(ownerAddr, aliasID), nil); err != nil {
+				return err
+			}
+		}
+	}
+	if err := aliasesIterator.Error(); err != nil {
+		return err
+	}
+
+	return database.PutBool(cs.caminoDB, multisigAliasOwnersIndexedKey, true)
+}
+
 func (cs *caminoState) writeMultisigAliases() error {
 	for key, alias := range cs.modifiedMultisigAliases {
 		delete(cs.modifiedMultisigAliases, key)
+		if err := cs.updateMultisigAliasOwnersIndex(key, alias); err != nil {
+			return err
+		}
 		if alias == nil {
 			if err := cs.multisigAliasesDB.Delete(key[:]); err != nil {
 				return err
 			}
 		} else {
 			multisigAlias := &msigAlias{
-				Memo:   alias.Memo,
-				Owners: alias.Owners,
-				Nonce:  alias.Nonce,
+				UpgradeVersionID:      alias.UpgradeVersionID,
+				Memo:                  alias.Memo,
+				Owners:                alias.Owners,
+				Nonce:                 alias.Nonce,
+				OwnersChangeThreshold: alias.OwnersChangeThreshold,
+				OwnersChangeDelay:     alias.OwnersChangeDelay,
+				SpendLimitThreshold:   alias.SpendLimitThreshold,
+				SpendLimitAmount:      alias.SpendLimitAmount,
+				SpendLimitPeriod:      alias.SpendLimitPeriod,
 			}
 			aliasBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, multisigAlias)
 			if err != nil {
@@ -90,3 +216,19 @@ func (cs *caminoState) writeMultisigAliases() error {
 	}
 	return nil
 }
+
+// aliasOwnerAddrs returns addresses of alias owners. Only secp256k1fx owners are supported.
+func aliasOwnerAddrs(owners verify.State) []ids.ShortID {
+	secpOwners, ok := owners.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return nil
+	}
+	return secpOwners.Addrs
+}
+
+func multisigAliasOwnerKey(ownerAddr, aliasID ids.ShortID) []byte {
+	key := make([]byte, len(ownerAddr)+len(aliasID))
+	copy(key, ownerAddr[:])
+	copy(key[len(ownerAddr):], aliasID[:])
+	return key
+}
@@ -0,0 +1,190 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+)
+
+// Sets pending timelocked change of multisig alias. Nil [change] removes it.
+func (cs *caminoState) SetMultisigAliasChange(aliasID ids.ShortID, change *multisig.AliasChange) {
+	cs.modifiedMultisigAliasChanges[aliasID] = change
+}
+
+func (cs *caminoState) GetMultisigAliasChange(aliasID ids.ShortID) (*multisig.AliasChange, error) {
+	if change, ok := cs.modifiedMultisigAliasChanges[aliasID]; ok {
+		if change == nil {
+			return nil, database.ErrNotFound
+		}
+		return change, nil
+	}
+	return cs.getMultisigAliasChangeFromDB(aliasID)
+}
+
+func (cs *caminoState) GetNextMultisigAliasChangeTime(removedAliasIDs set.Set[ids.ShortID]) (time.Time, error) {
+	_, nextChangeTime, err := cs.GetNextToApplyMultisigAliasChangesAndTime(removedAliasIDs)
+	return nextChangeTime, err
+}
+
+func (cs *caminoState) GetNextToApplyMultisigAliasChangesAndTime(removedAliasIDs set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	for aliasID := range cs.modifiedMultisigAliasChanges {
+		removedAliasIDs.Add(aliasID)
+	}
+
+	aliasIDs, nextChangeTime, err := cs.getNextToApplyMultisigAliasChangesAndTimeFromDB(removedAliasIDs)
+	if err != nil && err != database.ErrNotFound {
+		return nil, time.Time{}, err
+	}
+
+	aliasIDs, nextChangeTime = mergeNextToApplyMultisigAliasChanges(
+		aliasIDs,
+		nextChangeTime,
+		cs.modifiedMultisigAliasChanges,
+	)
+	if len(aliasIDs) == 0 {
+		return nil, mockable.MaxTime, database.ErrNotFound
+	}
+	return aliasIDs, time.Unix(int64(nextChangeTime), 0), nil
+}
+
+func (cs *caminoState) writeMultisigAliasChanges() error {
+	for aliasID, change := range cs.modifiedMultisigAliasChanges {
+		delete(cs.modifiedMultisigAliasChanges, aliasID)
+
+		oldChange, err := cs.getMultisigAliasChangeFromDB(aliasID)
+		switch {
+		case err == nil:
+			if err := cs.multisigAliasIDsByChangeTimeDB.Delete(multisigAliasChangeToKey(aliasID, oldChange.EffectiveTime)); err != nil {
+				return err
+			}
+		case err != database.ErrNotFound:
+			return err
+		}
+
+		if change == nil {
+			if err := cs.multisigAliasChangesDB.Delete(aliasID[:]); err != nil {
+				return err
+			}
+			continue
+		}
+
+		changeBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, change)
+		if err != nil {
+			return fmt.Errorf("failed to serialize multisig alias change: %w", err)
+		}
+		if err := cs.multisigAliasChangesDB.Put(aliasID[:], changeBytes); err != nil {
+			return err
+		}
+		if err := cs.multisigAliasIDsByChangeTimeDB.Put(multisigAliasChangeToKey(aliasID, change.EffectiveTime), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cs *caminoState) getMultisigAliasChangeFromDB(aliasID ids.ShortID) (*multisig.AliasChange, error) {
+	changeBytes, err := cs.multisigAliasChangesDB.Get(aliasID[:])
+	if err != nil {
+		return nil, err
+	}
+	change := &multisig.AliasChange{}
+	if _, err := blocks.GenesisCodec.Unmarshal(changeBytes, change); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+func (cs *caminoState) getNextToApplyMultisigAliasChangesAndTimeFromDB(removedAliasIDs set.Set[ids.ShortID]) ([]ids.ShortID, uint64, error) {
+	changesIterator := cs.multisigAliasIDsByChangeTimeDB.NewIterator()
+	defer changesIterator.Release()
+
+	var nextAliasIDs []ids.ShortID
+	nextChangeTime := uint64(math.MaxUint64)
+
+	for changesIterator.Next() {
+		aliasID, changeTime, err := bytesToMultisigAliasIDAndChangeTime(changesIterator.Key())
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if removedAliasIDs.Contains(aliasID) {
+			continue
+		}
+
+		// we expect values to be sorted by change time in ascending order
+		if changeTime > nextChangeTime {
+			break
+		}
+		nextChangeTime = changeTime
+		nextAliasIDs = append(nextAliasIDs, aliasID)
+	}
+
+	if err := changesIterator.Error(); err != nil {
+		return nil, 0, err
+	}
+
+	if len(nextAliasIDs) == 0 {
+		return nil, 0, database.ErrNotFound
+	}
+
+	return nextAliasIDs, nextChangeTime, nil
+}
+
+// mergeNextToApplyMultisigAliasChanges returns earliest change time and sorted alias IDs
+// with this change time from [aliasIDs] with [changeTime] and [modifiedChanges].
+// Alias IDs from [modifiedChanges] with nil change are ignored.
+// If there are no alias IDs, [aliasIDs] must be empty.
+func mergeNextToApplyMultisigAliasChanges(
+	aliasIDs []ids.ShortID,
+	changeTime uint64,
+	modifiedChanges map[ids.ShortID]*multisig.AliasChange,
+) ([]ids.ShortID, uint64) {
+	if len(aliasIDs) == 0 {
+		changeTime = math.MaxUint64
+	}
+
+	needSort := false // alias IDs from db or parent are already sorted
+	for aliasID, change := range modifiedChanges {
+		switch {
+		case change == nil || change.EffectiveTime > changeTime:
+			continue
+		case change.EffectiveTime < changeTime:
+			changeTime = change.EffectiveTime
+			aliasIDs = nil
+		}
+		aliasIDs = append(aliasIDs, aliasID)
+		needSort = true
+	}
+
+	if needSort {
+		utils.Sort(aliasIDs)
+	}
+	return aliasIDs, changeTime
+}
+
+func multisigAliasChangeToKey(aliasID ids.ShortID, changeTime uint64) []byte {
+	key := make([]byte, 8+len(aliasID))
+	binary.BigEndian.PutUint64(key, changeTime)
+	copy(key[8:], aliasID[:])
+	return key
+}
+
+func bytesToMultisigAliasIDAndChangeTime(key []byte) (ids.ShortID, uint64, error) {
+	aliasID, err := ids.ToShortID(key[8:])
+	if err != nil {
+		return ids.ShortEmpty, 0, err
+	}
+	return aliasID, binary.BigEndian.Uint64(key[:8]), nil
+}
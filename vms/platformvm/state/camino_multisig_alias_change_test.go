@@ -0,0 +1,102 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestWriteAndGetMultisigAliasChanges(t *testing.T) {
+	aliasID1 := ids.ShortID{1}
+	aliasID2 := ids.ShortID{2}
+	aliasID3 := ids.ShortID{3}
+
+	newChange := func(aliasID ids.ShortID, effectiveTime uint64) *multisig.AliasChange {
+		return &multisig.AliasChange{
+			Alias: multisig.Alias{
+				UpgradeVersionID: codec.UpgradeVersion1,
+				ID:               aliasID,
+				Memo:             []byte("memo"),
+				Owners: &secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{{11}, {12}},
+				},
+				OwnersChangeThreshold: 2,
+				OwnersChangeDelay:     100,
+			},
+			EffectiveTime: effectiveTime,
+		}
+	}
+
+	caminoState := &caminoState{
+		caminoDiff: &caminoDiff{
+			modifiedMultisigAliasChanges: map[ids.ShortID]*multisig.AliasChange{},
+		},
+		multisigAliasChangesDB:         memdb.New(),
+		multisigAliasIDsByChangeTimeDB: memdb.New(),
+	}
+
+	_, _, err := caminoState.GetNextToApplyMultisigAliasChangesAndTime(nil)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	change3 := newChange(aliasID3, 30)
+	caminoState.SetMultisigAliasChange(aliasID3, change3)
+	caminoState.SetMultisigAliasChange(aliasID2, newChange(aliasID2, 20))
+	caminoState.SetMultisigAliasChange(aliasID1, newChange(aliasID1, 20))
+
+	// not written yet
+	aliasIDs, nextChangeTime, err := caminoState.GetNextToApplyMultisigAliasChangesAndTime(nil)
+	require.NoError(t, err)
+	require.Equal(t, []ids.ShortID{aliasID1, aliasID2}, aliasIDs)
+	require.Equal(t, time.Unix(20, 0), nextChangeTime)
+
+	require.NoError(t, caminoState.writeMultisigAliasChanges())
+	require.Empty(t, caminoState.modifiedMultisigAliasChanges)
+
+	aliasIDs, nextChangeTime, err = caminoState.GetNextToApplyMultisigAliasChangesAndTime(nil)
+	require.NoError(t, err)
+	require.Equal(t, []ids.ShortID{aliasID1, aliasID2}, aliasIDs)
+	require.Equal(t, time.Unix(20, 0), nextChangeTime)
+
+	aliasIDs, nextChangeTime, err = caminoState.GetNextToApplyMultisigAliasChangesAndTime(set.Set[ids.ShortID]{aliasID1: struct{}{}})
+	require.NoError(t, err)
+	require.Equal(t, []ids.ShortID{aliasID2}, aliasIDs)
+	require.Equal(t, time.Unix(20, 0), nextChangeTime)
+
+	change, err := caminoState.GetMultisigAliasChange(aliasID3)
+	require.NoError(t, err)
+	require.Equal(t, change3, change)
+
+	// moving alias2 change later and cancelling alias1 change
+	caminoState.SetMultisigAliasChange(aliasID1, nil)
+	caminoState.SetMultisigAliasChange(aliasID2, newChange(aliasID2, 40))
+
+	_, err = caminoState.GetMultisigAliasChange(aliasID1)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	aliasIDs, nextChangeTime, err = caminoState.GetNextToApplyMultisigAliasChangesAndTime(nil)
+	require.NoError(t, err)
+	require.Equal(t, []ids.ShortID{aliasID3}, aliasIDs)
+	require.Equal(t, time.Unix(30, 0), nextChangeTime)
+
+	require.NoError(t, caminoState.writeMultisigAliasChanges())
+
+	_, err = caminoState.GetMultisigAliasChange(aliasID1)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	nextChangeTime, err = caminoState.GetNextMultisigAliasChangeTime(set.Set[ids.ShortID]{aliasID3: struct{}{}})
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(40, 0), nextChangeTime)
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+)
+
+// Sets spends made from multisig alias within its spend limit. Nil [usage] removes it.
+func (cs *caminoState) SetMultisigAliasSpendLimitUsage(aliasID ids.ShortID, usage *multisig.SpendLimitUsage) {
+	cs.modifiedMultisigAliasSpendUsages[aliasID] = usage
+}
+
+func (cs *caminoState) GetMultisigAliasSpendLimitUsage(aliasID ids.ShortID) (*multisig.SpendLimitUsage, error) {
+	if usage, ok := cs.modifiedMultisigAliasSpendUsages[aliasID]; ok {
+		if usage == nil {
+			return nil, database.ErrNotFound
+		}
+		return usage, nil
+	}
+
+	usageBytes, err := cs.multisigAliasSpendUsagesDB.Get(aliasID[:])
+	if err != nil {
+		return nil, err
+	}
+	usage := &multisig.SpendLimitUsage{}
+	if _, err := blocks.GenesisCodec.Unmarshal(usageBytes, usage); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+func (cs *caminoState) writeMultisigAliasSpendUsages() error {
+	for aliasID, usage := range cs.modifiedMultisigAliasSpendUsages {
+		delete(cs.modifiedMultisigAliasSpendUsages, aliasID)
+		if usage == nil {
+			if err := cs.multisigAliasSpendUsagesDB.Delete(aliasID[:]); err != nil {
+				return err
+			}
+			continue
+		}
+
+		usageBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, usage)
+		if err != nil {
+			return fmt.Errorf("failed to serialize multisig alias spend limit usage: %w", err)
+		}
+		if err := cs.multisigAliasSpendUsagesDB.Put(aliasID[:], usageBytes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+)
+
+func TestWriteAndGetMultisigAliasSpendLimitUsages(t *testing.T) {
+	aliasID1 := ids.ShortID{1}
+	aliasID2 := ids.ShortID{2}
+	usage1 := &multisig.SpendLimitUsage{Spends: []multisig.LimitedSpend{{Time: 10, Amount: 100}}}
+	usage2 := &multisig.SpendLimitUsage{Spends: []multisig.LimitedSpend{{Time: 10, Amount: 100}, {Time: 20, Amount: 50}}}
+
+	caminoState := &caminoState{
+		caminoDiff: &caminoDiff{
+			modifiedMultisigAliasSpendUsages: map[ids.ShortID]*multisig.SpendLimitUsage{},
+		},
+		multisigAliasSpendUsagesDB: memdb.New(),
+	}
+
+	_, err := caminoState.GetMultisigAliasSpendLimitUsage(aliasID1)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	caminoState.SetMultisigAliasSpendLimitUsage(aliasID1, usage1)
+	caminoState.SetMultisigAliasSpendLimitUsage(aliasID2, usage2)
+
+	// not written yet
+	usage, err := caminoState.GetMultisigAliasSpendLimitUsage(aliasID1)
+	require.NoError(t, err)
+	require.Equal(t, usage1, usage)
+
+	require.NoError(t, caminoState.writeMultisigAliasSpendUsages())
+	require.Empty(t, caminoState.modifiedMultisigAliasSpendUsages)
+
+	usage, err = caminoState.GetMultisigAliasSpendLimitUsage(aliasID1)
+	require.NoError(t, err)
+	require.Equal(t, usage1, usage)
+	usage, err = caminoState.GetMultisigAliasSpendLimitUsage(aliasID2)
+	require.NoError(t, err)
+	require.Equal(t, usage2, usage)
+
+	caminoState.SetMultisigAliasSpendLimitUsage(aliasID1, nil)
+	_, err = caminoState.GetMultisigAliasSpendLimitUsage(aliasID1)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	require.NoError(t, caminoState.writeMultisigAliasSpendUsages())
+	_, err = caminoState.GetMultisigAliasSpendLimitUsage(aliasID1)
+	require.ErrorIs(t, err, database.ErrNotFound)
+}
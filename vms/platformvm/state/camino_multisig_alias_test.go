@@ -9,6 +9,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
@@ -247,6 +248,7 @@ func TestWriteMultisigAliases(t *testing.T) {
 		"Fail: db errored on modifiedMultisigAliases Put": {
 			caminoState: func(c *gomock.Controller) *caminoState {
 				multisigAliasesDB := database.NewMockDatabase(c)
+				multisigAliasesDB.EXPECT().Get(multisigAlias1.ID[:]).Return(nil, database.ErrNotFound)
 				multisigAliasesDB.EXPECT().Put(multisigAlias1.ID[:], multisigAliasBytes1).Return(testError)
 				return &caminoState{
 					multisigAliasesDB: multisigAliasesDB,
@@ -270,6 +272,7 @@ func TestWriteMultisigAliases(t *testing.T) {
 		"Fail: db errored on modifiedMultisigAliases Delete": {
 			caminoState: func(c *gomock.Controller) *caminoState {
 				multisigAliasesDB := database.NewMockDatabase(c)
+				multisigAliasesDB.EXPECT().Get(multisigAlias1.ID[:]).Return(nil, database.ErrNotFound)
 				multisigAliasesDB.EXPECT().Delete(multisigAlias1.ID[:]).Return(testError)
 				return &caminoState{
 					caminoDiff: &caminoDiff{
@@ -293,7 +296,9 @@ func TestWriteMultisigAliases(t *testing.T) {
 		"OK": {
 			caminoState: func(c *gomock.Controller) *caminoState {
 				multisigAliasesDB := database.NewMockDatabase(c)
+				multisigAliasesDB.EXPECT().Get(multisigAlias1.ID[:]).Return(nil, database.ErrNotFound)
 				multisigAliasesDB.EXPECT().Put(multisigAlias1.ID[:], multisigAliasBytes1).Return(nil)
+				multisigAliasesDB.EXPECT().Get(multisigAlias2.ID[:]).Return(nil, database.ErrNotFound)
 				multisigAliasesDB.EXPECT().Delete(multisigAlias2.ID[:]).Return(nil)
 				return &caminoState{
 					multisigAliasesDB: multisigAliasesDB,
@@ -325,3 +330,69 @@ func TestWriteMultisigAliases(t *testing.T) {
 		})
 	}
 }
+
+func TestGetMultisigAliasesForAddress(t *testing.T) {
+	owner1 := ids.ShortID{11}
+	owner2 := ids.ShortID{12}
+	newAlias := func(aliasID ids.ShortID, owners ...ids.ShortID) *multisig.AliasWithNonce {
+		return &multisig.AliasWithNonce{Alias: multisig.Alias{
+			ID:     aliasID,
+			Owners: &secp256k1fx.OutputOwners{Threshold: 1, Addrs: owners},
+		}}
+	}
+	alias1 := newAlias(ids.ShortID{1}, owner1, owner2)
+	alias2 := newAlias(ids.ShortID{2}, owner1)
+	alias3 := newAlias(ids.ShortID{3}, owner2, alias2.ID)
+
+	caminoState := &caminoState{
+		caminoDiff: &caminoDiff{
+			modifiedMultisigAliases: map[ids.ShortID]*multisig.AliasWithNonce{},
+		},
+		caminoDB:                  memdb.New(),
+		multisigAliasesDB:         memdb.New(),
+		multisigAliasIDsByOwnerDB: memdb.New(),
+		multisigAliasesCache:      &cache.LRU[ids.ShortID, *multisig.AliasWithNonce]{Size: 10},
+	}
+
+	requireAliases := func(address ids.ShortID, expectedAliasIDs ...ids.ShortID) {
+		aliasIDs, err := caminoState.GetMultisigAliasesForAddress(address)
+		require.NoError(t, err)
+		require.ElementsMatch(t, expectedAliasIDs, aliasIDs)
+	}
+
+	caminoState.SetMultisigAlias(alias1)
+	caminoState.SetMultisigAlias(alias2)
+	caminoState.SetMultisigAlias(alias3)
+
+	// not written yet
+	requireAliases(owner1, alias1.ID, alias2.ID)
+	requireAliases(owner2, alias1.ID, alias3.ID)
+	requireAliases(alias2.ID, alias3.ID)
+
+	require.NoError(t, caminoState.writeMultisigAliases())
+
+	requireAliases(owner1, alias1.ID, alias2.ID)
+	requireAliases(owner2, alias1.ID, alias3.ID)
+	requireAliases(alias2.ID, alias3.ID)
+
+	// owner1 removed from alias1 owners
+	caminoState.SetMultisigAlias(newAlias(alias1.ID, owner2))
+	requireAliases(owner1, alias2.ID)
+
+	require.NoError(t, caminoState.writeMultisigAliases())
+	requireAliases(owner1, alias2.ID)
+	requireAliases(owner2, alias1.ID, alias3.ID)
+
+	// index is rebuilt for aliases written before it existed
+	caminoState.multisigAliasIDsByOwnerDB = memdb.New()
+	requireAliases(owner2)
+	require.NoError(t, caminoState.indexMultisigAliasOwners())
+	requireAliases(owner1, alias2.ID)
+	requireAliases(owner2, alias1.ID, alias3.ID)
+	requireAliases(alias2.ID, alias3.ID)
+
+	// index isn't rebuilt twice
+	caminoState.multisigAliasIDsByOwnerDB = memdb.New()
+	require.NoError(t, caminoState.indexMultisigAliasOwners())
+	requireAliases(owner2)
+}
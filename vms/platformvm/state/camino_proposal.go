@@ -0,0 +1,313 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+)
+
+type proposalDiff struct {
+	Proposal       dao.ProposalState
+	added, removed bool
+}
+
+type proposalStateWrapper struct {
+	dao.ProposalState `serialize:"true"`
+}
+
+func (cs *caminoState) AddProposal(proposalID ids.ID, proposal dao.ProposalState) {
+	cs.modifiedProposals[proposalID] = &proposalDiff{Proposal: proposal, added: true}
+}
+
+func (cs *caminoState) ModifyProposal(proposalID ids.ID, proposal dao.ProposalState) {
+	// proposal could be added and modified before write
+	oldProposalDiff, ok := cs.modifiedProposals[proposalID]
+	cs.modifiedProposals[proposalID] = &proposalDiff{Proposal: proposal, added: ok && oldProposalDiff.added}
+	cs.proposalsCache.Evict(proposalID)
+}
+
+func (cs *caminoState) RemoveProposal(proposalID ids.ID, proposal dao.ProposalState) {
+	cs.modifiedProposals[proposalID] = &proposalDiff{Proposal: proposal, removed: true}
+	cs.proposalsCache.Evict(proposalID)
+}
+
+func (cs *caminoState) GetProposal(proposalID ids.ID) (dao.ProposalState, error) {
+	if proposalDiff, ok := cs.modifiedProposals[proposalID]; ok {
+		if proposalDiff.removed {
+			return nil, database.ErrNotFound
+		}
+		return proposalDiff.Proposal, nil
+	}
+
+	if proposal, ok := cs.proposalsCache.Get(proposalID); ok {
+		if proposal == nil {
+			return nil, database.ErrNotFound
+		}
+		return proposal, nil
+	}
+
+	proposalBytes, err := cs.proposalsDB.Get(proposalID[:])
+	if err == database.ErrNotFound {
+		cs.proposalsCache.Put(proposalID, nil)
+		return nil, err
+	} else if err != nil {
+		return nil, err
+	}
+
+	proposal := &proposalStateWrapper{}
+	if _, err := blocks.GenesisCodec.Unmarshal(proposalBytes, proposal); err != nil {
+		return nil, err
+	}
+
+	cs.proposalsCache.Put(proposalID, proposal.ProposalState)
+
+	return proposal.ProposalState, nil
+}
+
+func (cs *caminoState) AddProposalIDToFinish(proposalID ids.ID) {
+	cs.modifiedProposalIDsToFinish[proposalID] = true
+}
+
+func (cs *caminoState) RemoveProposalIDToFinish(proposalID ids.ID) {
+	cs.modifiedProposalIDsToFinish[proposalID] = false
+}
+
+func (cs *caminoState) GetProposalIDsToFinish() ([]ids.ID, error) {
+	proposalIDsToFinish := []ids.ID{}
+	for proposalID, added := range cs.modifiedProposalIDsToFinish {
+		if added {
+			proposalIDsToFinish = append(proposalIDsToFinish, proposalID)
+		}
+	}
+
+	proposalIDsToFinishIterator := cs.proposalIDsToFinishDB.NewIterator()
+	defer proposalIDsToFinishIterator.Release()
+	for proposalIDsToFinishIterator.Next() {
+		proposalID, err := ids.ToID(proposalIDsToFinishIterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := cs.modifiedProposalIDsToFinish[proposalID]; !ok {
+			proposalIDsToFinish = append(proposalIDsToFinish, proposalID)
+		}
+	}
+	if err := proposalIDsToFinishIterator.Error(); err != nil {
+		return nil, err
+	}
+
+	utils.Sort(proposalIDsToFinish)
+	return proposalIDsToFinish, nil
+}
+
+func (cs *caminoState) GetNextProposalExpirationTime(removedProposalIDs set.Set[ids.ID]) (time.Time, error) {
+	if cs.proposalsNextExpirationTime == nil {
+		return mockable.MaxTime, database.ErrNotFound
+	}
+
+	for _, proposalID := range cs.proposalsNextToExpireIDs {
+		if !removedProposalIDs.Contains(proposalID) {
+			return *cs.proposalsNextExpirationTime, nil
+		}
+	}
+
+	_, nextExpirationTime, err := cs.getNextToExpireProposalIDsAndTimeFromDB(removedProposalIDs)
+	return nextExpirationTime, err
+}
+
+func (cs *caminoState) GetNextToExpireProposalIDsAndTime(removedProposalIDs set.Set[ids.ID]) ([]ids.ID, time.Time, error) {
+	if cs.proposalsNextExpirationTime == nil {
+		return nil, mockable.MaxTime, database.ErrNotFound
+	}
+
+	var nextProposalIDs []ids.ID
+	for _, proposalID := range cs.proposalsNextToExpireIDs {
+		if !removedProposalIDs.Contains(proposalID) {
+			nextProposalIDs = append(nextProposalIDs, proposalID)
+		}
+	}
+	if len(nextProposalIDs) > 0 {
+		return nextProposalIDs, *cs.proposalsNextExpirationTime, nil
+	}
+
+	return cs.getNextToExpireProposalIDsAndTimeFromDB(removedProposalIDs)
+}
+
+func (cs *caminoState) writeProposals() error {
+	// checking if all current proposals were removed
+	nextToExpireIDsIsEmpty := true
+	for _, proposalID := range cs.proposalsNextToExpireIDs {
+		if proposalDiff, ok := cs.modifiedProposals[proposalID]; !ok || !proposalDiff.removed {
+			nextToExpireIDsIsEmpty = false
+			break
+		}
+	}
+
+	// if not all current proposals were removed, we can try to update without peeking into db
+	var nextToExpireIDs []ids.ID
+	if !nextToExpireIDsIsEmpty {
+		// calculating earliest next expiration time
+		nextExpirationTime := *cs.proposalsNextExpirationTime
+		for _, proposalDiff := range cs.modifiedProposals {
+			if proposalEndtime := proposalDiff.Proposal.EndTime(); proposalDiff.added && proposalEndtime.Before(nextExpirationTime) {
+				nextExpirationTime = proposalEndtime
+			}
+		}
+		// adding current proposals
+		if nextExpirationTime.Equal(*cs.proposalsNextExpirationTime) {
+			for _, proposalID := range cs.proposalsNextToExpireIDs {
+				if proposalDiff, ok := cs.modifiedProposals[proposalID]; !ok || !proposalDiff.removed {
+					nextToExpireIDs = append(nextToExpireIDs, proposalID)
+				}
+			}
+		}
+		// adding new proposals
+		needSort := false // proposalIDs from db are already sorted
+		for proposalID, proposalDiff := range cs.modifiedProposals {
+			if proposalDiff.added && proposalDiff.Proposal.EndTime().Equal(nextExpirationTime) {
+				nextToExpireIDs = append(nextToExpireIDs, proposalID)
+				needSort = true
+			}
+		}
+		if needSort {
+			utils.Sort(nextToExpireIDs)
+		}
+		cs.proposalsNextToExpireIDs = nextToExpireIDs
+		cs.proposalsNextExpirationTime = &nextExpirationTime
+	}
+
+	// adding new proposals to db, deleting removed proposals from db
+	for proposalID, proposalDiff := range cs.modifiedProposals {
+		delete(cs.modifiedProposals, proposalID)
+		if proposalDiff.removed {
+			if err := cs.proposalsDB.Delete(proposalID[:]); err != nil {
+				return err
+			}
+			if err := cs.proposalIDsByEndtimeDB.Delete(proposalToKey(proposalID[:], proposalDiff.Proposal)); err != nil {
+				return err
+			}
+		} else {
+			proposalBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, &proposalStateWrapper{ProposalState: proposalDiff.Proposal})
+			if err != nil {
+				return fmt.Errorf("failed to serialize proposal: %w", err)
+			}
+			if err := cs.proposalsDB.Put(proposalID[:], proposalBytes); err != nil {
+				return err
+			}
+
+			if proposalDiff.added {
+				if err := cs.proposalIDsByEndtimeDB.Put(proposalToKey(proposalID[:], proposalDiff.Proposal), nil); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// getting earliest proposals from db if proposalsNextToExpireIDs is empty
+	if len(nextToExpireIDs) == 0 {
+		nextToExpireIDs, nextExpirationTime, err := cs.getNextToExpireProposalIDsAndTimeFromDB(nil)
+		switch {
+		case err == database.ErrNotFound:
+			cs.proposalsNextToExpireIDs = nil
+			cs.proposalsNextExpirationTime = nil
+		case err != nil:
+			return err
+		default:
+			cs.proposalsNextToExpireIDs = nextToExpireIDs
+			cs.proposalsNextExpirationTime = &nextExpirationTime
+		}
+	}
+
+	// updating proposal ids to finish
+	for proposalID, added := range cs.modifiedProposalIDsToFinish {
+		delete(cs.modifiedProposalIDsToFinish, proposalID)
+		if added {
+			if err := cs.proposalIDsToFinishDB.Put(proposalID[:], nil); err != nil {
+				return err
+			}
+		} else if err := cs.proposalIDsToFinishDB.Delete(proposalID[:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cs *caminoState) loadProposals() error {
+	cs.proposalsNextToExpireIDs = nil
+	cs.proposalsNextExpirationTime = nil
+	proposalsNextToExpireIDs, proposalsNextExpirationTime, err := cs.getNextToExpireProposalIDsAndTimeFromDB(nil)
+	if err == database.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	cs.proposalsNextToExpireIDs = proposalsNextToExpireIDs
+	cs.proposalsNextExpirationTime = &proposalsNextExpirationTime
+	return nil
+}
+
+func (cs *caminoState) getNextToExpireProposalIDsAndTimeFromDB(removedProposalIDs set.Set[ids.ID]) ([]ids.ID, time.Time, error) {
+	proposalsIterator := cs.proposalIDsByEndtimeDB.NewIterator()
+	defer proposalsIterator.Release()
+
+	var nextProposalIDs []ids.ID
+	nextProposalsEndTimestamp := uint64(math.MaxUint64)
+
+	for proposalsIterator.Next() {
+		proposalID, proposalEndtime, err := bytesToProposalIDAndEndtime(proposalsIterator.Key())
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+
+		if removedProposalIDs.Contains(proposalID) {
+			continue
+		}
+
+		// we expect values to be sorted by endtime in ascending order
+		if proposalEndtime > nextProposalsEndTimestamp {
+			break
+		}
+		if proposalEndtime < nextProposalsEndTimestamp {
+			nextProposalsEndTimestamp = proposalEndtime
+		}
+		nextProposalIDs = append(nextProposalIDs, proposalID)
+	}
+
+	if err := proposalsIterator.Error(); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	if len(nextProposalIDs) == 0 {
+		return nil, mockable.MaxTime, database.ErrNotFound
+	}
+
+	return nextProposalIDs, time.Unix(int64(nextProposalsEndTimestamp), 0), nil
+}
+
+// proposalID must be ids.ID 32 bytes
+func proposalToKey(proposalID []byte, proposal dao.ProposalState) []byte {
+	proposalSortKey := make([]byte, 8+32)
+	binary.BigEndian.PutUint64(proposalSortKey, uint64(proposal.EndTime().Unix()))
+	copy(proposalSortKey[8:], proposalID)
+	return proposalSortKey
+}
+
+// proposalID must be ids.ID 32 bytes
+func bytesToProposalIDAndEndtime(proposalSortKeyBytes []byte) (ids.ID, uint64, error) {
+	proposalID, err := ids.ToID(proposalSortKeyBytes[8:])
+	if err != nil {
+		return ids.Empty, 0, err
+	}
+	return proposalID, binary.BigEndian.Uint64(proposalSortKeyBytes[:8]), nil
+}
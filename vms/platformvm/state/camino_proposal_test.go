@@ -0,0 +1,304 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ava-labs/avalanchego/cache"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProposal(t *testing.T) {
+	proposalID := ids.GenerateTestID()
+	proposal1 := (&dao.BaseFeeProposal{
+		Options: []uint64{1, 2},
+		Start:   10,
+		End:     20,
+	}).CreateProposalState([]ids.ShortID{{1}, {2}})
+	proposalBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, &proposalStateWrapper{ProposalState: proposal1})
+	require.NoError(t, err)
+	testError := errors.New("test error")
+
+	tests := map[string]struct {
+		caminoState         func(*gomock.Controller) *caminoState
+		proposalID          ids.ID
+		expectedCaminoState func(*caminoState) *caminoState
+		expectedProposal    dao.ProposalState
+		expectedErr         error
+	}{
+		"Fail: proposal removed": {
+			caminoState: func(c *gomock.Controller) *caminoState {
+				return &caminoState{
+					caminoDiff: &caminoDiff{
+						modifiedProposals: map[ids.ID]*proposalDiff{
+							proposalID: {Proposal: proposal1, removed: true},
+						},
+					},
+				}
+			},
+			expectedCaminoState: func(actualCaminoState *caminoState) *caminoState {
+				return &caminoState{
+					caminoDiff: &caminoDiff{
+						modifiedProposals: map[ids.ID]*proposalDiff{
+							proposalID: {Proposal: proposal1, removed: true},
+						},
+					},
+				}
+			},
+			proposalID:  proposalID,
+			expectedErr: database.ErrNotFound,
+		},
+		"Fail: proposal in cache, but removed": {
+			caminoState: func(c *gomock.Controller) *caminoState {
+				cache := cache.NewMockCacher[ids.ID, dao.ProposalState](c)
+				cache.EXPECT().Get(proposalID).Return(nil, true)
+				return &caminoState{
+					proposalsCache: cache,
+					caminoDiff:     &caminoDiff{},
+				}
+			},
+			expectedCaminoState: func(actualCaminoState *caminoState) *caminoState {
+				return &caminoState{
+					proposalsCache: actualCaminoState.proposalsCache,
+					caminoDiff:     &caminoDiff{},
+				}
+			},
+			proposalID:  proposalID,
+			expectedErr: database.ErrNotFound,
+		},
+		"OK: proposal added": {
+			caminoState: func(c *gomock.Controller) *caminoState {
+				return &caminoState{
+					caminoDiff: &caminoDiff{
+						modifiedProposals: map[ids.ID]*proposalDiff{
+							proposalID: {Proposal: proposal1, added: true},
+						},
+					},
+				}
+			},
+			expectedCaminoState: func(actualCaminoState *caminoState) *caminoState {
+				return &caminoState{
+					caminoDiff: &caminoDiff{
+						modifiedProposals: map[ids.ID]*proposalDiff{
+							proposalID: {Proposal: proposal1, added: true},
+						},
+					},
+				}
+			},
+			proposalID:       proposalID,
+			expectedProposal: proposal1,
+		},
+		"OK: proposal in cache": {
+			caminoState: func(c *gomock.Controller) *caminoState {
+				cache := cache.NewMockCacher[ids.ID, dao.ProposalState](c)
+				cache.EXPECT().Get(proposalID).Return(proposal1, true)
+				return &caminoState{
+					proposalsCache: cache,
+					caminoDiff:     &caminoDiff{},
+				}
+			},
+			expectedCaminoState: func(actualCaminoState *caminoState) *caminoState {
+				return &caminoState{
+					proposalsCache: actualCaminoState.proposalsCache,
+					caminoDiff:     &caminoDiff{},
+				}
+			},
+			proposalID:       proposalID,
+			expectedProposal: proposal1,
+		},
+		"OK: proposal in db": {
+			caminoState: func(c *gomock.Controller) *caminoState {
+				cache := cache.NewMockCacher[ids.ID, dao.ProposalState](c)
+				cache.EXPECT().Get(proposalID).Return(nil, false)
+				cache.EXPECT().Put(proposalID, proposal1)
+				db := database.NewMockDatabase(c)
+				db.EXPECT().Get(proposalID[:]).Return(proposalBytes, nil)
+				return &caminoState{
+					proposalsDB:    db,
+					proposalsCache: cache,
+					caminoDiff:     &caminoDiff{},
+				}
+			},
+			expectedCaminoState: func(actualCaminoState *caminoState) *caminoState {
+				return &caminoState{
+					proposalsDB:    actualCaminoState.proposalsDB,
+					proposalsCache: actualCaminoState.proposalsCache,
+					caminoDiff:     &caminoDiff{},
+				}
+			},
+			proposalID:       proposalID,
+			expectedProposal: proposal1,
+		},
+		"Fail: db error": {
+			caminoState: func(c *gomock.Controller) *caminoState {
+				cache := cache.NewMockCacher[ids.ID, dao.ProposalState](c)
+				cache.EXPECT().Get(proposalID).Return(nil, false)
+				db := database.NewMockDatabase(c)
+				db.EXPECT().Get(proposalID[:]).Return(nil, testError)
+				return &caminoState{
+					proposalsDB:    db,
+					proposalsCache: cache,
+					caminoDiff:     &caminoDiff{},
+				}
+			},
+			expectedCaminoState: func(actualCaminoState *caminoState) *caminoState {
+				return &caminoState{
+					proposalsDB:    actualCaminoState.proposalsDB,
+					proposalsCache: actualCaminoState.proposalsCache,
+					caminoDiff:     &caminoDiff{},
+				}
+			},
+			proposalID:  proposalID,
+			expectedErr: testError,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			caminoState := tt.caminoState(ctrl)
+			actualProposal, err := caminoState.GetProposal(tt.proposalID)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expectedProposal, actualProposal)
+			require.Equal(t, tt.expectedCaminoState(caminoState), caminoState)
+		})
+	}
+}
+
+func TestModifyProposal(t *testing.T) {
+	proposalID := ids.GenerateTestID()
+	proposal1 := &dao.BaseFeeProposalState{Start: 10, End: 20}
+	proposal2 := &dao.BaseFeeProposalState{Start: 10, End: 20, SimpleVoteOptions: dao.SimpleVoteOptions[uint64]{TotalAllowedVoters: 1}}
+
+	tests := map[string]struct {
+		caminoState         func(*gomock.Controller) *caminoState
+		expectedCaminoState func(*caminoState) *caminoState
+	}{
+		"OK: not added": {
+			caminoState: func(c *gomock.Controller) *caminoState {
+				cache := cache.NewMockCacher[ids.ID, dao.ProposalState](c)
+				cache.EXPECT().Evict(proposalID)
+				return &caminoState{
+					proposalsCache: cache,
+					caminoDiff:     &caminoDiff{modifiedProposals: map[ids.ID]*proposalDiff{}},
+				}
+			},
+			expectedCaminoState: func(actualCaminoState *caminoState) *caminoState {
+				return &caminoState{
+					proposalsCache: actualCaminoState.proposalsCache,
+					caminoDiff: &caminoDiff{modifiedProposals: map[ids.ID]*proposalDiff{
+						proposalID: {Proposal: proposal2},
+					}},
+				}
+			},
+		},
+		"OK: added in the same diff": {
+			caminoState: func(c *gomock.Controller) *caminoState {
+				cache := cache.NewMockCacher[ids.ID, dao.ProposalState](c)
+				cache.EXPECT().Evict(proposalID)
+				return &caminoState{
+					proposalsCache: cache,
+					caminoDiff: &caminoDiff{modifiedProposals: map[ids.ID]*proposalDiff{
+						proposalID: {Proposal: proposal1, added: true},
+					}},
+				}
+			},
+			expectedCaminoState: func(actualCaminoState *caminoState) *caminoState {
+				return &caminoState{
+					proposalsCache: actualCaminoState.proposalsCache,
+					caminoDiff: &caminoDiff{modifiedProposals: map[ids.ID]*proposalDiff{
+						proposalID: {Proposal: proposal2, added: true},
+					}},
+				}
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			caminoState := tt.caminoState(ctrl)
+			caminoState.ModifyProposal(proposalID, proposal2)
+			require.Equal(t, tt.expectedCaminoState(caminoState), caminoState)
+		})
+	}
+}
+
+func TestWriteAndLoadProposals(t *testing.T) {
+	proposalID1 := ids.ID{1}
+	proposalID2 := ids.ID{2}
+	proposalID3 := ids.ID{3}
+	proposal1 := &dao.BaseFeeProposalState{Start: 10, End: 20}
+	proposal2 := &dao.BaseFeeProposalState{Start: 10, End: 20}
+	proposal3 := (&dao.AddMemberProposal{
+		ApplicantAddress: ids.ShortID{1},
+		Start:            10,
+		End:              30,
+	}).CreateProposalState([]ids.ShortID{{2}, {3}})
+
+	newCaminoState := func() *caminoState {
+		return &caminoState{
+			caminoDiff: &caminoDiff{
+				modifiedProposals:           map[ids.ID]*proposalDiff{},
+				modifiedProposalIDsToFinish: map[ids.ID]bool{},
+			},
+			proposalsDB:            memdb.New(),
+			proposalIDsByEndtimeDB: memdb.New(),
+			proposalIDsToFinishDB:  memdb.New(),
+		}
+	}
+
+	caminoState := newCaminoState()
+	caminoState.AddProposal(proposalID3, proposal3)
+	caminoState.AddProposal(proposalID2, proposal2)
+	caminoState.AddProposal(proposalID1, proposal1)
+	caminoState.AddProposalIDToFinish(proposalID3)
+	require.NoError(t, caminoState.writeProposals())
+
+	require.Equal(t, []ids.ID{proposalID1, proposalID2}, caminoState.proposalsNextToExpireIDs)
+	require.Equal(t, time.Unix(20, 0), *caminoState.proposalsNextExpirationTime)
+
+	proposalIDsToFinish, err := caminoState.GetProposalIDsToFinish()
+	require.NoError(t, err)
+	require.Equal(t, []ids.ID{proposalID3}, proposalIDsToFinish)
+
+	// loading from the same dbs
+	loadedCaminoState := newCaminoState()
+	loadedCaminoState.proposalsDB = caminoState.proposalsDB
+	loadedCaminoState.proposalIDsByEndtimeDB = caminoState.proposalIDsByEndtimeDB
+	loadedCaminoState.proposalIDsToFinishDB = caminoState.proposalIDsToFinishDB
+	loadedCaminoState.proposalsCache = &cache.LRU[ids.ID, dao.ProposalState]{Size: 10}
+	require.NoError(t, loadedCaminoState.loadProposals())
+	require.Equal(t, caminoState.proposalsNextToExpireIDs, loadedCaminoState.proposalsNextToExpireIDs)
+	require.Equal(t, caminoState.proposalsNextExpirationTime, loadedCaminoState.proposalsNextExpirationTime)
+
+	loadedProposal, err := loadedCaminoState.GetProposal(proposalID3)
+	require.NoError(t, err)
+	require.Equal(t, proposal3, loadedProposal)
+
+	// removing expired proposals
+	loadedCaminoState.RemoveProposal(proposalID1, proposal1)
+	loadedCaminoState.RemoveProposal(proposalID2, proposal2)
+	loadedCaminoState.RemoveProposalIDToFinish(proposalID3)
+	require.NoError(t, loadedCaminoState.writeProposals())
+
+	require.Equal(t, []ids.ID{proposalID3}, loadedCaminoState.proposalsNextToExpireIDs)
+	require.Equal(t, time.Unix(30, 0), *loadedCaminoState.proposalsNextExpirationTime)
+
+	_, err = loadedCaminoState.GetProposal(proposalID1)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	proposalIDsToFinish, err = loadedCaminoState.GetProposalIDsToFinish()
+	require.NoError(t, err)
+	require.Empty(t, proposalIDsToFinish)
+}
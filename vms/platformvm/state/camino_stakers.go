@@ -6,8 +6,9 @@ package state
 import (
 	"fmt"
 
-	"github.com/ava-labs/avalanchego/database"
+	"github.com/google/btree"
 
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/linkeddb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -56,6 +57,9 @@ func (cs *caminoState) loadDeferredValidators(s *state) error {
 			if err != nil {
 				return err
 			}
+			if err := cs.loadRotatedValidatorNodeID(staker); err != nil {
+				return err
+			}
 
 			validator := cs.deferredStakers.getOrCreateValidator(staker.SubnetID, staker.NodeID)
 			validator.validator = staker
@@ -102,3 +106,69 @@ func writeDeferredDiff(
 	}
 	return nil
 }
+
+// Sets [staker] node id to the one, which validator got with its last node rotation.
+// Does nothing, if validator node was never rotated.
+func (cs *caminoState) loadRotatedValidatorNodeID(staker *Staker) error {
+	nodeIDBytes, err := cs.rotatedValidatorNodeIDsDB.Get(staker.TxID[:])
+	switch {
+	case err == database.ErrNotFound:
+		return nil
+	case err != nil:
+		return err
+	}
+	staker.NodeID, err = ids.ToNodeID(nodeIDBytes)
+	return err
+}
+
+func (cs *caminoState) putRotatedValidatorNodeID(txID ids.ID, nodeID ids.NodeID) error {
+	return cs.rotatedValidatorNodeIDsDB.Put(txID[:], nodeID[:])
+}
+
+// Returns uptimes of validators, which node was rotated in [validatorDiffs], mapped by validator tx id.
+// Rotated validator is deleted with its old node id and added with the new one, keeping the same tx id.
+// Must be called before uptimes of old nodes are deleted.
+func (s *state) getRotatedValidatorUptimes(
+	subnetID ids.ID,
+	validatorDiffs map[ids.NodeID]*diffValidator,
+) (map[ids.ID]*uptimeAndReward, error) {
+	deletedValidatorNodeIDs := make(map[ids.ID]ids.NodeID)
+	for nodeID, validatorDiff := range validatorDiffs {
+		if validatorDiff.validatorStatus == deleted {
+			deletedValidatorNodeIDs[validatorDiff.validator.TxID] = nodeID
+		}
+	}
+
+	rotatedValidatorUptimes := make(map[ids.ID]*uptimeAndReward)
+	for _, validatorDiff := range validatorDiffs {
+		if validatorDiff.validatorStatus != added {
+			continue
+		}
+		staker := validatorDiff.validator
+		oldNodeID, ok := deletedValidatorNodeIDs[staker.TxID]
+		if !ok {
+			continue
+		}
+		upDuration, lastUpdated, err := s.validatorUptimes.GetUptime(oldNodeID, subnetID)
+		if err != nil {
+			return nil, err
+		}
+		rotatedValidatorUptimes[staker.TxID] = &uptimeAndReward{
+			txID:        staker.TxID,
+			lastUpdated: lastUpdated,
+
+			UpDuration:      upDuration,
+			LastUpdated:     uint64(lastUpdated.Unix()),
+			PotentialReward: staker.PotentialReward,
+		}
+	}
+	return rotatedValidatorUptimes, nil
+}
+
+// Deletes [staker] from [stakers], unless [stakers] contains other staker with the same tx id.
+// That happens, when validator node was rotated and new staker was put before the old one was deleted.
+func deleteStaker(stakers *btree.BTreeG[*Staker], staker *Staker) {
+	if storedStaker, ok := stakers.Get(staker); ok && storedStaker.NodeID == staker.NodeID {
+		stakers.Delete(staker)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+)
+
+func TestRotateCurrentValidatorNode(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s, db := newInitializedState(require)
+	require.NoError(s.(*state).initValidatorSets())
+	newNodeID := ids.GenerateTestNodeID()
+	upDuration := 5 * time.Hour
+	lastUpdated := initialTime.Add(10 * time.Hour)
+
+	require.NoError(s.SetUptime(initialNodeID, constants.PrimaryNetworkID, upDuration, lastUpdated))
+	require.NoError(s.Commit())
+
+	validator, err := s.GetCurrentValidator(constants.PrimaryNetworkID, initialNodeID)
+	require.NoError(err)
+	rotatedValidator := *validator
+	rotatedValidator.NodeID = newNodeID
+
+	lastAcceptedID := ids.GenerateTestID()
+	versions := NewMockVersions(ctrl)
+	versions.EXPECT().GetState(lastAcceptedID).AnyTimes().Return(s, true)
+	d, err := NewDiff(lastAcceptedID, versions)
+	require.NoError(err)
+
+	d.DeleteCurrentValidator(validator)
+	d.PutCurrentValidator(&rotatedValidator)
+
+	assertRotated := func(chain Chain) {
+		_, err := chain.GetCurrentValidator(constants.PrimaryNetworkID, initialNodeID)
+		require.ErrorIs(err, database.ErrNotFound)
+
+		actualValidator, err := chain.GetCurrentValidator(constants.PrimaryNetworkID, newNodeID)
+		require.NoError(err)
+		require.Equal(&rotatedValidator, actualValidator)
+
+		stakerIterator, err := chain.GetCurrentStakerIterator()
+		require.NoError(err)
+		require.True(stakerIterator.Next())
+		require.Equal(&rotatedValidator, stakerIterator.Value())
+		require.False(stakerIterator.Next())
+		stakerIterator.Release()
+	}
+
+	assertRotated(d)
+	d.Apply(s)
+	assertRotated(s)
+	require.NoError(s.Commit())
+
+	primaryValidators, ok := s.(*state).cfg.Validators.Get(constants.PrimaryNetworkID)
+	require.True(ok)
+	require.False(primaryValidators.Contains(initialNodeID))
+	require.True(primaryValidators.Contains(newNodeID))
+
+	s = newStateFromDB(require, db)
+	require.NoError(s.(*state).loadCurrentValidators())
+	assertRotated(s)
+
+	actualUpDuration, actualLastUpdated, err := s.GetUptime(newNodeID, constants.PrimaryNetworkID)
+	require.NoError(err)
+	require.Equal(upDuration, actualUpDuration)
+	require.Equal(lastUpdated, actualLastUpdated)
+}
@@ -7,11 +7,13 @@ import (
 	"math"
 	"time"
 
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -57,6 +59,30 @@ func (s *state) GetAddressStates(address ids.ShortID) (txs.AddressState, error)
 	return s.caminoState.GetAddressStates(address)
 }
 
+func (s *state) AddAddressStateChange(address ids.ShortID, change *AddressStateChange) {
+	s.caminoState.AddAddressStateChange(address, change)
+}
+
+func (s *state) GetAddressStateHistory(address ids.ShortID, startIndex uint64, limit int) ([]*AddressStateChange, error) {
+	return s.caminoState.GetAddressStateHistory(address, startIndex, limit)
+}
+
+func (s *state) SetKYCExpiration(address ids.ShortID, expiration uint64) {
+	s.caminoState.SetKYCExpiration(address, expiration)
+}
+
+func (s *state) GetKYCExpiration(address ids.ShortID) (uint64, error) {
+	return s.caminoState.GetKYCExpiration(address)
+}
+
+func (s *state) GetNextKYCExpirationTime(removedAddresses set.Set[ids.ShortID]) (time.Time, error) {
+	return s.caminoState.GetNextKYCExpirationTime(removedAddresses)
+}
+
+func (s *state) GetNextToExpireKYCAddressesAndTime(removedAddresses set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	return s.caminoState.GetNextToExpireKYCAddressesAndTime(removedAddresses)
+}
+
 func (s *state) SetDepositOffer(offer *deposit.Offer) {
 	s.caminoState.SetDepositOffer(offer)
 }
@@ -69,6 +95,14 @@ func (s *state) GetAllDepositOffers() ([]*deposit.Offer, error) {
 	return s.caminoState.GetAllDepositOffers()
 }
 
+func (s *state) SetDepositOfferAddressAmount(offerID ids.ID, address ids.ShortID, amount uint64) {
+	s.caminoState.SetDepositOfferAddressAmount(offerID, address, amount)
+}
+
+func (s *state) GetDepositOfferAddressAmount(offerID ids.ID, address ids.ShortID) (uint64, error) {
+	return s.caminoState.GetDepositOfferAddressAmount(offerID, address)
+}
+
 func (s *state) AddDeposit(depositTxID ids.ID, deposit *deposit.Deposit) {
 	s.caminoState.AddDeposit(depositTxID, deposit)
 }
@@ -101,6 +135,34 @@ func (s *state) GetMultisigAlias(alias ids.ShortID) (*multisig.AliasWithNonce, e
 	return s.caminoState.GetMultisigAlias(alias)
 }
 
+func (s *state) GetMultisigAliasesForAddress(address ids.ShortID) ([]ids.ShortID, error) {
+	return s.caminoState.GetMultisigAliasesForAddress(address)
+}
+
+func (s *state) SetMultisigAliasChange(aliasID ids.ShortID, change *multisig.AliasChange) {
+	s.caminoState.SetMultisigAliasChange(aliasID, change)
+}
+
+func (s *state) GetMultisigAliasChange(aliasID ids.ShortID) (*multisig.AliasChange, error) {
+	return s.caminoState.GetMultisigAliasChange(aliasID)
+}
+
+func (s *state) GetNextMultisigAliasChangeTime(removedAliasIDs set.Set[ids.ShortID]) (time.Time, error) {
+	return s.caminoState.GetNextMultisigAliasChangeTime(removedAliasIDs)
+}
+
+func (s *state) GetNextToApplyMultisigAliasChangesAndTime(removedAliasIDs set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	return s.caminoState.GetNextToApplyMultisigAliasChangesAndTime(removedAliasIDs)
+}
+
+func (s *state) SetMultisigAliasSpendLimitUsage(aliasID ids.ShortID, usage *multisig.SpendLimitUsage) {
+	s.caminoState.SetMultisigAliasSpendLimitUsage(aliasID, usage)
+}
+
+func (s *state) GetMultisigAliasSpendLimitUsage(aliasID ids.ShortID) (*multisig.SpendLimitUsage, error) {
+	return s.caminoState.GetMultisigAliasSpendLimitUsage(aliasID)
+}
+
 func (s *state) SetShortIDLink(id ids.ShortID, key ShortLinkKey, link *ids.ShortID) {
 	s.caminoState.SetShortIDLink(id, key, link)
 }
@@ -117,6 +179,30 @@ func (s *state) GetClaimable(ownerID ids.ID) (*Claimable, error) {
 	return s.caminoState.GetClaimable(ownerID)
 }
 
+func (s *state) SetRewardRestakeSetting(ownerID ids.ID, setting *RewardRestakeSetting) {
+	s.caminoState.SetRewardRestakeSetting(ownerID, setting)
+}
+
+func (s *state) GetRewardRestakeSetting(ownerID ids.ID) (*RewardRestakeSetting, error) {
+	return s.caminoState.GetRewardRestakeSetting(ownerID)
+}
+
+func (s *state) SetSubnetValidatorRequirements(subnetID ids.ID, requirements *SubnetValidatorRequirements) {
+	s.caminoState.SetSubnetValidatorRequirements(subnetID, requirements)
+}
+
+func (s *state) GetSubnetValidatorRequirements(subnetID ids.ID) (*SubnetValidatorRequirements, error) {
+	return s.caminoState.GetSubnetValidatorRequirements(subnetID)
+}
+
+func (s *state) SetDeferredValidatorInfo(validatorTxID ids.ID, info *DeferredValidatorInfo) {
+	s.caminoState.SetDeferredValidatorInfo(validatorTxID, info)
+}
+
+func (s *state) GetDeferredValidatorInfo(validatorTxID ids.ID) (*DeferredValidatorInfo, error) {
+	return s.caminoState.GetDeferredValidatorInfo(validatorTxID)
+}
+
 func (s *state) SetNotDistributedValidatorReward(reward uint64) {
 	s.caminoState.SetNotDistributedValidatorReward(reward)
 }
@@ -140,3 +226,103 @@ func (s *state) DeleteDeferredValidator(staker *Staker) {
 func (s *state) GetDeferredStakerIterator() (StakerIterator, error) {
 	return s.caminoState.GetDeferredStakerIterator()
 }
+
+func (s *state) GetAddressesWithStates(states txs.AddressState) ([]ids.ShortID, error) {
+	return s.caminoState.GetAddressesWithStates(states)
+}
+
+func (s *state) AddProposal(proposalID ids.ID, proposal dao.ProposalState) {
+	s.caminoState.AddProposal(proposalID, proposal)
+}
+
+func (s *state) ModifyProposal(proposalID ids.ID, proposal dao.ProposalState) {
+	s.caminoState.ModifyProposal(proposalID, proposal)
+}
+
+func (s *state) RemoveProposal(proposalID ids.ID, proposal dao.ProposalState) {
+	s.caminoState.RemoveProposal(proposalID, proposal)
+}
+
+func (s *state) GetProposal(proposalID ids.ID) (dao.ProposalState, error) {
+	return s.caminoState.GetProposal(proposalID)
+}
+
+func (s *state) AddProposalIDToFinish(proposalID ids.ID) {
+	s.caminoState.AddProposalIDToFinish(proposalID)
+}
+
+func (s *state) RemoveProposalIDToFinish(proposalID ids.ID) {
+	s.caminoState.RemoveProposalIDToFinish(proposalID)
+}
+
+func (s *state) GetProposalIDsToFinish() ([]ids.ID, error) {
+	return s.caminoState.GetProposalIDsToFinish()
+}
+
+func (s *state) GetNextProposalExpirationTime(removedProposalIDs set.Set[ids.ID]) (time.Time, error) {
+	return s.caminoState.GetNextProposalExpirationTime(removedProposalIDs)
+}
+
+func (s *state) GetNextToExpireProposalIDsAndTime(removedProposalIDs set.Set[ids.ID]) ([]ids.ID, time.Time, error) {
+	return s.caminoState.GetNextToExpireProposalIDsAndTime(removedProposalIDs)
+}
+
+func (s *state) SetBaseFee(baseFee uint64) {
+	s.caminoState.SetBaseFee(baseFee)
+}
+
+// Returns base fee, which is tx fee for camino txs. If base fee wasn't changed, config tx fee is returned.
+func (s *state) GetBaseFee() (uint64, error) {
+	baseFee, err := s.caminoState.GetBaseFee()
+	if err == database.ErrNotFound {
+		return s.cfg.TxFee, nil
+	}
+	return baseFee, err
+}
+
+func (s *state) SetTreasuryConfig(admin ids.ShortID, spendLimit, spendPeriod uint64) {
+	s.caminoState.SetTreasuryConfig(admin, spendLimit, spendPeriod)
+}
+
+func (s *state) SetTreasurySpentAmount(periodStart, amount uint64) {
+	s.caminoState.SetTreasurySpentAmount(periodStart, amount)
+}
+
+func (s *state) GetTreasurySpentAmount(periodStart uint64) (uint64, error) {
+	return s.caminoState.GetTreasurySpentAmount(periodStart)
+}
+
+func (s *state) AddTreasurySpend(spend *TreasurySpend) {
+	s.caminoState.AddTreasurySpend(spend)
+}
+
+func (s *state) GetTreasurySpends(startIndex uint64, limit int) ([]*TreasurySpend, error) {
+	return s.caminoState.GetTreasurySpends(startIndex, limit)
+}
+
+func (s *state) GetDepositOfferStats(offerID ids.ID) (*deposit.OfferStats, error) {
+	return s.caminoState.GetDepositOfferStats(offerID)
+}
+
+// updateDepositOfferMetrics sets deposit offer metrics for offers that are active at
+// current chain time and removes them for inactive offers.
+func (s *state) updateDepositOfferMetrics() error {
+	offers, err := s.caminoState.GetAllDepositOffers()
+	if err != nil {
+		return err
+	}
+
+	chainTime := uint64(s.GetTimestamp().Unix())
+	for _, offer := range offers {
+		if !offer.IsActiveAt(chainTime) {
+			s.metrics.RemoveDepositOfferStats(offer.ID)
+			continue
+		}
+		stats, err := s.caminoState.GetDepositOfferStats(offer.ID)
+		if err != nil {
+			return err
+		}
+		s.metrics.SetDepositOfferStats(offer, stats)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+)
+
+// SubnetValidatorRequirements defines requirements for node owners
+// of permissionless subnet validators
+type SubnetValidatorRequirements struct {
+	RequireConsortiumMember bool `serialize:"true"`
+	RequireKYCVerified      bool `serialize:"true"`
+}
+
+// Sets validator requirements of subnet. Nil [requirements] removes them.
+func (cs *caminoState) SetSubnetValidatorRequirements(subnetID ids.ID, requirements *SubnetValidatorRequirements) {
+	cs.modifiedSubnetValidatorRequirements[subnetID] = requirements
+}
+
+func (cs *caminoState) GetSubnetValidatorRequirements(subnetID ids.ID) (*SubnetValidatorRequirements, error) {
+	if requirements, ok := cs.modifiedSubnetValidatorRequirements[subnetID]; ok {
+		if requirements == nil {
+			return nil, database.ErrNotFound
+		}
+		return requirements, nil
+	}
+
+	requirementsBytes, err := cs.subnetValidatorRequirementsDB.Get(subnetID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	requirements := &SubnetValidatorRequirements{}
+	if _, err := blocks.GenesisCodec.Unmarshal(requirementsBytes, requirements); err != nil {
+		return nil, err
+	}
+	return requirements, nil
+}
+
+func (cs *caminoState) writeSubnetValidatorRequirements() error {
+	for subnetID, requirements := range cs.modifiedSubnetValidatorRequirements {
+		delete(cs.modifiedSubnetValidatorRequirements, subnetID)
+		if requirements == nil {
+			if err := cs.subnetValidatorRequirementsDB.Delete(subnetID[:]); err != nil {
+				return err
+			}
+			continue
+		}
+		requirementsBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, requirements)
+		if err != nil {
+			return fmt.Errorf("failed to serialize subnet validator requirements: %w", err)
+		}
+		if err := cs.subnetValidatorRequirementsDB.Put(subnetID[:], requirementsBytes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func TestWriteAndGetSubnetValidatorRequirements(t *testing.T) {
+	subnetID1 := ids.ID{1}
+	subnetID2 := ids.ID{2}
+	requirements1 := &SubnetValidatorRequirements{RequireConsortiumMember: true}
+	requirements2 := &SubnetValidatorRequirements{RequireConsortiumMember: true, RequireKYCVerified: true}
+
+	caminoState := &caminoState{
+		caminoDiff: &caminoDiff{
+			modifiedSubnetValidatorRequirements: map[ids.ID]*SubnetValidatorRequirements{},
+		},
+		subnetValidatorRequirementsDB: memdb.New(),
+	}
+
+	_, err := caminoState.GetSubnetValidatorRequirements(subnetID1)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	caminoState.SetSubnetValidatorRequirements(subnetID1, requirements1)
+	caminoState.SetSubnetValidatorRequirements(subnetID2, requirements2)
+
+	// not written yet
+	requirements, err := caminoState.GetSubnetValidatorRequirements(subnetID1)
+	require.NoError(t, err)
+	require.Equal(t, requirements1, requirements)
+
+	require.NoError(t, caminoState.writeSubnetValidatorRequirements())
+	require.Empty(t, caminoState.modifiedSubnetValidatorRequirements)
+
+	requirements, err = caminoState.GetSubnetValidatorRequirements(subnetID2)
+	require.NoError(t, err)
+	require.Equal(t, requirements2, requirements)
+
+	caminoState.SetSubnetValidatorRequirements(subnetID1, nil)
+
+	_, err = caminoState.GetSubnetValidatorRequirements(subnetID1)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	require.NoError(t, caminoState.writeSubnetValidatorRequirements())
+
+	_, err = caminoState.GetSubnetValidatorRequirements(subnetID1)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	requirements, err = caminoState.GetSubnetValidatorRequirements(subnetID2)
+	require.NoError(t, err)
+	require.Equal(t, requirements2, requirements)
+}
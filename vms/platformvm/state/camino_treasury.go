@@ -0,0 +1,189 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+)
+
+// TreasurySpend is a record of single payment from treasury
+type TreasurySpend struct {
+	// Height of block that accepted this spend, set when state is written
+	Height uint64 `serialize:"true"`
+	// ID of tx that made this spend
+	TxID ids.ID `serialize:"true"`
+	// Chain time in unix seconds when this spend was made
+	Timestamp uint64 `serialize:"true"`
+	// Amount paid from treasury
+	Amount uint64 `serialize:"true"`
+	// Reference provided by admin
+	Reference []byte `serialize:"true"`
+}
+
+// treasuryConfig is treasury part of camino config, which can be changed after genesis
+type treasuryConfig struct {
+	admin       ids.ShortID
+	spendLimit  uint64
+	spendPeriod uint64
+}
+
+// apply overrides treasury fields of [caminoConfig], if [c] isn't nil
+func (c *treasuryConfig) apply(caminoConfig *CaminoConfig) {
+	if c == nil {
+		return
+	}
+	caminoConfig.TreasuryAdmin = c.admin
+	caminoConfig.TreasurySpendLimit = c.spendLimit
+	caminoConfig.TreasurySpendPeriod = c.spendPeriod
+}
+
+func (cs *caminoState) SetTreasuryConfig(admin ids.ShortID, spendLimit, spendPeriod uint64) {
+	cs.modifiedTreasuryConfig = &treasuryConfig{
+		admin:       admin,
+		spendLimit:  spendLimit,
+		spendPeriod: spendPeriod,
+	}
+}
+
+// Treasury config singletons are absent in databases synced before treasury was introduced
+func (cs *caminoState) loadTreasuryConfig() error {
+	adminBytes, err := cs.caminoDB.Get(treasuryAdminKey)
+	switch {
+	case err == database.ErrNotFound:
+		return nil
+	case err != nil:
+		return err
+	}
+	cs.treasuryAdmin, err = ids.ToShortID(adminBytes)
+	if err != nil {
+		return err
+	}
+
+	cs.treasurySpendLimit, err = database.GetUInt64(cs.caminoDB, treasurySpendLimitKey)
+	if err != nil {
+		return err
+	}
+
+	cs.treasurySpendPeriod, err = database.GetUInt64(cs.caminoDB, treasurySpendPeriodKey)
+	return err
+}
+
+// Sets total amount spent from treasury during spending period starting at [periodStart].
+func (cs *caminoState) SetTreasurySpentAmount(periodStart, amount uint64) {
+	cs.modifiedTreasurySpentAmounts[periodStart] = amount
+}
+
+// Returns total amount spent from treasury during spending period starting at [periodStart].
+func (cs *caminoState) GetTreasurySpentAmount(periodStart uint64) (uint64, error) {
+	if amount, ok := cs.modifiedTreasurySpentAmounts[periodStart]; ok {
+		return amount, nil
+	}
+
+	amount, err := database.GetUInt64(cs.treasurySpentAmountsDB, database.PackUInt64(periodStart))
+	if err == database.ErrNotFound {
+		return 0, nil
+	}
+	return amount, err
+}
+
+func (cs *caminoState) AddTreasurySpend(spend *TreasurySpend) {
+	cs.addedTreasurySpends = append(cs.addedTreasurySpends, spend)
+}
+
+// Returns at most [limit] treasury spends in order of their acceptance, starting from
+// spend with [startIndex]. Spends that aren't written yet are also included, but their height isn't set.
+func (cs *caminoState) GetTreasurySpends(startIndex uint64, limit int) ([]*TreasurySpend, error) {
+	spendsCount, err := database.GetUInt64(cs.caminoDB, treasurySpendsCountKey)
+	if err != nil && err != database.ErrNotFound {
+		return nil, err
+	}
+
+	var spends []*TreasurySpend
+	if startIndex < spendsCount {
+		spendsIterator := cs.treasurySpendsDB.NewIteratorWithStart(database.PackUInt64(startIndex))
+		defer spendsIterator.Release()
+
+		for len(spends) < limit && spendsIterator.Next() {
+			spend := &TreasurySpend{}
+			if _, err := blocks.GenesisCodec.Unmarshal(spendsIterator.Value(), spend); err != nil {
+				return nil, err
+			}
+			spends = append(spends, spend)
+		}
+
+		if err := spendsIterator.Error(); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := uint64(0); i < uint64(len(cs.addedTreasurySpends)) && len(spends) < limit; i++ {
+		if spendsCount+i >= startIndex {
+			spends = append(spends, cs.addedTreasurySpends[i])
+		}
+	}
+
+	return spends, nil
+}
+
+func (cs *caminoState) writeTreasuryConfig() error {
+	if cs.modifiedTreasuryConfig == nil {
+		return nil
+	}
+	config := cs.modifiedTreasuryConfig
+	cs.modifiedTreasuryConfig = nil
+	if err := cs.caminoDB.Put(treasuryAdminKey, config.admin[:]); err != nil {
+		return fmt.Errorf("failed to write treasury admin: %w", err)
+	}
+	if err := database.PutUInt64(cs.caminoDB, treasurySpendLimitKey, config.spendLimit); err != nil {
+		return fmt.Errorf("failed to write treasury spend limit: %w", err)
+	}
+	if err := database.PutUInt64(cs.caminoDB, treasurySpendPeriodKey, config.spendPeriod); err != nil {
+		return fmt.Errorf("failed to write treasury spend period: %w", err)
+	}
+	cs.treasuryAdmin = config.admin
+	cs.treasurySpendLimit = config.spendLimit
+	cs.treasurySpendPeriod = config.spendPeriod
+	return nil
+}
+
+func (cs *caminoState) writeTreasurySpentAmounts() error {
+	for periodStart, amount := range cs.modifiedTreasurySpentAmounts {
+		delete(cs.modifiedTreasurySpentAmounts, periodStart)
+		if err := database.PutUInt64(cs.treasurySpentAmountsDB, database.PackUInt64(periodStart), amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cs *caminoState) writeTreasurySpends(height uint64) error {
+	if len(cs.addedTreasurySpends) == 0 {
+		return nil
+	}
+
+	spendsCount, err := database.GetUInt64(cs.caminoDB, treasurySpendsCountKey)
+	if err != nil && err != database.ErrNotFound {
+		return err
+	}
+
+	for _, addedSpend := range cs.addedTreasurySpends {
+		spend := *addedSpend
+		spend.Height = height
+		spendBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, &spend)
+		if err != nil {
+			return fmt.Errorf("failed to serialize treasury spend: %w", err)
+		}
+		if err := cs.treasurySpendsDB.Put(database.PackUInt64(spendsCount), spendBytes); err != nil {
+			return err
+		}
+		spendsCount++
+	}
+	cs.addedTreasurySpends = nil
+
+	return database.PutUInt64(cs.caminoDB, treasurySpendsCountKey, spendsCount)
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func TestWriteAndLoadTreasuryConfig(t *testing.T) {
+	db := memdb.New()
+	cs := &caminoState{
+		caminoDiff:          &caminoDiff{},
+		caminoDB:            db,
+		treasuryAdmin:       ids.ShortID{1},
+		treasurySpendLimit:  10,
+		treasurySpendPeriod: 100,
+	}
+
+	cs.SetTreasuryConfig(ids.ShortID{2}, 20, 200)
+	expectedCaminoConfig := &CaminoConfig{
+		TreasuryAdmin:       ids.ShortID{2},
+		TreasurySpendLimit:  20,
+		TreasurySpendPeriod: 200,
+	}
+
+	// not written yet
+	require.Equal(t, expectedCaminoConfig, cs.CaminoConfig())
+
+	require.NoError(t, cs.writeTreasuryConfig())
+	require.Nil(t, cs.modifiedTreasuryConfig)
+	require.Equal(t, expectedCaminoConfig, cs.CaminoConfig())
+
+	loadedCaminoState := &caminoState{caminoDiff: &caminoDiff{}, caminoDB: db}
+	require.NoError(t, loadedCaminoState.loadTreasuryConfig())
+	require.Equal(t, expectedCaminoConfig, loadedCaminoState.CaminoConfig())
+}
+
+func TestWriteAndGetTreasurySpentAmount(t *testing.T) {
+	caminoState := &caminoState{
+		caminoDiff: &caminoDiff{
+			modifiedTreasurySpentAmounts: map[uint64]uint64{},
+		},
+		treasurySpentAmountsDB: memdb.New(),
+	}
+
+	amount, err := caminoState.GetTreasurySpentAmount(100)
+	require.NoError(t, err)
+	require.Zero(t, amount)
+
+	caminoState.SetTreasurySpentAmount(100, 10)
+
+	// not written yet
+	amount, err = caminoState.GetTreasurySpentAmount(100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), amount)
+
+	require.NoError(t, caminoState.writeTreasurySpentAmounts())
+	require.Empty(t, caminoState.modifiedTreasurySpentAmounts)
+
+	amount, err = caminoState.GetTreasurySpentAmount(100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), amount)
+
+	amount, err = caminoState.GetTreasurySpentAmount(200)
+	require.NoError(t, err)
+	require.Zero(t, amount)
+}
+
+func TestWriteAndGetTreasurySpends(t *testing.T) {
+	caminoState := &caminoState{
+		caminoDiff:       &caminoDiff{},
+		caminoDB:         memdb.New(),
+		treasurySpendsDB: memdb.New(),
+	}
+
+	spend1 := &TreasurySpend{TxID: ids.ID{1}, Timestamp: 11, Amount: 101, Reference: []byte{1}}
+	spend2 := &TreasurySpend{TxID: ids.ID{2}, Timestamp: 12, Amount: 102, Reference: []byte{2}}
+	spend3 := &TreasurySpend{TxID: ids.ID{3}, Timestamp: 13, Amount: 103, Reference: []byte{3}}
+
+	spends, err := caminoState.GetTreasurySpends(0, 10)
+	require.NoError(t, err)
+	require.Empty(t, spends)
+
+	caminoState.AddTreasurySpend(spend1)
+	caminoState.AddTreasurySpend(spend2)
+
+	// not written yet
+	spends, err = caminoState.GetTreasurySpends(1, 10)
+	require.NoError(t, err)
+	require.Equal(t, []*TreasurySpend{spend2}, spends)
+
+	require.NoError(t, caminoState.writeTreasurySpends(5))
+	require.Empty(t, caminoState.addedTreasurySpends)
+
+	caminoState.AddTreasurySpend(spend3)
+
+	writtenSpend1 := *spend1
+	writtenSpend1.Height = 5
+	writtenSpend2 := *spend2
+	writtenSpend2.Height = 5
+
+	spends, err = caminoState.GetTreasurySpends(0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []*TreasurySpend{&writtenSpend1, &writtenSpend2, spend3}, spends)
+
+	spends, err = caminoState.GetTreasurySpends(1, 1)
+	require.NoError(t, err)
+	require.Equal(t, []*TreasurySpend{&writtenSpend2}, spends)
+
+	spends, err = caminoState.GetTreasurySpends(2, 10)
+	require.NoError(t, err)
+	require.Equal(t, []*TreasurySpend{spend3}, spends)
+}
@@ -26,7 +26,8 @@ func NewMaskedIterator(parentIterator StakerIterator, maskedStakers map[ids.ID]*
 func (i *maskedIterator) Next() bool {
 	for i.parentIterator.Next() {
 		staker := i.parentIterator.Value()
-		if _, ok := i.maskedStakers[staker.TxID]; !ok {
+		// Rotated validator has the same tx id as the masked one, but different node id
+		if maskedStaker, ok := i.maskedStakers[staker.TxID]; !ok || maskedStaker.NodeID != staker.NodeID {
 			return true
 		}
 	}
@@ -16,6 +16,7 @@ import (
 	avax "github.com/ava-labs/avalanchego/vms/components/avax"
 	multisig "github.com/ava-labs/avalanchego/vms/components/multisig"
 	config "github.com/ava-labs/avalanchego/vms/platformvm/config"
+	dao "github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	deposit "github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	locked "github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	status "github.com/ava-labs/avalanchego/vms/platformvm/status"
@@ -58,6 +59,151 @@ func (mr *MockChainMockRecorder) AddChain(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChain", reflect.TypeOf((*MockChain)(nil).AddChain), arg0)
 }
 
+// AddTreasurySpend mocks base method.
+func (m *MockChain) AddTreasurySpend(arg0 *TreasurySpend) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddTreasurySpend", arg0)
+}
+
+// AddTreasurySpend indicates an expected call of AddTreasurySpend.
+func (mr *MockChainMockRecorder) AddTreasurySpend(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTreasurySpend", reflect.TypeOf((*MockChain)(nil).AddTreasurySpend), arg0)
+}
+
+// GetDeferredValidatorInfo mocks base method.
+func (m *MockChain) GetDeferredValidatorInfo(arg0 ids.ID) (*DeferredValidatorInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeferredValidatorInfo", arg0)
+	ret0, _ := ret[0].(*DeferredValidatorInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeferredValidatorInfo indicates an expected call of GetDeferredValidatorInfo.
+func (mr *MockChainMockRecorder) GetDeferredValidatorInfo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeferredValidatorInfo", reflect.TypeOf((*MockChain)(nil).GetDeferredValidatorInfo), arg0)
+}
+
+// GetDepositOfferAddressAmount mocks base method.
+func (m *MockChain) GetDepositOfferAddressAmount(arg0 ids.ID, arg1 ids.ShortID) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDepositOfferAddressAmount", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDepositOfferAddressAmount indicates an expected call of GetDepositOfferAddressAmount.
+func (mr *MockChainMockRecorder) GetDepositOfferAddressAmount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepositOfferAddressAmount", reflect.TypeOf((*MockChain)(nil).GetDepositOfferAddressAmount), arg0, arg1)
+}
+
+// GetMultisigAliasChange mocks base method.
+func (m *MockChain) GetMultisigAliasChange(arg0 ids.ShortID) (*multisig.AliasChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMultisigAliasChange", arg0)
+	ret0, _ := ret[0].(*multisig.AliasChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultisigAliasChange indicates an expected call of GetMultisigAliasChange.
+func (mr *MockChainMockRecorder) GetMultisigAliasChange(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisigAliasChange", reflect.TypeOf((*MockChain)(nil).GetMultisigAliasChange), arg0)
+}
+
+// GetMultisigAliasSpendLimitUsage mocks base method.
+func (m *MockChain) GetMultisigAliasSpendLimitUsage(arg0 ids.ShortID) (*multisig.SpendLimitUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMultisigAliasSpendLimitUsage", arg0)
+	ret0, _ := ret[0].(*multisig.SpendLimitUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultisigAliasSpendLimitUsage indicates an expected call of GetMultisigAliasSpendLimitUsage.
+func (mr *MockChainMockRecorder) GetMultisigAliasSpendLimitUsage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisigAliasSpendLimitUsage", reflect.TypeOf((*MockChain)(nil).GetMultisigAliasSpendLimitUsage), arg0)
+}
+
+// GetNextMultisigAliasChangeTime mocks base method.
+func (m *MockChain) GetNextMultisigAliasChangeTime(arg0 set.Set[ids.ShortID]) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextMultisigAliasChangeTime", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextMultisigAliasChangeTime indicates an expected call of GetNextMultisigAliasChangeTime.
+func (mr *MockChainMockRecorder) GetNextMultisigAliasChangeTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextMultisigAliasChangeTime", reflect.TypeOf((*MockChain)(nil).GetNextMultisigAliasChangeTime), arg0)
+}
+
+// GetNextToApplyMultisigAliasChangesAndTime mocks base method.
+func (m *MockChain) GetNextToApplyMultisigAliasChangesAndTime(arg0 set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextToApplyMultisigAliasChangesAndTime", arg0)
+	ret0, _ := ret[0].([]ids.ShortID)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNextToApplyMultisigAliasChangesAndTime indicates an expected call of GetNextToApplyMultisigAliasChangesAndTime.
+func (mr *MockChainMockRecorder) GetNextToApplyMultisigAliasChangesAndTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextToApplyMultisigAliasChangesAndTime", reflect.TypeOf((*MockChain)(nil).GetNextToApplyMultisigAliasChangesAndTime), arg0)
+}
+
+// GetSubnetValidatorRequirements mocks base method.
+func (m *MockChain) GetSubnetValidatorRequirements(arg0 ids.ID) (*SubnetValidatorRequirements, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetValidatorRequirements", arg0)
+	ret0, _ := ret[0].(*SubnetValidatorRequirements)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetValidatorRequirements indicates an expected call of GetSubnetValidatorRequirements.
+func (mr *MockChainMockRecorder) GetSubnetValidatorRequirements(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetValidatorRequirements", reflect.TypeOf((*MockChain)(nil).GetSubnetValidatorRequirements), arg0)
+}
+
+// GetTreasurySpentAmount mocks base method.
+func (m *MockChain) GetTreasurySpentAmount(arg0 uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTreasurySpentAmount", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTreasurySpentAmount indicates an expected call of GetTreasurySpentAmount.
+func (mr *MockChainMockRecorder) GetTreasurySpentAmount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTreasurySpentAmount", reflect.TypeOf((*MockChain)(nil).GetTreasurySpentAmount), arg0)
+}
+
+// SetDeferredValidatorInfo mocks base method.
+func (m *MockChain) SetDeferredValidatorInfo(arg0 ids.ID, arg1 *DeferredValidatorInfo) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDeferredValidatorInfo", arg0, arg1)
+}
+
+// SetDeferredValidatorInfo indicates an expected call of SetDeferredValidatorInfo.
+func (mr *MockChainMockRecorder) SetDeferredValidatorInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDeferredValidatorInfo", reflect.TypeOf((*MockChain)(nil).SetDeferredValidatorInfo), arg0, arg1)
+}
+
 // SetDepositOffer mocks base method.
 func (m *MockChain) SetDepositOffer(arg0 *deposit.Offer) {
 	m.ctrl.T.Helper()
@@ -280,6 +426,21 @@ func (mr *MockChainMockRecorder) GetClaimable(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClaimable", reflect.TypeOf((*MockChain)(nil).GetClaimable), arg0)
 }
 
+// GetRewardRestakeSetting mocks base method.
+func (m *MockChain) GetRewardRestakeSetting(arg0 ids.ID) (*RewardRestakeSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRewardRestakeSetting", arg0)
+	ret0, _ := ret[0].(*RewardRestakeSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRewardRestakeSetting indicates an expected call of GetRewardRestakeSetting.
+func (mr *MockChainMockRecorder) GetRewardRestakeSetting(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardRestakeSetting", reflect.TypeOf((*MockChain)(nil).GetRewardRestakeSetting), arg0)
+}
+
 // GetCurrentDelegatorIterator mocks base method.
 func (m *MockChain) GetCurrentDelegatorIterator(arg0 ids.ID, arg1 ids.NodeID) (StakerIterator, error) {
 	m.ctrl.T.Helper()
@@ -722,6 +883,54 @@ func (mr *MockChainMockRecorder) SetClaimable(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClaimable", reflect.TypeOf((*MockChain)(nil).SetClaimable), arg0, arg1)
 }
 
+// SetDepositOfferAddressAmount mocks base method.
+func (m *MockChain) SetDepositOfferAddressAmount(arg0 ids.ID, arg1 ids.ShortID, arg2 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDepositOfferAddressAmount", arg0, arg1, arg2)
+}
+
+// SetDepositOfferAddressAmount indicates an expected call of SetDepositOfferAddressAmount.
+func (mr *MockChainMockRecorder) SetDepositOfferAddressAmount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDepositOfferAddressAmount", reflect.TypeOf((*MockChain)(nil).SetDepositOfferAddressAmount), arg0, arg1, arg2)
+}
+
+// SetMultisigAliasChange mocks base method.
+func (m *MockChain) SetMultisigAliasChange(arg0 ids.ShortID, arg1 *multisig.AliasChange) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMultisigAliasChange", arg0, arg1)
+}
+
+// SetMultisigAliasChange indicates an expected call of SetMultisigAliasChange.
+func (mr *MockChainMockRecorder) SetMultisigAliasChange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMultisigAliasChange", reflect.TypeOf((*MockChain)(nil).SetMultisigAliasChange), arg0, arg1)
+}
+
+// SetMultisigAliasSpendLimitUsage mocks base method.
+func (m *MockChain) SetMultisigAliasSpendLimitUsage(arg0 ids.ShortID, arg1 *multisig.SpendLimitUsage) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMultisigAliasSpendLimitUsage", arg0, arg1)
+}
+
+// SetMultisigAliasSpendLimitUsage indicates an expected call of SetMultisigAliasSpendLimitUsage.
+func (mr *MockChainMockRecorder) SetMultisigAliasSpendLimitUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMultisigAliasSpendLimitUsage", reflect.TypeOf((*MockChain)(nil).SetMultisigAliasSpendLimitUsage), arg0, arg1)
+}
+
+// SetRewardRestakeSetting mocks base method.
+func (m *MockChain) SetRewardRestakeSetting(arg0 ids.ID, arg1 *RewardRestakeSetting) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetRewardRestakeSetting", arg0, arg1)
+}
+
+// SetRewardRestakeSetting indicates an expected call of SetRewardRestakeSetting.
+func (mr *MockChainMockRecorder) SetRewardRestakeSetting(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRewardRestakeSetting", reflect.TypeOf((*MockChain)(nil).SetRewardRestakeSetting), arg0, arg1)
+}
+
 // SetCurrentSupply mocks base method.
 func (m *MockChain) SetCurrentSupply(arg0 ids.ID, arg1 uint64) {
 	m.ctrl.T.Helper()
@@ -783,6 +992,18 @@ func (mr *MockChainMockRecorder) SetShortIDLink(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetShortIDLink", reflect.TypeOf((*MockChain)(nil).SetShortIDLink), arg0, arg1, arg2)
 }
 
+// SetSubnetValidatorRequirements mocks base method.
+func (m *MockChain) SetSubnetValidatorRequirements(arg0 ids.ID, arg1 *SubnetValidatorRequirements) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetValidatorRequirements", arg0, arg1)
+}
+
+// SetSubnetValidatorRequirements indicates an expected call of SetSubnetValidatorRequirements.
+func (mr *MockChainMockRecorder) SetSubnetValidatorRequirements(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetValidatorRequirements", reflect.TypeOf((*MockChain)(nil).SetSubnetValidatorRequirements), arg0, arg1)
+}
+
 // SetTimestamp mocks base method.
 func (m *MockChain) SetTimestamp(arg0 time.Time) {
 	m.ctrl.T.Helper()
@@ -830,3 +1051,260 @@ func (mr *MockChainMockRecorder) RemoveDeposit(arg0, arg1 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDeposit", reflect.TypeOf((*MockChain)(nil).RemoveDeposit), arg0, arg1)
 }
+
+// GetAddressesWithStates mocks base method.
+func (m *MockChain) GetAddressesWithStates(arg0 txs.AddressState) ([]ids.ShortID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddressesWithStates", arg0)
+	ret0, _ := ret[0].([]ids.ShortID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddressesWithStates indicates an expected call of GetAddressesWithStates.
+func (mr *MockChainMockRecorder) GetAddressesWithStates(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddressesWithStates", reflect.TypeOf((*MockChain)(nil).GetAddressesWithStates), arg0)
+}
+
+// AddProposal mocks base method.
+func (m *MockChain) AddProposal(arg0 ids.ID, arg1 dao.ProposalState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddProposal", arg0, arg1)
+}
+
+// AddProposal indicates an expected call of AddProposal.
+func (mr *MockChainMockRecorder) AddProposal(arg0 interface{}, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProposal", reflect.TypeOf((*MockChain)(nil).AddProposal), arg0, arg1)
+}
+
+// ModifyProposal mocks base method.
+func (m *MockChain) ModifyProposal(arg0 ids.ID, arg1 dao.ProposalState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ModifyProposal", arg0, arg1)
+}
+
+// ModifyProposal indicates an expected call of ModifyProposal.
+func (mr *MockChainMockRecorder) ModifyProposal(arg0 interface{}, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyProposal", reflect.TypeOf((*MockChain)(nil).ModifyProposal), arg0, arg1)
+}
+
+// RemoveProposal mocks base method.
+func (m *MockChain) RemoveProposal(arg0 ids.ID, arg1 dao.ProposalState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveProposal", arg0, arg1)
+}
+
+// RemoveProposal indicates an expected call of RemoveProposal.
+func (mr *MockChainMockRecorder) RemoveProposal(arg0 interface{}, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProposal", reflect.TypeOf((*MockChain)(nil).RemoveProposal), arg0, arg1)
+}
+
+// GetProposal mocks base method.
+func (m *MockChain) GetProposal(arg0 ids.ID) (dao.ProposalState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposal", arg0)
+	ret0, _ := ret[0].(dao.ProposalState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposal indicates an expected call of GetProposal.
+func (mr *MockChainMockRecorder) GetProposal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposal", reflect.TypeOf((*MockChain)(nil).GetProposal), arg0)
+}
+
+// AddProposalIDToFinish mocks base method.
+func (m *MockChain) AddProposalIDToFinish(arg0 ids.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddProposalIDToFinish", arg0)
+}
+
+// AddProposalIDToFinish indicates an expected call of AddProposalIDToFinish.
+func (mr *MockChainMockRecorder) AddProposalIDToFinish(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProposalIDToFinish", reflect.TypeOf((*MockChain)(nil).AddProposalIDToFinish), arg0)
+}
+
+// RemoveProposalIDToFinish mocks base method.
+func (m *MockChain) RemoveProposalIDToFinish(arg0 ids.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveProposalIDToFinish", arg0)
+}
+
+// RemoveProposalIDToFinish indicates an expected call of RemoveProposalIDToFinish.
+func (mr *MockChainMockRecorder) RemoveProposalIDToFinish(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProposalIDToFinish", reflect.TypeOf((*MockChain)(nil).RemoveProposalIDToFinish), arg0)
+}
+
+// GetProposalIDsToFinish mocks base method.
+func (m *MockChain) GetProposalIDsToFinish() ([]ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposalIDsToFinish")
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposalIDsToFinish indicates an expected call of GetProposalIDsToFinish.
+func (mr *MockChainMockRecorder) GetProposalIDsToFinish() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposalIDsToFinish", reflect.TypeOf((*MockChain)(nil).GetProposalIDsToFinish))
+}
+
+// GetNextProposalExpirationTime mocks base method.
+func (m *MockChain) GetNextProposalExpirationTime(arg0 set.Set[ids.ID]) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextProposalExpirationTime", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextProposalExpirationTime indicates an expected call of GetNextProposalExpirationTime.
+func (mr *MockChainMockRecorder) GetNextProposalExpirationTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextProposalExpirationTime", reflect.TypeOf((*MockChain)(nil).GetNextProposalExpirationTime), arg0)
+}
+
+// GetNextToExpireProposalIDsAndTime mocks base method.
+func (m *MockChain) GetNextToExpireProposalIDsAndTime(arg0 set.Set[ids.ID]) ([]ids.ID, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextToExpireProposalIDsAndTime", arg0)
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNextToExpireProposalIDsAndTime indicates an expected call of GetNextToExpireProposalIDsAndTime.
+func (mr *MockChainMockRecorder) GetNextToExpireProposalIDsAndTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextToExpireProposalIDsAndTime", reflect.TypeOf((*MockChain)(nil).GetNextToExpireProposalIDsAndTime), arg0)
+}
+
+// SetBaseFee mocks base method.
+func (m *MockChain) SetBaseFee(arg0 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBaseFee", arg0)
+}
+
+// SetBaseFee indicates an expected call of SetBaseFee.
+func (mr *MockChainMockRecorder) SetBaseFee(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBaseFee", reflect.TypeOf((*MockChain)(nil).SetBaseFee), arg0)
+}
+
+// GetBaseFee mocks base method.
+func (m *MockChain) GetBaseFee() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBaseFee")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBaseFee indicates an expected call of GetBaseFee.
+func (mr *MockChainMockRecorder) GetBaseFee() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaseFee", reflect.TypeOf((*MockChain)(nil).GetBaseFee))
+}
+
+// SetKYCExpiration mocks base method.
+func (m *MockChain) SetKYCExpiration(arg0 ids.ShortID, arg1 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetKYCExpiration", arg0, arg1)
+}
+
+// SetKYCExpiration indicates an expected call of SetKYCExpiration.
+func (mr *MockChainMockRecorder) SetKYCExpiration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKYCExpiration", reflect.TypeOf((*MockChain)(nil).SetKYCExpiration), arg0, arg1)
+}
+
+// GetKYCExpiration mocks base method.
+func (m *MockChain) GetKYCExpiration(arg0 ids.ShortID) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKYCExpiration", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKYCExpiration indicates an expected call of GetKYCExpiration.
+func (mr *MockChainMockRecorder) GetKYCExpiration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKYCExpiration", reflect.TypeOf((*MockChain)(nil).GetKYCExpiration), arg0)
+}
+
+// GetNextKYCExpirationTime mocks base method.
+func (m *MockChain) GetNextKYCExpirationTime(arg0 set.Set[ids.ShortID]) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextKYCExpirationTime", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextKYCExpirationTime indicates an expected call of GetNextKYCExpirationTime.
+func (mr *MockChainMockRecorder) GetNextKYCExpirationTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextKYCExpirationTime", reflect.TypeOf((*MockChain)(nil).GetNextKYCExpirationTime), arg0)
+}
+
+// GetNextToExpireKYCAddressesAndTime mocks base method.
+func (m *MockChain) GetNextToExpireKYCAddressesAndTime(arg0 set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextToExpireKYCAddressesAndTime", arg0)
+	ret0, _ := ret[0].([]ids.ShortID)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNextToExpireKYCAddressesAndTime indicates an expected call of GetNextToExpireKYCAddressesAndTime.
+func (mr *MockChainMockRecorder) GetNextToExpireKYCAddressesAndTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextToExpireKYCAddressesAndTime", reflect.TypeOf((*MockChain)(nil).GetNextToExpireKYCAddressesAndTime), arg0)
+}
+
+// AddAddressStateChange mocks base method.
+func (m *MockChain) AddAddressStateChange(arg0 ids.ShortID, arg1 *AddressStateChange) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddAddressStateChange", arg0, arg1)
+}
+
+// AddAddressStateChange indicates an expected call of AddAddressStateChange.
+func (mr *MockChainMockRecorder) AddAddressStateChange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAddressStateChange", reflect.TypeOf((*MockChain)(nil).AddAddressStateChange), arg0, arg1)
+}
+
+// SetTreasuryConfig mocks base method.
+func (m *MockChain) SetTreasuryConfig(arg0 ids.ShortID, arg1 uint64, arg2 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTreasuryConfig", arg0, arg1, arg2)
+}
+
+// SetTreasuryConfig indicates an expected call of SetTreasuryConfig.
+func (mr *MockChainMockRecorder) SetTreasuryConfig(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTreasuryConfig", reflect.TypeOf((*MockChain)(nil).SetTreasuryConfig), arg0, arg1, arg2)
+}
+
+// SetTreasurySpentAmount mocks base method.
+func (m *MockChain) SetTreasurySpentAmount(arg0 uint64, arg1 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTreasurySpentAmount", arg0, arg1)
+}
+
+// SetTreasurySpentAmount indicates an expected call of SetTreasurySpentAmount.
+func (mr *MockChainMockRecorder) SetTreasurySpentAmount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTreasurySpentAmount", reflect.TypeOf((*MockChain)(nil).SetTreasurySpentAmount), arg0, arg1)
+}
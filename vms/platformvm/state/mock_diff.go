@@ -16,6 +16,7 @@ import (
 	avax "github.com/ava-labs/avalanchego/vms/components/avax"
 	multisig "github.com/ava-labs/avalanchego/vms/components/multisig"
 	config "github.com/ava-labs/avalanchego/vms/platformvm/config"
+	dao "github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	deposit "github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	locked "github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	status "github.com/ava-labs/avalanchego/vms/platformvm/status"
@@ -58,6 +59,151 @@ func (mr *MockDiffMockRecorder) AddChain(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChain", reflect.TypeOf((*MockDiff)(nil).AddChain), arg0)
 }
 
+// AddTreasurySpend mocks base method.
+func (m *MockDiff) AddTreasurySpend(arg0 *TreasurySpend) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddTreasurySpend", arg0)
+}
+
+// AddTreasurySpend indicates an expected call of AddTreasurySpend.
+func (mr *MockDiffMockRecorder) AddTreasurySpend(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTreasurySpend", reflect.TypeOf((*MockDiff)(nil).AddTreasurySpend), arg0)
+}
+
+// GetDeferredValidatorInfo mocks base method.
+func (m *MockDiff) GetDeferredValidatorInfo(arg0 ids.ID) (*DeferredValidatorInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeferredValidatorInfo", arg0)
+	ret0, _ := ret[0].(*DeferredValidatorInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeferredValidatorInfo indicates an expected call of GetDeferredValidatorInfo.
+func (mr *MockDiffMockRecorder) GetDeferredValidatorInfo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeferredValidatorInfo", reflect.TypeOf((*MockDiff)(nil).GetDeferredValidatorInfo), arg0)
+}
+
+// GetDepositOfferAddressAmount mocks base method.
+func (m *MockDiff) GetDepositOfferAddressAmount(arg0 ids.ID, arg1 ids.ShortID) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDepositOfferAddressAmount", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDepositOfferAddressAmount indicates an expected call of GetDepositOfferAddressAmount.
+func (mr *MockDiffMockRecorder) GetDepositOfferAddressAmount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepositOfferAddressAmount", reflect.TypeOf((*MockDiff)(nil).GetDepositOfferAddressAmount), arg0, arg1)
+}
+
+// GetMultisigAliasChange mocks base method.
+func (m *MockDiff) GetMultisigAliasChange(arg0 ids.ShortID) (*multisig.AliasChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMultisigAliasChange", arg0)
+	ret0, _ := ret[0].(*multisig.AliasChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultisigAliasChange indicates an expected call of GetMultisigAliasChange.
+func (mr *MockDiffMockRecorder) GetMultisigAliasChange(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisigAliasChange", reflect.TypeOf((*MockDiff)(nil).GetMultisigAliasChange), arg0)
+}
+
+// GetMultisigAliasSpendLimitUsage mocks base method.
+func (m *MockDiff) GetMultisigAliasSpendLimitUsage(arg0 ids.ShortID) (*multisig.SpendLimitUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMultisigAliasSpendLimitUsage", arg0)
+	ret0, _ := ret[0].(*multisig.SpendLimitUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultisigAliasSpendLimitUsage indicates an expected call of GetMultisigAliasSpendLimitUsage.
+func (mr *MockDiffMockRecorder) GetMultisigAliasSpendLimitUsage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisigAliasSpendLimitUsage", reflect.TypeOf((*MockDiff)(nil).GetMultisigAliasSpendLimitUsage), arg0)
+}
+
+// GetNextMultisigAliasChangeTime mocks base method.
+func (m *MockDiff) GetNextMultisigAliasChangeTime(arg0 set.Set[ids.ShortID]) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextMultisigAliasChangeTime", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextMultisigAliasChangeTime indicates an expected call of GetNextMultisigAliasChangeTime.
+func (mr *MockDiffMockRecorder) GetNextMultisigAliasChangeTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextMultisigAliasChangeTime", reflect.TypeOf((*MockDiff)(nil).GetNextMultisigAliasChangeTime), arg0)
+}
+
+// GetNextToApplyMultisigAliasChangesAndTime mocks base method.
+func (m *MockDiff) GetNextToApplyMultisigAliasChangesAndTime(arg0 set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextToApplyMultisigAliasChangesAndTime", arg0)
+	ret0, _ := ret[0].([]ids.ShortID)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNextToApplyMultisigAliasChangesAndTime indicates an expected call of GetNextToApplyMultisigAliasChangesAndTime.
+func (mr *MockDiffMockRecorder) GetNextToApplyMultisigAliasChangesAndTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextToApplyMultisigAliasChangesAndTime", reflect.TypeOf((*MockDiff)(nil).GetNextToApplyMultisigAliasChangesAndTime), arg0)
+}
+
+// GetSubnetValidatorRequirements mocks base method.
+func (m *MockDiff) GetSubnetValidatorRequirements(arg0 ids.ID) (*SubnetValidatorRequirements, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetValidatorRequirements", arg0)
+	ret0, _ := ret[0].(*SubnetValidatorRequirements)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetValidatorRequirements indicates an expected call of GetSubnetValidatorRequirements.
+func (mr *MockDiffMockRecorder) GetSubnetValidatorRequirements(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetValidatorRequirements", reflect.TypeOf((*MockDiff)(nil).GetSubnetValidatorRequirements), arg0)
+}
+
+// GetTreasurySpentAmount mocks base method.
+func (m *MockDiff) GetTreasurySpentAmount(arg0 uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTreasurySpentAmount", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTreasurySpentAmount indicates an expected call of GetTreasurySpentAmount.
+func (mr *MockDiffMockRecorder) GetTreasurySpentAmount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTreasurySpentAmount", reflect.TypeOf((*MockDiff)(nil).GetTreasurySpentAmount), arg0)
+}
+
+// SetDeferredValidatorInfo mocks base method.
+func (m *MockDiff) SetDeferredValidatorInfo(arg0 ids.ID, arg1 *DeferredValidatorInfo) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDeferredValidatorInfo", arg0, arg1)
+}
+
+// SetDeferredValidatorInfo indicates an expected call of SetDeferredValidatorInfo.
+func (mr *MockDiffMockRecorder) SetDeferredValidatorInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDeferredValidatorInfo", reflect.TypeOf((*MockDiff)(nil).SetDeferredValidatorInfo), arg0, arg1)
+}
+
 // SetDepositOffer mocks base method.
 func (m *MockDiff) SetDepositOffer(arg0 *deposit.Offer) {
 	m.ctrl.T.Helper()
@@ -304,6 +450,21 @@ func (mr *MockDiffMockRecorder) GetClaimable(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClaimable", reflect.TypeOf((*MockDiff)(nil).GetClaimable), arg0)
 }
 
+// GetRewardRestakeSetting mocks base method.
+func (m *MockDiff) GetRewardRestakeSetting(arg0 ids.ID) (*RewardRestakeSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRewardRestakeSetting", arg0)
+	ret0, _ := ret[0].(*RewardRestakeSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRewardRestakeSetting indicates an expected call of GetRewardRestakeSetting.
+func (mr *MockDiffMockRecorder) GetRewardRestakeSetting(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardRestakeSetting", reflect.TypeOf((*MockDiff)(nil).GetRewardRestakeSetting), arg0)
+}
+
 // GetCurrentDelegatorIterator mocks base method.
 func (m *MockDiff) GetCurrentDelegatorIterator(arg0 ids.ID, arg1 ids.NodeID) (StakerIterator, error) {
 	m.ctrl.T.Helper()
@@ -746,6 +907,54 @@ func (mr *MockDiffMockRecorder) SetClaimable(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClaimable", reflect.TypeOf((*MockDiff)(nil).SetClaimable), arg0, arg1)
 }
 
+// SetDepositOfferAddressAmount mocks base method.
+func (m *MockDiff) SetDepositOfferAddressAmount(arg0 ids.ID, arg1 ids.ShortID, arg2 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDepositOfferAddressAmount", arg0, arg1, arg2)
+}
+
+// SetDepositOfferAddressAmount indicates an expected call of SetDepositOfferAddressAmount.
+func (mr *MockDiffMockRecorder) SetDepositOfferAddressAmount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDepositOfferAddressAmount", reflect.TypeOf((*MockDiff)(nil).SetDepositOfferAddressAmount), arg0, arg1, arg2)
+}
+
+// SetMultisigAliasChange mocks base method.
+func (m *MockDiff) SetMultisigAliasChange(arg0 ids.ShortID, arg1 *multisig.AliasChange) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMultisigAliasChange", arg0, arg1)
+}
+
+// SetMultisigAliasChange indicates an expected call of SetMultisigAliasChange.
+func (mr *MockDiffMockRecorder) SetMultisigAliasChange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMultisigAliasChange", reflect.TypeOf((*MockDiff)(nil).SetMultisigAliasChange), arg0, arg1)
+}
+
+// SetMultisigAliasSpendLimitUsage mocks base method.
+func (m *MockDiff) SetMultisigAliasSpendLimitUsage(arg0 ids.ShortID, arg1 *multisig.SpendLimitUsage) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMultisigAliasSpendLimitUsage", arg0, arg1)
+}
+
+// SetMultisigAliasSpendLimitUsage indicates an expected call of SetMultisigAliasSpendLimitUsage.
+func (mr *MockDiffMockRecorder) SetMultisigAliasSpendLimitUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMultisigAliasSpendLimitUsage", reflect.TypeOf((*MockDiff)(nil).SetMultisigAliasSpendLimitUsage), arg0, arg1)
+}
+
+// SetRewardRestakeSetting mocks base method.
+func (m *MockDiff) SetRewardRestakeSetting(arg0 ids.ID, arg1 *RewardRestakeSetting) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetRewardRestakeSetting", arg0, arg1)
+}
+
+// SetRewardRestakeSetting indicates an expected call of SetRewardRestakeSetting.
+func (mr *MockDiffMockRecorder) SetRewardRestakeSetting(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRewardRestakeSetting", reflect.TypeOf((*MockDiff)(nil).SetRewardRestakeSetting), arg0, arg1)
+}
+
 // SetCurrentSupply mocks base method.
 func (m *MockDiff) SetCurrentSupply(arg0 ids.ID, arg1 uint64) {
 	m.ctrl.T.Helper()
@@ -794,6 +1003,18 @@ func (mr *MockDiffMockRecorder) SetShortIDLink(arg0, arg1, arg2 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetShortIDLink", reflect.TypeOf((*MockDiff)(nil).SetShortIDLink), arg0, arg1, arg2)
 }
 
+// SetSubnetValidatorRequirements mocks base method.
+func (m *MockDiff) SetSubnetValidatorRequirements(arg0 ids.ID, arg1 *SubnetValidatorRequirements) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetValidatorRequirements", arg0, arg1)
+}
+
+// SetSubnetValidatorRequirements indicates an expected call of SetSubnetValidatorRequirements.
+func (mr *MockDiffMockRecorder) SetSubnetValidatorRequirements(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetValidatorRequirements", reflect.TypeOf((*MockDiff)(nil).SetSubnetValidatorRequirements), arg0, arg1)
+}
+
 // SetTimestamp mocks base method.
 func (m *MockDiff) SetTimestamp(arg0 time.Time) {
 	m.ctrl.T.Helper()
@@ -841,3 +1062,260 @@ func (mr *MockDiffMockRecorder) RemoveDeposit(arg0, arg1 interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDeposit", reflect.TypeOf((*MockDiff)(nil).RemoveDeposit), arg0, arg1)
 }
+
+// GetAddressesWithStates mocks base method.
+func (m *MockDiff) GetAddressesWithStates(arg0 txs.AddressState) ([]ids.ShortID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddressesWithStates", arg0)
+	ret0, _ := ret[0].([]ids.ShortID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddressesWithStates indicates an expected call of GetAddressesWithStates.
+func (mr *MockDiffMockRecorder) GetAddressesWithStates(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddressesWithStates", reflect.TypeOf((*MockDiff)(nil).GetAddressesWithStates), arg0)
+}
+
+// AddProposal mocks base method.
+func (m *MockDiff) AddProposal(arg0 ids.ID, arg1 dao.ProposalState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddProposal", arg0, arg1)
+}
+
+// AddProposal indicates an expected call of AddProposal.
+func (mr *MockDiffMockRecorder) AddProposal(arg0 interface{}, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProposal", reflect.TypeOf((*MockDiff)(nil).AddProposal), arg0, arg1)
+}
+
+// ModifyProposal mocks base method.
+func (m *MockDiff) ModifyProposal(arg0 ids.ID, arg1 dao.ProposalState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ModifyProposal", arg0, arg1)
+}
+
+// ModifyProposal indicates an expected call of ModifyProposal.
+func (mr *MockDiffMockRecorder) ModifyProposal(arg0 interface{}, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyProposal", reflect.TypeOf((*MockDiff)(nil).ModifyProposal), arg0, arg1)
+}
+
+// RemoveProposal mocks base method.
+func (m *MockDiff) RemoveProposal(arg0 ids.ID, arg1 dao.ProposalState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveProposal", arg0, arg1)
+}
+
+// RemoveProposal indicates an expected call of RemoveProposal.
+func (mr *MockDiffMockRecorder) RemoveProposal(arg0 interface{}, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProposal", reflect.TypeOf((*MockDiff)(nil).RemoveProposal), arg0, arg1)
+}
+
+// GetProposal mocks base method.
+func (m *MockDiff) GetProposal(arg0 ids.ID) (dao.ProposalState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposal", arg0)
+	ret0, _ := ret[0].(dao.ProposalState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposal indicates an expected call of GetProposal.
+func (mr *MockDiffMockRecorder) GetProposal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposal", reflect.TypeOf((*MockDiff)(nil).GetProposal), arg0)
+}
+
+// AddProposalIDToFinish mocks base method.
+func (m *MockDiff) AddProposalIDToFinish(arg0 ids.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddProposalIDToFinish", arg0)
+}
+
+// AddProposalIDToFinish indicates an expected call of AddProposalIDToFinish.
+func (mr *MockDiffMockRecorder) AddProposalIDToFinish(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProposalIDToFinish", reflect.TypeOf((*MockDiff)(nil).AddProposalIDToFinish), arg0)
+}
+
+// RemoveProposalIDToFinish mocks base method.
+func (m *MockDiff) RemoveProposalIDToFinish(arg0 ids.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveProposalIDToFinish", arg0)
+}
+
+// RemoveProposalIDToFinish indicates an expected call of RemoveProposalIDToFinish.
+func (mr *MockDiffMockRecorder) RemoveProposalIDToFinish(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProposalIDToFinish", reflect.TypeOf((*MockDiff)(nil).RemoveProposalIDToFinish), arg0)
+}
+
+// GetProposalIDsToFinish mocks base method.
+func (m *MockDiff) GetProposalIDsToFinish() ([]ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposalIDsToFinish")
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposalIDsToFinish indicates an expected call of GetProposalIDsToFinish.
+func (mr *MockDiffMockRecorder) GetProposalIDsToFinish() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposalIDsToFinish", reflect.TypeOf((*MockDiff)(nil).GetProposalIDsToFinish))
+}
+
+// GetNextProposalExpirationTime mocks base method.
+func (m *MockDiff) GetNextProposalExpirationTime(arg0 set.Set[ids.ID]) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextProposalExpirationTime", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextProposalExpirationTime indicates an expected call of GetNextProposalExpirationTime.
+func (mr *MockDiffMockRecorder) GetNextProposalExpirationTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextProposalExpirationTime", reflect.TypeOf((*MockDiff)(nil).GetNextProposalExpirationTime), arg0)
+}
+
+// GetNextToExpireProposalIDsAndTime mocks base method.
+func (m *MockDiff) GetNextToExpireProposalIDsAndTime(arg0 set.Set[ids.ID]) ([]ids.ID, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextToExpireProposalIDsAndTime", arg0)
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNextToExpireProposalIDsAndTime indicates an expected call of GetNextToExpireProposalIDsAndTime.
+func (mr *MockDiffMockRecorder) GetNextToExpireProposalIDsAndTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextToExpireProposalIDsAndTime", reflect.TypeOf((*MockDiff)(nil).GetNextToExpireProposalIDsAndTime), arg0)
+}
+
+// SetBaseFee mocks base method.
+func (m *MockDiff) SetBaseFee(arg0 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBaseFee", arg0)
+}
+
+// SetBaseFee indicates an expected call of SetBaseFee.
+func (mr *MockDiffMockRecorder) SetBaseFee(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBaseFee", reflect.TypeOf((*MockDiff)(nil).SetBaseFee), arg0)
+}
+
+// GetBaseFee mocks base method.
+func (m *MockDiff) GetBaseFee() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBaseFee")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBaseFee indicates an expected call of GetBaseFee.
+func (mr *MockDiffMockRecorder) GetBaseFee() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaseFee", reflect.TypeOf((*MockDiff)(nil).GetBaseFee))
+}
+
+// SetKYCExpiration mocks base method.
+func (m *MockDiff) SetKYCExpiration(arg0 ids.ShortID, arg1 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetKYCExpiration", arg0, arg1)
+}
+
+// SetKYCExpiration indicates an expected call of SetKYCExpiration.
+func (mr *MockDiffMockRecorder) SetKYCExpiration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKYCExpiration", reflect.TypeOf((*MockDiff)(nil).SetKYCExpiration), arg0, arg1)
+}
+
+// GetKYCExpiration mocks base method.
+func (m *MockDiff) GetKYCExpiration(arg0 ids.ShortID) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKYCExpiration", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKYCExpiration indicates an expected call of GetKYCExpiration.
+func (mr *MockDiffMockRecorder) GetKYCExpiration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKYCExpiration", reflect.TypeOf((*MockDiff)(nil).GetKYCExpiration), arg0)
+}
+
+// GetNextKYCExpirationTime mocks base method.
+func (m *MockDiff) GetNextKYCExpirationTime(arg0 set.Set[ids.ShortID]) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextKYCExpirationTime", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextKYCExpirationTime indicates an expected call of GetNextKYCExpirationTime.
+func (mr *MockDiffMockRecorder) GetNextKYCExpirationTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextKYCExpirationTime", reflect.TypeOf((*MockDiff)(nil).GetNextKYCExpirationTime), arg0)
+}
+
+// GetNextToExpireKYCAddressesAndTime mocks base method.
+func (m *MockDiff) GetNextToExpireKYCAddressesAndTime(arg0 set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextToExpireKYCAddressesAndTime", arg0)
+	ret0, _ := ret[0].([]ids.ShortID)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNextToExpireKYCAddressesAndTime indicates an expected call of GetNextToExpireKYCAddressesAndTime.
+func (mr *MockDiffMockRecorder) GetNextToExpireKYCAddressesAndTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextToExpireKYCAddressesAndTime", reflect.TypeOf((*MockDiff)(nil).GetNextToExpireKYCAddressesAndTime), arg0)
+}
+
+// AddAddressStateChange mocks base method.
+func (m *MockDiff) AddAddressStateChange(arg0 ids.ShortID, arg1 *AddressStateChange) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddAddressStateChange", arg0, arg1)
+}
+
+// AddAddressStateChange indicates an expected call of AddAddressStateChange.
+func (mr *MockDiffMockRecorder) AddAddressStateChange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAddressStateChange", reflect.TypeOf((*MockDiff)(nil).AddAddressStateChange), arg0, arg1)
+}
+
+// SetTreasuryConfig mocks base method.
+func (m *MockDiff) SetTreasuryConfig(arg0 ids.ShortID, arg1 uint64, arg2 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTreasuryConfig", arg0, arg1, arg2)
+}
+
+// SetTreasuryConfig indicates an expected call of SetTreasuryConfig.
+func (mr *MockDiffMockRecorder) SetTreasuryConfig(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTreasuryConfig", reflect.TypeOf((*MockDiff)(nil).SetTreasuryConfig), arg0, arg1, arg2)
+}
+
+// SetTreasurySpentAmount mocks base method.
+func (m *MockDiff) SetTreasurySpentAmount(arg0 uint64, arg1 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTreasurySpentAmount", arg0, arg1)
+}
+
+// SetTreasurySpentAmount indicates an expected call of SetTreasurySpentAmount.
+func (mr *MockDiffMockRecorder) SetTreasurySpentAmount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTreasurySpentAmount", reflect.TypeOf((*MockDiff)(nil).SetTreasurySpentAmount), arg0, arg1)
+}
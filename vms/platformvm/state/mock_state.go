@@ -21,6 +21,7 @@ import (
 	multisig "github.com/ava-labs/avalanchego/vms/components/multisig"
 	blocks "github.com/ava-labs/avalanchego/vms/platformvm/blocks"
 	config "github.com/ava-labs/avalanchego/vms/platformvm/config"
+	dao "github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	deposit "github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	locked "github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	status "github.com/ava-labs/avalanchego/vms/platformvm/status"
@@ -75,6 +76,196 @@ func (mr *MockStateMockRecorder) AddChain(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChain", reflect.TypeOf((*MockState)(nil).AddChain), arg0)
 }
 
+// AddTreasurySpend mocks base method.
+func (m *MockState) AddTreasurySpend(arg0 *TreasurySpend) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddTreasurySpend", arg0)
+}
+
+// AddTreasurySpend indicates an expected call of AddTreasurySpend.
+func (mr *MockStateMockRecorder) AddTreasurySpend(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTreasurySpend", reflect.TypeOf((*MockState)(nil).AddTreasurySpend), arg0)
+}
+
+// GetDeferredValidatorInfo mocks base method.
+func (m *MockState) GetDeferredValidatorInfo(arg0 ids.ID) (*DeferredValidatorInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeferredValidatorInfo", arg0)
+	ret0, _ := ret[0].(*DeferredValidatorInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeferredValidatorInfo indicates an expected call of GetDeferredValidatorInfo.
+func (mr *MockStateMockRecorder) GetDeferredValidatorInfo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeferredValidatorInfo", reflect.TypeOf((*MockState)(nil).GetDeferredValidatorInfo), arg0)
+}
+
+// GetDepositOfferAddressAmount mocks base method.
+func (m *MockState) GetDepositOfferAddressAmount(arg0 ids.ID, arg1 ids.ShortID) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDepositOfferAddressAmount", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDepositOfferAddressAmount indicates an expected call of GetDepositOfferAddressAmount.
+func (mr *MockStateMockRecorder) GetDepositOfferAddressAmount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepositOfferAddressAmount", reflect.TypeOf((*MockState)(nil).GetDepositOfferAddressAmount), arg0, arg1)
+}
+
+// GetDepositOfferStats mocks base method.
+func (m *MockState) GetDepositOfferStats(arg0 ids.ID) (*deposit.OfferStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDepositOfferStats", arg0)
+	ret0, _ := ret[0].(*deposit.OfferStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDepositOfferStats indicates an expected call of GetDepositOfferStats.
+func (mr *MockStateMockRecorder) GetDepositOfferStats(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepositOfferStats", reflect.TypeOf((*MockState)(nil).GetDepositOfferStats), arg0)
+}
+
+// GetMultisigAliasChange mocks base method.
+func (m *MockState) GetMultisigAliasChange(arg0 ids.ShortID) (*multisig.AliasChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMultisigAliasChange", arg0)
+	ret0, _ := ret[0].(*multisig.AliasChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultisigAliasChange indicates an expected call of GetMultisigAliasChange.
+func (mr *MockStateMockRecorder) GetMultisigAliasChange(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisigAliasChange", reflect.TypeOf((*MockState)(nil).GetMultisigAliasChange), arg0)
+}
+
+// GetMultisigAliasSpendLimitUsage mocks base method.
+func (m *MockState) GetMultisigAliasSpendLimitUsage(arg0 ids.ShortID) (*multisig.SpendLimitUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMultisigAliasSpendLimitUsage", arg0)
+	ret0, _ := ret[0].(*multisig.SpendLimitUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultisigAliasSpendLimitUsage indicates an expected call of GetMultisigAliasSpendLimitUsage.
+func (mr *MockStateMockRecorder) GetMultisigAliasSpendLimitUsage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisigAliasSpendLimitUsage", reflect.TypeOf((*MockState)(nil).GetMultisigAliasSpendLimitUsage), arg0)
+}
+
+// GetMultisigAliasesForAddress mocks base method.
+func (m *MockState) GetMultisigAliasesForAddress(arg0 ids.ShortID) ([]ids.ShortID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMultisigAliasesForAddress", arg0)
+	ret0, _ := ret[0].([]ids.ShortID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultisigAliasesForAddress indicates an expected call of GetMultisigAliasesForAddress.
+func (mr *MockStateMockRecorder) GetMultisigAliasesForAddress(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisigAliasesForAddress", reflect.TypeOf((*MockState)(nil).GetMultisigAliasesForAddress), arg0)
+}
+
+// GetNextMultisigAliasChangeTime mocks base method.
+func (m *MockState) GetNextMultisigAliasChangeTime(arg0 set.Set[ids.ShortID]) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextMultisigAliasChangeTime", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextMultisigAliasChangeTime indicates an expected call of GetNextMultisigAliasChangeTime.
+func (mr *MockStateMockRecorder) GetNextMultisigAliasChangeTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextMultisigAliasChangeTime", reflect.TypeOf((*MockState)(nil).GetNextMultisigAliasChangeTime), arg0)
+}
+
+// GetNextToApplyMultisigAliasChangesAndTime mocks base method.
+func (m *MockState) GetNextToApplyMultisigAliasChangesAndTime(arg0 set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextToApplyMultisigAliasChangesAndTime", arg0)
+	ret0, _ := ret[0].([]ids.ShortID)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNextToApplyMultisigAliasChangesAndTime indicates an expected call of GetNextToApplyMultisigAliasChangesAndTime.
+func (mr *MockStateMockRecorder) GetNextToApplyMultisigAliasChangesAndTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextToApplyMultisigAliasChangesAndTime", reflect.TypeOf((*MockState)(nil).GetNextToApplyMultisigAliasChangesAndTime), arg0)
+}
+
+// GetSubnetValidatorRequirements mocks base method.
+func (m *MockState) GetSubnetValidatorRequirements(arg0 ids.ID) (*SubnetValidatorRequirements, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetValidatorRequirements", arg0)
+	ret0, _ := ret[0].(*SubnetValidatorRequirements)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetValidatorRequirements indicates an expected call of GetSubnetValidatorRequirements.
+func (mr *MockStateMockRecorder) GetSubnetValidatorRequirements(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetValidatorRequirements", reflect.TypeOf((*MockState)(nil).GetSubnetValidatorRequirements), arg0)
+}
+
+// GetTreasurySpends mocks base method.
+func (m *MockState) GetTreasurySpends(arg0 uint64, arg1 int) ([]*TreasurySpend, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTreasurySpends", arg0, arg1)
+	ret0, _ := ret[0].([]*TreasurySpend)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTreasurySpends indicates an expected call of GetTreasurySpends.
+func (mr *MockStateMockRecorder) GetTreasurySpends(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTreasurySpends", reflect.TypeOf((*MockState)(nil).GetTreasurySpends), arg0, arg1)
+}
+
+// GetTreasurySpentAmount mocks base method.
+func (m *MockState) GetTreasurySpentAmount(arg0 uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTreasurySpentAmount", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTreasurySpentAmount indicates an expected call of GetTreasurySpentAmount.
+func (mr *MockStateMockRecorder) GetTreasurySpentAmount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTreasurySpentAmount", reflect.TypeOf((*MockState)(nil).GetTreasurySpentAmount), arg0)
+}
+
+// SetDeferredValidatorInfo mocks base method.
+func (m *MockState) SetDeferredValidatorInfo(arg0 ids.ID, arg1 *DeferredValidatorInfo) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDeferredValidatorInfo", arg0, arg1)
+}
+
+// SetDeferredValidatorInfo indicates an expected call of SetDeferredValidatorInfo.
+func (mr *MockStateMockRecorder) SetDeferredValidatorInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDeferredValidatorInfo", reflect.TypeOf((*MockState)(nil).SetDeferredValidatorInfo), arg0, arg1)
+}
+
 // SetDepositOffer mocks base method.
 func (m *MockState) SetDepositOffer(arg0 *deposit.Offer) {
 	m.ctrl.T.Helper()
@@ -352,6 +543,21 @@ func (mr *MockStateMockRecorder) GetClaimable(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClaimable", reflect.TypeOf((*MockState)(nil).GetClaimable), arg0)
 }
 
+// GetRewardRestakeSetting mocks base method.
+func (m *MockState) GetRewardRestakeSetting(arg0 ids.ID) (*RewardRestakeSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRewardRestakeSetting", arg0)
+	ret0, _ := ret[0].(*RewardRestakeSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRewardRestakeSetting indicates an expected call of GetRewardRestakeSetting.
+func (mr *MockStateMockRecorder) GetRewardRestakeSetting(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardRestakeSetting", reflect.TypeOf((*MockState)(nil).GetRewardRestakeSetting), arg0)
+}
+
 // GetCurrentDelegatorIterator mocks base method.
 func (m *MockState) GetCurrentDelegatorIterator(arg0 ids.ID, arg1 ids.NodeID) (StakerIterator, error) {
 	m.ctrl.T.Helper()
@@ -887,6 +1093,54 @@ func (mr *MockStateMockRecorder) SetClaimable(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClaimable", reflect.TypeOf((*MockState)(nil).SetClaimable), arg0, arg1)
 }
 
+// SetDepositOfferAddressAmount mocks base method.
+func (m *MockState) SetDepositOfferAddressAmount(arg0 ids.ID, arg1 ids.ShortID, arg2 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDepositOfferAddressAmount", arg0, arg1, arg2)
+}
+
+// SetDepositOfferAddressAmount indicates an expected call of SetDepositOfferAddressAmount.
+func (mr *MockStateMockRecorder) SetDepositOfferAddressAmount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDepositOfferAddressAmount", reflect.TypeOf((*MockState)(nil).SetDepositOfferAddressAmount), arg0, arg1, arg2)
+}
+
+// SetMultisigAliasChange mocks base method.
+func (m *MockState) SetMultisigAliasChange(arg0 ids.ShortID, arg1 *multisig.AliasChange) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMultisigAliasChange", arg0, arg1)
+}
+
+// SetMultisigAliasChange indicates an expected call of SetMultisigAliasChange.
+func (mr *MockStateMockRecorder) SetMultisigAliasChange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMultisigAliasChange", reflect.TypeOf((*MockState)(nil).SetMultisigAliasChange), arg0, arg1)
+}
+
+// SetMultisigAliasSpendLimitUsage mocks base method.
+func (m *MockState) SetMultisigAliasSpendLimitUsage(arg0 ids.ShortID, arg1 *multisig.SpendLimitUsage) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMultisigAliasSpendLimitUsage", arg0, arg1)
+}
+
+// SetMultisigAliasSpendLimitUsage indicates an expected call of SetMultisigAliasSpendLimitUsage.
+func (mr *MockStateMockRecorder) SetMultisigAliasSpendLimitUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMultisigAliasSpendLimitUsage", reflect.TypeOf((*MockState)(nil).SetMultisigAliasSpendLimitUsage), arg0, arg1)
+}
+
+// SetRewardRestakeSetting mocks base method.
+func (m *MockState) SetRewardRestakeSetting(arg0 ids.ID, arg1 *RewardRestakeSetting) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetRewardRestakeSetting", arg0, arg1)
+}
+
+// SetRewardRestakeSetting indicates an expected call of SetRewardRestakeSetting.
+func (mr *MockStateMockRecorder) SetRewardRestakeSetting(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRewardRestakeSetting", reflect.TypeOf((*MockState)(nil).SetRewardRestakeSetting), arg0, arg1)
+}
+
 // SetCurrentSupply mocks base method.
 func (m *MockState) SetCurrentSupply(arg0 ids.ID, arg1 uint64) {
 	m.ctrl.T.Helper()
@@ -959,6 +1213,18 @@ func (mr *MockStateMockRecorder) SetShortIDLink(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetShortIDLink", reflect.TypeOf((*MockState)(nil).SetShortIDLink), arg0, arg1, arg2)
 }
 
+// SetSubnetValidatorRequirements mocks base method.
+func (m *MockState) SetSubnetValidatorRequirements(arg0 ids.ID, arg1 *SubnetValidatorRequirements) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetValidatorRequirements", arg0, arg1)
+}
+
+// SetSubnetValidatorRequirements indicates an expected call of SetSubnetValidatorRequirements.
+func (mr *MockStateMockRecorder) SetSubnetValidatorRequirements(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetValidatorRequirements", reflect.TypeOf((*MockState)(nil).SetSubnetValidatorRequirements), arg0, arg1)
+}
+
 // SetTimestamp mocks base method.
 func (m *MockState) SetTimestamp(arg0 time.Time) {
 	m.ctrl.T.Helper()
@@ -971,6 +1237,30 @@ func (mr *MockStateMockRecorder) SetTimestamp(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTimestamp", reflect.TypeOf((*MockState)(nil).SetTimestamp), arg0)
 }
 
+// SetTreasuryConfig mocks base method.
+func (m *MockState) SetTreasuryConfig(arg0 ids.ShortID, arg1 uint64, arg2 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTreasuryConfig", arg0, arg1, arg2)
+}
+
+// SetTreasuryConfig indicates an expected call of SetTreasuryConfig.
+func (mr *MockStateMockRecorder) SetTreasuryConfig(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTreasuryConfig", reflect.TypeOf((*MockState)(nil).SetTreasuryConfig), arg0, arg1, arg2)
+}
+
+// SetTreasurySpentAmount mocks base method.
+func (m *MockState) SetTreasurySpentAmount(arg0 uint64, arg1 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTreasurySpentAmount", arg0, arg1)
+}
+
+// SetTreasurySpentAmount indicates an expected call of SetTreasurySpentAmount.
+func (mr *MockStateMockRecorder) SetTreasurySpentAmount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTreasurySpentAmount", reflect.TypeOf((*MockState)(nil).SetTreasurySpentAmount), arg0, arg1)
+}
+
 // SetUptime mocks base method.
 func (m *MockState) SetUptime(arg0 ids.NodeID, arg1 ids.ID, arg2 time.Duration, arg3 time.Time) error {
 	m.ctrl.T.Helper()
@@ -1049,3 +1339,251 @@ func (mr *MockStateMockRecorder) RemoveDeposit(arg0, arg1 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDeposit", reflect.TypeOf((*MockState)(nil).RemoveDeposit), arg0, arg1)
 }
+
+// GetAddressesWithStates mocks base method.
+func (m *MockState) GetAddressesWithStates(arg0 txs.AddressState) ([]ids.ShortID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddressesWithStates", arg0)
+	ret0, _ := ret[0].([]ids.ShortID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddressesWithStates indicates an expected call of GetAddressesWithStates.
+func (mr *MockStateMockRecorder) GetAddressesWithStates(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddressesWithStates", reflect.TypeOf((*MockState)(nil).GetAddressesWithStates), arg0)
+}
+
+// AddProposal mocks base method.
+func (m *MockState) AddProposal(arg0 ids.ID, arg1 dao.ProposalState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddProposal", arg0, arg1)
+}
+
+// AddProposal indicates an expected call of AddProposal.
+func (mr *MockStateMockRecorder) AddProposal(arg0 interface{}, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProposal", reflect.TypeOf((*MockState)(nil).AddProposal), arg0, arg1)
+}
+
+// ModifyProposal mocks base method.
+func (m *MockState) ModifyProposal(arg0 ids.ID, arg1 dao.ProposalState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ModifyProposal", arg0, arg1)
+}
+
+// ModifyProposal indicates an expected call of ModifyProposal.
+func (mr *MockStateMockRecorder) ModifyProposal(arg0 interface{}, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyProposal", reflect.TypeOf((*MockState)(nil).ModifyProposal), arg0, arg1)
+}
+
+// RemoveProposal mocks base method.
+func (m *MockState) RemoveProposal(arg0 ids.ID, arg1 dao.ProposalState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveProposal", arg0, arg1)
+}
+
+// RemoveProposal indicates an expected call of RemoveProposal.
+func (mr *MockStateMockRecorder) RemoveProposal(arg0 interface{}, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProposal", reflect.TypeOf((*MockState)(nil).RemoveProposal), arg0, arg1)
+}
+
+// GetProposal mocks base method.
+func (m *MockState) GetProposal(arg0 ids.ID) (dao.ProposalState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposal", arg0)
+	ret0, _ := ret[0].(dao.ProposalState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposal indicates an expected call of GetProposal.
+func (mr *MockStateMockRecorder) GetProposal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposal", reflect.TypeOf((*MockState)(nil).GetProposal), arg0)
+}
+
+// AddProposalIDToFinish mocks base method.
+func (m *MockState) AddProposalIDToFinish(arg0 ids.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddProposalIDToFinish", arg0)
+}
+
+// AddProposalIDToFinish indicates an expected call of AddProposalIDToFinish.
+func (mr *MockStateMockRecorder) AddProposalIDToFinish(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProposalIDToFinish", reflect.TypeOf((*MockState)(nil).AddProposalIDToFinish), arg0)
+}
+
+// RemoveProposalIDToFinish mocks base method.
+func (m *MockState) RemoveProposalIDToFinish(arg0 ids.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveProposalIDToFinish", arg0)
+}
+
+// RemoveProposalIDToFinish indicates an expected call of RemoveProposalIDToFinish.
+func (mr *MockStateMockRecorder) RemoveProposalIDToFinish(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProposalIDToFinish", reflect.TypeOf((*MockState)(nil).RemoveProposalIDToFinish), arg0)
+}
+
+// GetProposalIDsToFinish mocks base method.
+func (m *MockState) GetProposalIDsToFinish() ([]ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposalIDsToFinish")
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposalIDsToFinish indicates an expected call of GetProposalIDsToFinish.
+func (mr *MockStateMockRecorder) GetProposalIDsToFinish() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposalIDsToFinish", reflect.TypeOf((*MockState)(nil).GetProposalIDsToFinish))
+}
+
+// GetNextProposalExpirationTime mocks base method.
+func (m *MockState) GetNextProposalExpirationTime(arg0 set.Set[ids.ID]) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextProposalExpirationTime", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextProposalExpirationTime indicates an expected call of GetNextProposalExpirationTime.
+func (mr *MockStateMockRecorder) GetNextProposalExpirationTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextProposalExpirationTime", reflect.TypeOf((*MockState)(nil).GetNextProposalExpirationTime), arg0)
+}
+
+// GetNextToExpireProposalIDsAndTime mocks base method.
+func (m *MockState) GetNextToExpireProposalIDsAndTime(arg0 set.Set[ids.ID]) ([]ids.ID, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextToExpireProposalIDsAndTime", arg0)
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNextToExpireProposalIDsAndTime indicates an expected call of GetNextToExpireProposalIDsAndTime.
+func (mr *MockStateMockRecorder) GetNextToExpireProposalIDsAndTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextToExpireProposalIDsAndTime", reflect.TypeOf((*MockState)(nil).GetNextToExpireProposalIDsAndTime), arg0)
+}
+
+// SetBaseFee mocks base method.
+func (m *MockState) SetBaseFee(arg0 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetBaseFee", arg0)
+}
+
+// SetBaseFee indicates an expected call of SetBaseFee.
+func (mr *MockStateMockRecorder) SetBaseFee(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBaseFee", reflect.TypeOf((*MockState)(nil).SetBaseFee), arg0)
+}
+
+// GetBaseFee mocks base method.
+func (m *MockState) GetBaseFee() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBaseFee")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBaseFee indicates an expected call of GetBaseFee.
+func (mr *MockStateMockRecorder) GetBaseFee() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaseFee", reflect.TypeOf((*MockState)(nil).GetBaseFee))
+}
+
+// SetKYCExpiration mocks base method.
+func (m *MockState) SetKYCExpiration(arg0 ids.ShortID, arg1 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetKYCExpiration", arg0, arg1)
+}
+
+// SetKYCExpiration indicates an expected call of SetKYCExpiration.
+func (mr *MockStateMockRecorder) SetKYCExpiration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKYCExpiration", reflect.TypeOf((*MockState)(nil).SetKYCExpiration), arg0, arg1)
+}
+
+// GetKYCExpiration mocks base method.
+func (m *MockState) GetKYCExpiration(arg0 ids.ShortID) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKYCExpiration", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKYCExpiration indicates an expected call of GetKYCExpiration.
+func (mr *MockStateMockRecorder) GetKYCExpiration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKYCExpiration", reflect.TypeOf((*MockState)(nil).GetKYCExpiration), arg0)
+}
+
+// GetNextKYCExpirationTime mocks base method.
+func (m *MockState) GetNextKYCExpirationTime(arg0 set.Set[ids.ShortID]) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextKYCExpirationTime", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextKYCExpirationTime indicates an expected call of GetNextKYCExpirationTime.
+func (mr *MockStateMockRecorder) GetNextKYCExpirationTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextKYCExpirationTime", reflect.TypeOf((*MockState)(nil).GetNextKYCExpirationTime), arg0)
+}
+
+// GetNextToExpireKYCAddressesAndTime mocks base method.
+func (m *MockState) GetNextToExpireKYCAddressesAndTime(arg0 set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextToExpireKYCAddressesAndTime", arg0)
+	ret0, _ := ret[0].([]ids.ShortID)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNextToExpireKYCAddressesAndTime indicates an expected call of GetNextToExpireKYCAddressesAndTime.
+func (mr *MockStateMockRecorder) GetNextToExpireKYCAddressesAndTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextToExpireKYCAddressesAndTime", reflect.TypeOf((*MockState)(nil).GetNextToExpireKYCAddressesAndTime), arg0)
+}
+
+// AddAddressStateChange mocks base method.
+func (m *MockState) AddAddressStateChange(arg0 ids.ShortID, arg1 *AddressStateChange) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddAddressStateChange", arg0, arg1)
+}
+
+// AddAddressStateChange indicates an expected call of AddAddressStateChange.
+func (mr *MockStateMockRecorder) AddAddressStateChange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAddressStateChange", reflect.TypeOf((*MockState)(nil).AddAddressStateChange), arg0, arg1)
+}
+
+// GetAddressStateHistory mocks base method.
+func (m *MockState) GetAddressStateHistory(arg0 ids.ShortID, arg1 uint64, arg2 int) ([]*AddressStateChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddressStateHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*AddressStateChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddressStateHistory indicates an expected call of GetAddressStateHistory.
+func (mr *MockStateMockRecorder) GetAddressStateHistory(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddressStateHistory", reflect.TypeOf((*MockState)(nil).GetAddressStateHistory), arg0, arg1, arg2)
+}
@@ -143,7 +143,7 @@ func (v *baseStakers) DeleteValidator(staker *Staker) {
 	validatorDiff.validatorStatus = deleted
 	validatorDiff.validator = staker
 
-	v.stakers.Delete(staker)
+	deleteStaker(v.stakers, staker)
 }
 
 func (v *baseStakers) GetDelegatorIterator(subnetID ids.ID, nodeID ids.NodeID) StakerIterator {
@@ -297,7 +297,7 @@ func (s *diffStakers) DeleteValidator(staker *Staker) {
 		// This validator was added and immediately removed in this diff. We
 		// treat it as if it was never added.
 		validatorDiff.validatorStatus = unmodified
-		s.addedStakers.Delete(validatorDiff.validator)
+		deleteStaker(s.addedStakers, validatorDiff.validator)
 		validatorDiff.validator = nil
 	} else {
 		validatorDiff.validatorStatus = deleted
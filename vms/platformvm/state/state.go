@@ -42,6 +42,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
 	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
@@ -145,6 +146,15 @@ type State interface {
 
 	SetHeight(height uint64)
 
+	// Returns at most [limit] address state changes of [address] in order of their
+	// acceptance, starting from change with [startIndex].
+	GetAddressStateHistory(address ids.ShortID, startIndex uint64, limit int) ([]*AddressStateChange, error)
+	GetTreasurySpends(startIndex uint64, limit int) ([]*TreasurySpend, error)
+	// Returns stats of active deposits created with offer [offerID].
+	GetDepositOfferStats(offerID ids.ID) (*deposit.OfferStats, error)
+	// Returns sorted IDs of multisig aliases, which have [address] as their direct owner.
+	GetMultisigAliasesForAddress(address ids.ShortID) ([]ids.ShortID, error)
+
 	// Discard uncommitted changes to the database.
 	Abort()
 
@@ -1153,6 +1163,9 @@ func (s *state) loadCurrentValidators() error {
 		if err != nil {
 			return err
 		}
+		if err := s.caminoState.loadRotatedValidatorNodeID(staker); err != nil {
+			return err
+		}
 
 		validator := s.currentStakers.getOrCreateValidator(staker.SubnetID, staker.NodeID)
 		validator.validator = staker
@@ -1413,7 +1426,8 @@ func (s *state) write(updateValidators bool, height uint64) error {
 		s.writeSubnetSupplies(),
 		s.writeChains(),
 		s.writeMetadata(),
-		s.caminoState.Write(),
+		s.caminoState.Write(height),
+		s.updateDepositOfferMetrics(), // must be called after caminoState.Write
 	)
 	return errs.Err
 }
@@ -1625,6 +1639,11 @@ func (s *state) writeCurrentStakers(updateValidators bool, height uint64) error
 		weightDiffDB := linkeddb.NewDefault(rawWeightDiffDB)
 		weightDiffs := make(map[ids.NodeID]*ValidatorWeightDiff)
 
+		rotatedValidatorUptimes, err := s.getRotatedValidatorUptimes(subnetID, validatorDiffs)
+		if err != nil {
+			return err
+		}
+
 		// Record the change in weight and/or public key for each validator.
 		for nodeID, validatorDiff := range validatorDiffs {
 			// Copy [nodeID] so it doesn't get overwritten next iteration.
@@ -1647,6 +1666,13 @@ func (s *state) writeCurrentStakers(updateValidators bool, height uint64) error
 					LastUpdated:     uint64(staker.StartTime.Unix()),
 					PotentialReward: staker.PotentialReward,
 				}
+				if rotatedVdr, ok := rotatedValidatorUptimes[staker.TxID]; ok {
+					// The validator node is being rotated, it keeps uptime of its old node.
+					vdr = rotatedVdr
+					if err := s.caminoState.putRotatedValidatorNodeID(staker.TxID, nodeID); err != nil {
+						return err
+					}
+				}
 
 				vdrBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, vdr)
 				if err != nil {
@@ -1674,8 +1700,11 @@ func (s *state) writeCurrentStakers(updateValidators bool, height uint64) error
 					}
 				}
 
-				if err := validatorDB.Delete(staker.TxID[:]); err != nil {
-					return fmt.Errorf("failed to delete current staker: %w", err)
+				// Rotated validator record is overwritten, when the validator is added with its new node.
+				if _, ok := rotatedValidatorUptimes[staker.TxID]; !ok {
+					if err := validatorDB.Delete(staker.TxID[:]); err != nil {
+						return fmt.Errorf("failed to delete current staker: %w", err)
+					}
 				}
 
 				s.validatorUptimes.DeleteUptime(nodeID, subnetID)
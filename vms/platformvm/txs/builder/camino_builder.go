@@ -8,12 +8,19 @@ import (
 	"fmt"
 
 	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
@@ -35,6 +42,10 @@ var (
 	errWrongLockMode    = errors.New("this tx can't be used with this caminoGenesis.LockModeBondDeposit")
 	errNoUTXOsForImport = errors.New("no utxos for import")
 	errWrongOutType     = errors.New("wrong output type")
+	errNoDepositUTXOs   = errors.New("no deposited utxos that can be transferred")
+
+	errTreasuryAdminNotSet       = errors.New("treasury admin isn't set")
+	errInsufficientTreasuryFunds = errors.New("insufficient treasury funds")
 )
 
 type CaminoBuilder interface {
@@ -75,6 +86,7 @@ type CaminoTxBuilder interface {
 
 	NewUnlockDepositTx(
 		depositTxIDs []ids.ID,
+		force bool,
 		keys []*secp256k1.PrivateKey,
 		change *secp256k1fx.OutputOwners,
 	) (*txs.Tx, error)
@@ -101,11 +113,93 @@ type CaminoTxBuilder interface {
 		change *secp256k1fx.OutputOwners,
 	) (*txs.Tx, error)
 
+	NewVestingTx(
+		amount uint64,
+		transferTo *secp256k1fx.OutputOwners,
+		schedule *locked.VestingSchedule,
+		keys []*secp256k1.PrivateKey,
+		change *secp256k1fx.OutputOwners,
+	) (*txs.Tx, error)
+
+	NewMultisigAliasTx(
+		alias *multisig.Alias,
+		keys []*secp256k1.PrivateKey,
+		change *secp256k1fx.OutputOwners,
+	) (*txs.Tx, error)
+
+	NewCancelMultisigAliasChangeTx(
+		aliasID ids.ShortID,
+		keys []*secp256k1.PrivateKey,
+		change *secp256k1fx.OutputOwners,
+	) (*txs.Tx, error)
+
+	NewAddDepositOfferTx(
+		offer *deposit.Offer,
+		offerCreatorAddress ids.ShortID,
+		keys []*secp256k1.PrivateKey,
+		change *secp256k1fx.OutputOwners,
+	) (*txs.Tx, error)
+
+	NewUpdateDepositOfferTx(
+		offerID ids.ID,
+		flags deposit.OfferFlag,
+		end uint64,
+		totalMaxAmount uint64,
+		totalMaxRewardAmount uint64,
+		offerUpdaterAddress ids.ShortID,
+		keys []*secp256k1.PrivateKey,
+		change *secp256k1fx.OutputOwners,
+	) (*txs.Tx, error)
+
+	NewTransferDepositTx(
+		depositTxID ids.ID,
+		rewardOwner *secp256k1fx.OutputOwners,
+		keys []*secp256k1.PrivateKey,
+		change *secp256k1fx.OutputOwners,
+	) (*txs.Tx, error)
+
+	NewSetRewardRestakeTx(
+		claimableOwner *secp256k1fx.OutputOwners,
+		depositOfferID ids.ID,
+		depositDuration uint32,
+		keys []*secp256k1.PrivateKey,
+		change *secp256k1fx.OutputOwners,
+	) (*txs.Tx, error)
+
+	NewSetSubnetValidatorRequirementsTx(
+		subnetID ids.ID,
+		requireConsortiumMember bool,
+		requireKYCVerified bool,
+		keys []*secp256k1.PrivateKey,
+		change *secp256k1fx.OutputOwners,
+	) (*txs.Tx, error)
+
+	NewTreasurySpendTx(
+		amount uint64,
+		to *secp256k1fx.OutputOwners,
+		reference []byte,
+		keys []*secp256k1.PrivateKey,
+		change *secp256k1fx.OutputOwners,
+	) (*txs.Tx, error)
+
+	NewRotateValidatorNodeTx(
+		oldNodeID ids.NodeID,
+		newNodeID ids.NodeID,
+		nodeOwnerAddress ids.ShortID,
+		keys []*secp256k1.PrivateKey,
+		change *secp256k1fx.OutputOwners,
+	) (*txs.Tx, error)
+
 	NewRewardsImportTx() (*txs.Tx, error)
 
 	NewSystemUnlockDepositTx(
 		depositTxIDs []ids.ID,
 	) (*txs.Tx, error)
+
+	NewFinishProposalsTx(
+		earlyFinishedProposalIDs []ids.ID,
+		expiredProposalIDs []ids.ID,
+	) (*txs.Tx, error)
 }
 
 func NewCamino(
@@ -214,6 +308,7 @@ func (b *caminoBuilder) NewCaminoAddValidatorTx(
 				Threshold: 1,
 				Addrs:     []ids.ShortID{rewardAddress},
 			},
+			DelegationShares: shares,
 		},
 		NodeOwnerAuth: &nodeOwnerInput.(*secp256k1fx.TransferInput).Input,
 	}
@@ -225,6 +320,82 @@ func (b *caminoBuilder) NewCaminoAddValidatorTx(
 	return tx, tx.SyntacticVerify(b.ctx)
 }
 
+func (b *caminoBuilder) NewAddDelegatorTx(
+	stakeAmount,
+	startTime,
+	endTime uint64,
+	nodeID ids.NodeID,
+	rewardAddress ids.ShortID,
+	keys []*secp256k1.PrivateKey,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	caminoGenesis, err := b.state.CaminoConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if !caminoGenesis.LockModeBondDeposit {
+		return b.builder.NewAddDelegatorTx(
+			stakeAmount,
+			startTime,
+			endTime,
+			nodeID,
+			rewardAddress,
+			keys,
+			changeAddr,
+		)
+	}
+
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, _, err := b.Lock(
+		b.state,
+		keys,
+		stakeAmount,
+		baseFee,
+		locked.StateBonded,
+		nil,
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{changeAddr},
+		},
+		0,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	utx := &txs.CaminoAddDelegatorTx{
+		AddDelegatorTx: txs.AddDelegatorTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    b.ctx.NetworkID,
+				BlockchainID: b.ctx.ChainID,
+				Ins:          ins,
+				Outs:         outs,
+			}},
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				Start:  startTime,
+				End:    endTime,
+				Wght:   stakeAmount,
+			},
+			DelegationRewardsOwner: &secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{rewardAddress},
+			},
+		},
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
 func (b *caminoBuilder) NewAddSubnetValidatorTx(
 	weight,
 	startTime,
@@ -297,7 +468,12 @@ func (b *caminoBuilder) NewAddressStateTx(
 	keys []*secp256k1.PrivateKey,
 	change *secp256k1fx.OutputOwners,
 ) (*txs.Tx, error) {
-	ins, outs, signers, _, err := b.Lock(b.state, keys, 0, b.cfg.TxFee, locked.StateUnlocked, nil, change, 0)
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, _, err := b.Lock(b.state, keys, 0, baseFee, locked.StateUnlocked, nil, change, 0)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -338,7 +514,12 @@ func (b *caminoBuilder) NewDepositTx(
 		return nil, errWrongLockMode
 	}
 
-	ins, outs, signers, _, err := b.Lock(b.state, keys, amount, b.cfg.TxFee, locked.StateDeposited, nil, change, 0)
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, _, err := b.Lock(b.state, keys, amount, baseFee, locked.StateDeposited, nil, change, 0)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -368,6 +549,7 @@ func (b *caminoBuilder) NewDepositTx(
 
 func (b *caminoBuilder) NewUnlockDepositTx(
 	depositTxIDs []ids.ID,
+	force bool,
 	keys []*secp256k1.PrivateKey,
 	change *secp256k1fx.OutputOwners,
 ) (*txs.Tx, error) {
@@ -380,13 +562,18 @@ func (b *caminoBuilder) NewUnlockDepositTx(
 	}
 
 	// unlocking
-	ins, outs, signers, err := b.UnlockDeposit(b.state, keys, depositTxIDs)
+	ins, outs, signers, err := b.UnlockDeposit(b.state, keys, depositTxIDs, force)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
 
 	// burning fee
-	feeIns, feeOuts, feeSigners, _, err := b.Lock(b.state, keys, 0, b.cfg.TxFee, locked.StateUnlocked, nil, change, 0)
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	feeIns, feeOuts, feeSigners, _, err := b.Lock(b.state, keys, 0, baseFee, locked.StateUnlocked, nil, change, 0)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -408,6 +595,10 @@ func (b *caminoBuilder) NewUnlockDepositTx(
 			Outs:         outs,
 		}},
 	}
+	if force {
+		utx.UpgradeVersionID = codec.UpgradeVersion1
+		utx.Force = true
+	}
 
 	tx, err := txs.NewSigned(utx, txs.Codec, signers)
 	if err != nil {
@@ -430,7 +621,12 @@ func (b *caminoBuilder) NewClaimTx(
 		return nil, errWrongLockMode
 	}
 
-	ins, outs, signers, _, err := b.Lock(b.state, keys, 0, b.cfg.TxFee, locked.StateUnlocked, nil, change, 0)
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, _, err := b.Lock(b.state, keys, 0, baseFee, locked.StateUnlocked, nil, change, 0)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -511,7 +707,12 @@ func (b *caminoBuilder) NewRegisterNodeTx(
 	keys []*secp256k1.PrivateKey,
 	change *secp256k1fx.OutputOwners,
 ) (*txs.Tx, error) {
-	ins, outs, signers, _, err := b.Lock(b.state, keys, 0, b.cfg.TxFee, locked.StateUnlocked, nil, change, 0)
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, _, err := b.Lock(b.state, keys, 0, baseFee, locked.StateUnlocked, nil, change, 0)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -567,7 +768,12 @@ func (b *caminoBuilder) NewBaseTx(
 	keys []*secp256k1.PrivateKey,
 	change *secp256k1fx.OutputOwners,
 ) (*txs.Tx, error) {
-	ins, outs, signers, _, err := b.Lock(b.state, keys, amount, b.cfg.TxFee, locked.StateUnlocked, transferTo, change, 0)
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, _, err := b.Lock(b.state, keys, amount, baseFee, locked.StateUnlocked, transferTo, change, 0)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -586,94 +792,870 @@ func (b *caminoBuilder) NewBaseTx(
 	return tx, tx.SyntacticVerify(b.ctx)
 }
 
-func (b *caminoBuilder) NewRewardsImportTx() (*txs.Tx, error) {
-	caminoGenesis, err := b.state.CaminoConfig()
+func (b *caminoBuilder) NewVestingTx(
+	amount uint64,
+	transferTo *secp256k1fx.OutputOwners,
+	schedule *locked.VestingSchedule,
+	keys []*secp256k1.PrivateKey,
+	change *secp256k1fx.OutputOwners,
+) (*txs.Tx, error) {
+	baseFee, err := b.state.GetBaseFee()
 	if err != nil {
 		return nil, err
 	}
 
-	if !caminoGenesis.LockModeBondDeposit {
-		return nil, errWrongLockMode
+	amountToBurn, err := math.Add64(amount, baseFee)
+	if err != nil {
+		return nil, err
 	}
 
-	allUTXOsBytes, _, _, err := b.ctx.SharedMemory.Indexed(
-		b.ctx.CChainID,
-		treasury.AddrTraitsBytes,
-		ids.ShortEmpty[:], ids.Empty[:], MaxPageSize,
-	)
+	// transferred amount is taken as burned, vesting out is added afterwards
+	ins, outs, signers, _, err := b.Lock(b.state, keys, 0, amountToBurn, locked.StateUnlocked, nil, change, 0)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching atomic UTXOs: %w", err)
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
 
-	now := b.clk.Unix()
+	outs = append(outs, &avax.TransferableOutput{
+		Asset: avax.Asset{ID: b.ctx.AVAXAssetID},
+		Out: &locked.VestingOut{
+			Schedule: *schedule,
+			TransferableOut: &secp256k1fx.TransferOutput{
+				Amt:          amount,
+				OutputOwners: *transferTo,
+			},
+		},
+	})
+	avax.SortTransferableOutputs(outs, txs.Codec)
 
-	utxos := []*avax.UTXO{}
-	for _, utxoBytes := range allUTXOsBytes {
-		utxo := &avax.TimedUTXO{}
-		if _, err := txs.Codec.Unmarshal(utxoBytes, utxo); err != nil {
-			// that means that this could be simple, not-timed utxo
-			continue
-		}
+	utx := &txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    b.ctx.NetworkID,
+		BlockchainID: b.ctx.ChainID,
+		Ins:          ins,
+		Outs:         outs,
+	}}
 
-		if utxo.Timestamp <= now-atomic.SharedMemorySyncBound {
-			utxos = append(utxos, &utxo.UTXO)
-		}
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
 	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
 
-	if len(utxos) == 0 {
-		return nil, errNoUTXOsForImport
+func (b *caminoBuilder) NewMultisigAliasTx(
+	alias *multisig.Alias,
+	keys []*secp256k1.PrivateKey,
+	change *secp256k1fx.OutputOwners,
+) (*txs.Tx, error) {
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
 	}
 
-	ins := make([]*avax.TransferableInput, len(utxos))
+	ins, outs, signers, _, err := b.Lock(b.state, keys, 0, baseFee, locked.StateUnlocked, nil, change, 0)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
 
-	for i, utxo := range utxos {
-		inputIntf, _, err := fakeTreasuryKeychain.Spend(utxo.Out, now)
+	var auth verify.Verifiable = &secp256k1fx.Input{}
+	if alias.ID != ids.ShortEmpty {
+		currentAlias, err := b.state.GetMultisigAlias(alias.ID)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("couldn't get multisig alias %s: %w", alias.ID, err)
 		}
-		input, ok := inputIntf.(avax.TransferableIn)
-		if !ok {
+		aliasOwners, err := secp256k1fx.AliasOwnersChangeOwners(&currentAlias.Alias)
+		if err != nil {
 			return nil, err
 		}
-		ins[i] = &avax.TransferableInput{
-			UTXOID: utxo.UTXOID,
-			Asset:  utxo.Asset,
-			In:     input,
+
+		kc := secp256k1fx.NewKeychain(keys...)
+		in, aliasSigners, err := kc.SpendMultiSig(
+			&secp256k1fx.TransferOutput{OutputOwners: *aliasOwners},
+			0,
+			b.state,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errKeyMissing, err)
 		}
+		signers = append(signers, aliasSigners)
+		auth = &in.(*secp256k1fx.TransferInput).Input
 	}
 
-	avax.SortTransferableInputs(ins)
-
-	utx := &txs.RewardsImportTx{
+	utx := &txs.MultisigAliasTx{
 		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
 			NetworkID:    b.ctx.NetworkID,
 			BlockchainID: b.ctx.ChainID,
 			Ins:          ins,
+			Outs:         outs,
 		}},
+		MultisigAlias: *alias,
+		Auth:          auth,
 	}
-	tx, err := txs.NewSigned(utx, txs.Codec, nil)
+
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
 	if err != nil {
 		return nil, err
 	}
-
 	return tx, tx.SyntacticVerify(b.ctx)
 }
 
-func (b *caminoBuilder) NewSystemUnlockDepositTx(
-	depositTxIDs []ids.ID,
+func (b *caminoBuilder) NewCancelMultisigAliasChangeTx(
+	aliasID ids.ShortID,
+	keys []*secp256k1.PrivateKey,
+	change *secp256k1fx.OutputOwners,
 ) (*txs.Tx, error) {
-	ins, outs, err := b.Unlock(b.state, depositTxIDs, locked.StateDeposited)
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, _, err := b.Lock(b.state, keys, 0, baseFee, locked.StateUnlocked, nil, change, 0)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
 
-	utx := &txs.UnlockDepositTx{
+	alias, err := b.state.GetMultisigAlias(aliasID)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get multisig alias %s: %w", aliasID, err)
+	}
+	aliasOwners, ok := alias.Owners.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return nil, errNotSECPOwner
+	}
+
+	kc := secp256k1fx.NewKeychain(keys...)
+	in, aliasSigners, err := kc.SpendMultiSig(
+		&secp256k1fx.TransferOutput{OutputOwners: *aliasOwners},
+		0,
+		b.state,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errKeyMissing, err)
+	}
+	signers = append(signers, aliasSigners)
+
+	utx := &txs.MultisigAliasTx{
+		UpgradeVersionID: codec.UpgradeVersion1,
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		MultisigAlias:       alias.Alias,
+		Auth:                &in.(*secp256k1fx.TransferInput).Input,
+		CancelPendingChange: true,
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *caminoBuilder) NewAddDepositOfferTx(
+	offer *deposit.Offer,
+	offerCreatorAddress ids.ShortID,
+	keys []*secp256k1.PrivateKey,
+	change *secp256k1fx.OutputOwners,
+) (*txs.Tx, error) {
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, _, err := b.Lock(b.state, keys, 0, baseFee, locked.StateUnlocked, nil, change, 0)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	kc := secp256k1fx.NewKeychain(keys...)
+	in, offerCreatorSigners, err := kc.SpendMultiSig(
+		&secp256k1fx.TransferOutput{
+			OutputOwners: secp256k1fx.OutputOwners{
+				Addrs:     []ids.ShortID{offerCreatorAddress},
+				Threshold: 1,
+			},
+		},
+		0,
+		b.state,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errKeyMissing, err)
+	}
+	signers = append(signers, offerCreatorSigners)
+
+	utx := &txs.AddDepositOfferTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		DepositOffer:               offer,
+		DepositOfferCreatorAddress: offerCreatorAddress,
+		DepositOfferCreatorAuth:    &in.(*secp256k1fx.TransferInput).Input,
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *caminoBuilder) NewUpdateDepositOfferTx(
+	offerID ids.ID,
+	flags deposit.OfferFlag,
+	end uint64,
+	totalMaxAmount uint64,
+	totalMaxRewardAmount uint64,
+	offerUpdaterAddress ids.ShortID,
+	keys []*secp256k1.PrivateKey,
+	change *secp256k1fx.OutputOwners,
+) (*txs.Tx, error) {
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, _, err := b.Lock(b.state, keys, 0, baseFee, locked.StateUnlocked, nil, change, 0)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	kc := secp256k1fx.NewKeychain(keys...)
+	in, offerUpdaterSigners, err := kc.SpendMultiSig(
+		&secp256k1fx.TransferOutput{
+			OutputOwners: secp256k1fx.OutputOwners{
+				Addrs:     []ids.ShortID{offerUpdaterAddress},
+				Threshold: 1,
+			},
+		},
+		0,
+		b.state,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errKeyMissing, err)
+	}
+	signers = append(signers, offerUpdaterSigners)
+
+	utx := &txs.UpdateDepositOfferTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		DepositOfferID:             offerID,
+		Flags:                      flags,
+		End:                        end,
+		TotalMaxAmount:             totalMaxAmount,
+		TotalMaxRewardAmount:       totalMaxRewardAmount,
+		DepositOfferUpdaterAddress: offerUpdaterAddress,
+		DepositOfferUpdaterAuth:    &in.(*secp256k1fx.TransferInput).Input,
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+// NewTransferDepositTx transfers all deposited tokens of deposit to [rewardOwner],
+// which will also become new deposit reward owner. If [rewardOwner] is nil,
+// current deposit reward owner is used.
+func (b *caminoBuilder) NewTransferDepositTx(
+	depositTxID ids.ID,
+	rewardOwner *secp256k1fx.OutputOwners,
+	keys []*secp256k1.PrivateKey,
+	change *secp256k1fx.OutputOwners,
+) (*txs.Tx, error) {
+	caminoGenesis, err := b.state.CaminoConfig()
+	if err != nil {
+		return nil, err
+	}
+	if !caminoGenesis.LockModeBondDeposit {
+		return nil, errWrongLockMode
+	}
+
+	deposit, err := b.state.GetDeposit(depositTxID)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, err := b.state.GetDepositOffer(deposit.DepositOfferID)
+	if err != nil {
+		return nil, err
+	}
+
+	currentRewardOwner, ok := deposit.RewardOwner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return nil, errNotSECPOwner
+	}
+	if rewardOwner == nil {
+		rewardOwner = currentRewardOwner
+	}
+
+	// transferring deposited tokens
+
+	kc := secp256k1fx.NewKeychain(keys...)
+	now := b.clk.Unix()
+
+	depositTxIDs := set.NewSet[ids.ID](1)
+	depositTxIDs.Add(depositTxID)
+
+	utxos, err := b.state.LockedUTXOs(depositTxIDs, kc.Addresses(), locked.StateDeposited)
+	if err != nil {
+		return nil, err
+	}
+
+	transferredLockIDs := locked.IDs{DepositTxID: depositTxID}
+	ins := []*avax.TransferableInput{}
+	signers := [][]*secp256k1.PrivateKey{}
+	transferredAmount := uint64(0)
+	for _, utxo := range utxos {
+		lockedOut, ok := utxo.Out.(*locked.Out)
+		if !ok || lockedOut.IDs != transferredLockIDs {
+			// bonded deposited tokens can't be transferred
+			continue
+		}
+
+		innerOut, ok := lockedOut.TransferableOut.(*secp256k1fx.TransferOutput)
+		if !ok {
+			return nil, errWrongOutType
+		}
+
+		in, inSigners, err := kc.SpendMultiSig(innerOut, now, b.state)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errKeyMissing, err)
+		}
+
+		ins = append(ins, &avax.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In: &locked.In{
+				IDs:            lockedOut.IDs,
+				TransferableIn: in.(avax.TransferableIn),
+			},
+		})
+		signers = append(signers, inSigners)
+		transferredAmount, err = math.Add64(transferredAmount, innerOut.Amt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if transferredAmount == 0 {
+		return nil, errNoDepositUTXOs
+	}
+
+	outs := []*avax.TransferableOutput{{
+		Asset: avax.Asset{ID: b.ctx.AVAXAssetID},
+		Out: &locked.Out{
+			IDs: transferredLockIDs,
+			TransferableOut: &secp256k1fx.TransferOutput{
+				Amt:          transferredAmount,
+				OutputOwners: *rewardOwner,
+			},
+		},
+	}}
+
+	// burning fee
+
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	feeIns, feeOuts, feeSigners, _, err := b.Lock(b.state, keys, 0, baseFee, locked.StateUnlocked, nil, change, 0)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	ins = append(ins, feeIns...)
+	outs = append(outs, feeOuts...)
+	signers = append(signers, feeSigners...)
+
+	avax.SortTransferableInputsWithSigners(ins, signers)
+	avax.SortTransferableOutputs(outs, txs.Codec)
+
+	// reward owner and offer owner auths
+
+	rewardOwnerAuth, rewardOwnerSigners, err := kc.SpendMultiSig(
+		&secp256k1fx.TransferOutput{OutputOwners: *currentRewardOwner},
+		0,
+		b.state,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errKeyMissing, err)
+	}
+	signers = append(signers, rewardOwnerSigners)
+
+	var offerOwnerAuth verify.Verifiable = &secp256k1fx.Input{}
+	if offer.OwnerAddress != ids.ShortEmpty {
+		in, offerOwnerSigners, err := kc.SpendMultiSig(
+			&secp256k1fx.TransferOutput{
+				OutputOwners: secp256k1fx.OutputOwners{
+					Addrs:     []ids.ShortID{offer.OwnerAddress},
+					Threshold: 1,
+				},
+			},
+			0,
+			b.state,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errKeyMissing, err)
+		}
+		offerOwnerAuth = &in.(*secp256k1fx.TransferInput).Input
+		signers = append(signers, offerOwnerSigners)
+	}
+
+	utx := &txs.TransferDepositTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		DepositTxID:           depositTxID,
+		RewardOwner:           rewardOwner,
+		RewardOwnerAuth:       &rewardOwnerAuth.(*secp256k1fx.TransferInput).Input,
+		DepositOfferOwnerAuth: offerOwnerAuth,
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+// NewSetRewardRestakeTx sets deposit offer and duration, that will be used to restake validator
+// rewards of [claimableOwner]. Empty [depositOfferID] disables restaking.
+func (b *caminoBuilder) NewSetRewardRestakeTx(
+	claimableOwner *secp256k1fx.OutputOwners,
+	depositOfferID ids.ID,
+	depositDuration uint32,
+	keys []*secp256k1.PrivateKey,
+	change *secp256k1fx.OutputOwners,
+) (*txs.Tx, error) {
+	caminoGenesis, err := b.state.CaminoConfig()
+	if err != nil {
+		return nil, err
+	}
+	if !caminoGenesis.LockModeBondDeposit {
+		return nil, errWrongLockMode
+	}
+
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, _, err := b.Lock(b.state, keys, 0, baseFee, locked.StateUnlocked, nil, change, 0)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	kc := secp256k1fx.NewKeychain(keys...)
+	in, claimableOwnerSigners, err := kc.SpendMultiSig(
+		&secp256k1fx.TransferOutput{OutputOwners: *claimableOwner},
+		0,
+		b.state,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errKeyMissing, err)
+	}
+	signers = append(signers, claimableOwnerSigners)
+
+	utx := &txs.SetRewardRestakeTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		ClaimableOwner:     claimableOwner,
+		ClaimableOwnerAuth: &in.(*secp256k1fx.TransferInput).Input,
+		DepositOfferID:     depositOfferID,
+		DepositDuration:    depositDuration,
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+// NewSetSubnetValidatorRequirementsTx sets requirements for node owners of
+// validators of not yet transformed subnet [subnetID].
+func (b *caminoBuilder) NewSetSubnetValidatorRequirementsTx(
+	subnetID ids.ID,
+	requireConsortiumMember bool,
+	requireKYCVerified bool,
+	keys []*secp256k1.PrivateKey,
+	change *secp256k1fx.OutputOwners,
+) (*txs.Tx, error) {
+	caminoGenesis, err := b.state.CaminoConfig()
+	if err != nil {
+		return nil, err
+	}
+	if !caminoGenesis.LockModeBondDeposit {
+		return nil, errWrongLockMode
+	}
+
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, _, err := b.Lock(b.state, keys, 0, baseFee, locked.StateUnlocked, nil, change, 0)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	subnetAuth, subnetSigners, err := b.Authorize(b.state, subnetID, keys)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't authorize tx's subnet restrictions: %w", err)
+	}
+	signers = append(signers, subnetSigners)
+
+	utx := &txs.SetSubnetValidatorRequirementsTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		Subnet:                  subnetID,
+		SubnetAuth:              subnetAuth,
+		RequireConsortiumMember: requireConsortiumMember,
+		RequireKYCVerified:      requireKYCVerified,
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *caminoBuilder) NewTreasurySpendTx(
+	amount uint64,
+	to *secp256k1fx.OutputOwners,
+	reference []byte,
+	keys []*secp256k1.PrivateKey,
+	change *secp256k1fx.OutputOwners,
+) (*txs.Tx, error) {
+	caminoGenesis, err := b.state.CaminoConfig()
+	if err != nil {
+		return nil, err
+	}
+	if !caminoGenesis.LockModeBondDeposit {
+		return nil, errWrongLockMode
+	}
+	if caminoGenesis.TreasuryAdmin == ids.ShortEmpty {
+		return nil, errTreasuryAdminNotSet
+	}
+
+	// Spending treasury utxos, they don't require signatures
+
+	treasuryAddrs := set.NewSet[ids.ShortID](1)
+	treasuryAddrs.Add(treasury.Addr)
+	treasuryUTXOs, err := avax.GetAllUTXOs(b.state, treasuryAddrs)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get treasury utxos: %w", err)
+	}
+
+	now := b.clk.Unix()
+	treasuryIns := []*avax.TransferableInput{}
+	consumed := uint64(0)
+	for _, utxo := range treasuryUTXOs {
+		if consumed >= amount {
+			break
+		}
+
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok || utxo.AssetID() != b.ctx.AVAXAssetID || !out.OutputOwners.Equals(treasury.Owner) {
+			continue
+		}
+
+		inputIntf, _, err := fakeTreasuryKeychain.Spend(out, now)
+		if err != nil {
+			return nil, err
+		}
+		input, ok := inputIntf.(avax.TransferableIn)
+		if !ok {
+			return nil, fmt.Errorf("expected tx input to be avax.TransferableIn, got %T", inputIntf)
+		}
+
+		consumed, err = math.Add64(consumed, out.Amt)
+		if err != nil {
+			return nil, err
+		}
+
+		treasuryIns = append(treasuryIns, &avax.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In:     input,
+		})
+	}
+
+	if consumed < amount {
+		return nil, fmt.Errorf("%w: available %d, requested %d", errInsufficientTreasuryFunds, consumed, amount)
+	}
+
+	treasuryOuts := []*avax.TransferableOutput{{
+		Asset: avax.Asset{ID: b.ctx.AVAXAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          amount,
+			OutputOwners: *to,
+		},
+	}}
+	if consumed > amount {
+		treasuryOuts = append(treasuryOuts, &avax.TransferableOutput{
+			Asset: avax.Asset{ID: b.ctx.AVAXAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          consumed - amount,
+				OutputOwners: *treasury.Owner,
+			},
+		})
+	}
+
+	utils.Sort(treasuryIns)
+	avax.SortTransferableOutputs(treasuryOuts, txs.Codec)
+
+	// Paying fee
+
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, _, err := b.Lock(b.state, keys, 0, baseFee, locked.StateUnlocked, nil, change, 0)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	// Treasury admin auth
+
+	kc := secp256k1fx.NewKeychain(keys...)
+	in, treasuryAdminSigners, err := kc.SpendMultiSig(
+		&secp256k1fx.TransferOutput{
+			OutputOwners: secp256k1fx.OutputOwners{
+				Addrs:     []ids.ShortID{caminoGenesis.TreasuryAdmin},
+				Threshold: 1,
+			},
+		},
+		0,
+		b.state,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errKeyMissing, err)
+	}
+	signers = append(signers, treasuryAdminSigners)
+
+	utx := &txs.TreasurySpendTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		Reference:         reference,
+		TreasuryIns:       treasuryIns,
+		TreasuryOuts:      treasuryOuts,
+		TreasuryAdminAuth: &in.(*secp256k1fx.TransferInput).Input,
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *caminoBuilder) NewRotateValidatorNodeTx(
+	oldNodeID ids.NodeID,
+	newNodeID ids.NodeID,
+	nodeOwnerAddress ids.ShortID,
+	keys []*secp256k1.PrivateKey,
+	change *secp256k1fx.OutputOwners,
+) (*txs.Tx, error) {
+	baseFee, err := b.state.GetBaseFee()
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, signers, _, err := b.Lock(b.state, keys, 0, baseFee, locked.StateUnlocked, nil, change, 0)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	nodeSigners, err := getSigner(keys, ids.ShortID(newNodeID))
+	if err != nil {
+		return nil, err
+	}
+	signers = append(signers, nodeSigners)
+
+	kc := secp256k1fx.NewKeychain(keys...)
+	in, consortiumSigners, err := kc.SpendMultiSig(
+		&secp256k1fx.TransferOutput{
+			OutputOwners: secp256k1fx.OutputOwners{
+				Addrs:     []ids.ShortID{nodeOwnerAddress},
+				Threshold: 1,
+			},
+		},
+		0,
+		b.state,
+	)
+	if err != nil {
+		return nil, err
+	}
+	signers = append(signers, consortiumSigners)
+
+	utx := &txs.RotateValidatorNodeTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		OldNodeID:        oldNodeID,
+		NewNodeID:        newNodeID,
+		NodeOwnerAuth:    &in.(*secp256k1fx.TransferInput).Input,
+		NodeOwnerAddress: nodeOwnerAddress,
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *caminoBuilder) NewRewardsImportTx() (*txs.Tx, error) {
+	caminoGenesis, err := b.state.CaminoConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if !caminoGenesis.LockModeBondDeposit {
+		return nil, errWrongLockMode
+	}
+
+	allUTXOsBytes, _, _, err := b.ctx.SharedMemory.Indexed(
+		b.ctx.CChainID,
+		treasury.AddrTraitsBytes,
+		ids.ShortEmpty[:], ids.Empty[:], MaxPageSize,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching atomic UTXOs: %w", err)
+	}
+
+	now := b.clk.Unix()
+
+	utxos := []*avax.UTXO{}
+	for _, utxoBytes := range allUTXOsBytes {
+		utxo := &avax.TimedUTXO{}
+		if _, err := txs.Codec.Unmarshal(utxoBytes, utxo); err != nil {
+			// that means that this could be simple, not-timed utxo
+			continue
+		}
+
+		if utxo.Timestamp <= now-atomic.SharedMemorySyncBound {
+			utxos = append(utxos, &utxo.UTXO)
+		}
+	}
+
+	if len(utxos) == 0 {
+		return nil, errNoUTXOsForImport
+	}
+
+	ins := make([]*avax.TransferableInput, len(utxos))
+
+	for i, utxo := range utxos {
+		inputIntf, _, err := fakeTreasuryKeychain.Spend(utxo.Out, now)
+		if err != nil {
+			return nil, err
+		}
+		input, ok := inputIntf.(avax.TransferableIn)
+		if !ok {
+			return nil, err
+		}
+		ins[i] = &avax.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In:     input,
+		}
+	}
+
+	avax.SortTransferableInputs(ins)
+
+	utx := &txs.RewardsImportTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+		}},
+	}
+	tx, err := txs.NewSigned(utx, txs.Codec, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *caminoBuilder) NewSystemUnlockDepositTx(
+	depositTxIDs []ids.ID,
+) (*txs.Tx, error) {
+	ins, outs, err := b.Unlock(b.state, depositTxIDs, locked.StateDeposited)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	utx := &txs.UnlockDepositTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, nil)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *caminoBuilder) NewFinishProposalsTx(
+	earlyFinishedProposalIDs []ids.ID,
+	expiredProposalIDs []ids.ID,
+) (*txs.Tx, error) {
+	proposalIDs := make([]ids.ID, 0, len(earlyFinishedProposalIDs)+len(expiredProposalIDs))
+	proposalIDs = append(proposalIDs, earlyFinishedProposalIDs...)
+	proposalIDs = append(proposalIDs, expiredProposalIDs...)
+
+	ins, outs, err := b.Unlock(b.state, proposalIDs, locked.StateBonded)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	utx := &txs.FinishProposalsTx{
 		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
 			NetworkID:    b.ctx.NetworkID,
 			BlockchainID: b.ctx.ChainID,
 			Ins:          ins,
 			Outs:         outs,
 		}},
+		EarlyFinishedProposalIDs: earlyFinishedProposalIDs,
+		ExpiredProposalIDs:       expiredProposalIDs,
 	}
 
 	tx, err := txs.NewSigned(utx, txs.Codec, nil)
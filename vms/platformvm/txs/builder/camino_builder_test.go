@@ -11,12 +11,17 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/nodeid"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/api"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -253,6 +258,7 @@ func TestUnlockDepositTx(t *testing.T) {
 
 			tx, err := env.txBuilder.NewUnlockDepositTx(
 				[]ids.ID{depositTxID},
+				false,
 				[]*secp256k1.PrivateKey{testKey},
 				nil,
 			)
@@ -325,6 +331,7 @@ func TestNewClaimTx(t *testing.T) {
 			state: func(ctrl *gomock.Controller) state.State {
 				s := state.NewMockState(ctrl)
 				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetBaseFee().Return(defaultTxFee, nil)
 				// fee
 				expectLock(s, map[ids.ShortID][]*avax.UTXO{feeAddr: {feeUTXO}, rewardOwner1Addr: {}})
 				// deposits
@@ -370,6 +377,7 @@ func TestNewClaimTx(t *testing.T) {
 			state: func(ctrl *gomock.Controller) state.State {
 				s := state.NewMockState(ctrl)
 				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetBaseFee().Return(defaultTxFee, nil)
 				// fee
 				expectLock(s, map[ids.ShortID][]*avax.UTXO{feeAddr: {feeUTXO}, rewardOwner1Addr: {}, rewardOwner2Addr: {}})
 				// deposits
@@ -437,6 +445,7 @@ func TestNewClaimTx(t *testing.T) {
 			state: func(ctrl *gomock.Controller) state.State {
 				s := state.NewMockState(ctrl)
 				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetBaseFee().Return(defaultTxFee, nil)
 				// fee
 				expectLock(s, map[ids.ShortID][]*avax.UTXO{feeAddr: {feeUTXO}, rewardOwner1Addr: {}, rewardOwner2Addr: {}})
 				// deposits
@@ -510,6 +519,7 @@ func TestNewClaimTx(t *testing.T) {
 			state: func(ctrl *gomock.Controller) state.State {
 				s := state.NewMockState(ctrl)
 				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetBaseFee().Return(defaultTxFee, nil)
 				// fee
 				expectLock(s, map[ids.ShortID][]*avax.UTXO{feeAddr: {feeUTXO}, rewardOwner1Addr: {}})
 				// claimables
@@ -556,6 +566,7 @@ func TestNewClaimTx(t *testing.T) {
 			state: func(ctrl *gomock.Controller) state.State {
 				s := state.NewMockState(ctrl)
 				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetBaseFee().Return(defaultTxFee, nil)
 				// fee
 				expectLock(s, map[ids.ShortID][]*avax.UTXO{feeAddr: {feeUTXO}, rewardOwner1Addr: {}, rewardOwner2Addr: {}})
 				// deposits
@@ -629,6 +640,7 @@ func TestNewClaimTx(t *testing.T) {
 			state: func(ctrl *gomock.Controller) state.State {
 				s := state.NewMockState(ctrl)
 				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetBaseFee().Return(defaultTxFee, nil)
 				// fee
 				expectLock(s, map[ids.ShortID][]*avax.UTXO{feeAddr: {feeUTXO}})
 				// deposits
@@ -648,6 +660,7 @@ func TestNewClaimTx(t *testing.T) {
 			state: func(ctrl *gomock.Controller) state.State {
 				s := state.NewMockState(ctrl)
 				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetBaseFee().Return(defaultTxFee, nil)
 				// fee
 				expectLock(s, map[ids.ShortID][]*avax.UTXO{feeAddr: {feeUTXO}})
 				// deposits
@@ -667,6 +680,7 @@ func TestNewClaimTx(t *testing.T) {
 			state: func(ctrl *gomock.Controller) state.State {
 				s := state.NewMockState(ctrl)
 				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetBaseFee().Return(defaultTxFee, nil)
 				// fee
 				expectLock(s, map[ids.ShortID][]*avax.UTXO{feeAddr: {feeUTXO}})
 				// deposits
@@ -687,6 +701,7 @@ func TestNewClaimTx(t *testing.T) {
 			state: func(ctrl *gomock.Controller) state.State {
 				s := state.NewMockState(ctrl)
 				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetBaseFee().Return(defaultTxFee, nil)
 				// fee
 				expectLock(s, map[ids.ShortID][]*avax.UTXO{feeAddr: {feeUTXO}})
 				// claimables
@@ -706,6 +721,7 @@ func TestNewClaimTx(t *testing.T) {
 			state: func(ctrl *gomock.Controller) state.State {
 				s := state.NewMockState(ctrl)
 				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetBaseFee().Return(defaultTxFee, nil)
 				// fee
 				expectLock(s, map[ids.ShortID][]*avax.UTXO{feeAddr: {feeUTXO}})
 				// claimables
@@ -855,3 +871,429 @@ func TestNewRewardsImportTx(t *testing.T) {
 		})
 	}
 }
+
+func TestNewMultisigAliasTx(t *testing.T) {
+	caminoConfig := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	env := newCaminoEnvironment(true, caminoConfig)
+	env.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(t, shutdownCaminoEnvironment(env))
+	}()
+
+	aliasOwnerKey := caminoPreFundedKeys[0]
+	existingAliasID := ids.ShortID{1, 1, 1}
+	env.state.SetMultisigAlias(&multisig.AliasWithNonce{Alias: multisig.Alias{
+		ID: existingAliasID,
+		Owners: &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{aliasOwnerKey.Address()},
+		},
+	}})
+	policyAliasID := ids.ShortID{1, 1, 2}
+	env.state.SetMultisigAlias(&multisig.AliasWithNonce{Alias: multisig.Alias{
+		UpgradeVersionID: codec.UpgradeVersion1,
+		ID:               policyAliasID,
+		Owners: &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{aliasOwnerKey.Address(), caminoPreFundedKeys[1].Address()},
+		},
+		OwnersChangeThreshold: 2,
+	}})
+	newOwners := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{caminoPreFundedKeys[1].Address()},
+	}
+
+	tests := map[string]struct {
+		aliasID      ids.ShortID
+		keys         []*secp256k1.PrivateKey
+		expectedAuth verify.Verifiable
+		expectedErr  error
+	}{
+		"OK: create alias": {
+			aliasID:      ids.ShortEmpty,
+			keys:         caminoPreFundedKeys,
+			expectedAuth: &secp256k1fx.Input{},
+		},
+		"OK: update alias": {
+			aliasID:      existingAliasID,
+			keys:         caminoPreFundedKeys,
+			expectedAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+		},
+		"OK: update alias with owners change threshold": {
+			aliasID:      policyAliasID,
+			keys:         caminoPreFundedKeys,
+			expectedAuth: &secp256k1fx.Input{SigIndices: []uint32{0, 1}},
+		},
+		"Fail: not enough keys for owners change threshold": {
+			aliasID:     policyAliasID,
+			keys:        caminoPreFundedKeys[1:],
+			expectedErr: errKeyMissing,
+		},
+		"Fail: alias doesn't exist": {
+			aliasID:     ids.ShortID{2, 2, 2},
+			keys:        caminoPreFundedKeys,
+			expectedErr: database.ErrNotFound,
+		},
+		"Fail: no alias owner key": {
+			aliasID:     existingAliasID,
+			keys:        caminoPreFundedKeys[1:],
+			expectedErr: errKeyMissing,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tx, err := env.txBuilder.NewMultisigAliasTx(
+				&multisig.Alias{
+					ID:     tt.aliasID,
+					Owners: newOwners,
+				},
+				tt.keys,
+				nil,
+			)
+			require.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+			utx, ok := tx.Unsigned.(*txs.MultisigAliasTx)
+			require.True(t, ok)
+			require.Equal(t, tt.expectedAuth, utx.Auth)
+			require.Equal(t, tt.aliasID, utx.MultisigAlias.ID)
+		})
+	}
+}
+
+func TestNewCancelMultisigAliasChangeTx(t *testing.T) {
+	caminoConfig := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	env := newCaminoEnvironment(true, caminoConfig)
+	env.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(t, shutdownCaminoEnvironment(env))
+	}()
+
+	aliasOwnerAddrs := []ids.ShortID{caminoPreFundedKeys[0].Address(), caminoPreFundedKeys[1].Address()}
+	utils.Sort(aliasOwnerAddrs)
+	aliasID := ids.ShortID{1, 1, 1}
+	alias := multisig.Alias{
+		UpgradeVersionID: codec.UpgradeVersion1,
+		ID:               aliasID,
+		Owners: &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     aliasOwnerAddrs,
+		},
+		OwnersChangeThreshold: 2,
+		OwnersChangeDelay:     100,
+	}
+	env.state.SetMultisigAlias(&multisig.AliasWithNonce{Alias: alias})
+
+	tests := map[string]struct {
+		aliasID     ids.ShortID
+		keys        []*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"OK": {
+			aliasID: aliasID,
+			keys:    caminoPreFundedKeys,
+		},
+		"Fail: alias doesn't exist": {
+			aliasID:     ids.ShortID{2, 2, 2},
+			keys:        caminoPreFundedKeys,
+			expectedErr: database.ErrNotFound,
+		},
+		"Fail: no alias owner key": {
+			aliasID:     aliasID,
+			keys:        caminoPreFundedKeys[2:],
+			expectedErr: errKeyMissing,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tx, err := env.txBuilder.NewCancelMultisigAliasChangeTx(tt.aliasID, tt.keys, nil)
+			require.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+			utx, ok := tx.Unsigned.(*txs.MultisigAliasTx)
+			require.True(t, ok)
+			require.True(t, utx.CancelPendingChange)
+			require.Equal(t, alias, utx.MultisigAlias)
+			// cancellation only needs alias owners threshold
+			require.Equal(t, &secp256k1fx.Input{SigIndices: []uint32{0}}, utx.Auth)
+		})
+	}
+}
+
+func TestNewAddDepositOfferTx(t *testing.T) {
+	caminoConfig := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	env := newCaminoEnvironment(true, caminoConfig)
+	env.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(t, shutdownCaminoEnvironment(env))
+	}()
+
+	offerCreatorKey := caminoPreFundedKeys[0]
+	offer := &deposits.Offer{
+		UpgradeVersionID: codec.UpgradeVersion1,
+		Start:            uint64(defaultGenesisTime.Unix()),
+		End:              uint64(defaultGenesisTime.Unix()) + 100,
+		MinDuration:      1,
+		MaxDuration:      100,
+		MinAmount:        deposits.OfferMinDepositAmount,
+	}
+
+	tests := map[string]struct {
+		offerCreatorAddress ids.ShortID
+		keys                []*secp256k1.PrivateKey
+		expectedErr         error
+	}{
+		"OK": {
+			offerCreatorAddress: offerCreatorKey.Address(),
+			keys:                caminoPreFundedKeys,
+		},
+		"Fail: no offer creator key": {
+			offerCreatorAddress: offerCreatorKey.Address(),
+			keys:                caminoPreFundedKeys[1:],
+			expectedErr:         errKeyMissing,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tx, err := env.txBuilder.NewAddDepositOfferTx(
+				offer,
+				tt.offerCreatorAddress,
+				tt.keys,
+				nil,
+			)
+			require.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+			utx, ok := tx.Unsigned.(*txs.AddDepositOfferTx)
+			require.True(t, ok)
+			require.Equal(t, tt.offerCreatorAddress, utx.DepositOfferCreatorAddress)
+			require.Equal(t, &secp256k1fx.Input{SigIndices: []uint32{0}}, utx.DepositOfferCreatorAuth)
+			require.Len(t, tx.Creds, len(utx.Ins)+1)
+		})
+	}
+}
+
+func TestNewTransferDepositTx(t *testing.T) {
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+		DepositOffers: []*deposits.Offer{{
+			Start:       uint64(defaultGenesisTime.Unix()),
+			End:         uint64(defaultGenesisTime.Unix()) + 100,
+			MinAmount:   1,
+			MinDuration: 60,
+			MaxDuration: 60,
+		}},
+	}
+
+	depositOwnerKey, err := testKeyfactory.NewPrivateKey()
+	require.NoError(t, err)
+	depositOwner := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{depositOwnerKey.Address()},
+	}
+	newOwner := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	depositTxID := ids.GenerateTestID()
+
+	tests := map[string]struct {
+		rewardOwner         *secp256k1fx.OutputOwners
+		keys                []*secp256k1.PrivateKey
+		expectedRewardOwner *secp256k1fx.OutputOwners
+		expectedErr         error
+	}{
+		"OK: new reward owner": {
+			rewardOwner:         &newOwner,
+			keys:                []*secp256k1.PrivateKey{depositOwnerKey},
+			expectedRewardOwner: &newOwner,
+		},
+		"OK: current reward owner": {
+			keys:                []*secp256k1.PrivateKey{depositOwnerKey},
+			expectedRewardOwner: &depositOwner,
+		},
+		"Fail: no deposit owner key": {
+			rewardOwner: &newOwner,
+			keys:        caminoPreFundedKeys,
+			expectedErr: errNoDepositUTXOs,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newCaminoEnvironment(true, caminoGenesisConf)
+			env.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(t, shutdownCaminoEnvironment(env))
+			}()
+
+			genesisOffers, err := env.state.GetAllDepositOffers()
+			require.NoError(t, err)
+
+			env.state.AddDeposit(depositTxID, &deposits.Deposit{
+				DepositOfferID: genesisOffers[0].ID,
+				Duration:       60,
+				Amount:         10,
+				Start:          uint64(defaultGenesisTime.Unix()),
+				RewardOwner:    &depositOwner,
+			})
+			env.state.AddUTXO(generateTestUTXO(ids.ID{1}, avaxAssetID, 10, depositOwner, depositTxID, ids.Empty))
+			env.state.AddUTXO(generateTestUTXO(ids.ID{2}, avaxAssetID, defaultTxFee, depositOwner, ids.Empty, ids.Empty))
+			require.NoError(t, env.state.Commit())
+
+			tx, err := env.txBuilder.NewTransferDepositTx(
+				depositTxID,
+				tt.rewardOwner,
+				tt.keys,
+				nil,
+			)
+			require.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+
+			utx, ok := tx.Unsigned.(*txs.TransferDepositTx)
+			require.True(t, ok)
+			require.Equal(t, tt.expectedRewardOwner, utx.RewardOwner)
+			require.Equal(t, &secp256k1fx.Input{SigIndices: []uint32{0}}, utx.RewardOwnerAuth)
+			require.Equal(t, &secp256k1fx.Input{}, utx.DepositOfferOwnerAuth)
+			require.Len(t, tx.Creds, len(utx.Ins)+1)
+			require.Equal(t, []*avax.TransferableOutput{{
+				Asset: avax.Asset{ID: avaxAssetID},
+				Out: &locked.Out{
+					IDs: locked.IDs{DepositTxID: depositTxID},
+					TransferableOut: &secp256k1fx.TransferOutput{
+						Amt:          10,
+						OutputOwners: *tt.expectedRewardOwner,
+					},
+				},
+			}}, utx.Outs)
+		})
+	}
+}
+
+func TestNewSetRewardRestakeTx(t *testing.T) {
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	claimableOwnerKey, err := testKeyfactory.NewPrivateKey()
+	require.NoError(t, err)
+	claimableOwner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{claimableOwnerKey.Address()},
+	}
+	depositOfferID := ids.GenerateTestID()
+
+	tests := map[string]struct {
+		keys        []*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"OK": {
+			keys: []*secp256k1.PrivateKey{caminoPreFundedKeys[0], claimableOwnerKey},
+		},
+		"Fail: no claimable owner key": {
+			keys:        []*secp256k1.PrivateKey{caminoPreFundedKeys[0]},
+			expectedErr: errKeyMissing,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			env := newCaminoEnvironment(true, caminoGenesisConf)
+			env.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(t, shutdownCaminoEnvironment(env))
+			}()
+
+			tx, err := env.txBuilder.NewSetRewardRestakeTx(
+				claimableOwner,
+				depositOfferID,
+				60,
+				tt.keys,
+				nil,
+			)
+			require.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+
+			utx, ok := tx.Unsigned.(*txs.SetRewardRestakeTx)
+			require.True(t, ok)
+			require.Equal(t, claimableOwner, utx.ClaimableOwner)
+			require.Equal(t, &secp256k1fx.Input{SigIndices: []uint32{0}}, utx.ClaimableOwnerAuth)
+			require.Equal(t, depositOfferID, utx.DepositOfferID)
+			require.Equal(t, uint32(60), utx.DepositDuration)
+			require.Len(t, tx.Creds, len(utx.Ins)+1)
+		})
+	}
+}
+
+func TestNewCaminoAddDelegatorTx(t *testing.T) {
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	env := newCaminoEnvironment(true, caminoGenesisConf)
+	env.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(t, shutdownCaminoEnvironment(env))
+	}()
+
+	nodeID := ids.GenerateTestNodeID()
+	rewardAddr := ids.GenerateTestShortID()
+	stakeAmount := env.config.MinDelegatorStake
+	startTime := uint64(defaultGenesisTime.Add(time.Second).Unix())
+	endTime := startTime + uint64(defaultMinStakingDuration/time.Second)
+
+	tx, err := env.txBuilder.NewAddDelegatorTx(
+		stakeAmount,
+		startTime,
+		endTime,
+		nodeID,
+		rewardAddr,
+		[]*secp256k1.PrivateKey{caminoPreFundedKeys[0]},
+		caminoPreFundedKeys[0].Address(),
+	)
+	require.NoError(t, err)
+
+	utx, ok := tx.Unsigned.(*txs.CaminoAddDelegatorTx)
+	require.True(t, ok)
+	require.Equal(t, txs.Validator{
+		NodeID: nodeID,
+		Start:  startTime,
+		End:    endTime,
+		Wght:   stakeAmount,
+	}, utx.Validator)
+	require.Equal(t, &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{rewardAddr},
+	}, utx.DelegationRewardsOwner)
+	require.Empty(t, utx.StakeOuts)
+
+	bonded := uint64(0)
+	for _, out := range utx.Stake() {
+		bonded += out.Out.Amount()
+	}
+	require.Equal(t, stakeAmount, bonded)
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var _ DelegatorTx = (*CaminoAddDelegatorTx)(nil)
+
+// CaminoAddDelegatorTx is an unsigned caminoAddDelegatorTx.
+// Delegated tokens are bonded with this tx id instead of being moved to stake outputs.
+type CaminoAddDelegatorTx struct {
+	AddDelegatorTx `serialize:"true"`
+}
+
+func (tx *CaminoAddDelegatorTx) Stake() []*avax.TransferableOutput {
+	var stake []*avax.TransferableOutput
+	for _, out := range tx.Outs {
+		if lockedOut, ok := out.Out.(*locked.Out); ok && lockedOut.IsNewlyLockedWith(locked.StateBonded) {
+			stake = append(stake, out)
+		}
+	}
+	return stake
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *CaminoAddDelegatorTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.Validator.NodeID == ids.EmptyNodeID:
+		return errEmptyNodeID
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+	if err := verify.All(&tx.Validator, tx.DelegationRewardsOwner); err != nil {
+		return fmt.Errorf("failed to verify validator or rewards owner: %w", err)
+	}
+
+	totalStakeWeight := uint64(0)
+	for _, out := range tx.Outs {
+		lockedOut, ok := out.Out.(*locked.Out)
+		if ok && lockedOut.IsNewlyLockedWith(locked.StateBonded) {
+			newWeight, err := math.Add64(totalStakeWeight, lockedOut.Amount())
+			if err != nil {
+				return err
+			}
+			totalStakeWeight = newWeight
+
+			if out.AssetID() != ctx.AVAXAssetID {
+				return errAssetNotAVAX
+			}
+		}
+	}
+
+	switch {
+	case len(tx.StakeOuts) > 0:
+		return errStakeOutsNotEmpty
+	case totalStakeWeight != tx.Validator.Wght:
+		return fmt.Errorf("%w: weight %d != stake %d", errDelegatorWeightMismatch, tx.Validator.Wght, totalStakeWeight)
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
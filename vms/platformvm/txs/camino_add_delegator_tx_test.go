@@ -0,0 +1,105 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestCaminoAddDelegatorTxSyntacticVerify(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	ctx.AVAXAssetID = ids.GenerateTestID()
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 0, 1}}}
+	depositTxID := ids.ID{0, 1}
+	nodeID := ids.NodeID{1}
+
+	baseTx := func(outs ...*avax.TransferableOutput) BaseTx {
+		return BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    ctx.NetworkID,
+			BlockchainID: ctx.ChainID,
+			Ins: []*avax.TransferableInput{
+				generateTestIn(ctx.AVAXAssetID, 10, ids.Empty, ids.Empty, []uint32{0}),
+			},
+			Outs: outs,
+		}}
+	}
+
+	tests := map[string]struct {
+		tx          *CaminoAddDelegatorTx
+		expectedErr error
+	}{
+		"Nil tx": {
+			expectedErr: ErrNilTx,
+		},
+		"Empty node id": {
+			tx: &CaminoAddDelegatorTx{AddDelegatorTx: AddDelegatorTx{
+				BaseTx:                 baseTx(generateTestOut(ctx.AVAXAssetID, 10, owner1, ids.Empty, locked.ThisTxID)),
+				Validator:              Validator{Start: 1, End: 2, Wght: 10},
+				DelegationRewardsOwner: &owner1,
+			}},
+			expectedErr: errEmptyNodeID,
+		},
+		"Stake outputs aren't empty": {
+			tx: &CaminoAddDelegatorTx{AddDelegatorTx: AddDelegatorTx{
+				BaseTx:                 baseTx(generateTestOut(ctx.AVAXAssetID, 10, owner1, ids.Empty, locked.ThisTxID)),
+				Validator:              Validator{NodeID: nodeID, Start: 1, End: 2, Wght: 10},
+				StakeOuts:              []*avax.TransferableOutput{generateTestOut(ctx.AVAXAssetID, 10, owner1, ids.Empty, ids.Empty)},
+				DelegationRewardsOwner: &owner1,
+			}},
+			expectedErr: errStakeOutsNotEmpty,
+		},
+		"Bonded output isn't avax": {
+			tx: &CaminoAddDelegatorTx{AddDelegatorTx: AddDelegatorTx{
+				BaseTx:                 baseTx(generateTestOut(ids.GenerateTestID(), 10, owner1, ids.Empty, locked.ThisTxID)),
+				Validator:              Validator{NodeID: nodeID, Start: 1, End: 2, Wght: 10},
+				DelegationRewardsOwner: &owner1,
+			}},
+			expectedErr: errAssetNotAVAX,
+		},
+		"Weight mismatch": {
+			tx: &CaminoAddDelegatorTx{AddDelegatorTx: AddDelegatorTx{
+				BaseTx:                 baseTx(generateTestOut(ctx.AVAXAssetID, 10, owner1, ids.Empty, locked.ThisTxID)),
+				Validator:              Validator{NodeID: nodeID, Start: 1, End: 2, Wght: 9},
+				DelegationRewardsOwner: &owner1,
+			}},
+			expectedErr: errDelegatorWeightMismatch,
+		},
+		"OK": {
+			tx: &CaminoAddDelegatorTx{AddDelegatorTx: AddDelegatorTx{
+				BaseTx:                 baseTx(generateTestOut(ctx.AVAXAssetID, 10, owner1, ids.Empty, locked.ThisTxID)),
+				Validator:              Validator{NodeID: nodeID, Start: 1, End: 2, Wght: 10},
+				DelegationRewardsOwner: &owner1,
+			}},
+		},
+		"OK: deposited stake": {
+			tx: &CaminoAddDelegatorTx{AddDelegatorTx: AddDelegatorTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestIn(ctx.AVAXAssetID, 10, depositTxID, ids.Empty, []uint32{0}),
+					},
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 10, owner1, depositTxID, locked.ThisTxID),
+					},
+				}},
+				Validator:              Validator{NodeID: nodeID, Start: 1, End: 2, Wght: 10},
+				DelegationRewardsOwner: &owner1,
+			}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.tx.SyntacticVerify(ctx), tt.expectedErr)
+		})
+	}
+}
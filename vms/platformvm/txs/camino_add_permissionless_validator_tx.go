@@ -0,0 +1,102 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+)
+
+var (
+	_ ValidatorTx = (*CaminoAddPermissionlessValidatorTx)(nil)
+
+	errPrimaryNetworkPermissionlessValidator = errors.New("camino permissionless validator can't validate primary network")
+)
+
+// CaminoAddPermissionlessValidatorTx is an unsigned caminoAddPermissionlessValidatorTx.
+// Staked subnet asset is bonded with this tx id instead of being moved to stake outputs.
+type CaminoAddPermissionlessValidatorTx struct {
+	AddPermissionlessValidatorTx `serialize:"true"`
+
+	// Auth that will be used to verify credential for [NodeOwnerAuth].
+	// If node owner address is msig-alias, auth must match real signatures.
+	NodeOwnerAuth verify.Verifiable `serialize:"true" json:"nodeOwnerAuth"`
+}
+
+func (tx *CaminoAddPermissionlessValidatorTx) Stake() []*avax.TransferableOutput {
+	var stake []*avax.TransferableOutput
+	for _, out := range tx.Outs {
+		if lockedOut, ok := out.Out.(*locked.Out); ok && lockedOut.IsNewlyLockedWith(locked.StateBonded) {
+			stake = append(stake, out)
+		}
+	}
+	return stake
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *CaminoAddPermissionlessValidatorTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.Validator.NodeID == ids.EmptyNodeID:
+		return errEmptyNodeID
+	case tx.Subnet == constants.PrimaryNetworkID:
+		return errPrimaryNetworkPermissionlessValidator
+	case tx.DelegationShares > reward.PercentDenominator:
+		return errTooManyShares
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+	if err := verify.All(&tx.Validator, tx.Signer, tx.ValidatorRewardsOwner, tx.DelegatorRewardsOwner, tx.NodeOwnerAuth); err != nil {
+		return fmt.Errorf("failed to verify validator, signer, rewards owners or node owner auth: %w", err)
+	}
+
+	if tx.Signer.Key() != nil {
+		return fmt.Errorf("%w: subnet validator can't have BLS key", errInvalidSigner)
+	}
+
+	stakedAssetID := ids.Empty
+	totalStakeWeight := uint64(0)
+	for _, out := range tx.Outs {
+		lockedOut, ok := out.Out.(*locked.Out)
+		if ok && lockedOut.IsNewlyLockedWith(locked.StateBonded) {
+			newWeight, err := math.Add64(totalStakeWeight, lockedOut.Amount())
+			if err != nil {
+				return err
+			}
+			totalStakeWeight = newWeight
+
+			assetID := out.AssetID()
+			if stakedAssetID == ids.Empty {
+				stakedAssetID = assetID
+			} else if assetID != stakedAssetID {
+				return fmt.Errorf("%w: %q and %q", errMultipleStakedAssets, stakedAssetID, assetID)
+			}
+		}
+	}
+
+	switch {
+	case len(tx.StakeOuts) > 0:
+		return errStakeOutsNotEmpty
+	case totalStakeWeight != tx.Validator.Wght:
+		return fmt.Errorf("%w: weight %d != stake %d", errValidatorWeightMismatch, tx.Validator.Wght, totalStakeWeight)
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
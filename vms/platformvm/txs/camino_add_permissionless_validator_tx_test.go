@@ -0,0 +1,136 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestCaminoAddPermissionlessValidatorTxSyntacticVerify(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	ctx.AVAXAssetID = ids.GenerateTestID()
+	subnetAssetID := ids.GenerateTestID()
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 0, 1}}}
+	subnetID := ids.ID{0, 1}
+	nodeID := ids.NodeID{1}
+
+	blsSK, err := bls.NewSecretKey()
+	require.NoError(t, err)
+
+	ins := []*avax.TransferableInput{
+		generateTestIn(ctx.AVAXAssetID, 10, ids.Empty, ids.Empty, []uint32{0}),
+		generateTestIn(subnetAssetID, 10, ids.Empty, ids.Empty, []uint32{0}),
+	}
+	avax.SortTransferableInputs(ins)
+
+	baseTx := func(outs ...*avax.TransferableOutput) BaseTx {
+		avax.SortTransferableOutputs(outs, Codec)
+		return BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    ctx.NetworkID,
+			BlockchainID: ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}}
+	}
+
+	validatorTx := func(baseTx BaseTx, subnetID ids.ID, nodeID ids.NodeID, weight uint64) *CaminoAddPermissionlessValidatorTx {
+		return &CaminoAddPermissionlessValidatorTx{
+			AddPermissionlessValidatorTx: AddPermissionlessValidatorTx{
+				BaseTx:                baseTx,
+				Validator:             Validator{NodeID: nodeID, Start: 1, End: 2, Wght: weight},
+				Subnet:                subnetID,
+				Signer:                &signer.Empty{},
+				ValidatorRewardsOwner: &owner1,
+				DelegatorRewardsOwner: &owner1,
+			},
+			NodeOwnerAuth: &secp256k1fx.Input{},
+		}
+	}
+
+	tests := map[string]struct {
+		tx          func() *CaminoAddPermissionlessValidatorTx
+		expectedErr error
+	}{
+		"Nil tx": {
+			tx:          func() *CaminoAddPermissionlessValidatorTx { return nil },
+			expectedErr: ErrNilTx,
+		},
+		"Empty node id": {
+			tx: func() *CaminoAddPermissionlessValidatorTx {
+				return validatorTx(baseTx(generateTestOut(subnetAssetID, 10, owner1, ids.Empty, locked.ThisTxID)), subnetID, ids.EmptyNodeID, 10)
+			},
+			expectedErr: errEmptyNodeID,
+		},
+		"Primary network": {
+			tx: func() *CaminoAddPermissionlessValidatorTx {
+				return validatorTx(baseTx(generateTestOut(subnetAssetID, 10, owner1, ids.Empty, locked.ThisTxID)), constants.PrimaryNetworkID, nodeID, 10)
+			},
+			expectedErr: errPrimaryNetworkPermissionlessValidator,
+		},
+		"Too many shares": {
+			tx: func() *CaminoAddPermissionlessValidatorTx {
+				tx := validatorTx(baseTx(generateTestOut(subnetAssetID, 10, owner1, ids.Empty, locked.ThisTxID)), subnetID, nodeID, 10)
+				tx.DelegationShares = reward.PercentDenominator + 1
+				return tx
+			},
+			expectedErr: errTooManyShares,
+		},
+		"Has BLS key": {
+			tx: func() *CaminoAddPermissionlessValidatorTx {
+				tx := validatorTx(baseTx(generateTestOut(subnetAssetID, 10, owner1, ids.Empty, locked.ThisTxID)), subnetID, nodeID, 10)
+				tx.Signer = signer.NewProofOfPossession(blsSK)
+				return tx
+			},
+			expectedErr: errInvalidSigner,
+		},
+		"Multiple bonded assets": {
+			tx: func() *CaminoAddPermissionlessValidatorTx {
+				return validatorTx(baseTx(
+					generateTestOut(ctx.AVAXAssetID, 5, owner1, ids.Empty, locked.ThisTxID),
+					generateTestOut(subnetAssetID, 5, owner1, ids.Empty, locked.ThisTxID),
+				), subnetID, nodeID, 10)
+			},
+			expectedErr: errMultipleStakedAssets,
+		},
+		"Stake outputs aren't empty": {
+			tx: func() *CaminoAddPermissionlessValidatorTx {
+				tx := validatorTx(baseTx(generateTestOut(subnetAssetID, 10, owner1, ids.Empty, locked.ThisTxID)), subnetID, nodeID, 10)
+				tx.StakeOuts = []*avax.TransferableOutput{generateTestOut(subnetAssetID, 10, owner1, ids.Empty, ids.Empty)}
+				return tx
+			},
+			expectedErr: errStakeOutsNotEmpty,
+		},
+		"Weight mismatch": {
+			tx: func() *CaminoAddPermissionlessValidatorTx {
+				return validatorTx(baseTx(generateTestOut(subnetAssetID, 10, owner1, ids.Empty, locked.ThisTxID)), subnetID, nodeID, 9)
+			},
+			expectedErr: errValidatorWeightMismatch,
+		},
+		"OK": {
+			tx: func() *CaminoAddPermissionlessValidatorTx {
+				return validatorTx(baseTx(
+					generateTestOut(ctx.AVAXAssetID, 9, owner1, ids.Empty, ids.Empty),
+					generateTestOut(subnetAssetID, 10, owner1, ids.Empty, locked.ThisTxID),
+				), subnetID, nodeID, 10)
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.tx().SyntacticVerify(ctx), tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var (
+	_ UnsignedTx = (*AddProposalTx)(nil)
+
+	errBadProposal            = errors.New("bad proposal")
+	errBadProposerAuth        = errors.New("bad proposer auth")
+	errEmptyProposerAddress   = errors.New("proposer address is empty")
+	errNotBondedOrUnlockedOut = errors.New("proposal tx outputs can only be bonded by this tx or not locked by this tx")
+	errBondedOutputNotAVAX    = errors.New("bonded output must be AVAX")
+	errNilProposal            = errors.New("proposal is nil")
+)
+
+// AddProposalTx is an unsigned addProposalTx
+type AddProposalTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// Proposal that will be added. Proposal bond must be locked by tx outputs with bond lock.
+	Proposal dao.Proposal `serialize:"true" json:"proposal"`
+	// Address of proposer
+	ProposerAddress ids.ShortID `serialize:"true" json:"proposerAddress"`
+	// Auth that will be used to verify credential for proposer
+	ProposerAuth verify.Verifiable `serialize:"true" json:"proposerAuth"`
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *AddProposalTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.Proposal == nil:
+		return errNilProposal
+	case tx.ProposerAddress == ids.ShortEmpty:
+		return errEmptyProposerAddress
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	if err := tx.Proposal.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errBadProposal, err)
+	}
+
+	if err := tx.ProposerAuth.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errBadProposerAuth, err)
+	}
+
+	if err := locked.VerifyLockMode(tx.Ins, tx.Outs, true); err != nil {
+		return err
+	}
+
+	for _, out := range tx.Outs {
+		lockedOut, ok := out.Out.(*locked.Out)
+		if !ok {
+			continue
+		}
+		if lockedOut.IDs.DepositTxID == locked.ThisTxID ||
+			lockedOut.IDs.BondTxID != locked.ThisTxID && lockedOut.IDs.BondTxID != ids.Empty {
+			return errNotBondedOrUnlockedOut
+		}
+		if lockedOut.IsNewlyLockedWith(locked.StateBonded) && out.AssetID() != ctx.AVAXAssetID {
+			return errBondedOutputNotAVAX
+		}
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+// BondAmount returns amount of tokens bonded by this tx outputs
+func (tx *AddProposalTx) BondAmount() (uint64, error) {
+	bondAmount := uint64(0)
+	for _, out := range tx.Outs {
+		if lockedOut, ok := out.Out.(*locked.Out); ok && lockedOut.IsNewlyLockedWith(locked.StateBonded) {
+			newBondAmount, err := math.Add64(bondAmount, lockedOut.Amount())
+			if err != nil {
+				return 0, err
+			}
+			bondAmount = newBondAmount
+		}
+	}
+	return bondAmount, nil
+}
+
+func (tx *AddProposalTx) Visit(visitor Visitor) error {
+	return visitor.AddProposalTx(tx)
+}
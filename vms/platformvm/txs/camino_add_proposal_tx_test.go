@@ -0,0 +1,165 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddProposalTxSyntacticVerify(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	ctx.AVAXAssetID = ids.GenerateTestID()
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 0, 1}}}
+	otherTxID := ids.ID{0, 1}
+	proposerAddress := ids.ShortID{1}
+	proposal := &dao.BaseFeeProposal{Start: 1, End: 2, Options: []uint64{1}}
+
+	baseTx := BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+	}}
+
+	tests := map[string]struct {
+		tx          *AddProposalTx
+		expectedErr error
+	}{
+		"Nil tx": {
+			expectedErr: ErrNilTx,
+		},
+		"Nil proposal": {
+			tx: &AddProposalTx{
+				BaseTx:          baseTx,
+				ProposerAddress: proposerAddress,
+			},
+			expectedErr: errNilProposal,
+		},
+		"Empty proposer address": {
+			tx: &AddProposalTx{
+				BaseTx:   baseTx,
+				Proposal: proposal,
+			},
+			expectedErr: errEmptyProposerAddress,
+		},
+		"Bad proposal": {
+			tx: &AddProposalTx{
+				BaseTx:          baseTx,
+				Proposal:        &dao.BaseFeeProposal{Start: 2, End: 2, Options: []uint64{1}},
+				ProposerAddress: proposerAddress,
+			},
+			expectedErr: errBadProposal,
+		},
+		"Bad proposer auth": {
+			tx: &AddProposalTx{
+				BaseTx:          baseTx,
+				Proposal:        proposal,
+				ProposerAddress: proposerAddress,
+				ProposerAuth:    (*secp256k1fx.Input)(nil),
+			},
+			expectedErr: errBadProposerAuth,
+		},
+		"Stakable base tx input": {
+			tx: &AddProposalTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestStakeableIn(ctx.AVAXAssetID, 1, 1, []uint32{0}),
+					},
+				}},
+				Proposal:        proposal,
+				ProposerAddress: proposerAddress,
+				ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			expectedErr: locked.ErrWrongInType,
+		},
+		"Newly deposited output": {
+			tx: &AddProposalTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 1, owner1, locked.ThisTxID, ids.Empty),
+					},
+				}},
+				Proposal:        proposal,
+				ProposerAddress: proposerAddress,
+				ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			expectedErr: errNotBondedOrUnlockedOut,
+		},
+		"Output bonded by other tx": {
+			tx: &AddProposalTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 1, owner1, ids.Empty, otherTxID),
+					},
+				}},
+				Proposal:        proposal,
+				ProposerAddress: proposerAddress,
+				ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			expectedErr: errNotBondedOrUnlockedOut,
+		},
+		"Bonded output isn't AVAX": {
+			tx: &AddProposalTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ids.GenerateTestID(), 1, owner1, ids.Empty, locked.ThisTxID),
+					},
+				}},
+				Proposal:        proposal,
+				ProposerAddress: proposerAddress,
+				ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			expectedErr: errBondedOutputNotAVAX,
+		},
+		"OK": {
+			tx: &AddProposalTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 1, owner1, ids.Empty, ids.Empty),
+						generateTestOut(ctx.AVAXAssetID, 1, owner1, ids.Empty, locked.ThisTxID),
+					},
+				}},
+				Proposal:        proposal,
+				ProposerAddress: proposerAddress,
+				ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.tx.SyntacticVerify(ctx), tt.expectedErr)
+		})
+	}
+}
+
+func TestAddProposalTxBondAmount(t *testing.T) {
+	avaxAssetID := ids.GenerateTestID()
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 0, 1}}}
+	tx := &AddProposalTx{BaseTx: BaseTx{BaseTx: avax.BaseTx{
+		Outs: []*avax.TransferableOutput{
+			generateTestOut(avaxAssetID, 1, owner1, ids.Empty, locked.ThisTxID),
+			generateTestOut(avaxAssetID, 10, owner1, ids.Empty, ids.Empty),
+			generateTestOut(avaxAssetID, 100, owner1, ids.Empty, locked.ThisTxID),
+		},
+	}}}
+	bondAmount, err := tx.BondAmount()
+	require.NoError(t, err)
+	require.Equal(t, uint64(101), bondAmount)
+}
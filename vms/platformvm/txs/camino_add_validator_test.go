@@ -13,6 +13,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/nodeid"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
@@ -55,7 +56,7 @@ func TestCaminoAddValidatorTxSyntacticVerify(t *testing.T) {
 		},
 		"Too many shares": {
 			preExecute: func(t *testing.T, utx *CaminoAddValidatorTx) *CaminoAddValidatorTx {
-				utx.DelegationShares++
+				utx.DelegationShares = reward.PercentDenominator + 1
 				return utx
 			},
 			expectedSpecificErr: errTooManyShares,
@@ -13,6 +13,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 )
 
 var (
@@ -48,7 +49,7 @@ func (tx *CaminoAddValidatorTx) SyntacticVerify(ctx *snow.Context) error {
 		return ErrNilTx
 	case tx.SyntacticallyVerified: // already passed syntactic verification
 		return nil
-	case tx.DelegationShares > 0:
+	case tx.DelegationShares > reward.PercentDenominator:
 		return errTooManyShares
 	case tx.Validator.NodeID == ids.EmptyNodeID:
 		return errEmptyNodeID
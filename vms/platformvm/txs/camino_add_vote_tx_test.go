@@ -0,0 +1,102 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddVoteTxSyntacticVerify(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	ctx.AVAXAssetID = ids.GenerateTestID()
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 0, 1}}}
+	proposalID := ids.ID{1}
+	voterAddress := ids.ShortID{1}
+
+	baseTx := BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+	}}
+
+	tests := map[string]struct {
+		tx          *AddVoteTx
+		expectedErr error
+	}{
+		"Nil tx": {
+			expectedErr: ErrNilTx,
+		},
+		"Empty proposal id": {
+			tx: &AddVoteTx{
+				BaseTx:       baseTx,
+				Vote:         &dao.SimpleVote{},
+				VoterAddress: voterAddress,
+			},
+			expectedErr: errEmptyProposalID,
+		},
+		"Nil vote": {
+			tx: &AddVoteTx{
+				BaseTx:       baseTx,
+				ProposalID:   proposalID,
+				VoterAddress: voterAddress,
+			},
+			expectedErr: errNilVote,
+		},
+		"Empty voter address": {
+			tx: &AddVoteTx{
+				BaseTx:     baseTx,
+				ProposalID: proposalID,
+				Vote:       &dao.SimpleVote{},
+			},
+			expectedErr: errEmptyVoterAddress,
+		},
+		"Bad voter auth": {
+			tx: &AddVoteTx{
+				BaseTx:       baseTx,
+				ProposalID:   proposalID,
+				Vote:         &dao.SimpleVote{},
+				VoterAddress: voterAddress,
+				VoterAuth:    (*secp256k1fx.Input)(nil),
+			},
+			expectedErr: errBadVoterAuth,
+		},
+		"Locked base tx output": {
+			tx: &AddVoteTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 1, owner1, ids.Empty, locked.ThisTxID),
+					},
+				}},
+				ProposalID:   proposalID,
+				Vote:         &dao.SimpleVote{},
+				VoterAddress: voterAddress,
+				VoterAuth:    &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			expectedErr: locked.ErrWrongOutType,
+		},
+		"OK": {
+			tx: &AddVoteTx{
+				BaseTx:       baseTx,
+				ProposalID:   proposalID,
+				Vote:         &dao.SimpleVote{},
+				VoterAddress: voterAddress,
+				VoterAuth:    &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.tx.SyntacticVerify(ctx), tt.expectedErr)
+		})
+	}
+}
@@ -62,6 +62,8 @@ var (
 
 	ErrEmptyAddress = errors.New("address is empty")
 	ErrInvalidState = errors.New("invalid state")
+
+	errKYCExpirationNotAllowed = errors.New("kyc expiration can only be set together with kyc verified state")
 )
 
 // AddressStateTx is an unsigned AddressStateTx
@@ -80,6 +82,9 @@ type AddressStateTx struct {
 	Executor ids.ShortID `serialize:"true" json:"executor" upgradeVersion:"1"`
 	// Signature(s) to authenticate executor
 	ExecutorAuth verify.Verifiable `serialize:"true" json:"executorAuth" upgradeVersion:"1"`
+	// Unix time after which KYC verification expires. Only allowed
+	// when KYCVerified state is set, 0 means that it never expires
+	KYCExpiration uint64 `serialize:"true" json:"kycExpiration" upgradeVersion:"2"`
 }
 
 // SyntacticVerify returns nil if [tx] is valid
@@ -104,6 +109,10 @@ func (tx *AddressStateTx) SyntacticVerify(ctx *snow.Context) error {
 		}
 	}
 
+	if tx.KYCExpiration != 0 && (tx.Remove || tx.State != AddressStateBitKYCVerified) {
+		return errKYCExpirationNotAllowed
+	}
+
 	if err := locked.VerifyNoLocks(tx.Ins, tx.Outs); err != nil {
 		return err
 	}
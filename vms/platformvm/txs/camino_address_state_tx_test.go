@@ -189,4 +189,28 @@ func TestAddressStateTxSyntacticVerify(t *testing.T) {
 	require.NoError(err)
 	err = stx.SyntacticVerify(ctx)
 	require.NoError(err)
+
+	// Upgraded v2 / kyc expiration with not kyc verified state
+	addressStateTxUpgraded.SyntacticallyVerified = false
+	addressStateTxUpgraded.UpgradeVersionID = codec.UpgradeVersion2
+	addressStateTxUpgraded.KYCExpiration = 100
+	stx, err = NewSigned(addressStateTxUpgraded, Codec, signers)
+	require.NoError(err)
+	err = stx.SyntacticVerify(ctx)
+	require.ErrorIs(err, errKYCExpirationNotAllowed)
+
+	// Upgraded v2 / kyc expiration with removed kyc verified state
+	addressStateTxUpgraded.State = AddressStateBitKYCVerified
+	addressStateTxUpgraded.Remove = true
+	stx, err = NewSigned(addressStateTxUpgraded, Codec, signers)
+	require.NoError(err)
+	err = stx.SyntacticVerify(ctx)
+	require.ErrorIs(err, errKYCExpirationNotAllowed)
+
+	// Upgraded v2 / Ok
+	addressStateTxUpgraded.Remove = false
+	stx, err = NewSigned(addressStateTxUpgraded, Codec, signers)
+	require.NoError(err)
+	err = stx.SyntacticVerify(ctx)
+	require.NoError(err)
 }
@@ -7,9 +7,11 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 )
@@ -48,10 +50,32 @@ type ClaimAmount struct {
 
 // ClaimTx is an unsigned ClaimTx
 type ClaimTx struct {
+	UpgradeVersionID codec.UpgradeVersionID
 	// Metadata, inputs and outputs
 	BaseTx `serialize:"true"`
 	// Array, describing what types and amounts of claimables.
 	Claimables []ClaimAmount `serialize:"true" json:"claimables"`
+	// Unlocked fee sponsor utxos that will be spent to pay tx fee
+	FeeSponsorIns []*avax.TransferableInput `serialize:"true" json:"feeSponsorInputs" upgradeVersion:"1"`
+	// Unlocked outputs produced from fee sponsor inputs
+	FeeSponsorOuts []*avax.TransferableOutput `serialize:"true" json:"feeSponsorOutputs" upgradeVersion:"1"`
+}
+
+// InitCtx sets the FxID fields in the inputs and outputs of this
+// [ClaimTx]. Also sets the [ctx] to the given [vm.ctx] so that
+// the addresses can be json marshalled into human readable format
+func (tx *ClaimTx) InitCtx(ctx *snow.Context) {
+	tx.BaseTx.InitCtx(ctx)
+	initFeeSponsorCtx(ctx, tx.FeeSponsorIns, tx.FeeSponsorOuts)
+}
+
+func (tx *ClaimTx) InputIDs() set.Set[ids.ID] {
+	return feeSponsorInputIDs(&tx.BaseTx, tx.FeeSponsorIns)
+}
+
+// Outputs returns base tx outputs followed by fee sponsor outputs
+func (tx *ClaimTx) Outputs() []*avax.TransferableOutput {
+	return feeSponsorOutputs(&tx.BaseTx, tx.FeeSponsorOuts)
 }
 
 // SyntacticVerify returns nil if [tx] is valid
@@ -90,6 +114,13 @@ func (tx *ClaimTx) SyntacticVerify(ctx *snow.Context) error {
 		return err
 	}
 
+	if tx.UpgradeVersionID.Version() < 1 && (len(tx.FeeSponsorIns) != 0 || len(tx.FeeSponsorOuts) != 0) {
+		return errFeeSponsorNotAllowed
+	}
+	if err := verifyFeeSponsor(&tx.BaseTx, tx.FeeSponsorIns, tx.FeeSponsorOuts); err != nil {
+		return err
+	}
+
 	// cache that this is valid
 	tx.SyntacticallyVerified = true
 	return nil
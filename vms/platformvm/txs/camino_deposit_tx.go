@@ -11,7 +11,10 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 )
@@ -23,6 +26,7 @@ var (
 	errInvalidRewardOwner    = errors.New("invalid reward owner")
 	errBadOfferOwnerAuth     = errors.New("bad offer owner auth")
 	errBadDepositCreatorAuth = errors.New("bad deposit creator auth")
+	errTooBigAllowlistProof  = errors.New("allowlist proof is too big")
 )
 
 // DepositTx is an unsigned depositTx
@@ -43,6 +47,12 @@ type DepositTx struct {
 	DepositCreatorAuth verify.Verifiable `serialize:"true" json:"depositCreatorAuth" upgradeVersion:"1"`
 	// Auth for deposit offer owner
 	DepositOfferOwnerAuth verify.Verifiable `serialize:"true" json:"ownerAuth" upgradeVersion:"1"`
+	// Merkle proof of deposit creator address inclusion into offer allowlist. Could be empty, if offer allowlist is empty.
+	AllowlistProof []ids.ID `serialize:"true" json:"allowlistProof" upgradeVersion:"2"`
+	// Unlocked fee sponsor utxos that will be spent to pay tx fee
+	FeeSponsorIns []*avax.TransferableInput `serialize:"true" json:"feeSponsorInputs" upgradeVersion:"3"`
+	// Unlocked outputs produced from fee sponsor inputs
+	FeeSponsorOuts []*avax.TransferableOutput `serialize:"true" json:"feeSponsorOutputs" upgradeVersion:"3"`
 
 	depositAmount *uint64
 }
@@ -53,6 +63,16 @@ type DepositTx struct {
 func (tx *DepositTx) InitCtx(ctx *snow.Context) {
 	tx.BaseTx.InitCtx(ctx)
 	tx.RewardsOwner.InitCtx(ctx)
+	initFeeSponsorCtx(ctx, tx.FeeSponsorIns, tx.FeeSponsorOuts)
+}
+
+func (tx *DepositTx) InputIDs() set.Set[ids.ID] {
+	return feeSponsorInputIDs(&tx.BaseTx, tx.FeeSponsorIns)
+}
+
+// Outputs returns base tx outputs followed by fee sponsor outputs
+func (tx *DepositTx) Outputs() []*avax.TransferableOutput {
+	return feeSponsorOutputs(&tx.BaseTx, tx.FeeSponsorOuts)
 }
 
 func (tx *DepositTx) DepositAmount() uint64 {
@@ -105,6 +125,17 @@ func (tx *DepositTx) SyntacticVerify(ctx *snow.Context) error {
 		}
 	}
 
+	if len(tx.AllowlistProof) > deposit.MaxAllowlistProofLength {
+		return errTooBigAllowlistProof
+	}
+
+	if tx.UpgradeVersionID.Version() < 3 && (len(tx.FeeSponsorIns) != 0 || len(tx.FeeSponsorOuts) != 0) {
+		return errFeeSponsorNotAllowed
+	}
+	if err := verifyFeeSponsor(&tx.BaseTx, tx.FeeSponsorIns, tx.FeeSponsorOuts); err != nil {
+		return err
+	}
+
 	// cache that this is valid
 	tx.SyntacticallyVerified = true
 	return nil
@@ -10,6 +10,7 @@ import (
 	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/stretchr/testify/require"
@@ -75,6 +76,21 @@ func TestDepositTxSyntacticVerify(t *testing.T) {
 			},
 			expectedErr: errBadOfferOwnerAuth,
 		},
+		"V2, too big allowlist proof": {
+			tx: &DepositTx{
+				UpgradeVersionID: codec.UpgradeVersion2,
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+				}},
+				RewardsOwner:          &secp256k1fx.OutputOwners{},
+				DepositCreatorAddress: ids.ShortID{1},
+				DepositCreatorAuth:    &secp256k1fx.Input{},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+				AllowlistProof:        make([]ids.ID, deposit.MaxAllowlistProofLength+1),
+			},
+			expectedErr: errTooBigAllowlistProof,
+		},
 		"OK: v0": {
 			tx: &DepositTx{
 				BaseTx: BaseTx{BaseTx: avax.BaseTx{
@@ -109,6 +125,20 @@ func TestDepositTxSyntacticVerify(t *testing.T) {
 				DepositOfferOwnerAuth: &secp256k1fx.Input{},
 			},
 		},
+		"OK: v2": {
+			tx: &DepositTx{
+				UpgradeVersionID: codec.UpgradeVersion2,
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+				}},
+				RewardsOwner:          &secp256k1fx.OutputOwners{},
+				DepositCreatorAddress: ids.ShortID{1},
+				DepositCreatorAuth:    &secp256k1fx.Input{},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+				AllowlistProof:        []ids.ID{{1}},
+			},
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
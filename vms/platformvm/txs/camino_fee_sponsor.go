@@ -0,0 +1,107 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	errFeeSponsorInsNotSortedUnique    = errors.New("fee sponsor inputs not sorted and unique")
+	errFeeSponsorOutsNotSorted         = errors.New("fee sponsor outputs not sorted")
+	errFeeSponsorInsOverlapWithBaseIns = errors.New("fee sponsor inputs overlap with base tx inputs")
+	errFeeSponsorOutsWithoutIns        = errors.New("fee sponsor outputs without fee sponsor inputs")
+	errFeeSponsorInOrOutFailedToVerify = errors.New("fee sponsor input or output failed verification")
+	errFeeSponsorNotAllowed            = errors.New("fee sponsor requires higher tx upgrade version")
+)
+
+func initFeeSponsorCtx(
+	ctx *snow.Context,
+	feeSponsorIns []*avax.TransferableInput,
+	feeSponsorOuts []*avax.TransferableOutput,
+) {
+	for _, in := range feeSponsorIns {
+		in.FxID = secp256k1fx.ID
+	}
+	for _, out := range feeSponsorOuts {
+		out.FxID = secp256k1fx.ID
+		out.InitCtx(ctx)
+	}
+}
+
+func feeSponsorInputIDs(tx *BaseTx, feeSponsorIns []*avax.TransferableInput) set.Set[ids.ID] {
+	inputIDs := tx.InputIDs()
+	for _, in := range feeSponsorIns {
+		inputIDs.Add(in.InputID())
+	}
+	return inputIDs
+}
+
+// feeSponsorOutputs returns base tx outputs followed by fee sponsor outputs
+func feeSponsorOutputs(tx *BaseTx, feeSponsorOuts []*avax.TransferableOutput) []*avax.TransferableOutput {
+	if len(feeSponsorOuts) == 0 {
+		return tx.Outs
+	}
+	outs := make([]*avax.TransferableOutput, 0, len(tx.Outs)+len(feeSponsorOuts))
+	outs = append(outs, tx.Outs...)
+	return append(outs, feeSponsorOuts...)
+}
+
+// verifyFeeSponsor returns nil if fee sponsor inputs and outputs are well formed
+// and don't spend the same utxos as [tx] inputs.
+//
+// Fee sponsor is another party, which pays tx fee instead of tx spender.
+// Fee sponsor inputs spend unlocked sponsor utxos and fee sponsor outputs are unlocked sponsor change.
+// Fee sponsor inputs have their own credentials, which follow all other tx credentials.
+func verifyFeeSponsor(
+	tx *BaseTx,
+	feeSponsorIns []*avax.TransferableInput,
+	feeSponsorOuts []*avax.TransferableOutput,
+) error {
+	if len(feeSponsorIns) == 0 {
+		if len(feeSponsorOuts) != 0 {
+			return errFeeSponsorOutsWithoutIns
+		}
+		return nil
+	}
+
+	for _, in := range feeSponsorIns {
+		if err := in.Verify(); err != nil {
+			return fmt.Errorf("%w: %s", errFeeSponsorInOrOutFailedToVerify, err)
+		}
+	}
+	for _, out := range feeSponsorOuts {
+		if err := out.Verify(); err != nil {
+			return fmt.Errorf("%w: %s", errFeeSponsorInOrOutFailedToVerify, err)
+		}
+	}
+
+	switch {
+	case !utils.IsSortedAndUniqueSortable(feeSponsorIns):
+		return errFeeSponsorInsNotSortedUnique
+	case !avax.IsSortedTransferableOutputs(feeSponsorOuts, Codec):
+		return errFeeSponsorOutsNotSorted
+	}
+
+	if err := locked.VerifyNoLocks(feeSponsorIns, feeSponsorOuts); err != nil {
+		return err
+	}
+
+	baseInputIDs := tx.InputIDs()
+	for _, in := range feeSponsorIns {
+		if baseInputIDs.Contains(in.InputID()) {
+			return errFeeSponsorInsOverlapWithBaseIns
+		}
+	}
+	return nil
+}
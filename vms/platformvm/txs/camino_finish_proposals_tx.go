@@ -0,0 +1,87 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var (
+	_ UnsignedTx = (*FinishProposalsTx)(nil)
+
+	errNoFinishedProposals   = errors.New("no proposals are finished")
+	errNotSortedOrUnique     = errors.New("proposal ids are not sorted or not unique")
+	errProposalInBothLists   = errors.New("proposal is both early finished and expired")
+	errUnlockedInputInSystem = errors.New("system tx has not locked input")
+)
+
+// FinishProposalsTx is an unsigned finishProposalsTx. It is issued by the system
+// and finishes proposals: applies successful proposals outcomes and unbonds proposal bonds.
+type FinishProposalsTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// IDs of proposals that were finished before their end time, because their outcome is already known
+	EarlyFinishedProposalIDs []ids.ID `serialize:"true" json:"earlyFinishedProposalIDs"`
+	// IDs of proposals that were finished, because their end time was reached
+	ExpiredProposalIDs []ids.ID `serialize:"true" json:"expiredProposalIDs"`
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *FinishProposalsTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case len(tx.EarlyFinishedProposalIDs) == 0 && len(tx.ExpiredProposalIDs) == 0:
+		return errNoFinishedProposals
+	case !utils.IsSortedAndUniqueSortable(tx.EarlyFinishedProposalIDs) ||
+		!utils.IsSortedAndUniqueSortable(tx.ExpiredProposalIDs):
+		return errNotSortedOrUnique
+	}
+
+	expiredProposalIDs := set.NewSet[ids.ID](len(tx.ExpiredProposalIDs))
+	expiredProposalIDs.Add(tx.ExpiredProposalIDs...)
+	for _, proposalID := range tx.EarlyFinishedProposalIDs {
+		if expiredProposalIDs.Contains(proposalID) {
+			return errProposalInBothLists
+		}
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	if err := locked.VerifyLockMode(tx.Ins, tx.Outs, true); err != nil {
+		return err
+	}
+
+	for _, in := range tx.Ins {
+		if lockedIn, ok := in.In.(*locked.In); !ok || !lockedIn.IsLockedWith(locked.StateBonded) {
+			return errUnlockedInputInSystem
+		}
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+// ProposalIDs returns all proposal ids finished by this tx
+func (tx *FinishProposalsTx) ProposalIDs() []ids.ID {
+	proposalIDs := make([]ids.ID, 0, len(tx.EarlyFinishedProposalIDs)+len(tx.ExpiredProposalIDs))
+	proposalIDs = append(proposalIDs, tx.EarlyFinishedProposalIDs...)
+	return append(proposalIDs, tx.ExpiredProposalIDs...)
+}
+
+func (tx *FinishProposalsTx) Visit(visitor Visitor) error {
+	return visitor.FinishProposalsTx(tx)
+}
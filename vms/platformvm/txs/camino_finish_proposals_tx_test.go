@@ -0,0 +1,91 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinishProposalsTxSyntacticVerify(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	ctx.AVAXAssetID = ids.GenerateTestID()
+	proposalID1 := ids.ID{1}
+	proposalID2 := ids.ID{2}
+
+	baseTx := BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+	}}
+
+	tests := map[string]struct {
+		tx          *FinishProposalsTx
+		expectedErr error
+	}{
+		"Nil tx": {
+			expectedErr: ErrNilTx,
+		},
+		"No proposals": {
+			tx:          &FinishProposalsTx{BaseTx: baseTx},
+			expectedErr: errNoFinishedProposals,
+		},
+		"Not sorted early finished proposals": {
+			tx: &FinishProposalsTx{
+				BaseTx:                   baseTx,
+				EarlyFinishedProposalIDs: []ids.ID{proposalID2, proposalID1},
+			},
+			expectedErr: errNotSortedOrUnique,
+		},
+		"Not unique expired proposals": {
+			tx: &FinishProposalsTx{
+				BaseTx:             baseTx,
+				ExpiredProposalIDs: []ids.ID{proposalID1, proposalID1},
+			},
+			expectedErr: errNotSortedOrUnique,
+		},
+		"Proposal is both early finished and expired": {
+			tx: &FinishProposalsTx{
+				BaseTx:                   baseTx,
+				EarlyFinishedProposalIDs: []ids.ID{proposalID1},
+				ExpiredProposalIDs:       []ids.ID{proposalID1, proposalID2},
+			},
+			expectedErr: errProposalInBothLists,
+		},
+		"Unlocked input": {
+			tx: &FinishProposalsTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestIn(ctx.AVAXAssetID, 1, ids.Empty, ids.Empty, []uint32{}),
+					},
+				}},
+				ExpiredProposalIDs: []ids.ID{proposalID1},
+			},
+			expectedErr: errUnlockedInputInSystem,
+		},
+		"OK": {
+			tx: &FinishProposalsTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestIn(ctx.AVAXAssetID, 1, ids.Empty, proposalID1, []uint32{}),
+					},
+				}},
+				EarlyFinishedProposalIDs: []ids.ID{proposalID2},
+				ExpiredProposalIDs:       []ids.ID{proposalID1},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.tx.SyntacticVerify(ctx), tt.expectedErr)
+		})
+	}
+}
@@ -7,25 +7,35 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
 var (
-	_                            UnsignedTx = (*MultisigAliasTx)(nil)
-	errFailedToVerifyAliasOrAuth            = errors.New("failed to verify alias or auth")
+	_ UnsignedTx = (*MultisigAliasTx)(nil)
+
+	errFailedToVerifyAliasOrAuth = errors.New("failed to verify alias or auth")
+	errCancelNewAliasChange      = errors.New("can't cancel pending change of new alias")
 )
 
 // MultisigAliasTx is an unsigned multisig alias tx
 type MultisigAliasTx struct {
+	// We upgrade this struct beginning Athens phase
+	UpgradeVersionID codec.UpgradeVersionID
 	// Metadata, inputs and outputs
 	BaseTx `serialize:"true"`
 	// Multisig alias definition. MultisigAlias.ID must be empty, if its the new alias
 	MultisigAlias multisig.Alias `serialize:"true" json:"multisigAlias"`
 	// Auth that allows existing owners to change an alias
 	Auth verify.Verifiable `serialize:"true" json:"auth"`
+	// If true, pending timelocked change of existing alias will be cancelled and
+	// alias definition from this tx will be ignored, except of its ID.
+	CancelPendingChange bool `serialize:"true" json:"cancelPendingChange" upgradeVersion:"1"`
 }
 
 // InitCtx sets the FxID fields in the inputs and outputs of this
@@ -51,6 +61,17 @@ func (tx *MultisigAliasTx) SyntacticVerify(ctx *snow.Context) error {
 	if err := verify.All(&tx.MultisigAlias, tx.Auth); err != nil {
 		return fmt.Errorf("%w: %s", errFailedToVerifyAliasOrAuth, err.Error())
 	}
+	if _, err := secp256k1fx.AliasOwnersChangeOwners(&tx.MultisigAlias); err != nil {
+		return fmt.Errorf("%w: %s", errFailedToVerifyAliasOrAuth, err.Error())
+	}
+	if tx.MultisigAlias.SpendLimitThreshold != 0 {
+		if _, err := secp256k1fx.AliasSpendLimitOwners(&tx.MultisigAlias); err != nil {
+			return fmt.Errorf("%w: %s", errFailedToVerifyAliasOrAuth, err.Error())
+		}
+	}
+	if tx.CancelPendingChange && tx.MultisigAlias.ID == ids.ShortEmpty {
+		return errCancelNewAliasChange
+	}
 
 	if err := locked.VerifyNoLocks(tx.Ins, tx.Outs); err != nil {
 		return err
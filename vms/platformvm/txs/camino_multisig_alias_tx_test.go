@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
@@ -92,6 +93,93 @@ func TestMultisigAliasTxSyntacticVerify(t *testing.T) {
 			},
 			expectedErr: errFailedToVerifyAliasOrAuth,
 		},
+		"Owners change threshold is bigger than owners count": {
+			tx: &MultisigAliasTx{
+				BaseTx: baseTx,
+				MultisigAlias: multisig.Alias{
+					UpgradeVersionID: codec.UpgradeVersion1,
+					ID:               ids.GenerateTestShortID(),
+					Memo:             memo,
+					Owners: &secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     sortedAddrs,
+					},
+					OwnersChangeThreshold: 3,
+				},
+				Auth: &secp256k1fx.Input{SigIndices: []uint32{0, 1}},
+			},
+			expectedErr: errFailedToVerifyAliasOrAuth,
+		},
+		"Spend limit threshold isn't less than owners threshold": {
+			tx: &MultisigAliasTx{
+				BaseTx: baseTx,
+				MultisigAlias: multisig.Alias{
+					UpgradeVersionID: codec.UpgradeVersion2,
+					ID:               ids.GenerateTestShortID(),
+					Memo:             memo,
+					Owners: &secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     sortedAddrs,
+					},
+					SpendLimitThreshold: 1,
+					SpendLimitAmount:    100,
+					SpendLimitPeriod:    3600,
+				},
+				Auth: &secp256k1fx.Input{SigIndices: []uint32{0, 1}},
+			},
+			expectedErr: errFailedToVerifyAliasOrAuth,
+		},
+		"Cancel pending change of new alias": {
+			tx: &MultisigAliasTx{
+				UpgradeVersionID: codec.UpgradeVersion1,
+				BaseTx:           baseTx,
+				MultisigAlias: multisig.Alias{
+					Memo: memo,
+					Owners: &secp256k1fx.OutputOwners{
+						Threshold: 2,
+						Addrs:     sortedAddrs,
+					},
+				},
+				Auth:                &secp256k1fx.Input{SigIndices: []uint32{0, 1}},
+				CancelPendingChange: true,
+			},
+			expectedErr: errCancelNewAliasChange,
+		},
+		"OK: owners change policy": {
+			tx: &MultisigAliasTx{
+				BaseTx: baseTx,
+				MultisigAlias: multisig.Alias{
+					UpgradeVersionID: codec.UpgradeVersion1,
+					ID:               ids.GenerateTestShortID(),
+					Memo:             memo,
+					Owners: &secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     sortedAddrs,
+					},
+					OwnersChangeThreshold: 2,
+					OwnersChangeDelay:     3600,
+				},
+				Auth: &secp256k1fx.Input{SigIndices: []uint32{0, 1}},
+			},
+		},
+		"OK: spend limit": {
+			tx: &MultisigAliasTx{
+				BaseTx: baseTx,
+				MultisigAlias: multisig.Alias{
+					UpgradeVersionID: codec.UpgradeVersion2,
+					ID:               ids.GenerateTestShortID(),
+					Memo:             memo,
+					Owners: &secp256k1fx.OutputOwners{
+						Threshold: 2,
+						Addrs:     sortedAddrs,
+					},
+					SpendLimitThreshold: 1,
+					SpendLimitAmount:    100,
+					SpendLimitPeriod:    3600,
+				},
+				Auth: &secp256k1fx.Input{SigIndices: []uint32{0, 1}},
+			},
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,375 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+// partiallySignedTxMagic prefixes binary representation of partially signed tx,
+// so it can't be confused with signed tx, which starts with codec version.
+var partiallySignedTxMagic = []byte{'p', 't', 'x', 0xff}
+
+var (
+	_ secp256k1fx.AliasGetter = aliasesGetter(nil)
+
+	ErrMissingSigners = errors.New("missing signers")
+
+	errNotPartiallySignedTx      = errors.New("not a partially signed tx")
+	errDifferentUnsignedTx       = errors.New("partially signed txs have different unsigned txs")
+	errDifferentCredentials      = errors.New("partially signed txs have different credentials owners")
+	errConflictingSignatures     = errors.New("conflicting signatures for the same signature index")
+	errWrongSignatureIndex       = errors.New("signature doesn't belong to signer with its signature index")
+	errWrongSignatureLen         = errors.New("wrong signature length")
+	errSigsAndSigIdxsLenMismatch = errors.New("signatures and signature indices have different length")
+)
+
+// PartiallySignedTx is a tx, which credentials are being collected from
+// multiple signers that don't share their keys, e.g. owners of multisig alias.
+type PartiallySignedTx struct {
+	// The body of this transaction
+	Unsigned UnsignedTx `serialize:"true" json:"unsignedTx"`
+	// One partial credential per each credential of the signed tx
+	Creds []*PartialCredential `serialize:"true" json:"credentials"`
+	// Multisig aliases referenced by credentials owners,
+	// so that signature indices could be resolved without node state
+	Aliases []*multisig.AliasWithNonce `serialize:"true" json:"aliases"`
+}
+
+type PartialCredential struct {
+	// Owners that must sign this credential
+	Owners secp256k1fx.OutputOwners `serialize:"true" json:"owners"`
+	// Collected signatures with their global signature indices, sorted by indices
+	Signatures secp256k1fx.MultisigCredential `serialize:"true" json:"signatures"`
+}
+
+// NewPartiallySignedTx creates partially signed tx without any signatures.
+// [credsOwners] must contain owners of each credential in the same order as tx credentials.
+func NewPartiallySignedTx(
+	utx UnsignedTx,
+	credsOwners []*secp256k1fx.OutputOwners,
+	msig secp256k1fx.AliasGetter,
+) (*PartiallySignedTx, error) {
+	ptx := &PartiallySignedTx{
+		Unsigned: utx,
+		Creds:    make([]*PartialCredential, len(credsOwners)),
+	}
+
+	aliases := map[ids.ShortID]*multisig.AliasWithNonce{}
+	for i, owners := range credsOwners {
+		ptx.Creds[i] = &PartialCredential{Owners: *owners}
+		if err := secp256k1fx.TraverseAliases(owners, msig, func(alias *multisig.AliasWithNonce) {
+			aliases[alias.ID] = alias
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	ptx.Aliases = make([]*multisig.AliasWithNonce, 0, len(aliases))
+	for _, alias := range aliases {
+		ptx.Aliases = append(ptx.Aliases, alias)
+	}
+	sortAliases(ptx.Aliases)
+
+	return ptx, nil
+}
+
+// ParsePartiallySignedTx parses partially signed tx from its binary representation.
+func ParsePartiallySignedTx(ptxBytes []byte) (*PartiallySignedTx, error) {
+	if !IsPartiallySignedTx(ptxBytes) {
+		return nil, errNotPartiallySignedTx
+	}
+	ptx := &PartiallySignedTx{}
+	if _, err := Codec.Unmarshal(ptxBytes[len(partiallySignedTxMagic):], ptx); err != nil {
+		return nil, fmt.Errorf("couldn't parse partially signed tx: %w", err)
+	}
+	return ptx, nil
+}
+
+// IsPartiallySignedTx returns true if [txBytes] is binary representation of partially signed tx.
+func IsPartiallySignedTx(txBytes []byte) bool {
+	return bytes.HasPrefix(txBytes, partiallySignedTxMagic)
+}
+
+// Bytes returns binary representation of this partially signed tx.
+func (ptx *PartiallySignedTx) Bytes() ([]byte, error) {
+	ptxBytes, err := Codec.Marshal(Version, ptx)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't marshal partially signed tx: %w", err)
+	}
+	return append(append([]byte{}, partiallySignedTxMagic...), ptxBytes...), nil
+}
+
+// Sign adds signatures of all signers from [kc], that are required by tx credentials.
+func (ptx *PartiallySignedTx) Sign(kc keychain.Keychain) error {
+	unsignedHash, err := ptx.unsignedHash()
+	if err != nil {
+		return err
+	}
+
+	msig := ptx.aliasGetter()
+	for credIndex, cred := range ptx.Creds {
+		sigIndices, err := secp256k1fx.OwnersSigIndices(&cred.Owners, msig)
+		if err != nil {
+			return err
+		}
+
+		sigs, err := cred.sigsMap()
+		if err != nil {
+			return fmt.Errorf("credential %d: %w", credIndex, err)
+		}
+		for addr, addrSigIndices := range sigIndices {
+			signer, ok := kc.Get(addr)
+			if !ok {
+				continue
+			}
+			var sig *[secp256k1.SignatureLen]byte
+			for _, sigIndex := range addrSigIndices {
+				if _, ok := sigs[sigIndex]; ok {
+					continue
+				}
+				if sig == nil {
+					sigBytes, err := signer.SignHash(unsignedHash)
+					if err != nil {
+						return err
+					}
+					if len(sigBytes) != secp256k1.SignatureLen {
+						return errWrongSignatureLen
+					}
+					sig = &[secp256k1.SignatureLen]byte{}
+					copy(sig[:], sigBytes)
+				}
+				sigs[sigIndex] = *sig
+			}
+		}
+		cred.setSigs(sigs)
+	}
+	return nil
+}
+
+// Merge adds signatures from [other] to this partially signed tx.
+// Both txs must have the same unsigned tx and credentials owners.
+func (ptx *PartiallySignedTx) Merge(other *PartiallySignedTx) error {
+	unsignedBytes, err := Codec.Marshal(Version, &ptx.Unsigned)
+	if err != nil {
+		return fmt.Errorf("couldn't marshal unsigned tx: %w", err)
+	}
+	otherUnsignedBytes, err := Codec.Marshal(Version, &other.Unsigned)
+	if err != nil {
+		return fmt.Errorf("couldn't marshal unsigned tx: %w", err)
+	}
+	if !bytes.Equal(unsignedBytes, otherUnsignedBytes) {
+		return errDifferentUnsignedTx
+	}
+	if len(ptx.Creds) != len(other.Creds) {
+		return errDifferentCredentials
+	}
+
+	aliases := ptx.aliasGetter()
+	newAliases := []*multisig.AliasWithNonce{}
+	for _, alias := range other.Aliases {
+		if _, ok := aliases[alias.ID]; !ok {
+			aliases[alias.ID] = alias
+			newAliases = append(newAliases, alias)
+		}
+	}
+
+	// all checks are done before modifying ptx, so it stays untouched in case of error
+	mergedSigs := make([]map[uint32][secp256k1.SignatureLen]byte, len(ptx.Creds))
+	unsignedHash := hashing.ComputeHash256(unsignedBytes)
+	secpFactory := secp256k1.Factory{}
+	for credIndex, cred := range ptx.Creds {
+		otherCred := other.Creds[credIndex]
+		if !cred.Owners.Equals(&otherCred.Owners) {
+			return errDifferentCredentials
+		}
+
+		sigIndices, err := secp256k1fx.OwnersSigIndices(&cred.Owners, aliases)
+		if err != nil {
+			return err
+		}
+		signers := make(map[uint32]ids.ShortID)
+		for addr, addrSigIndices := range sigIndices {
+			for _, sigIndex := range addrSigIndices {
+				signers[sigIndex] = addr
+			}
+		}
+
+		sigs, err := cred.sigsMap()
+		if err != nil {
+			return fmt.Errorf("credential %d: %w", credIndex, err)
+		}
+		otherSigs, err := otherCred.sigsMap()
+		if err != nil {
+			return fmt.Errorf("credential %d: %w", credIndex, err)
+		}
+		for sigIndex, otherSig := range otherSigs {
+			if sig, ok := sigs[sigIndex]; ok {
+				if sig != otherSig {
+					return fmt.Errorf("%w: credential %d, signature index %d",
+						errConflictingSignatures, credIndex, sigIndex)
+				}
+				continue
+			}
+			pk, err := secpFactory.RecoverHashPublicKey(unsignedHash, otherSig[:])
+			if err != nil {
+				return err
+			}
+			if signer, ok := signers[sigIndex]; !ok || signer != pk.Address() {
+				return fmt.Errorf("%w: credential %d, signature index %d",
+					errWrongSignatureIndex, credIndex, sigIndex)
+			}
+			sigs[sigIndex] = otherSig
+		}
+		mergedSigs[credIndex] = sigs
+	}
+
+	for credIndex, cred := range ptx.Creds {
+		cred.setSigs(mergedSigs[credIndex])
+	}
+	ptx.Aliases = append(ptx.Aliases, newAliases...)
+	sortAliases(ptx.Aliases)
+	return nil
+}
+
+// MissingSigners returns addresses that could still sign each credential,
+// which doesn't have enough signatures yet. Complete credentials are omitted.
+func (ptx *PartiallySignedTx) MissingSigners() (map[int][]ids.ShortID, error) {
+	msig := ptx.aliasGetter()
+	missingSigners := map[int][]ids.ShortID{}
+	for credIndex, cred := range ptx.Creds {
+		sigs, err := cred.sigsMap()
+		if err != nil {
+			return nil, fmt.Errorf("credential %d: %w", credIndex, err)
+		}
+		_, err = secp256k1fx.SelectSignatures(&cred.Owners, msig, sigs)
+		switch {
+		case err == secp256k1fx.ErrNotEnoughSignatures:
+		case err != nil:
+			return nil, err
+		default:
+			continue
+		}
+
+		sigIndices, err := secp256k1fx.OwnersSigIndices(&cred.Owners, msig)
+		if err != nil {
+			return nil, err
+		}
+		signers := []ids.ShortID{}
+		for addr, addrSigIndices := range sigIndices {
+			signed := false
+			for _, sigIndex := range addrSigIndices {
+				if _, ok := sigs[sigIndex]; ok {
+					signed = true
+					break
+				}
+			}
+			if !signed {
+				signers = append(signers, addr)
+			}
+		}
+		sort.Slice(signers, func(i, j int) bool {
+			return bytes.Compare(signers[i][:], signers[j][:]) < 0
+		})
+		missingSigners[credIndex] = signers
+	}
+	return missingSigners, nil
+}
+
+// SignedTx returns signed tx, if all credentials have enough signatures.
+// Otherwise returns ErrMissingSigners.
+func (ptx *PartiallySignedTx) SignedTx() (*Tx, error) {
+	missingSigners, err := ptx.MissingSigners()
+	if err != nil {
+		return nil, err
+	}
+	if len(missingSigners) > 0 {
+		return nil, fmt.Errorf("%w: %d credentials are incomplete", ErrMissingSigners, len(missingSigners))
+	}
+
+	msig := ptx.aliasGetter()
+	tx := &Tx{
+		Unsigned: ptx.Unsigned,
+		Creds:    make([]verify.Verifiable, len(ptx.Creds)),
+	}
+	for credIndex, cred := range ptx.Creds {
+		sigs, err := cred.sigsMap()
+		if err != nil {
+			return nil, fmt.Errorf("credential %d: %w", credIndex, err)
+		}
+		tx.Creds[credIndex], err = secp256k1fx.SelectSignatures(&cred.Owners, msig, sigs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tx, tx.Initialize(Codec)
+}
+
+func (ptx *PartiallySignedTx) unsignedHash() ([]byte, error) {
+	unsignedBytes, err := Codec.Marshal(Version, &ptx.Unsigned)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't marshal unsigned tx: %w", err)
+	}
+	return hashing.ComputeHash256(unsignedBytes), nil
+}
+
+func (ptx *PartiallySignedTx) aliasGetter() aliasesGetter {
+	aliases := make(aliasesGetter, len(ptx.Aliases))
+	for _, alias := range ptx.Aliases {
+		aliases[alias.ID] = alias
+	}
+	return aliases
+}
+
+func (cred *PartialCredential) sigsMap() (map[uint32][secp256k1.SignatureLen]byte, error) {
+	if len(cred.Signatures.Sigs) != len(cred.Signatures.SigIdxs) {
+		return nil, errSigsAndSigIdxsLenMismatch
+	}
+	sigs := make(map[uint32][secp256k1.SignatureLen]byte, len(cred.Signatures.Sigs))
+	for i, sigIndex := range cred.Signatures.SigIdxs {
+		sigs[sigIndex] = cred.Signatures.Sigs[i]
+	}
+	return sigs, nil
+}
+
+func (cred *PartialCredential) setSigs(sigs map[uint32][secp256k1.SignatureLen]byte) {
+	cred.Signatures.SigIdxs = make([]uint32, 0, len(sigs))
+	for sigIndex := range sigs {
+		cred.Signatures.SigIdxs = append(cred.Signatures.SigIdxs, sigIndex)
+	}
+	sort.Slice(cred.Signatures.SigIdxs, func(i, j int) bool {
+		return cred.Signatures.SigIdxs[i] < cred.Signatures.SigIdxs[j]
+	})
+	cred.Signatures.Sigs = make([][secp256k1.SignatureLen]byte, len(cred.Signatures.SigIdxs))
+	for i, sigIndex := range cred.Signatures.SigIdxs {
+		cred.Signatures.Sigs[i] = sigs[sigIndex]
+	}
+}
+
+func sortAliases(aliases []*multisig.AliasWithNonce) {
+	sort.Slice(aliases, func(i, j int) bool {
+		return bytes.Compare(aliases[i].ID[:], aliases[j].ID[:]) < 0
+	})
+}
+
+type aliasesGetter map[ids.ShortID]*multisig.AliasWithNonce
+
+func (a aliasesGetter) GetMultisigAlias(aliasID ids.ShortID) (*multisig.AliasWithNonce, error) {
+	if alias, ok := a[aliasID]; ok {
+		return alias, nil
+	}
+	return nil, database.ErrNotFound
+}
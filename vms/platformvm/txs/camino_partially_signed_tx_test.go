@@ -0,0 +1,108 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestPartiallySignedTx(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	signersKeys := caminoPreFundedKeys[:3]
+	signersAddrs := make([]ids.ShortID, len(signersKeys))
+	for i, key := range signersKeys {
+		signersAddrs[i] = key.Address()
+	}
+	utils.Sort(signersAddrs)
+
+	aliasID := ids.ShortID{1}
+	aliases := aliasesGetter{aliasID: {Alias: multisig.Alias{
+		ID:     aliasID,
+		Owners: &secp256k1fx.OutputOwners{Threshold: 2, Addrs: signersAddrs},
+	}}}
+	credOwners := &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{aliasID}}
+
+	utx := &BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+		Memo:         []byte{1},
+	}}
+
+	newPtx := func(t *testing.T) *PartiallySignedTx {
+		ptx, err := NewPartiallySignedTx(utx, []*secp256k1fx.OutputOwners{credOwners}, aliases)
+		require.NoError(t, err)
+		require.Len(t, ptx.Aliases, 1)
+		return ptx
+	}
+
+	// each signer signs its own copy
+	ptxs := make([]*PartiallySignedTx, len(signersKeys))
+	for i, key := range signersKeys {
+		ptxs[i] = newPtx(t)
+		require.NoError(t, ptxs[i].Sign(secp256k1fx.NewKeychain(key)))
+		require.Len(t, ptxs[i].Creds[0].Signatures.Sigs, 1)
+	}
+
+	// single signature isn't enough
+	missingSigners, err := ptxs[0].MissingSigners()
+	require.NoError(t, err)
+	require.Len(t, missingSigners, 1)
+	require.Len(t, missingSigners[0], 2)
+	require.NotContains(t, missingSigners[0], signersKeys[0].Address())
+	_, err = ptxs[0].SignedTx()
+	require.ErrorIs(t, err, ErrMissingSigners)
+
+	// binary roundtrip
+	ptxBytes, err := ptxs[1].Bytes()
+	require.NoError(t, err)
+	require.True(t, IsPartiallySignedTx(ptxBytes))
+	parsedPtx, err := ParsePartiallySignedTx(ptxBytes)
+	require.NoError(t, err)
+
+	// merging signatures of all signers
+	require.NoError(t, ptxs[0].Merge(parsedPtx))
+	require.NoError(t, ptxs[0].Merge(ptxs[2]))
+	require.Len(t, ptxs[0].Creds[0].Signatures.Sigs, 3)
+	missingSigners, err = ptxs[0].MissingSigners()
+	require.NoError(t, err)
+	require.Empty(t, missingSigners)
+
+	tx, err := ptxs[0].SignedTx()
+	require.NoError(t, err)
+	require.Len(t, tx.Creds, 1)
+	cred, ok := tx.Creds[0].(*secp256k1fx.MultisigCredential)
+	require.True(t, ok)
+	require.Len(t, cred.Sigs, 2) // only threshold number of signatures
+	_, err = Parse(Codec, tx.Bytes())
+	require.NoError(t, err)
+
+	// merging signature of the wrong signer
+	wrongPtx := newPtx(t)
+	wrongPtx.Creds[0].Signatures = ptxs[0].Creds[0].Signatures
+	wrongPtx.Creds[0].Signatures.SigIdxs = []uint32{1, 2, 0}
+	err = newPtx(t).Merge(wrongPtx)
+	require.ErrorIs(t, err, errWrongSignatureIndex)
+
+	// merging tx with different unsigned tx
+	otherUtx := *utx
+	otherUtx.Memo = []byte{2}
+	otherPtx, err := NewPartiallySignedTx(&otherUtx, []*secp256k1fx.OutputOwners{credOwners}, aliases)
+	require.NoError(t, err)
+	require.ErrorIs(t, ptxs[0].Merge(otherPtx), errDifferentUnsignedTx)
+
+	// merging conflicting signatures
+	conflictingPtx := newPtx(t)
+	require.NoError(t, conflictingPtx.Merge(ptxs[1]))
+	conflictingPtx.Creds[0].Signatures.Sigs[0][0]++
+	require.ErrorIs(t, ptxs[0].Merge(conflictingPtx), errConflictingSignatures)
+}
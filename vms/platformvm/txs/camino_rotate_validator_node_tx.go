@@ -0,0 +1,79 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var (
+	_ UnsignedTx = (*RotateValidatorNodeTx)(nil)
+
+	errSameNodeIDs = errors.New("old and new nodeIDs are the same")
+)
+
+// RotateValidatorNodeTx replaces node of consortium member current validator
+// without stopping validation. Validator keeps its bond, uptime and reward.
+type RotateValidatorNodeTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// Node id of validator that will be replaced
+	OldNodeID ids.NodeID `serialize:"true" json:"oldNodeID"`
+	// Node id that will validate instead of [OldNodeID]
+	NewNodeID ids.NodeID `serialize:"true" json:"newNodeID"`
+	// Auth that will be used to verify credential for [NodeOwnerAddress].
+	// If [NodeOwnerAddress] is msig-alias, auth must match real signatures.
+	NodeOwnerAuth verify.Verifiable `serialize:"true" json:"nodeOwnerAuth"`
+	// Address of consortium member to which both old and new nodes are registered
+	NodeOwnerAddress ids.ShortID `serialize:"true" json:"nodeOwnerAddress"`
+}
+
+// InitCtx sets the FxID fields in the inputs and outputs of this
+// [RotateValidatorNodeTx]. Also sets the [ctx] to the given [vm.ctx] so that
+// the addresses can be json marshalled into human readable format
+func (tx *RotateValidatorNodeTx) InitCtx(ctx *snow.Context) {
+	tx.BaseTx.InitCtx(ctx)
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *RotateValidatorNodeTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.NewNodeID == ids.EmptyNodeID || tx.OldNodeID == ids.EmptyNodeID:
+		return errNoNodeID
+	case tx.NewNodeID == tx.OldNodeID:
+		return errSameNodeIDs
+	case tx.NodeOwnerAddress == ids.ShortEmpty:
+		return errConsortiumMemberAddrEmpty
+	}
+
+	if err := locked.VerifyNoLocks(tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	if err := tx.NodeOwnerAuth.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errBadConsortiumMemberAuth, err)
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *RotateValidatorNodeTx) Visit(visitor Visitor) error {
+	return visitor.RotateValidatorNodeTx(tx)
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestRotateValidatorNodeTxSyntacticVerify(t *testing.T) {
+	ctx := defaultContext()
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 1}}}
+	depositTxID := ids.ID{1}
+
+	baseTx := BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+	}}
+
+	tests := map[string]struct {
+		tx          *RotateValidatorNodeTx
+		expectedErr error
+	}{
+		"Nil tx": {
+			expectedErr: ErrNilTx,
+		},
+		"Old nodeID empty": {
+			tx: &RotateValidatorNodeTx{
+				BaseTx:    baseTx,
+				NewNodeID: ids.NodeID{1},
+			},
+			expectedErr: errNoNodeID,
+		},
+		"New nodeID empty": {
+			tx: &RotateValidatorNodeTx{
+				BaseTx:    baseTx,
+				OldNodeID: ids.NodeID{1},
+			},
+			expectedErr: errNoNodeID,
+		},
+		"Same nodeIDs": {
+			tx: &RotateValidatorNodeTx{
+				BaseTx:    baseTx,
+				OldNodeID: ids.NodeID{1},
+				NewNodeID: ids.NodeID{1},
+			},
+			expectedErr: errSameNodeIDs,
+		},
+		"Consortium member address empty": {
+			tx: &RotateValidatorNodeTx{
+				BaseTx:    baseTx,
+				OldNodeID: ids.NodeID{1},
+				NewNodeID: ids.NodeID{2},
+			},
+			expectedErr: errConsortiumMemberAddrEmpty,
+		},
+		"Locked base tx input": {
+			tx: &RotateValidatorNodeTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestIn(ctx.AVAXAssetID, 1, depositTxID, ids.Empty, []uint32{0}),
+					},
+				}},
+				OldNodeID:        ids.NodeID{1},
+				NewNodeID:        ids.NodeID{2},
+				NodeOwnerAddress: ids.ShortID{3},
+			},
+			expectedErr: locked.ErrWrongInType,
+		},
+		"Locked base tx output": {
+			tx: &RotateValidatorNodeTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 1, owner1, depositTxID, ids.Empty),
+					},
+				}},
+				OldNodeID:        ids.NodeID{1},
+				NewNodeID:        ids.NodeID{2},
+				NodeOwnerAddress: ids.ShortID{3},
+			},
+			expectedErr: locked.ErrWrongOutType,
+		},
+		"Bad consortium member auth": {
+			tx: &RotateValidatorNodeTx{
+				BaseTx:           baseTx,
+				OldNodeID:        ids.NodeID{1},
+				NewNodeID:        ids.NodeID{2},
+				NodeOwnerAddress: ids.ShortID{3},
+				NodeOwnerAuth:    (*secp256k1fx.Input)(nil),
+			},
+			expectedErr: errBadConsortiumMemberAuth,
+		},
+		"OK": {
+			tx: &RotateValidatorNodeTx{
+				BaseTx:           baseTx,
+				OldNodeID:        ids.NodeID{1},
+				NewNodeID:        ids.NodeID{2},
+				NodeOwnerAuth:    &secp256k1fx.Input{},
+				NodeOwnerAddress: ids.ShortID{3},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.tx.SyntacticVerify(ctx), tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var (
+	_ UnsignedTx = (*SetRewardRestakeTx)(nil)
+
+	errInvalidClaimableOwner  = errors.New("invalid claimable owner")
+	errBadClaimableOwnerAuth  = errors.New("bad claimable owner auth")
+	errZeroRestakeDuration    = errors.New("restake deposit duration is zero")
+	errNotZeroRestakeDuration = errors.New("restake deposit duration isn't zero, but deposit offer id is empty")
+)
+
+// SetRewardRestakeTx is an unsigned tx, which sets or removes deposit offer,
+// that will be used to automatically deposit validator rewards of claimable owner
+type SetRewardRestakeTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// Owner of claimable, which validator rewards will be restaked
+	ClaimableOwner fx.Owner `serialize:"true" json:"claimableOwner"`
+	// Auth for claimable owner
+	ClaimableOwnerAuth verify.Verifiable `serialize:"true" json:"claimableOwnerAuth"`
+	// ID of deposit offer that will be used for restaked deposits. Empty ID disables restaking
+	DepositOfferID ids.ID `serialize:"true" json:"depositOfferID"`
+	// Duration of restaked deposits. Must be zero, if deposit offer id is empty
+	DepositDuration uint32 `serialize:"true" json:"depositDuration"`
+}
+
+// InitCtx sets the FxID fields in the inputs and outputs of this
+// [SetRewardRestakeTx]. Also sets the [ctx] to the given [vm.ctx] so that
+// the addresses can be json marshalled into human readable format
+func (tx *SetRewardRestakeTx) InitCtx(ctx *snow.Context) {
+	tx.BaseTx.InitCtx(ctx)
+	tx.ClaimableOwner.InitCtx(ctx)
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *SetRewardRestakeTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.DepositOfferID != ids.Empty && tx.DepositDuration == 0:
+		return errZeroRestakeDuration
+	case tx.DepositOfferID == ids.Empty && tx.DepositDuration != 0:
+		return errNotZeroRestakeDuration
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	if err := tx.ClaimableOwner.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errInvalidClaimableOwner, err)
+	}
+
+	if err := tx.ClaimableOwnerAuth.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errBadClaimableOwnerAuth, err)
+	}
+
+	if err := locked.VerifyNoLocks(tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *SetRewardRestakeTx) Visit(visitor Visitor) error {
+	return visitor.SetRewardRestakeTx(tx)
+}
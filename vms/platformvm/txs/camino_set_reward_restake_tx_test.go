@@ -0,0 +1,125 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestSetRewardRestakeTxSyntacticVerify(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	ctx.AVAXAssetID = ids.GenerateTestID()
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 0, 1}}}
+	depositTxID := ids.ID{0, 1}
+	offerID := ids.ID{0, 2}
+
+	baseTx := BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+	}}
+
+	tests := map[string]struct {
+		tx          *SetRewardRestakeTx
+		expectedErr error
+	}{
+		"Nil tx": {
+			expectedErr: ErrNilTx,
+		},
+		"Zero deposit duration": {
+			tx: &SetRewardRestakeTx{
+				BaseTx:             baseTx,
+				ClaimableOwner:     &owner1,
+				ClaimableOwnerAuth: &secp256k1fx.Input{},
+				DepositOfferID:     offerID,
+			},
+			expectedErr: errZeroRestakeDuration,
+		},
+		"Not zero deposit duration without deposit offer": {
+			tx: &SetRewardRestakeTx{
+				BaseTx:             baseTx,
+				ClaimableOwner:     &owner1,
+				ClaimableOwnerAuth: &secp256k1fx.Input{},
+				DepositDuration:    1,
+			},
+			expectedErr: errNotZeroRestakeDuration,
+		},
+		"Bad claimable owner": {
+			tx: &SetRewardRestakeTx{
+				BaseTx:             baseTx,
+				ClaimableOwner:     &secp256k1fx.OutputOwners{Threshold: 2},
+				ClaimableOwnerAuth: &secp256k1fx.Input{},
+				DepositOfferID:     offerID,
+				DepositDuration:    1,
+			},
+			expectedErr: errInvalidClaimableOwner,
+		},
+		"Bad claimable owner auth": {
+			tx: &SetRewardRestakeTx{
+				BaseTx:             baseTx,
+				ClaimableOwner:     &owner1,
+				ClaimableOwnerAuth: (*secp256k1fx.Input)(nil),
+				DepositOfferID:     offerID,
+				DepositDuration:    1,
+			},
+			expectedErr: errBadClaimableOwnerAuth,
+		},
+		"Locked base tx input": {
+			tx: &SetRewardRestakeTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestIn(ctx.AVAXAssetID, 1, depositTxID, ids.Empty, []uint32{0}),
+					},
+				}},
+				ClaimableOwner:     &owner1,
+				ClaimableOwnerAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: locked.ErrWrongInType,
+		},
+		"Locked base tx output": {
+			tx: &SetRewardRestakeTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 1, owner1, depositTxID, ids.Empty),
+					},
+				}},
+				ClaimableOwner:     &owner1,
+				ClaimableOwnerAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: locked.ErrWrongOutType,
+		},
+		"OK: set": {
+			tx: &SetRewardRestakeTx{
+				BaseTx:             baseTx,
+				ClaimableOwner:     &owner1,
+				ClaimableOwnerAuth: &secp256k1fx.Input{},
+				DepositOfferID:     offerID,
+				DepositDuration:    1,
+			},
+		},
+		"OK: remove": {
+			tx: &SetRewardRestakeTx{
+				BaseTx:             baseTx,
+				ClaimableOwner:     &owner1,
+				ClaimableOwnerAuth: &secp256k1fx.Input{},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.tx.SyntacticVerify(ctx), tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var (
+	_ UnsignedTx = (*SetSubnetValidatorRequirementsTx)(nil)
+
+	errPrimaryNetworkRequirements = errors.New("can't set validator requirements for primary network")
+	errBadSubnetAuth              = errors.New("bad subnet auth")
+)
+
+// SetSubnetValidatorRequirementsTx is an unsigned tx, which sets requirements
+// for node owners of validators of permissionless subnet. Requirements can only
+// be changed, while subnet isn't transformed into permissionless subnet yet.
+type SetSubnetValidatorRequirementsTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// ID of the subnet, which validators requirements will be set
+	Subnet ids.ID `serialize:"true" json:"subnetID"`
+	// Proves that the issuer has the right to modify the subnet
+	SubnetAuth verify.Verifiable `serialize:"true" json:"subnetAuthorization"`
+	// If true, validator node owner must be consortium member
+	RequireConsortiumMember bool `serialize:"true" json:"requireConsortiumMember"`
+	// If true, validator node owner must be kyc verified
+	RequireKYCVerified bool `serialize:"true" json:"requireKYCVerified"`
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *SetSubnetValidatorRequirementsTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.Subnet == constants.PrimaryNetworkID:
+		return errPrimaryNetworkRequirements
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	if err := tx.SubnetAuth.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errBadSubnetAuth, err)
+	}
+
+	if err := locked.VerifyNoLocks(tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *SetSubnetValidatorRequirementsTx) Visit(visitor Visitor) error {
+	return visitor.SetSubnetValidatorRequirementsTx(tx)
+}
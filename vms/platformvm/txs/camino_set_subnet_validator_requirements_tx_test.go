@@ -0,0 +1,97 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestSetSubnetValidatorRequirementsTxSyntacticVerify(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	ctx.AVAXAssetID = ids.GenerateTestID()
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 0, 1}}}
+	depositTxID := ids.ID{0, 1}
+	subnetID := ids.ID{0, 2}
+
+	baseTx := BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+	}}
+
+	tests := map[string]struct {
+		tx          *SetSubnetValidatorRequirementsTx
+		expectedErr error
+	}{
+		"Nil tx": {
+			expectedErr: ErrNilTx,
+		},
+		"Primary network": {
+			tx: &SetSubnetValidatorRequirementsTx{
+				BaseTx:     baseTx,
+				Subnet:     constants.PrimaryNetworkID,
+				SubnetAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errPrimaryNetworkRequirements,
+		},
+		"Bad subnet auth": {
+			tx: &SetSubnetValidatorRequirementsTx{
+				BaseTx:     baseTx,
+				Subnet:     subnetID,
+				SubnetAuth: (*secp256k1fx.Input)(nil),
+			},
+			expectedErr: errBadSubnetAuth,
+		},
+		"Locked base tx input": {
+			tx: &SetSubnetValidatorRequirementsTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestIn(ctx.AVAXAssetID, 1, depositTxID, ids.Empty, []uint32{0}),
+					},
+				}},
+				Subnet:     subnetID,
+				SubnetAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: locked.ErrWrongInType,
+		},
+		"Locked base tx output": {
+			tx: &SetSubnetValidatorRequirementsTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 1, owner1, depositTxID, ids.Empty),
+					},
+				}},
+				Subnet:     subnetID,
+				SubnetAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: locked.ErrWrongOutType,
+		},
+		"OK": {
+			tx: &SetSubnetValidatorRequirementsTx{
+				BaseTx:                  baseTx,
+				Subnet:                  subnetID,
+				SubnetAuth:              &secp256k1fx.Input{},
+				RequireConsortiumMember: true,
+				RequireKYCVerified:      true,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.tx.SyntacticVerify(ctx), tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+)
+
+var (
+	_ UnsignedTx = (*TransferDepositTx)(nil)
+
+	errEmptyDepositTxID           = errors.New("deposit tx id is empty")
+	errBadRewardOwnerAuth         = errors.New("bad reward owner auth")
+	errWrongTransferredLockIDs    = errors.New("locked input or output isn't deposited with transferred deposit or is bonded")
+	errTransferredAmountMismatch  = errors.New("transferred deposited amount doesn't match consumed deposited amount")
+	errNoTransferredDepositAmount = errors.New("no deposited tokens are transferred")
+)
+
+// TransferDepositTx is an unsigned tx, which transfers deposited tokens of existing deposit
+// and its rewards to the new owner
+type TransferDepositTx struct {
+	// Metadata, inputs and outputs. Deposited inputs and outputs must be locked
+	// only with transferred deposit, other inputs and outputs must be unlocked.
+	BaseTx `serialize:"true"`
+	// ID of deposit that will be transferred
+	DepositTxID ids.ID `serialize:"true" json:"depositTxID"`
+	// New deposit reward owner. Transferred deposited tokens must be owned by it
+	RewardOwner fx.Owner `serialize:"true" json:"rewardOwner"`
+	// Auth for current deposit reward owner
+	RewardOwnerAuth verify.Verifiable `serialize:"true" json:"rewardOwnerAuth"`
+	// Auth for deposit offer owner. Ignored, if offer owner is empty
+	DepositOfferOwnerAuth verify.Verifiable `serialize:"true" json:"depositOfferOwnerAuth"`
+}
+
+// InitCtx sets the FxID fields in the inputs and outputs of this
+// [TransferDepositTx]. Also sets the [ctx] to the given [vm.ctx] so that
+// the addresses can be json marshalled into human readable format
+func (tx *TransferDepositTx) InitCtx(ctx *snow.Context) {
+	tx.BaseTx.InitCtx(ctx)
+	tx.RewardOwner.InitCtx(ctx)
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *TransferDepositTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.DepositTxID == ids.Empty:
+		return errEmptyDepositTxID
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+	if err := tx.RewardOwner.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errInvalidRewardOwner, err)
+	}
+	if err := tx.RewardOwnerAuth.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errBadRewardOwnerAuth, err)
+	}
+	if err := tx.DepositOfferOwnerAuth.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errBadOfferOwnerAuth, err)
+	}
+
+	transferredLockIDs := locked.IDs{DepositTxID: tx.DepositTxID, BondTxID: ids.Empty}
+
+	consumedDepositedAmount := uint64(0)
+	for _, input := range tx.Ins {
+		switch in := input.In.(type) {
+		case *stakeable.LockIn:
+			return locked.ErrWrongInType
+		case *locked.In:
+			if in.IDs != transferredLockIDs {
+				return errWrongTransferredLockIDs
+			}
+			newAmount, err := math.Add64(consumedDepositedAmount, in.Amount())
+			if err != nil {
+				return err
+			}
+			consumedDepositedAmount = newAmount
+		}
+	}
+
+	producedDepositedAmount := uint64(0)
+	for _, output := range tx.Outs {
+		switch out := output.Out.(type) {
+		case *stakeable.LockOut:
+			return locked.ErrWrongOutType
+		case *locked.Out:
+			if out.IDs != transferredLockIDs {
+				return errWrongTransferredLockIDs
+			}
+			newAmount, err := math.Add64(producedDepositedAmount, out.Amount())
+			if err != nil {
+				return err
+			}
+			producedDepositedAmount = newAmount
+		}
+	}
+
+	switch {
+	case consumedDepositedAmount == 0:
+		return errNoTransferredDepositAmount
+	case consumedDepositedAmount != producedDepositedAmount:
+		return errTransferredAmountMismatch
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *TransferDepositTx) Visit(visitor Visitor) error {
+	return visitor.TransferDepositTx(tx)
+}
@@ -0,0 +1,184 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferDepositTxSyntacticVerify(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	ctx.AVAXAssetID = ids.GenerateTestID()
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 0, 1}}}
+	depositTxID := ids.ID{0, 1}
+	otherDepositTxID := ids.ID{0, 2}
+	bondTxID := ids.ID{0, 3}
+
+	baseTx := func(ins []*avax.TransferableInput, outs []*avax.TransferableOutput) BaseTx {
+		return BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    ctx.NetworkID,
+			BlockchainID: ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}}
+	}
+
+	depositedIns := []*avax.TransferableInput{
+		generateTestIn(ctx.AVAXAssetID, 2, depositTxID, ids.Empty, []uint32{0}),
+	}
+	depositedOuts := []*avax.TransferableOutput{
+		generateTestOut(ctx.AVAXAssetID, 2, owner1, depositTxID, ids.Empty),
+	}
+
+	insWithFee := []*avax.TransferableInput{
+		depositedIns[0],
+		generateTestIn(ctx.AVAXAssetID, 1, ids.Empty, ids.Empty, []uint32{0}),
+	}
+	avax.SortTransferableInputs(insWithFee)
+
+	tests := map[string]struct {
+		tx          *TransferDepositTx
+		expectedErr error
+	}{
+		"Nil tx": {
+			expectedErr: ErrNilTx,
+		},
+		"Empty deposit tx id": {
+			tx: &TransferDepositTx{
+				BaseTx: baseTx(depositedIns, depositedOuts),
+			},
+			expectedErr: errEmptyDepositTxID,
+		},
+		"Bad reward owner": {
+			tx: &TransferDepositTx{
+				BaseTx:      baseTx(depositedIns, depositedOuts),
+				DepositTxID: depositTxID,
+				RewardOwner: &secp256k1fx.OutputOwners{Threshold: 2, Addrs: []ids.ShortID{{1}}},
+			},
+			expectedErr: errInvalidRewardOwner,
+		},
+		"Bad reward owner auth": {
+			tx: &TransferDepositTx{
+				BaseTx:          baseTx(depositedIns, depositedOuts),
+				DepositTxID:     depositTxID,
+				RewardOwner:     &owner1,
+				RewardOwnerAuth: (*secp256k1fx.Input)(nil),
+			},
+			expectedErr: errBadRewardOwnerAuth,
+		},
+		"Bad deposit offer owner auth": {
+			tx: &TransferDepositTx{
+				BaseTx:                baseTx(depositedIns, depositedOuts),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &owner1,
+				RewardOwnerAuth:       &secp256k1fx.Input{},
+				DepositOfferOwnerAuth: (*secp256k1fx.Input)(nil),
+			},
+			expectedErr: errBadOfferOwnerAuth,
+		},
+		"Stakeable input": {
+			tx: &TransferDepositTx{
+				BaseTx: baseTx([]*avax.TransferableInput{
+					generateTestStakeableIn(ctx.AVAXAssetID, 1, 1, []uint32{0}),
+				}, depositedOuts),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &owner1,
+				RewardOwnerAuth:       &secp256k1fx.Input{},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: locked.ErrWrongInType,
+		},
+		"Stakeable output": {
+			tx: &TransferDepositTx{
+				BaseTx: baseTx(depositedIns, []*avax.TransferableOutput{
+					generateTestStakeableOut(ctx.AVAXAssetID, 1, 1, owner1),
+				}),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &owner1,
+				RewardOwnerAuth:       &secp256k1fx.Input{},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: locked.ErrWrongOutType,
+		},
+		"Input deposited by other deposit": {
+			tx: &TransferDepositTx{
+				BaseTx: baseTx([]*avax.TransferableInput{
+					generateTestIn(ctx.AVAXAssetID, 2, otherDepositTxID, ids.Empty, []uint32{0}),
+				}, depositedOuts),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &owner1,
+				RewardOwnerAuth:       &secp256k1fx.Input{},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errWrongTransferredLockIDs,
+		},
+		"Bonded input": {
+			tx: &TransferDepositTx{
+				BaseTx: baseTx([]*avax.TransferableInput{
+					generateTestIn(ctx.AVAXAssetID, 2, depositTxID, bondTxID, []uint32{0}),
+				}, depositedOuts),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &owner1,
+				RewardOwnerAuth:       &secp256k1fx.Input{},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errWrongTransferredLockIDs,
+		},
+		"Output deposited by other deposit": {
+			tx: &TransferDepositTx{
+				BaseTx: baseTx(depositedIns, []*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, 2, owner1, otherDepositTxID, ids.Empty),
+				}),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &owner1,
+				RewardOwnerAuth:       &secp256k1fx.Input{},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errWrongTransferredLockIDs,
+		},
+		"No deposited inputs": {
+			tx: &TransferDepositTx{
+				BaseTx:                baseTx(nil, nil),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &owner1,
+				RewardOwnerAuth:       &secp256k1fx.Input{},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errNoTransferredDepositAmount,
+		},
+		"Produced deposited amount differs from consumed": {
+			tx: &TransferDepositTx{
+				BaseTx: baseTx(depositedIns, []*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, 1, owner1, depositTxID, ids.Empty),
+				}),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &owner1,
+				RewardOwnerAuth:       &secp256k1fx.Input{},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errTransferredAmountMismatch,
+		},
+		"OK": {
+			tx: &TransferDepositTx{
+				BaseTx:                baseTx(insWithFee, depositedOuts),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &owner1,
+				RewardOwnerAuth:       &secp256k1fx.Input{},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.tx.SyntacticVerify(ctx), tt.expectedErr)
+		})
+	}
+}
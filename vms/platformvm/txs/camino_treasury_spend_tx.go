@@ -0,0 +1,144 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/vms/types"
+)
+
+// MaxTreasurySpendReferenceSize is max size of treasury spend reference in bytes
+const MaxTreasurySpendReferenceSize = 256
+
+var (
+	_ UnsignedTx = (*TreasurySpendTx)(nil)
+
+	errNoTreasuryIns                 = errors.New("no treasury inputs")
+	errEmptyTreasurySpendReference   = errors.New("treasury spend reference is empty")
+	errTreasurySpendReferenceTooBig  = errors.New("treasury spend reference is too big")
+	errTreasuryInsNotSortedUnique    = errors.New("treasury inputs not sorted and unique")
+	errTreasuryOutsNotSorted         = errors.New("treasury outputs not sorted")
+	errTreasuryInsOverlapWithBaseIns = errors.New("treasury inputs overlap with base tx inputs")
+	errBadTreasuryAdminAuth          = errors.New("bad treasury admin auth")
+	errTreasuryInOrOutFailedToVerify = errors.New("treasury input or output failed verification")
+)
+
+// TreasurySpendTx is an unsigned treasurySpendTx
+type TreasurySpendTx struct {
+	// Metadata, inputs and outputs. Inputs and outputs are used to pay fee.
+	BaseTx `serialize:"true"`
+	// Reference to off-chain decision that allows this spend, e.g. invoice or proposal id
+	Reference types.JSONByteSlice `serialize:"true" json:"reference"`
+	// Treasury utxos that will be spent
+	TreasuryIns []*avax.TransferableInput `serialize:"true" json:"treasuryInputs"`
+	// Outputs produced from treasury inputs. Outputs owned by treasury are change,
+	// other outputs are amount spent from treasury
+	TreasuryOuts []*avax.TransferableOutput `serialize:"true" json:"treasuryOutputs"`
+	// Auth that will be used to verify credential for treasury admin
+	TreasuryAdminAuth verify.Verifiable `serialize:"true" json:"treasuryAdminAuth"`
+}
+
+// InitCtx sets the FxID fields in the inputs and outputs of this
+// [TreasurySpendTx]. Also sets the [ctx] to the given [vm.ctx] so that
+// the addresses can be json marshalled into human readable format
+func (tx *TreasurySpendTx) InitCtx(ctx *snow.Context) {
+	tx.BaseTx.InitCtx(ctx)
+	for _, in := range tx.TreasuryIns {
+		in.FxID = secp256k1fx.ID
+	}
+	for _, out := range tx.TreasuryOuts {
+		out.FxID = secp256k1fx.ID
+		out.InitCtx(ctx)
+	}
+}
+
+func (tx *TreasurySpendTx) InputIDs() set.Set[ids.ID] {
+	inputIDs := tx.BaseTx.InputIDs()
+	for _, in := range tx.TreasuryIns {
+		inputIDs.Add(in.InputID())
+	}
+	return inputIDs
+}
+
+// Outputs returns base tx outputs followed by treasury outputs
+func (tx *TreasurySpendTx) Outputs() []*avax.TransferableOutput {
+	outs := make([]*avax.TransferableOutput, 0, len(tx.Outs)+len(tx.TreasuryOuts))
+	outs = append(outs, tx.Outs...)
+	return append(outs, tx.TreasuryOuts...)
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *TreasurySpendTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case len(tx.Reference) == 0:
+		return errEmptyTreasurySpendReference
+	case len(tx.Reference) > MaxTreasurySpendReferenceSize:
+		return errTreasurySpendReferenceTooBig
+	case len(tx.TreasuryIns) == 0:
+		return errNoTreasuryIns
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	for _, out := range tx.TreasuryOuts {
+		if err := out.Verify(); err != nil {
+			return fmt.Errorf("%w: %s", errTreasuryInOrOutFailedToVerify, err)
+		}
+	}
+	for _, in := range tx.TreasuryIns {
+		if err := in.Verify(); err != nil {
+			return fmt.Errorf("%w: %s", errTreasuryInOrOutFailedToVerify, err)
+		}
+	}
+
+	switch {
+	case !avax.IsSortedTransferableOutputs(tx.TreasuryOuts, Codec):
+		return errTreasuryOutsNotSorted
+	case !utils.IsSortedAndUniqueSortable(tx.TreasuryIns):
+		return errTreasuryInsNotSortedUnique
+	}
+
+	baseInputIDs := tx.BaseTx.InputIDs()
+	for _, in := range tx.TreasuryIns {
+		if baseInputIDs.Contains(in.InputID()) {
+			return errTreasuryInsOverlapWithBaseIns
+		}
+	}
+
+	if err := tx.TreasuryAdminAuth.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errBadTreasuryAdminAuth, err)
+	}
+
+	if err := locked.VerifyNoLocks(tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+
+	if err := locked.VerifyNoLocks(tx.TreasuryIns, tx.TreasuryOuts); err != nil {
+		return err
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *TreasurySpendTx) Visit(visitor Visitor) error {
+	return visitor.TreasurySpendTx(tx)
+}
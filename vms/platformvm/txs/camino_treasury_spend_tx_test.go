@@ -0,0 +1,164 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestTreasurySpendTxSyntacticVerify(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	ctx.AVAXAssetID = ids.GenerateTestID()
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 0, 1}}}
+	depositTxID := ids.ID{0, 1}
+	reference := []byte{1}
+
+	baseTx := BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+	}}
+	treasuryIn1 := generateTestIn(ctx.AVAXAssetID, 10, ids.Empty, ids.Empty, []uint32{})
+	treasuryIn2 := generateTestIn(ctx.AVAXAssetID, 20, ids.Empty, ids.Empty, []uint32{})
+	treasuryIn1.TxID = ids.ID{1}
+	treasuryIn2.TxID = ids.ID{2}
+	treasuryOut := generateTestOut(ctx.AVAXAssetID, 30, owner1, ids.Empty, ids.Empty)
+
+	tests := map[string]struct {
+		tx          *TreasurySpendTx
+		expectedErr error
+	}{
+		"Nil tx": {
+			expectedErr: ErrNilTx,
+		},
+		"Empty reference": {
+			tx: &TreasurySpendTx{
+				BaseTx:            baseTx,
+				TreasuryIns:       []*avax.TransferableInput{treasuryIn1},
+				TreasuryAdminAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errEmptyTreasurySpendReference,
+		},
+		"Too big reference": {
+			tx: &TreasurySpendTx{
+				BaseTx:            baseTx,
+				Reference:         make([]byte, MaxTreasurySpendReferenceSize+1),
+				TreasuryIns:       []*avax.TransferableInput{treasuryIn1},
+				TreasuryAdminAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errTreasurySpendReferenceTooBig,
+		},
+		"No treasury inputs": {
+			tx: &TreasurySpendTx{
+				BaseTx:            baseTx,
+				Reference:         reference,
+				TreasuryAdminAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errNoTreasuryIns,
+		},
+		"Treasury inputs not sorted": {
+			tx: &TreasurySpendTx{
+				BaseTx:            baseTx,
+				Reference:         reference,
+				TreasuryIns:       []*avax.TransferableInput{treasuryIn2, treasuryIn1},
+				TreasuryAdminAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errTreasuryInsNotSortedUnique,
+		},
+		"Treasury input overlaps with base tx input": {
+			tx: &TreasurySpendTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins:          []*avax.TransferableInput{treasuryIn1},
+				}},
+				Reference:         reference,
+				TreasuryIns:       []*avax.TransferableInput{treasuryIn1},
+				TreasuryAdminAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errTreasuryInsOverlapWithBaseIns,
+		},
+		"Bad treasury admin auth": {
+			tx: &TreasurySpendTx{
+				BaseTx:            baseTx,
+				Reference:         reference,
+				TreasuryIns:       []*avax.TransferableInput{treasuryIn1},
+				TreasuryAdminAuth: (*secp256k1fx.Input)(nil),
+			},
+			expectedErr: errBadTreasuryAdminAuth,
+		},
+		"Locked base tx input": {
+			tx: &TreasurySpendTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestIn(ctx.AVAXAssetID, 1, depositTxID, ids.Empty, []uint32{0}),
+					},
+				}},
+				Reference:         reference,
+				TreasuryIns:       []*avax.TransferableInput{treasuryIn2},
+				TreasuryAdminAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: locked.ErrWrongInType,
+		},
+		"Locked treasury input": {
+			tx: &TreasurySpendTx{
+				BaseTx:    baseTx,
+				Reference: reference,
+				TreasuryIns: []*avax.TransferableInput{
+					generateTestIn(ctx.AVAXAssetID, 1, depositTxID, ids.Empty, []uint32{}),
+				},
+				TreasuryAdminAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: locked.ErrWrongInType,
+		},
+		"Locked treasury output": {
+			tx: &TreasurySpendTx{
+				BaseTx:      baseTx,
+				Reference:   reference,
+				TreasuryIns: []*avax.TransferableInput{treasuryIn1},
+				TreasuryOuts: []*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, 10, owner1, depositTxID, ids.Empty),
+				},
+				TreasuryAdminAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: locked.ErrWrongOutType,
+		},
+		"OK": {
+			tx: &TreasurySpendTx{
+				BaseTx:            baseTx,
+				Reference:         reference,
+				TreasuryIns:       []*avax.TransferableInput{treasuryIn1, treasuryIn2},
+				TreasuryOuts:      []*avax.TransferableOutput{treasuryOut},
+				TreasuryAdminAuth: &secp256k1fx.Input{},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.tx.SyntacticVerify(ctx), tt.expectedErr)
+		})
+	}
+}
+
+func TestTreasurySpendTxOutputs(t *testing.T) {
+	owner := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{1}}}
+	baseOut := generateTestOut(ids.ID{1}, 1, owner, ids.Empty, ids.Empty)
+	treasuryOut := generateTestOut(ids.ID{1}, 2, owner, ids.Empty, ids.Empty)
+	tx := &TreasurySpendTx{
+		BaseTx: BaseTx{BaseTx: avax.BaseTx{
+			Outs: []*avax.TransferableOutput{baseOut},
+		}},
+		TreasuryOuts: []*avax.TransferableOutput{treasuryOut},
+	}
+	require.Equal(t, []*avax.TransferableOutput{baseOut, treasuryOut}, tx.Outputs())
+}
@@ -6,6 +6,7 @@ package txs
 import (
 	"fmt"
 
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 )
@@ -14,8 +15,12 @@ var _ UnsignedTx = (*UnlockDepositTx)(nil)
 
 // UnlockDepositTx is an unsigned unlockDepositTx
 type UnlockDepositTx struct {
+	UpgradeVersionID codec.UpgradeVersionID
 	// Metadata, inputs and outputs
 	BaseTx `serialize:"true"`
+	// If true, deposits will be fully unlocked before the end of their unlock period.
+	// Deposit rewards will be forfeited and early unlock penalty will be sent to treasury.
+	Force bool `serialize:"true" json:"force" upgradeVersion:"1"`
 }
 
 // SyntacticVerify returns nil if [tx] is valid
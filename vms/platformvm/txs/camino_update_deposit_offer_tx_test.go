@@ -0,0 +1,127 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateDepositOfferTxSyntacticVerify(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	ctx.AVAXAssetID = ids.GenerateTestID()
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 0, 1}}}
+	depositTxID := ids.ID{0, 1}
+	offerID := ids.ID{0, 2}
+	updaterAddress := ids.ShortID{1}
+
+	baseTx := BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+	}}
+
+	tests := map[string]struct {
+		tx          *UpdateDepositOfferTx
+		expectedErr error
+	}{
+		"Nil tx": {
+			expectedErr: ErrNilTx,
+		},
+		"Empty deposit offer id": {
+			tx: &UpdateDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferUpdaterAddress: updaterAddress,
+			},
+			expectedErr: errEmptyDepositOfferID,
+		},
+		"Empty deposit offer updater address": {
+			tx: &UpdateDepositOfferTx{
+				BaseTx:         baseTx,
+				DepositOfferID: offerID,
+			},
+			expectedErr: errEmptyDepositOfferUpdaterAddress,
+		},
+		"Unknown flags": {
+			tx: &UpdateDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferID:             offerID,
+				Flags:                      0b10,
+				DepositOfferUpdaterAddress: updaterAddress,
+			},
+			expectedErr: errWrongDepositOfferFlags,
+		},
+		"Both limits": {
+			tx: &UpdateDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferID:             offerID,
+				TotalMaxAmount:             1,
+				TotalMaxRewardAmount:       1,
+				DepositOfferUpdaterAddress: updaterAddress,
+			},
+			expectedErr: errWrongDepositOfferUpdateLimitValues,
+		},
+		"Bad deposit offer updater auth": {
+			tx: &UpdateDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferID:             offerID,
+				DepositOfferUpdaterAddress: updaterAddress,
+				DepositOfferUpdaterAuth:    (*secp256k1fx.Input)(nil),
+			},
+			expectedErr: errBadDepositOfferUpdaterAuth,
+		},
+		"Locked base tx input": {
+			tx: &UpdateDepositOfferTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestIn(ctx.AVAXAssetID, 1, depositTxID, ids.Empty, []uint32{0}),
+					},
+				}},
+				DepositOfferID:             offerID,
+				DepositOfferUpdaterAddress: updaterAddress,
+				DepositOfferUpdaterAuth:    &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			expectedErr: locked.ErrWrongInType,
+		},
+		"Locked base tx output": {
+			tx: &UpdateDepositOfferTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 1, owner1, depositTxID, ids.Empty),
+					},
+				}},
+				DepositOfferID:             offerID,
+				DepositOfferUpdaterAddress: updaterAddress,
+				DepositOfferUpdaterAuth:    &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			expectedErr: locked.ErrWrongOutType,
+		},
+		"OK": {
+			tx: &UpdateDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferID:             offerID,
+				Flags:                      deposit.OfferFlagLocked,
+				End:                        1,
+				TotalMaxRewardAmount:       1,
+				DepositOfferUpdaterAddress: updaterAddress,
+				DepositOfferUpdaterAuth:    &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.tx.SyntacticVerify(ctx), tt.expectedErr)
+		})
+	}
+}
@@ -13,4 +13,13 @@ type CaminoVisitor interface {
 	BaseTx(*BaseTx) error
 	MultisigAliasTx(*MultisigAliasTx) error
 	AddDepositOfferTx(*AddDepositOfferTx) error
+	AddProposalTx(*AddProposalTx) error
+	AddVoteTx(*AddVoteTx) error
+	FinishProposalsTx(*FinishProposalsTx) error
+	UpdateDepositOfferTx(*UpdateDepositOfferTx) error
+	TransferDepositTx(*TransferDepositTx) error
+	SetRewardRestakeTx(*SetRewardRestakeTx) error
+	SetSubnetValidatorRequirementsTx(*SetSubnetValidatorRequirementsTx) error
+	TreasurySpendTx(*TreasurySpendTx) error
+	RotateValidatorNodeTx(*RotateValidatorNodeTx) error
 }
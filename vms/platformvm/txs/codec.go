@@ -20,6 +20,7 @@ import (
 	"github.com/ava-labs/avalanchego/codec/linearcodec"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
@@ -122,6 +123,29 @@ func RegisterUnsignedTxsTypes(targetCodec codec.CaminoRegistry) error {
 		targetCodec.RegisterCustomType(&multisig.AliasWithNonce{}),
 		targetCodec.RegisterCustomType(&secp256k1fx.CrossTransferOutput{}),
 		targetCodec.RegisterCustomType(&AddDepositOfferTx{}),
+		targetCodec.RegisterCustomType(&AddProposalTx{}),
+		targetCodec.RegisterCustomType(&AddVoteTx{}),
+		targetCodec.RegisterCustomType(&FinishProposalsTx{}),
+		targetCodec.RegisterCustomType(&dao.SimpleVote{}),
+		targetCodec.RegisterCustomType(&dao.BaseFeeProposal{}),
+		targetCodec.RegisterCustomType(&dao.BaseFeeProposalState{}),
+		targetCodec.RegisterCustomType(&dao.AddMemberProposal{}),
+		targetCodec.RegisterCustomType(&dao.AddMemberProposalState{}),
+		targetCodec.RegisterCustomType(&dao.ExcludeMemberProposal{}),
+		targetCodec.RegisterCustomType(&dao.ExcludeMemberProposalState{}),
+		targetCodec.RegisterCustomType(&UpdateDepositOfferTx{}),
+		targetCodec.RegisterCustomType(&TransferDepositTx{}),
+		targetCodec.RegisterCustomType(&SetRewardRestakeTx{}),
+		targetCodec.RegisterCustomType(&CaminoAddDelegatorTx{}),
+		targetCodec.RegisterCustomType(&CaminoAddPermissionlessValidatorTx{}),
+		targetCodec.RegisterCustomType(&SetSubnetValidatorRequirementsTx{}),
+		targetCodec.RegisterCustomType(&locked.VestingOut{}),
+		targetCodec.RegisterCustomType(&TreasurySpendTx{}),
+		targetCodec.RegisterCustomType(&RotateValidatorNodeTx{}),
+		targetCodec.RegisterCustomType(&dao.ReinstateValidatorProposal{}),
+		targetCodec.RegisterCustomType(&dao.ReinstateValidatorProposalState{}),
+		targetCodec.RegisterCustomType(&dao.TreasuryConfigProposal{}),
+		targetCodec.RegisterCustomType(&dao.TreasuryConfigProposalState{}),
 	)
 	return errs.Err
 }
@@ -4,16 +4,22 @@
 package executor
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/nodeid"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/platformvm/api"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
@@ -407,3 +413,200 @@ func deferValidator(env *caminoEnvironment, nodeOwnerAddress ids.ShortID, key *s
 
 	return tx, nil
 }
+
+func TestCaminoAdvanceTimeToKYCExpiration(t *testing.T) {
+	address1 := ids.ShortID{1}
+	address2 := ids.ShortID{2}
+	address3 := ids.ShortID{3}
+	newChainTime := time.Unix(100, 0)
+	testErr := errors.New("test err")
+
+	tests := map[string]struct {
+		state           func(*gomock.Controller) *state.MockChain
+		expectedChanges map[ids.ShortID]txs.AddressState
+		expectedErr     error
+	}{
+		"Fail: state errored": {
+			state: func(c *gomock.Controller) *state.MockChain {
+				s := state.NewMockChain(c)
+				s.EXPECT().GetNextToExpireKYCAddressesAndTime(set.Set[ids.ShortID]{}).
+					Return(nil, time.Time{}, testErr)
+				return s
+			},
+			expectedErr: testErr,
+		},
+		"OK: no kyc expirations": {
+			state: func(c *gomock.Controller) *state.MockChain {
+				s := state.NewMockChain(c)
+				s.EXPECT().GetNextToExpireKYCAddressesAndTime(set.Set[ids.ShortID]{}).
+					Return(nil, mockable.MaxTime, database.ErrNotFound)
+				return s
+			},
+		},
+		"OK: kyc expiration after new chain time": {
+			state: func(c *gomock.Controller) *state.MockChain {
+				s := state.NewMockChain(c)
+				s.EXPECT().GetNextToExpireKYCAddressesAndTime(set.Set[ids.ShortID]{}).
+					Return([]ids.ShortID{address1}, newChainTime.Add(time.Second), nil)
+				return s
+			},
+		},
+		"OK: kyc expired": {
+			state: func(c *gomock.Controller) *state.MockChain {
+				s := state.NewMockChain(c)
+				s.EXPECT().GetNextToExpireKYCAddressesAndTime(set.Set[ids.ShortID]{}).
+					Return([]ids.ShortID{address1, address2}, newChainTime.Add(-time.Second), nil)
+				s.EXPECT().GetAddressStates(address1).
+					Return(txs.AddressStateKYCVerified|txs.AddressStateConsortiumMember, nil)
+				s.EXPECT().GetAddressStates(address2).
+					Return(txs.AddressStateKYCVerified, nil)
+				s.EXPECT().GetNextToExpireKYCAddressesAndTime(set.Set[ids.ShortID]{address1: {}, address2: {}}).
+					Return([]ids.ShortID{address3}, newChainTime, nil)
+				s.EXPECT().GetAddressStates(address3).
+					Return(txs.AddressStateKYCVerified, nil)
+				s.EXPECT().GetNextToExpireKYCAddressesAndTime(set.Set[ids.ShortID]{address1: {}, address2: {}, address3: {}}).
+					Return(nil, mockable.MaxTime, database.ErrNotFound)
+				return s
+			},
+			expectedChanges: map[ids.ShortID]txs.AddressState{
+				address1: txs.AddressStateKYCExpired | txs.AddressStateConsortiumMember,
+				address2: txs.AddressStateKYCExpired,
+				address3: txs.AddressStateKYCExpired,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			changes := &stateChanges{}
+			err := expireKYCAddresses(tt.state(ctrl), newChainTime, changes)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expectedChanges, changes.expiredKYCAddressStates)
+			require.Equal(t, len(tt.expectedChanges), changes.Len())
+		})
+	}
+}
+
+func TestCaminoStateChangesApplyKYCExpiration(t *testing.T) {
+	address1 := ids.ShortID{1}
+	address2 := ids.ShortID{2}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	changes := &caminoStateChanges{
+		expiredKYCAddressStates: map[ids.ShortID]txs.AddressState{
+			address1: txs.AddressStateKYCExpired | txs.AddressStateConsortiumMember,
+			address2: txs.AddressStateKYCExpired,
+		},
+	}
+
+	s := state.NewMockDiff(ctrl)
+	for address, addressStates := range changes.expiredKYCAddressStates {
+		s.EXPECT().SetAddressStates(address, addressStates)
+		s.EXPECT().SetKYCExpiration(address, uint64(0))
+		s.EXPECT().AddAddressStateChange(address, &state.AddressStateChange{
+			Bit:    txs.AddressStateBitKYCVerified,
+			Remove: true,
+		})
+		s.EXPECT().AddAddressStateChange(address, &state.AddressStateChange{
+			Bit: txs.AddressStateBitKYCExpired,
+		})
+	}
+
+	changes.Apply(s)
+}
+
+func TestCaminoAdvanceTimeToMultisigAliasChange(t *testing.T) {
+	newChainTime := time.Unix(100, 0)
+	testErr := errors.New("test err")
+
+	newAlias := func(aliasID ids.ShortID, ownerAddr ids.ShortID, nonce uint64) *multisig.AliasWithNonce {
+		return &multisig.AliasWithNonce{
+			Alias: multisig.Alias{
+				ID: aliasID,
+				Owners: &secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{ownerAddr},
+				},
+			},
+			Nonce: nonce,
+		}
+	}
+	alias1 := newAlias(ids.ShortID{1}, ids.ShortID{11}, 0)
+	alias2 := newAlias(ids.ShortID{2}, ids.ShortID{12}, 5)
+	alias1Change := &multisig.AliasChange{
+		Alias:         newAlias(alias1.ID, ids.ShortID{21}, 0).Alias,
+		EffectiveTime: uint64(newChainTime.Unix()) - 1,
+	}
+	alias2Change := &multisig.AliasChange{
+		Alias:         newAlias(alias2.ID, ids.ShortID{22}, 0).Alias,
+		EffectiveTime: uint64(newChainTime.Unix()),
+	}
+
+	tests := map[string]struct {
+		state           func(*gomock.Controller) *state.MockChain
+		expectedChanges map[ids.ShortID]*multisig.AliasWithNonce
+		expectedErr     error
+	}{
+		"Fail: state errored": {
+			state: func(c *gomock.Controller) *state.MockChain {
+				s := state.NewMockChain(c)
+				s.EXPECT().GetNextToApplyMultisigAliasChangesAndTime(set.Set[ids.ShortID]{}).
+					Return(nil, time.Time{}, testErr)
+				return s
+			},
+			expectedErr: testErr,
+		},
+		"OK: no alias changes": {
+			state: func(c *gomock.Controller) *state.MockChain {
+				s := state.NewMockChain(c)
+				s.EXPECT().GetNextToApplyMultisigAliasChangesAndTime(set.Set[ids.ShortID]{}).
+					Return(nil, mockable.MaxTime, database.ErrNotFound)
+				return s
+			},
+		},
+		"OK: alias change after new chain time": {
+			state: func(c *gomock.Controller) *state.MockChain {
+				s := state.NewMockChain(c)
+				s.EXPECT().GetNextToApplyMultisigAliasChangesAndTime(set.Set[ids.ShortID]{}).
+					Return([]ids.ShortID{alias1.ID}, newChainTime.Add(time.Second), nil)
+				return s
+			},
+		},
+		"OK: alias changes took effect": {
+			state: func(c *gomock.Controller) *state.MockChain {
+				s := state.NewMockChain(c)
+				s.EXPECT().GetNextToApplyMultisigAliasChangesAndTime(set.Set[ids.ShortID]{}).
+					Return([]ids.ShortID{alias1.ID}, time.Unix(int64(alias1Change.EffectiveTime), 0), nil)
+				s.EXPECT().GetMultisigAlias(alias1.ID).Return(alias1, nil)
+				s.EXPECT().GetMultisigAliasChange(alias1.ID).Return(alias1Change, nil)
+				s.EXPECT().GetNextToApplyMultisigAliasChangesAndTime(set.Set[ids.ShortID]{alias1.ID: {}}).
+					Return([]ids.ShortID{alias2.ID}, newChainTime, nil)
+				s.EXPECT().GetMultisigAlias(alias2.ID).Return(alias2, nil)
+				s.EXPECT().GetMultisigAliasChange(alias2.ID).Return(alias2Change, nil)
+				s.EXPECT().GetNextToApplyMultisigAliasChangesAndTime(set.Set[ids.ShortID]{alias1.ID: {}, alias2.ID: {}}).
+					Return(nil, mockable.MaxTime, database.ErrNotFound)
+				return s
+			},
+			expectedChanges: map[ids.ShortID]*multisig.AliasWithNonce{
+				alias1.ID: {Alias: alias1Change.Alias, Nonce: 1},
+				alias2.ID: {Alias: alias2Change.Alias, Nonce: 6},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			changes := &stateChanges{}
+			err := applyMultisigAliasChanges(tt.state(ctrl), newChainTime, changes)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expectedChanges, changes.changedMultisigAliases)
+			require.Equal(t, len(tt.expectedChanges), changes.Len())
+		})
+	}
+}
@@ -11,7 +11,7 @@ import (
 )
 
 // GetNextChainEventTime returns the next chain event time
-// For example: stakers set changed, deposit expired
+// For example: stakers set changed, deposit expired, proposal expired, kyc expired, msig alias changed
 func GetNextChainEventTime(state state.Chain, stakerChangeTime time.Time) (time.Time, error) {
 	earliestTime := stakerChangeTime
 	nextDeferredStakerEndTime, err := getNextDeferredStakerEndTime(state)
@@ -32,6 +32,33 @@ func GetNextChainEventTime(state state.Chain, stakerChangeTime time.Time) (time.
 		earliestTime = depositUnlockTime
 	}
 
+	proposalExpirationTime, err := state.GetNextProposalExpirationTime(nil)
+	if err != nil && err != database.ErrNotFound {
+		return time.Time{}, err
+	}
+
+	if err != database.ErrNotFound && proposalExpirationTime.Before(earliestTime) {
+		earliestTime = proposalExpirationTime
+	}
+
+	kycExpirationTime, err := state.GetNextKYCExpirationTime(nil)
+	if err != nil && err != database.ErrNotFound {
+		return time.Time{}, err
+	}
+
+	if err != database.ErrNotFound && kycExpirationTime.Before(earliestTime) {
+		earliestTime = kycExpirationTime
+	}
+
+	multisigAliasChangeTime, err := state.GetNextMultisigAliasChangeTime(nil)
+	if err != nil && err != database.ErrNotFound {
+		return time.Time{}, err
+	}
+
+	if err != database.ErrNotFound && multisigAliasChangeTime.Before(earliestTime) {
+		earliestTime = multisigAliasChangeTime
+	}
+
 	return earliestTime, nil
 }
 
@@ -0,0 +1,223 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+var (
+	_ dao.VerifierVisitor = (*proposalVerifier)(nil)
+	_ dao.ExecutorVisitor = (*proposalExecutor)(nil)
+
+	errAlreadyConsortiumMember = errors.New("address is already consortium member")
+	errNotNodeOwnerProposer    = errors.New("proposer isn't node owner")
+	errValidatorNotDeferred    = errors.New("validator isn't deferred")
+)
+
+type proposalVerifier struct {
+	state         state.Chain
+	addProposalTx *txs.AddProposalTx
+}
+
+type proposalExecutor struct {
+	state state.Chain
+	// ID of tx that finishes proposal
+	txID ids.ID
+}
+
+func (e *CaminoStandardTxExecutor) proposalVerifier(tx *txs.AddProposalTx) *proposalVerifier {
+	return &proposalVerifier{state: e.State, addProposalTx: tx}
+}
+
+func (e *CaminoStandardTxExecutor) proposalExecutor() *proposalExecutor {
+	return &proposalExecutor{state: e.State, txID: e.Tx.ID()}
+}
+
+// BaseFeeProposal
+
+func (*proposalVerifier) BaseFeeProposal(*dao.BaseFeeProposal) error {
+	return nil
+}
+
+func (e *proposalExecutor) BaseFeeProposal(proposal *dao.BaseFeeProposalState) error {
+	e.state.SetBaseFee(proposal.Fee())
+	return nil
+}
+
+// AddMemberProposal
+
+func (e *proposalVerifier) AddMemberProposal(proposal *dao.AddMemberProposal) error {
+	applicantAddressState, err := e.state.GetAddressStates(proposal.ApplicantAddress)
+	if err != nil {
+		return err
+	}
+
+	if applicantAddressState&txs.AddressStateConsortiumMember != 0 {
+		return errAlreadyConsortiumMember
+	}
+
+	return nil
+}
+
+func (e *proposalExecutor) AddMemberProposal(proposal *dao.AddMemberProposalState) error {
+	addressState, err := e.state.GetAddressStates(proposal.ApplicantAddress)
+	if err != nil {
+		return err
+	}
+
+	if newAddressState := addressState | txs.AddressStateConsortiumMember; newAddressState != addressState {
+		e.state.SetAddressStates(proposal.ApplicantAddress, newAddressState)
+		e.state.AddAddressStateChange(proposal.ApplicantAddress, &state.AddressStateChange{
+			TxID: e.txID,
+			Bit:  txs.AddressStateBitConsortium,
+		})
+	}
+	return nil
+}
+
+// ExcludeMemberProposal
+
+func (e *proposalVerifier) ExcludeMemberProposal(proposal *dao.ExcludeMemberProposal) error {
+	memberAddressState, err := e.state.GetAddressStates(proposal.MemberAddress)
+	if err != nil {
+		return err
+	}
+
+	if memberAddressState&txs.AddressStateConsortiumMember == 0 {
+		return errNotConsortiumMember
+	}
+
+	return nil
+}
+
+func (e *proposalExecutor) ExcludeMemberProposal(proposal *dao.ExcludeMemberProposalState) error {
+	addressState, err := e.state.GetAddressStates(proposal.MemberAddress)
+	if err != nil {
+		return err
+	}
+
+	if newAddressState := addressState &^ txs.AddressStateConsortiumMember; newAddressState != addressState {
+		e.state.SetAddressStates(proposal.MemberAddress, newAddressState)
+		e.state.AddAddressStateChange(proposal.MemberAddress, &state.AddressStateChange{
+			TxID:   e.txID,
+			Bit:    txs.AddressStateBitConsortium,
+			Remove: true,
+		})
+	}
+	return nil
+}
+
+// ReinstateValidatorProposal
+
+func (e *proposalVerifier) ReinstateValidatorProposal(proposal *dao.ReinstateValidatorProposal) error {
+	if e.addProposalTx.ProposerAddress != proposal.NodeOwnerAddress {
+		return errNotNodeOwnerProposer
+	}
+
+	nodeShortID, err := e.state.GetShortIDLink(proposal.NodeOwnerAddress, state.ShortLinkKeyRegisterNode)
+	if err == database.ErrNotFound {
+		return errNodeNotRegistered
+	} else if err != nil {
+		return err
+	}
+
+	if _, err := e.state.GetDeferredValidator(constants.PrimaryNetworkID, ids.NodeID(nodeShortID)); err == database.ErrNotFound {
+		return errValidatorNotDeferred
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Validator could be already reactivated by admin or removed after its staking period ended,
+// while proposal was active. In that case, successful proposal has no effect.
+func (e *proposalExecutor) ReinstateValidatorProposal(proposal *dao.ReinstateValidatorProposalState) error {
+	nodeShortID, err := e.state.GetShortIDLink(proposal.NodeOwnerAddress, state.ShortLinkKeyRegisterNode)
+	if err == database.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	stakerToReactivate, err := e.state.GetDeferredValidator(constants.PrimaryNetworkID, ids.NodeID(nodeShortID))
+	if err == database.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	e.state.DeleteDeferredValidator(stakerToReactivate)
+	e.state.SetDeferredValidatorInfo(stakerToReactivate.TxID, nil)
+	e.state.PutCurrentValidator(stakerToReactivate)
+
+	addressState, err := e.state.GetAddressStates(proposal.NodeOwnerAddress)
+	if err != nil {
+		return err
+	}
+
+	if newAddressState := addressState &^ txs.AddressStateNodeDeferred; newAddressState != addressState {
+		e.state.SetAddressStates(proposal.NodeOwnerAddress, newAddressState)
+		e.state.AddAddressStateChange(proposal.NodeOwnerAddress, &state.AddressStateChange{
+			TxID:   e.txID,
+			Bit:    txs.AddressStateBitNodeDeferred,
+			Remove: true,
+		})
+	}
+	return nil
+}
+
+// TreasuryConfigProposal
+
+func (*proposalVerifier) TreasuryConfigProposal(*dao.TreasuryConfigProposal) error {
+	return nil
+}
+
+// Treasury spent amounts are stored by spend period start, so after spend period change
+// amount spent in current period is counted only if new period starts at the same time.
+func (e *proposalExecutor) TreasuryConfigProposal(proposal *dao.TreasuryConfigProposalState) error {
+	e.state.SetTreasuryConfig(proposal.Admin, proposal.SpendLimit, proposal.SpendPeriod)
+	return nil
+}
+
+// GetFinishedProposalIDs returns ids of proposals that must be finished at [chainTime]:
+// proposals, which outcome is already known, and proposals, which end time is [chainTime].
+// Proposal that is both early finished and expired is returned only as expired.
+func GetFinishedProposalIDs(state state.Chain, chainTime time.Time) ([]ids.ID, []ids.ID, error) {
+	var expiredProposalIDs []ids.ID
+	nextToExpireProposalIDs, nextExpirationTime, err := state.GetNextToExpireProposalIDsAndTime(nil)
+	switch {
+	case err != nil && err != database.ErrNotFound:
+		return nil, nil, err
+	case err == nil && nextExpirationTime.Equal(chainTime):
+		expiredProposalIDs = nextToExpireProposalIDs
+	}
+
+	proposalIDsToFinish, err := state.GetProposalIDsToFinish()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	expiredProposalIDsSet := set.NewSet[ids.ID](len(expiredProposalIDs))
+	expiredProposalIDsSet.Add(expiredProposalIDs...)
+
+	var earlyFinishedProposalIDs []ids.ID
+	for _, proposalID := range proposalIDsToFinish {
+		if !expiredProposalIDsSet.Contains(proposalID) {
+			earlyFinishedProposalIDs = append(earlyFinishedProposalIDs, proposalID)
+		}
+	}
+
+	return earlyFinishedProposalIDs, expiredProposalIDs, nil
+}
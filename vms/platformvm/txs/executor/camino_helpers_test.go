@@ -682,6 +682,7 @@ func expectVerifyLock(
 	addrs []ids.ShortID,
 	aliases []*multisig.AliasWithNonce,
 ) {
+	s.EXPECT().GetBaseFee().Return(defaultTxFee, nil)
 	expectGetUTXOsFromInputs(s, ins, utxos)
 	expectGetMultisigAliases(s, addrs, aliases)
 }
@@ -693,6 +694,7 @@ func expectVerifyUnlockDeposit(
 	addrs []ids.ShortID,
 	aliases []*multisig.AliasWithNonce, //nolint:unparam
 ) {
+	s.EXPECT().GetBaseFee().Return(defaultTxFee, nil)
 	expectGetUTXOsFromInputs(s, ins, utxos)
 	expectGetMultisigAliases(s, addrs, aliases)
 }
@@ -6,23 +6,121 @@ package executor
 import (
 	"time"
 
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 )
 
-type caminoStateChanges struct{}
+type caminoStateChanges struct {
+	// address states of addresses, which kyc verification expired
+	expiredKYCAddressStates map[ids.ShortID]txs.AddressState
+	// multisig aliases, which timelocked changes took effect
+	changedMultisigAliases map[ids.ShortID]*multisig.AliasWithNonce
+}
 
-func (*caminoStateChanges) Apply(_ state.Diff) {
+func (cs *caminoStateChanges) Apply(stateDiff state.Diff) {
+	for address, addressStates := range cs.expiredKYCAddressStates {
+		stateDiff.SetAddressStates(address, addressStates)
+		stateDiff.SetKYCExpiration(address, 0)
+		// kyc expiration isn't caused by any tx, so txID and executor are empty
+		stateDiff.AddAddressStateChange(address, &state.AddressStateChange{
+			Bit:    txs.AddressStateBitKYCVerified,
+			Remove: true,
+		})
+		stateDiff.AddAddressStateChange(address, &state.AddressStateChange{
+			Bit: txs.AddressStateBitKYCExpired,
+		})
+	}
+	for aliasID, alias := range cs.changedMultisigAliases {
+		stateDiff.SetMultisigAlias(alias)
+		stateDiff.SetMultisigAliasChange(aliasID, nil)
+	}
 }
 
-func (*caminoStateChanges) Len() int {
-	return 0
+func (cs *caminoStateChanges) Len() int {
+	return len(cs.expiredKYCAddressStates) + len(cs.changedMultisigAliases)
 }
 
 func caminoAdvanceTimeTo(
 	_ *Backend,
-	_ state.Chain,
-	_ time.Time,
-	_ *stateChanges,
+	parentState state.Chain,
+	newChainTime time.Time,
+	changes *stateChanges,
 ) error {
-	return nil
+	if err := expireKYCAddresses(parentState, newChainTime, changes); err != nil {
+		return err
+	}
+	return applyMultisigAliasChanges(parentState, newChainTime, changes)
+}
+
+func expireKYCAddresses(
+	parentState state.Chain,
+	newChainTime time.Time,
+	changes *stateChanges,
+) error {
+	expiredKYCAddresses := set.Set[ids.ShortID]{}
+	for {
+		addresses, expirationTime, err := parentState.GetNextToExpireKYCAddressesAndTime(expiredKYCAddresses)
+		switch {
+		case err == database.ErrNotFound:
+			return nil
+		case err != nil:
+			return err
+		case expirationTime.After(newChainTime):
+			return nil
+		}
+
+		if changes.expiredKYCAddressStates == nil {
+			changes.expiredKYCAddressStates = make(map[ids.ShortID]txs.AddressState, len(addresses))
+		}
+		for _, address := range addresses {
+			addressStates, err := parentState.GetAddressStates(address)
+			if err != nil {
+				return err
+			}
+			changes.expiredKYCAddressStates[address] = addressStates&^txs.AddressStateKYCVerified | txs.AddressStateKYCExpired
+			expiredKYCAddresses.Add(address)
+		}
+	}
+}
+
+func applyMultisigAliasChanges(
+	parentState state.Chain,
+	newChainTime time.Time,
+	changes *stateChanges,
+) error {
+	changedAliasIDs := set.Set[ids.ShortID]{}
+	for {
+		aliasIDs, changeTime, err := parentState.GetNextToApplyMultisigAliasChangesAndTime(changedAliasIDs)
+		switch {
+		case err == database.ErrNotFound:
+			return nil
+		case err != nil:
+			return err
+		case changeTime.After(newChainTime):
+			return nil
+		}
+
+		if changes.changedMultisigAliases == nil {
+			changes.changedMultisigAliases = make(map[ids.ShortID]*multisig.AliasWithNonce, len(aliasIDs))
+		}
+		for _, aliasID := range aliasIDs {
+			alias, err := parentState.GetMultisigAlias(aliasID)
+			if err != nil {
+				return err
+			}
+			change, err := parentState.GetMultisigAliasChange(aliasID)
+			if err != nil {
+				return err
+			}
+			changes.changedMultisigAliases[aliasID] = &multisig.AliasWithNonce{
+				Alias: change.Alias,
+				Nonce: alias.Nonce + 1,
+			}
+			changedAliasIDs.Add(aliasID)
+		}
+	}
 }
@@ -4,20 +4,28 @@
 package executor
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 
 	"github.com/ava-labs/avalanchego/chains/atomic"
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -66,18 +74,58 @@ var (
 	errNoUnlock                          = errors.New("no tokens unlocked")
 	errAliasCredentialMismatch           = errors.New("alias credential isn't matching")
 	errAliasNotFound                     = errors.New("alias not found on state")
+	errAliasChangePending                = errors.New("alias already has pending change")
+	errNoPendingAliasChange              = errors.New("alias has no pending change")
 	errUnlockedMoreThanAvailable         = errors.New("unlocked more deposited tokens than was available for unlock")
 	errMixedDeposits                     = errors.New("tx has expired deposit input and active-deposit/unlocked input")
 	errExpiredDepositNotFullyUnlocked    = errors.New("unlocked only part of expired deposit")
 	errBurnedDepositUnlock               = errors.New("burned undeposited tokens")
 	errAdminCannotBeDeleted              = errors.New("admin cannot be deleted")
+	errKYCExpirationInThePast            = errors.New("kyc expiration is not after chain time")
 	errNotAthensPhase                    = errors.New("not allowed before AthensPhase")
+	errNotBerlinPhase                    = errors.New("not allowed before BerlinPhase")
 	errOfferCreatorCredentialMismatch    = errors.New("offer creator credential isn't matching")
 	errNotOfferCreator                   = errors.New("address isn't allowed to create deposit offers")
 	errDepositCreatorCredentialMismatch  = errors.New("deposit creator credential isn't matching")
 	errOfferPermissionCredentialMismatch = errors.New("offer-usage permission credential isn't matching")
 	errEmptyDepositCreatorAddress        = errors.New("empty deposit creator address, while offer owner isn't empty")
 	errWrongTxUpgradeVersion             = errors.New("wrong tx upgrade version")
+	errProposalStartToEarly              = errors.New("proposal start time is to early")
+	errWrongProposalBondAmount           = errors.New("wrong proposal bond amount")
+	errProposerCredentialMismatch        = errors.New("proposer credential isn't matching")
+	errProposalInactive                  = errors.New("proposal is inactive")
+	errVoterCredentialMismatch           = errors.New("voter credential isn't matching")
+	errExpiredProposalsMismatch          = errors.New("expired proposals mismatch")
+	errEarlyFinishedProposalsMismatch    = errors.New("early finished proposals mismatch")
+	errNotOfferUpdater                   = errors.New("address isn't allowed to update this deposit offer")
+	errOfferUpdaterCredentialMismatch    = errors.New("offer updater credential isn't matching")
+	errWrongDepositOfferEnd              = errors.New("deposit offer end can't be before chain time or after current offer end")
+	errWrongDepositOfferLimits           = errors.New("deposit offer limits can only be lowered, but not below already used amount")
+	errDepositExpired                    = errors.New("deposit is expired")
+	errRewardOwnerCredentialMismatch     = errors.New("reward owner credential isn't matching")
+	errOfferOwnerCredentialMismatch      = errors.New("offer owner credential isn't matching")
+	errTransferredUTXOMismatch           = errors.New("transferred input doesn't match deposited utxo")
+	errDepositNotFullyTransferred        = errors.New("transferred only part of deposit")
+	errTransferredToWrongOwner           = errors.New("transferred deposited tokens aren't owned by new reward owner")
+	errRestakeOfferHasOwner              = errors.New("deposit offer with owner can't be used for reward restaking")
+	errRestakeOfferHasEligibilityRules   = errors.New("deposit offer with eligibility rules can't be used for reward restaking")
+	errDepositCreatorNotEligible         = errors.New("deposit creator address state doesn't match offer required address state")
+	errDepositCreatorNotAllowlisted      = errors.New("deposit creator isn't in offer allowlist")
+	errDepositCreatorLimitExceeded       = errors.New("deposit creator total deposited amount exceeds offer max address amount")
+	errNotKYCVerified                    = errors.New("address isn't kyc verified")
+	errEarlyUnlockNotAllowed             = errors.New("deposit offer doesn't allow early unlock")
+	errForceUnlockExpiredDeposit         = errors.New("expired deposit can't be force-unlocked")
+	errForceUnlockNotFull                = errors.New("force-unlocked only part of deposit")
+	errWrongEarlyUnlockPenalty           = errors.New("early unlock penalty produced to treasury doesn't match expected penalty")
+	errForceUnlockNotBalanced            = errors.New("force-unlock produced more tokens than consumed minus fee")
+	errTreasuryAdminNotSet               = errors.New("treasury admin isn't set")
+	errTreasuryAdminCredentialMismatch   = errors.New("treasury admin credential isn't matching")
+	errNotTreasuryUTXO                   = errors.New("treasury input doesn't spend unlocked treasury utxo")
+	errWrongTreasuryOutType              = errors.New("treasury output isn't unlocked secp256k1fx output")
+	errTreasurySpendNotBalanced          = errors.New("treasury outputs amount doesn't match treasury inputs amount")
+	errNoTreasurySpend                   = errors.New("nothing is spent from treasury")
+	errTreasurySpendLimitExceeded        = errors.New("treasury spend limit for current period exceeded")
+	errRotatedNodeHasStakers             = errors.New("rotated node has delegators or subnet validators")
 )
 
 type CaminoStandardTxExecutor struct {
@@ -288,15 +336,136 @@ func (e *CaminoStandardTxExecutor) AddDelegatorTx(tx *txs.AddDelegatorTx) error
 		return err
 	}
 
-	if caminoConfig.LockModeBondDeposit {
+	_, isCaminoTx := e.Tx.Unsigned.(*txs.CaminoAddDelegatorTx)
+
+	switch {
+	case caminoConfig.LockModeBondDeposit && !isCaminoTx:
 		return errWrongTxType
+	case !caminoConfig.LockModeBondDeposit && isCaminoTx:
+		return errWrongLockMode
 	}
 
 	if err := locked.VerifyLockMode(tx.Ins, tx.Outs, caminoConfig.LockModeBondDeposit); err != nil {
 		return err
 	}
 
-	return e.StandardTxExecutor.AddDelegatorTx(tx)
+	if !isCaminoTx {
+		return e.StandardTxExecutor.AddDelegatorTx(tx)
+	}
+
+	// verify camino tx
+
+	if err := e.Tx.SyntacticVerify(e.Backend.Ctx); err != nil {
+		return err
+	}
+
+	currentTimestamp := e.State.GetTimestamp()
+	if !e.Config.IsBerlinPhaseActivated(currentTimestamp) {
+		return errNotBerlinPhase
+	}
+
+	// verify delegator
+
+	duration := tx.Validator.Duration()
+
+	switch {
+	case duration < e.Backend.Config.MinStakeDuration:
+		// Ensure staking length is not too short
+		return errStakeTooShort
+	case duration > e.Backend.Config.MaxStakeDuration:
+		// Ensure staking length is not too long
+		return errStakeTooLong
+	case tx.Validator.Wght < e.Backend.Config.MinDelegatorStake:
+		// Ensure delegator is staking at least the minimum amount
+		return errWeightTooSmall
+	}
+
+	txID := e.Tx.ID()
+	newStaker, err := state.NewPendingStaker(txID, tx)
+	if err != nil {
+		return err
+	}
+
+	if e.Backend.Bootstrapped.Get() {
+		// Ensure the proposed delegator starts after the current time
+		startTime := tx.StartTime()
+		if !currentTimestamp.Before(startTime) {
+			return fmt.Errorf(
+				"%w: %s >= %s",
+				errTimestampNotBeforeStartTime,
+				currentTimestamp,
+				startTime,
+			)
+		}
+
+		// Deferred validators can't be delegated to
+		validator, err := GetValidator(e.State, constants.PrimaryNetworkID, tx.Validator.NodeID)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to fetch the primary network validator for %s: %w",
+				tx.Validator.NodeID,
+				err,
+			)
+		}
+
+		maximumWeight, err := math.Mul64(MaxValidatorWeightFactor, validator.Weight)
+		if err != nil {
+			return errStakeOverflow
+		}
+		maximumWeight = math.Min(maximumWeight, e.Backend.Config.MaxValidatorStake)
+
+		canDelegate, err := canDelegate(e.State, validator, maximumWeight, newStaker)
+		if err != nil {
+			return err
+		}
+		if !canDelegate {
+			return errOverDelegated
+		}
+
+		rewardOwner, ok := tx.DelegationRewardsOwner.(*secp256k1fx.OutputOwners)
+		if !ok {
+			return errWrongOwnerType
+		}
+
+		if err := e.Fx.VerifyMultisigOwner(
+			&secp256k1fx.TransferOutput{
+				OutputOwners: *rewardOwner,
+			}, e.State,
+		); err != nil {
+			return err
+		}
+
+		baseFee, err := e.State.GetBaseFee()
+		if err != nil {
+			return err
+		}
+
+		// Verify the flowcheck
+		if err := e.Backend.FlowChecker.VerifyLock(
+			tx,
+			e.State,
+			tx.Ins,
+			tx.Outs,
+			e.Tx.Creds,
+			0,
+			baseFee,
+			e.Backend.Ctx.AVAXAssetID,
+			locked.StateBonded,
+		); err != nil {
+			return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+		}
+
+		// Make sure the tx doesn't start too far in the future. This is done last
+		// to allow the verifier visitor to explicitly check for this error.
+		maxStartTime := currentTimestamp.Add(MaxFutureStartTime)
+		if startTime.After(maxStartTime) {
+			return errFutureStakeTime
+		}
+	}
+
+	e.State.PutPendingDelegator(newStaker)
+	avax.Consume(e.State, tx.Ins)
+	return utxo.ProduceLocked(e.State, txID, tx.Outs, locked.StateBonded)
 }
 
 func (e *CaminoStandardTxExecutor) AddPermissionlessValidatorTx(tx *txs.AddPermissionlessValidatorTx) error {
@@ -305,15 +474,254 @@ func (e *CaminoStandardTxExecutor) AddPermissionlessValidatorTx(tx *txs.AddPermi
 		return err
 	}
 
-	if caminoConfig.LockModeBondDeposit {
+	caminoTx, isCaminoTx := e.Tx.Unsigned.(*txs.CaminoAddPermissionlessValidatorTx)
+
+	switch {
+	case caminoConfig.LockModeBondDeposit && !isCaminoTx:
 		return errWrongTxType
+	case !caminoConfig.LockModeBondDeposit && isCaminoTx:
+		return errWrongLockMode
 	}
 
 	if err := locked.VerifyLockMode(tx.Ins, tx.Outs, caminoConfig.LockModeBondDeposit); err != nil {
 		return err
 	}
 
-	return e.StandardTxExecutor.AddPermissionlessValidatorTx(tx)
+	if !caminoConfig.LockModeBondDeposit {
+		return e.StandardTxExecutor.AddPermissionlessValidatorTx(tx)
+	}
+
+	// verify camino tx
+
+	if err := e.Tx.SyntacticVerify(e.Backend.Ctx); err != nil {
+		return err
+	}
+
+	if !e.Config.IsBerlinPhaseActivated(e.State.GetTimestamp()) {
+		return errNotBerlinPhase
+	}
+
+	if len(e.Tx.Creds) < 2 {
+		return errWrongCredentialsNumber
+	}
+
+	// verify that node owner signed this tx and matches subnet requirements
+
+	nodeOwnerAddress, err := e.State.GetShortIDLink(
+		ids.ShortID(tx.NodeID()),
+		state.ShortLinkKeyRegisterNode,
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errNodeNotRegistered, err)
+	}
+
+	if err := e.Backend.Fx.VerifyMultisigPermission(
+		e.Tx.Unsigned,
+		caminoTx.NodeOwnerAuth,
+		e.Tx.Creds[len(e.Tx.Creds)-1], // node owner cred
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{nodeOwnerAddress},
+		},
+		e.State,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errSignatureMissing, err)
+	}
+
+	if err := e.verifySubnetValidatorRequirements(tx.Subnet, nodeOwnerAddress); err != nil {
+		return err
+	}
+
+	// verify validator
+
+	validatorRules, err := getValidatorRules(e.Backend, e.State, tx.Subnet)
+	if err != nil {
+		return err
+	}
+
+	duration := tx.Validator.Duration()
+	stakedAssetID := caminoTx.Stake()[0].AssetID()
+	switch {
+	case tx.Validator.Wght < validatorRules.minValidatorStake:
+		// Ensure validator is staking at least the minimum amount
+		return errWeightTooSmall
+	case tx.Validator.Wght > validatorRules.maxValidatorStake:
+		// Ensure validator isn't staking too much
+		return errWeightTooLarge
+	case tx.DelegationShares < validatorRules.minDelegationFee:
+		// Ensure the validator fee is at least the minimum amount
+		return errInsufficientDelegationFee
+	case duration < validatorRules.minStakeDuration:
+		// Ensure staking length is not too short
+		return errStakeTooShort
+	case duration > validatorRules.maxStakeDuration:
+		// Ensure staking length is not too long
+		return errStakeTooLong
+	case stakedAssetID != validatorRules.assetID:
+		// Wrong assetID used
+		return fmt.Errorf(
+			"%w: %s != %s",
+			errWrongStakedAssetID,
+			validatorRules.assetID,
+			stakedAssetID,
+		)
+	}
+
+	if e.Backend.Bootstrapped.Get() {
+		currentTimestamp := e.State.GetTimestamp()
+		// Ensure the proposed validator starts after the current time
+		startTime := tx.StartTime()
+		if !currentTimestamp.Before(startTime) {
+			return fmt.Errorf(
+				"%w: %s >= %s",
+				errTimestampNotBeforeStartTime,
+				currentTimestamp,
+				startTime,
+			)
+		}
+
+		if err := validatorExists(e.State, tx.Subnet, tx.Validator.NodeID); err != nil {
+			return err
+		}
+
+		primaryNetworkValidator, err := GetValidator(e.State, constants.PrimaryNetworkID, tx.Validator.NodeID)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to fetch the primary network validator for %s: %w",
+				tx.Validator.NodeID,
+				err,
+			)
+		}
+
+		// Ensure that the period this validator validates the specified subnet
+		// is a subset of the time they validate the primary network.
+		if !tx.Validator.BoundedBy(primaryNetworkValidator.StartTime, primaryNetworkValidator.EndTime) {
+			return errValidatorSubset
+		}
+
+		rewardOwner, ok := tx.ValidatorRewardsOwner.(*secp256k1fx.OutputOwners)
+		if !ok {
+			return errWrongOwnerType
+		}
+
+		if err := e.Fx.VerifyMultisigOwner(
+			&secp256k1fx.TransferOutput{
+				OutputOwners: *rewardOwner,
+			}, e.State,
+		); err != nil {
+			return err
+		}
+
+		// Verify the flowcheck: subnet asset is bonded and avax fee is burned
+		if err := e.verifyLockByAsset(
+			tx,
+			tx.Ins,
+			tx.Outs,
+			e.Tx.Creds[:len(e.Tx.Creds)-1],
+			map[ids.ID]uint64{e.Ctx.AVAXAssetID: e.Config.AddSubnetValidatorFee},
+			locked.StateBonded,
+		); err != nil {
+			return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+		}
+
+		// Make sure the tx doesn't start too far in the future. This is done last
+		// to allow the verifier visitor to explicitly check for this error.
+		maxStartTime := currentTimestamp.Add(MaxFutureStartTime)
+		if startTime.After(maxStartTime) {
+			return errFutureStakeTime
+		}
+	}
+
+	txID := e.Tx.ID()
+	newStaker, err := state.NewPendingStaker(txID, tx)
+	if err != nil {
+		return err
+	}
+	e.State.PutPendingValidator(newStaker)
+	avax.Consume(e.State, tx.Ins)
+	return utxo.ProduceLocked(e.State, txID, tx.Outs, locked.StateBonded)
+}
+
+// verifySubnetValidatorRequirements verifies that [nodeOwnerAddress] address states
+// match validator requirements of subnet, if there are any.
+func (e *CaminoStandardTxExecutor) verifySubnetValidatorRequirements(subnetID ids.ID, nodeOwnerAddress ids.ShortID) error {
+	requirements, err := e.State.GetSubnetValidatorRequirements(subnetID)
+	if err == database.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	nodeOwnerAddressState, err := e.State.GetAddressStates(nodeOwnerAddress)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case requirements.RequireConsortiumMember && nodeOwnerAddressState&txs.AddressStateConsortiumMember == 0:
+		return errNotConsortiumMember
+	case requirements.RequireKYCVerified && nodeOwnerAddressState&txs.AddressStateKYCVerified == 0:
+		return errNotKYCVerified
+	}
+	return nil
+}
+
+// verifyLockByAsset splits [ins], [creds] and [outs] by asset and verifies lock of each asset
+// separately. [burnedAmounts] are amounts of corresponding assets that must be burned.
+func (e *CaminoStandardTxExecutor) verifyLockByAsset(
+	tx txs.UnsignedTx,
+	ins []*avax.TransferableInput,
+	outs []*avax.TransferableOutput,
+	creds []verify.Verifiable,
+	burnedAmounts map[ids.ID]uint64,
+	appliedLockState locked.State,
+) error {
+	if len(ins) != len(creds) {
+		return fmt.Errorf(
+			"there are %d inputs and %d credentials: %w",
+			len(ins),
+			len(creds),
+			errWrongCredentialsNumber,
+		)
+	}
+
+	assetIDs := set.NewSet[ids.ID](len(burnedAmounts))
+	assetIns := make(map[ids.ID][]*avax.TransferableInput)
+	assetCreds := make(map[ids.ID][]verify.Verifiable)
+	assetOuts := make(map[ids.ID][]*avax.TransferableOutput)
+	for assetID := range burnedAmounts {
+		assetIDs.Add(assetID)
+	}
+	for i, in := range ins {
+		assetID := in.AssetID()
+		assetIDs.Add(assetID)
+		assetIns[assetID] = append(assetIns[assetID], in)
+		assetCreds[assetID] = append(assetCreds[assetID], creds[i])
+	}
+	for _, out := range outs {
+		assetID := out.AssetID()
+		assetIDs.Add(assetID)
+		assetOuts[assetID] = append(assetOuts[assetID], out)
+	}
+
+	sortedAssetIDs := assetIDs.List()
+	utils.Sort(sortedAssetIDs)
+	for _, assetID := range sortedAssetIDs {
+		if err := e.Backend.FlowChecker.VerifyLock(
+			tx,
+			e.State,
+			assetIns[assetID],
+			assetOuts[assetID],
+			assetCreds[assetID],
+			0,
+			burnedAmounts[assetID],
+			assetID,
+			appliedLockState,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (e *CaminoStandardTxExecutor) AddPermissionlessDelegatorTx(tx *txs.AddPermissionlessDelegatorTx) error {
@@ -358,6 +766,10 @@ func (e *CaminoStandardTxExecutor) ExportTx(tx *txs.ExportTx) error {
 		return err
 	}
 
+	if err := locked.VerifyNoVesting(tx.ExportedOutputs); err != nil {
+		return err
+	}
+
 	if err := e.StandardTxExecutor.ExportTx(tx); err != nil {
 		return err
 	}
@@ -450,11 +862,60 @@ func (e *CaminoStandardTxExecutor) TransformSubnetTx(tx *txs.TransformSubnetTx)
 		return err
 	}
 
-	return e.StandardTxExecutor.TransformSubnetTx(tx)
-}
-
-func (e *CaminoProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error {
-	caminoConfig, err := e.OnCommitState.CaminoConfig()
+	caminoConfig, err := e.State.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	if !caminoConfig.LockModeBondDeposit {
+		return e.StandardTxExecutor.TransformSubnetTx(tx)
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	if !e.Config.IsBerlinPhaseActivated(e.State.GetTimestamp()) {
+		return errNotBerlinPhase
+	}
+
+	// Note: math.MaxInt32 * time.Second < math.MaxInt64 - so this can never
+	// overflow.
+	if time.Duration(tx.MaxStakeDuration)*time.Second > e.Backend.Config.MaxStakeDuration {
+		return errMaxStakeDurationTooLarge
+	}
+
+	baseTxCreds, err := verifyPoASubnetAuthorization(e.Backend, e.State, e.Tx, tx.Subnet, tx.SubnetAuth)
+	if err != nil {
+		return err
+	}
+
+	// Invariant: [tx.AssetID != e.Ctx.AVAXAssetID]. This prevents the first
+	//            entry in this map literal from being overwritten by the
+	//            second entry.
+	if err := e.verifyLockByAsset(
+		tx,
+		tx.Ins,
+		tx.Outs,
+		baseTxCreds,
+		map[ids.ID]uint64{
+			e.Ctx.AVAXAssetID: e.Config.TransformSubnetTxFee,
+			tx.AssetID:        tx.MaximumSupply - tx.InitialSupply,
+		},
+		locked.StateUnlocked,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	avax.Consume(e.State, tx.Ins)
+	avax.Produce(e.State, e.Tx.ID(), tx.Outs)
+	e.State.AddSubnetTransformation(e.Tx)
+	e.State.SetCurrentSupply(tx.Subnet, tx.InitialSupply)
+	return nil
+}
+
+func (e *CaminoProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error {
+	caminoConfig, err := e.OnCommitState.CaminoConfig()
 	if err != nil {
 		return err
 	}
@@ -551,7 +1012,14 @@ func (e *CaminoProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx)
 		return fmt.Errorf("failed to get next removed staker tx: %w", err)
 	}
 
-	if _, ok := stakerTx.Unsigned.(txs.ValidatorTx); !ok {
+	var validatorTx txs.ValidatorTx
+	var delegatorTx *txs.CaminoAddDelegatorTx
+	switch uStakerTx := stakerTx.Unsigned.(type) {
+	case txs.ValidatorTx:
+		validatorTx = uStakerTx
+	case *txs.CaminoAddDelegatorTx:
+		delegatorTx = uStakerTx
+	default:
 		// Invariant: Permissioned stakers are removed by the advancement of
 		//            time and the current chain timestamp is == this staker's
 		//            EndTime. This means only permissionless stakers should be
@@ -559,12 +1027,26 @@ func (e *CaminoProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx)
 		return errShouldBePermissionlessStaker
 	}
 
-	if removeFromCurrent {
+	switch {
+	case delegatorTx != nil:
+		e.OnCommitState.DeleteCurrentDelegator(stakerToRemove)
+		e.OnAbortState.DeleteCurrentDelegator(stakerToRemove)
+		if err := e.rewardDelegator(stakerToRemove); err != nil {
+			return err
+		}
+	case removeFromCurrent:
 		e.OnCommitState.DeleteCurrentValidator(stakerToRemove)
 		e.OnAbortState.DeleteCurrentValidator(stakerToRemove)
-	} else {
+		if stakerToRemove.SubnetID != constants.PrimaryNetworkID {
+			if err := e.rewardSubnetValidator(stakerToRemove, validatorTx, uint32(len(caminoTx.Outs))); err != nil {
+				return err
+			}
+		}
+	default:
 		e.OnCommitState.DeleteDeferredValidator(stakerToRemove)
 		e.OnAbortState.DeleteDeferredValidator(stakerToRemove)
+		e.OnCommitState.SetDeferredValidatorInfo(stakerToRemove.TxID, nil)
+		e.OnAbortState.SetDeferredValidatorInfo(stakerToRemove.TxID, nil)
 		// Reset deferred bit on node owner address for onCommitState
 		nodeOwnerAddressOnCommit, err := e.OnCommitState.GetShortIDLink(
 			ids.ShortID(stakerToRemove.NodeID),
@@ -577,7 +1059,14 @@ func (e *CaminoProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx)
 		if err != nil {
 			return err
 		}
-		e.OnCommitState.SetAddressStates(nodeOwnerAddressOnCommit, nodeOwnerAddressStateOnCommit&^txs.AddressStateNodeDeferred)
+		if newAddressState := nodeOwnerAddressStateOnCommit &^ txs.AddressStateNodeDeferred; newAddressState != nodeOwnerAddressStateOnCommit {
+			e.OnCommitState.SetAddressStates(nodeOwnerAddressOnCommit, newAddressState)
+			e.OnCommitState.AddAddressStateChange(nodeOwnerAddressOnCommit, &state.AddressStateChange{
+				TxID:   e.Tx.ID(),
+				Bit:    txs.AddressStateBitNodeDeferred,
+				Remove: true,
+			})
+		}
 
 		// Reset deferred bit on node owner address for onAbortState
 		nodeOwnerAddressOnAbort, err := e.OnAbortState.GetShortIDLink(
@@ -604,6 +1093,149 @@ func (e *CaminoProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx)
 	return nil
 }
 
+// rewardSubnetValidator mints potential reward of permissionless subnet [validator] in subnet asset
+// to its validation rewards owner on commit. On abort, validator's potential reward is removed from
+// subnet current supply. Reward utxo output index is [outputIndex].
+func (e *CaminoProposalTxExecutor) rewardSubnetValidator(
+	validator *state.Staker,
+	validatorTx txs.ValidatorTx,
+	outputIndex uint32,
+) error {
+	if validator.PotentialReward == 0 {
+		return nil
+	}
+
+	currentSupply, err := e.OnAbortState.GetCurrentSupply(validator.SubnetID)
+	if err != nil {
+		return err
+	}
+	newSupply, err := math.Sub(currentSupply, validator.PotentialReward)
+	if err != nil {
+		return err
+	}
+	e.OnAbortState.SetCurrentSupply(validator.SubnetID, newSupply)
+
+	outIntf, err := e.Fx.CreateOutput(validator.PotentialReward, validatorTx.ValidationRewardsOwner())
+	if err != nil {
+		return fmt.Errorf("failed to create output: %w", err)
+	}
+	out, ok := outIntf.(verify.State)
+	if !ok {
+		return errInvalidState
+	}
+
+	utxo := &avax.UTXO{
+		UTXOID: avax.UTXOID{
+			TxID:        e.Tx.ID(),
+			OutputIndex: outputIndex,
+		},
+		// Invariant: The staked asset must be equal to the reward asset.
+		Asset: validatorTx.Stake()[0].Asset,
+		Out:   out,
+	}
+
+	e.OnCommitState.AddUTXO(utxo)
+	e.OnCommitState.AddRewardUTXO(validator.TxID, utxo)
+	return nil
+}
+
+// rewardDelegator splits potential reward of [delegator] between delegator and its validator
+// according to validator's delegation fee and adds both parts to their reward owners claimables
+// on commit. On abort, delegator's potential reward is removed from current supply.
+func (e *CaminoProposalTxExecutor) rewardDelegator(delegator *state.Staker) error {
+	if delegator.PotentialReward == 0 {
+		return nil
+	}
+
+	currentSupply, err := e.OnAbortState.GetCurrentSupply(delegator.SubnetID)
+	if err != nil {
+		return err
+	}
+	newSupply, err := math.Sub(currentSupply, delegator.PotentialReward)
+	if err != nil {
+		return err
+	}
+	e.OnAbortState.SetCurrentSupply(delegator.SubnetID, newSupply)
+
+	// Validator could be deferred, while its delegators are still current
+	validator, err := e.OnCommitState.GetCurrentValidator(delegator.SubnetID, delegator.NodeID)
+	if err == database.ErrNotFound {
+		validator, err = e.OnCommitState.GetDeferredValidator(delegator.SubnetID, delegator.NodeID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get delegator's validator: %w", err)
+	}
+
+	validatorTx, _, err := e.OnCommitState.GetTx(validator.TxID)
+	if err != nil {
+		return fmt.Errorf("failed to get delegator's validator tx: %w", err)
+	}
+	uValidatorTx, ok := validatorTx.Unsigned.(*txs.CaminoAddValidatorTx)
+	if !ok {
+		return errWrongTxType
+	}
+
+	delegatorTx, _, err := e.OnCommitState.GetTx(delegator.TxID)
+	if err != nil {
+		return fmt.Errorf("failed to get delegator tx: %w", err)
+	}
+	uDelegatorTx, ok := delegatorTx.Unsigned.(*txs.CaminoAddDelegatorTx)
+	if !ok {
+		return errWrongTxType
+	}
+
+	// Calculate split of reward between delegator and validator
+	delegatorShares := reward.PercentDenominator - uint64(uValidatorTx.Shares())
+	delegatorReward := delegatorShares * (delegator.PotentialReward / reward.PercentDenominator) // delegatorShares <= reward.PercentDenominator so no overflow
+	// Delay rounding as long as possible for small numbers
+	if optimisticReward, err := math.Mul64(delegatorShares, delegator.PotentialReward); err == nil {
+		delegatorReward = optimisticReward / reward.PercentDenominator
+	}
+	validatorReward := delegator.PotentialReward - delegatorReward // delegatorReward <= reward so no underflow
+
+	if err := addClaimableValidatorReward(e.OnCommitState, uDelegatorTx.RewardsOwner(), delegatorReward); err != nil {
+		return err
+	}
+	return addClaimableValidatorReward(e.OnCommitState, uValidatorTx.DelegationRewardsOwner(), validatorReward)
+}
+
+// addClaimableValidatorReward adds [amount] to validator reward of [owner] claimable
+func addClaimableValidatorReward(chainState state.Chain, owner fx.Owner, amount uint64) error {
+	if amount == 0 {
+		return nil
+	}
+
+	secpOwner, ok := owner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return errWrongOwnerType
+	}
+
+	ownerID, err := txs.GetOwnerID(secpOwner)
+	if err != nil {
+		return err
+	}
+
+	claimable, err := chainState.GetClaimable(ownerID)
+	if err != nil && err != database.ErrNotFound {
+		return err
+	}
+
+	newClaimable := &state.Claimable{
+		Owner:           secpOwner,
+		ValidatorReward: amount,
+	}
+	if claimable != nil {
+		newClaimable.ExpiredDepositReward = claimable.ExpiredDepositReward
+		newClaimable.ValidatorReward, err = math.Add64(claimable.ValidatorReward, amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	chainState.SetClaimable(ownerID, newClaimable)
+	return nil
+}
+
 func (e *CaminoStandardTxExecutor) DepositTx(tx *txs.DepositTx) error {
 	caminoConfig, err := e.State.CaminoConfig()
 	if err != nil {
@@ -630,6 +1262,7 @@ func (e *CaminoStandardTxExecutor) DepositTx(tx *txs.DepositTx) error {
 	depositAmount := tx.DepositAmount()
 	chainTime := e.State.GetTimestamp()
 	athensPhase := e.Config.IsAthensPhaseActivated(chainTime)
+	berlinPhase := e.Config.IsBerlinPhaseActivated(chainTime)
 
 	switch {
 	case !depositOffer.IsActiveAt(uint64(chainTime.Unix())):
@@ -659,12 +1292,26 @@ func (e *CaminoStandardTxExecutor) DepositTx(tx *txs.DepositTx) error {
 		return errDepositTooBig
 	}
 
-	baseTxCreds := e.Tx.Creds
-	if depositOffer.OwnerAddress != ids.ShortEmpty {
+	if tx.UpgradeVersionID.Version() > 1 && !berlinPhase {
+		return errNotBerlinPhase
+	}
+
+	creds, feeSponsorCreds, err := splitFeeSponsorCreds(e.Tx.Creds, tx.FeeSponsorIns)
+	if err != nil {
+		return err
+	}
+
+	baseTxCreds := creds
+	hasEligibilityRules := depositOffer.HasEligibilityRules()
+	if depositOffer.OwnerAddress != ids.ShortEmpty || hasEligibilityRules {
 		if !athensPhase {
 			return errNotAthensPhase
 		}
 
+		if hasEligibilityRules && !berlinPhase {
+			return errNotBerlinPhase
+		}
+
 		if tx.UpgradeVersionID.Version() == 0 {
 			return errWrongTxUpgradeVersion
 		}
@@ -673,27 +1320,34 @@ func (e *CaminoStandardTxExecutor) DepositTx(tx *txs.DepositTx) error {
 			return errEmptyDepositCreatorAddress
 		}
 
-		if len(e.Tx.Creds) < 3 {
-			return errWrongCredentialsNumber
-		}
+		// deposit creator credential and optional offer owner credential
+		depositCreatorCredIndex := len(creds) - 1
+		if depositOffer.OwnerAddress != ids.ShortEmpty {
+			if len(creds) < 3 {
+				return errWrongCredentialsNumber
+			}
 
-		if err := e.Fx.VerifyMultisigMessage(
-			depositOffer.PermissionMsg(tx.DepositCreatorAddress),
-			tx.DepositOfferOwnerAuth,
-			e.Tx.Creds[len(e.Tx.Creds)-1], // offer usage permission credential created by offer owner
-			&secp256k1fx.OutputOwners{
-				Threshold: 1,
-				Addrs:     []ids.ShortID{depositOffer.OwnerAddress},
-			},
-			e.State,
-		); err != nil {
-			return fmt.Errorf("%w: %s", errOfferPermissionCredentialMismatch, err)
+			if err := e.Fx.VerifyMultisigMessage(
+				depositOffer.PermissionMsg(tx.DepositCreatorAddress),
+				tx.DepositOfferOwnerAuth,
+				creds[len(creds)-1], // offer usage permission credential created by offer owner
+				&secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{depositOffer.OwnerAddress},
+				},
+				e.State,
+			); err != nil {
+				return fmt.Errorf("%w: %s", errOfferPermissionCredentialMismatch, err)
+			}
+			depositCreatorCredIndex--
+		} else if len(creds) < 2 {
+			return errWrongCredentialsNumber
 		}
 
 		if err := e.Fx.VerifyMultisigPermission(
 			tx,
 			tx.DepositCreatorAuth,
-			e.Tx.Creds[len(e.Tx.Creds)-2], // deposit creator credential
+			creds[depositCreatorCredIndex], // deposit creator credential
 			&secp256k1fx.OutputOwners{
 				Threshold: 1,
 				Addrs:     []ids.ShortID{tx.DepositCreatorAddress},
@@ -703,7 +1357,15 @@ func (e *CaminoStandardTxExecutor) DepositTx(tx *txs.DepositTx) error {
 			return fmt.Errorf("%w: %s", errDepositCreatorCredentialMismatch, err)
 		}
 
-		baseTxCreds = e.Tx.Creds[:len(e.Tx.Creds)-2]
+		baseTxCreds = creds[:depositCreatorCredIndex]
+	}
+
+	var depositCreatorAmount uint64
+	if hasEligibilityRules {
+		depositCreatorAmount, err = e.verifyDepositEligibility(depositOffer, tx, depositAmount)
+		if err != nil {
+			return err
+		}
 	}
 
 	rewardOwner, ok := tx.RewardsOwner.(*secp256k1fx.OutputOwners)
@@ -719,14 +1381,22 @@ func (e *CaminoStandardTxExecutor) DepositTx(tx *txs.DepositTx) error {
 		return err
 	}
 
-	if err := e.FlowChecker.VerifyLock(
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
+	if err := e.FlowChecker.VerifyLockWithFeeSponsor(
 		tx,
 		e.State,
 		tx.Ins,
 		tx.Outs,
 		baseTxCreds,
+		tx.FeeSponsorIns,
+		tx.FeeSponsorOuts,
+		feeSponsorCreds,
 		0,
-		e.Config.TxFee,
+		baseFee,
 		e.Ctx.AVAXAssetID,
 		locked.StateDeposited,
 	); err != nil {
@@ -755,19 +1425,75 @@ func (e *CaminoStandardTxExecutor) DepositTx(tx *txs.DepositTx) error {
 		e.State.SetDepositOffer(&updatedOffer)
 	}
 
+	if depositOffer.MaxAddressAmount > 0 {
+		e.State.SetDepositOfferAddressAmount(depositOffer.ID, tx.DepositCreatorAddress, depositCreatorAmount)
+	}
+
 	if newSupply != currentSupply {
 		e.State.SetCurrentSupply(constants.PrimaryNetworkID, newSupply)
 	}
 	e.State.AddDeposit(txID, deposit)
 
 	avax.Consume(e.State, tx.Ins)
-	if err := utxo.ProduceLocked(e.State, txID, tx.Outs, locked.StateDeposited); err != nil {
+	avax.Consume(e.State, tx.FeeSponsorIns)
+	if err := utxo.ProduceLocked(e.State, txID, tx.Outputs(), locked.StateDeposited); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// splitFeeSponsorCreds splits [creds] into tx credentials and fee sponsor credentials,
+// which are the last credentials of tx, one for each of [feeSponsorIns].
+func splitFeeSponsorCreds(
+	creds []verify.Verifiable,
+	feeSponsorIns []*avax.TransferableInput,
+) ([]verify.Verifiable, []verify.Verifiable, error) {
+	if len(creds) < len(feeSponsorIns) {
+		return nil, nil, errWrongCredentialsNumber
+	}
+	feeSponsorCredsIndex := len(creds) - len(feeSponsorIns)
+	return creds[:feeSponsorCredsIndex], creds[feeSponsorCredsIndex:], nil
+}
+
+// verifyDepositEligibility verifies that deposit creator of [tx] matches [offer] eligibility rules.
+// Returns total amount deposited with this offer by deposit creator including [depositAmount].
+func (e *CaminoStandardTxExecutor) verifyDepositEligibility(
+	offer *deposits.Offer,
+	tx *txs.DepositTx,
+	depositAmount uint64,
+) (uint64, error) {
+	if offer.RequiredAddressState != 0 {
+		depositCreatorAddressState, err := e.State.GetAddressStates(tx.DepositCreatorAddress)
+		if err != nil {
+			return 0, err
+		}
+		requiredAddressState := txs.AddressState(offer.RequiredAddressState)
+		if depositCreatorAddressState&requiredAddressState != requiredAddressState {
+			return 0, errDepositCreatorNotEligible
+		}
+	}
+
+	if offer.AllowlistRoot != ids.Empty &&
+		!deposits.VerifyAllowlistProof(offer.AllowlistRoot, tx.DepositCreatorAddress, tx.AllowlistProof) {
+		return 0, errDepositCreatorNotAllowlisted
+	}
+
+	if offer.MaxAddressAmount == 0 {
+		return 0, nil
+	}
+
+	depositCreatorAmount, err := e.State.GetDepositOfferAddressAmount(offer.ID, tx.DepositCreatorAddress)
+	if err != nil {
+		return 0, err
+	}
+	depositCreatorAmount, err = math.Add64(depositCreatorAmount, depositAmount)
+	if err != nil || depositCreatorAmount > offer.MaxAddressAmount {
+		return 0, errDepositCreatorLimitExceeded
+	}
+	return depositCreatorAmount, nil
+}
+
 func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) error {
 	caminoConfig, err := e.State.CaminoConfig()
 	if err != nil {
@@ -782,7 +1508,12 @@ func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) erro
 		return err
 	}
 
-	chainTimestamp := uint64(e.State.GetTimestamp().Unix())
+	chainTime := e.State.GetTimestamp()
+	if tx.UpgradeVersionID.Version() > 0 && !e.Config.IsBerlinPhaseActivated(chainTime) {
+		return errNotBerlinPhase
+	}
+
+	chainTimestamp := uint64(chainTime.Unix())
 	consumedDepositedAmounts := make(map[ids.ID]uint64)
 	producedDepositedAmounts := make(map[ids.ID]uint64)
 	hasExpiredDeposits := false
@@ -801,6 +1532,10 @@ func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) erro
 
 				isExpired := deposit.IsExpired(chainTimestamp)
 
+				if tx.Force && isExpired {
+					return errForceUnlockExpiredDeposit
+				}
+
 				if hasExpiredDeposits && !isExpired || hasActiveDepositsOrUnlockedIns && isExpired {
 					return errMixedDeposits
 				}
@@ -826,6 +1561,11 @@ func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) erro
 	}
 
 	produced := uint64(0)
+	producedPenalty := uint64(0)
+	outs := tx.Outs
+	if tx.Force {
+		outs = make([]*avax.TransferableOutput, 0, len(tx.Outs))
+	}
 	for _, output := range tx.Outs {
 		if lockedOut, ok := output.Out.(*locked.Out); ok && lockedOut.DepositTxID != ids.Empty {
 			producedDepositedAmounts[lockedOut.DepositTxID], err = math.Add64(producedDepositedAmounts[lockedOut.DepositTxID], lockedOut.Amount())
@@ -837,22 +1577,47 @@ func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) erro
 		if err != nil {
 			return err
 		}
+
+		// early unlock penalty outputs are taken from deposited tokens,
+		// so they are verified here against expected penalty and not by flow checker
+		if !tx.Force {
+			continue
+		}
+		if out, ok := output.Out.(*secp256k1fx.TransferOutput); ok && out.OutputOwners.Equals(treasury.Owner) {
+			producedPenalty, err = math.Add64(producedPenalty, out.Amount())
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		outs = append(outs, output)
 	}
 
 	if hasExpiredDeposits && consumed != produced {
 		return errBurnedDepositUnlock
 	}
 
-	amountToBurn := e.Config.TxFee
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
+	amountToBurn := baseFee
 	if hasExpiredDeposits {
 		amountToBurn = 0
 	}
 
+	if tx.Force {
+		if producedWithFee, err := math.Add64(produced, amountToBurn); err != nil || consumed < producedWithFee {
+			return errForceUnlockNotBalanced
+		}
+	}
+
 	if err := e.FlowChecker.VerifyUnlockDeposit(
 		e.State,
 		tx,
 		tx.Ins,
-		tx.Outs,
+		outs,
 		e.Tx.Creds,
 		amountToBurn,
 		e.Ctx.AVAXAssetID,
@@ -861,6 +1626,8 @@ func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) erro
 		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
 	}
 
+	expectedPenalty := uint64(0)
+	forfeitedReward := uint64(0)
 	for depositTxID, consumedDepositedAmount := range consumedDepositedAmounts {
 		deposit, err := e.State.GetDeposit(depositTxID)
 		if err != nil {
@@ -918,6 +1685,42 @@ func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) erro
 
 				e.State.SetClaimable(claimableOwnerID, newClaimable)
 			}
+			e.State.RemoveDeposit(depositTxID, deposit)
+		} else if tx.Force {
+			if newTotalUnlockedAmount != deposit.Amount {
+				return errForceUnlockNotFull
+			}
+
+			offer, err := e.State.GetDepositOffer(deposit.DepositOfferID)
+			if err != nil {
+				return err
+			}
+
+			if !offer.EarlyUnlockAllowed {
+				return errEarlyUnlockNotAllowed
+			}
+
+			expectedPenalty, err = math.Add64(expectedPenalty, deposit.EarlyUnlockPenalty(offer, chainTimestamp))
+			if err != nil {
+				return err
+			}
+
+			depositForfeitedReward := deposit.TotalReward(offer) - deposit.ClaimedRewardAmount
+			forfeitedReward, err = math.Add64(forfeitedReward, depositForfeitedReward)
+			if err != nil {
+				return err
+			}
+
+			// forfeited reward was counted as rewarded on deposit creation, so it's returned to offer
+			if offer.TotalMaxAmount == 0 && offer.TotalMaxRewardAmount > 0 && depositForfeitedReward > 0 {
+				updatedOffer := *offer
+				updatedOffer.RewardedAmount, err = math.Sub(offer.RewardedAmount, depositForfeitedReward)
+				if err != nil {
+					return err
+				}
+				e.State.SetDepositOffer(&updatedOffer)
+			}
+
 			e.State.RemoveDeposit(depositTxID, deposit)
 		} else {
 			offer, err := e.State.GetDepositOffer(deposit.DepositOfferID)
@@ -941,6 +1744,23 @@ func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) erro
 		}
 	}
 
+	if producedPenalty != expectedPenalty {
+		return fmt.Errorf("%w: expected %d, but produced %d", errWrongEarlyUnlockPenalty, expectedPenalty, producedPenalty)
+	}
+
+	// forfeited reward was added to current supply on deposit creation, so it's removed now
+	if forfeitedReward > 0 {
+		currentSupply, err := e.State.GetCurrentSupply(constants.PrimaryNetworkID)
+		if err != nil {
+			return err
+		}
+		newSupply, err := math.Sub(currentSupply, forfeitedReward)
+		if err != nil {
+			return err
+		}
+		e.State.SetCurrentSupply(constants.PrimaryNetworkID, newSupply)
+	}
+
 	txID := e.Tx.ID()
 
 	avax.Consume(e.State, tx.Ins)
@@ -965,12 +1785,25 @@ func (e *CaminoStandardTxExecutor) ClaimTx(tx *txs.ClaimTx) error {
 		return err
 	}
 
+	chainTime := e.State.GetTimestamp()
+	if tx.UpgradeVersionID.Version() > 0 && !e.Config.IsBerlinPhaseActivated(chainTime) {
+		return errNotBerlinPhase
+	}
+
+	creds, feeSponsorCreds, err := splitFeeSponsorCreds(e.Tx.Creds, tx.FeeSponsorIns)
+	if err != nil {
+		return err
+	}
+	if len(creds) < len(tx.Claimables) {
+		return errWrongCredentialsNumber
+	}
+
 	// Common vars
 
-	currentTimestamp := uint64(e.State.GetTimestamp().Unix())
+	currentTimestamp := uint64(chainTime.Unix())
 	txID := e.Tx.ID()
 	claimedAmount := uint64(0)
-	claimableCreds := e.Tx.Creds[len(e.Tx.Creds)-len(tx.Claimables):]
+	claimableCreds := creds[len(creds)-len(tx.Claimables):]
 
 	for i, txClaimable := range tx.Claimables {
 		switch txClaimable.Type {
@@ -1093,14 +1926,22 @@ func (e *CaminoStandardTxExecutor) ClaimTx(tx *txs.ClaimTx) error {
 
 	// BaseTx check (fee, reward outs)
 
-	if err := e.FlowChecker.VerifyLock(
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
+	if err := e.FlowChecker.VerifyLockWithFeeSponsor(
 		tx,
 		e.State,
 		tx.Ins,
 		tx.Outs,
-		e.Tx.Creds[:len(e.Tx.Creds)-len(tx.Claimables)],
+		creds[:len(creds)-len(tx.Claimables)],
+		tx.FeeSponsorIns,
+		tx.FeeSponsorOuts,
+		feeSponsorCreds,
 		claimedAmount,
-		e.Config.TxFee,
+		baseFee,
 		e.Ctx.AVAXAssetID,
 		locked.StateUnlocked,
 	); err != nil {
@@ -1108,7 +1949,8 @@ func (e *CaminoStandardTxExecutor) ClaimTx(tx *txs.ClaimTx) error {
 	}
 
 	avax.Consume(e.State, tx.Ins)
-	avax.Produce(e.State, txID, tx.Outs)
+	avax.Consume(e.State, tx.FeeSponsorIns)
+	avax.Produce(e.State, txID, tx.Outputs())
 
 	return nil
 }
@@ -1196,6 +2038,11 @@ func (e *CaminoStandardTxExecutor) RegisterNodeTx(tx *txs.RegisterNodeTx) error
 
 	// verify the flowcheck
 
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
 	if err := e.FlowChecker.VerifyLock(
 		tx,
 		e.State,
@@ -1203,7 +2050,7 @@ func (e *CaminoStandardTxExecutor) RegisterNodeTx(tx *txs.RegisterNodeTx) error
 		tx.Outs,
 		e.Tx.Creds[:len(e.Tx.Creds)-2], // base tx creds
 		0,
-		e.Config.TxFee,
+		baseFee,
 		e.Ctx.AVAXAssetID,
 		locked.StateUnlocked,
 	); err != nil {
@@ -1325,7 +2172,8 @@ func (e *CaminoStandardTxExecutor) RewardsImportTx(tx *txs.RewardsImportTx) erro
 	rewardOwners := map[ids.ID]*reward{}
 	for currentStakerIterator.Next() {
 		staker := currentStakerIterator.Value()
-		if staker.SubnetID != constants.PrimaryNetworkID {
+		if staker.SubnetID != constants.PrimaryNetworkID ||
+			staker.Priority != txs.PrimaryNetworkValidatorCurrentPriority {
 			continue
 		}
 
@@ -1399,10 +2247,17 @@ func (e *CaminoStandardTxExecutor) RewardsImportTx(tx *txs.RewardsImportTx) erro
 		e.State.SetNotDistributedValidatorReward(newNotDistributedAmount)
 	}
 
-	// Set claimables
+	// Set claimables and restake validator rewards
 
 	if addedReward != 0 {
-		for rewardOwnerID, reward := range rewardOwners {
+		txID := e.Tx.ID()
+
+		// restaked deposits are limited by offers, so owners must be processed in deterministic order
+		rewardOwnerIDs := maps.Keys(rewardOwners)
+		utils.Sort(rewardOwnerIDs)
+
+		for i, rewardOwnerID := range rewardOwnerIDs {
+			reward := rewardOwners[rewardOwnerID]
 			claimable, err := e.State.GetClaimable(rewardOwnerID)
 			if err != nil && err != database.ErrNotFound {
 				return err
@@ -1426,6 +2281,11 @@ func (e *CaminoStandardTxExecutor) RewardsImportTx(tx *txs.RewardsImportTx) erro
 				return err
 			}
 
+			newClaimable, err = e.restakeValidatorReward(rewardOwnerID, txID.Prefix(uint64(i)), newClaimable, chainTime)
+			if err != nil {
+				return err
+			}
+
 			e.State.SetClaimable(rewardOwnerID, newClaimable)
 		}
 	}
@@ -1459,121 +2319,1325 @@ func (e *CaminoStandardTxExecutor) BaseTx(tx *txs.BaseTx) error {
 	}
 
 	if e.Bootstrapped.Get() {
-		if err := e.Backend.FlowChecker.VerifyLock(
-			tx,
-			e.State,
+		baseFee, err := e.State.GetBaseFee()
+		if err != nil {
+			return err
+		}
+
+		spendLimitUsages, err := e.Backend.FlowChecker.VerifyLockWithSpendLimits(
+			tx,
+			e.State,
 			tx.Ins,
 			tx.Outs,
 			e.Tx.Creds,
 			0,
-			e.Backend.Config.TxFee,
+			baseFee,
 			e.Backend.Ctx.AVAXAssetID,
 			locked.StateUnlocked,
-		); err != nil {
+		)
+		if err != nil {
 			return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
 		}
+
+		for aliasID, usage := range spendLimitUsages {
+			e.State.SetMultisigAliasSpendLimitUsage(aliasID, usage)
+		}
+	}
+
+	avax.Consume(e.State, tx.Ins)
+	avax.Produce(e.State, e.Tx.ID(), tx.Outs)
+
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) MultisigAliasTx(tx *txs.MultisigAliasTx) error {
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	chainTime := e.State.GetTimestamp()
+
+	if (tx.UpgradeVersionID.Version() > 0 || tx.MultisigAlias.UpgradeVersionID.Version() > 0) &&
+		!e.Config.IsBerlinPhaseActivated(chainTime) {
+		return errNotBerlinPhase
+	}
+
+	baseCreds := e.Tx.Creds[:len(e.Tx.Creds)]
+
+	var aliasID ids.ShortID
+	nonce := uint64(0)
+	changeDelay := uint64(0)
+
+	txID := e.Tx.ID()
+
+	// Update existing multisig definition
+
+	if tx.MultisigAlias.ID != ids.ShortEmpty {
+		if len(e.Tx.Creds) < 2 {
+			return errWrongCredentialsNumber
+		}
+
+		alias, err := e.State.GetMultisigAlias(tx.MultisigAlias.ID)
+		if err != nil {
+			return fmt.Errorf("%w, alias: %s", errAliasNotFound, tx.MultisigAlias.ID)
+		}
+
+		hasPendingChange := true
+		if _, err := e.State.GetMultisigAliasChange(alias.ID); err == database.ErrNotFound {
+			hasPendingChange = false
+		} else if err != nil {
+			return err
+		}
+
+		// pending change can be cancelled with alias owners threshold,
+		// but alias can only be changed with alias owners change threshold
+		authOwners := alias.Owners
+		switch {
+		case tx.CancelPendingChange && !hasPendingChange:
+			return errNoPendingAliasChange
+		case !tx.CancelPendingChange && hasPendingChange:
+			return errAliasChangePending
+		case !tx.CancelPendingChange:
+			authOwners, err = secp256k1fx.AliasOwnersChangeOwners(&alias.Alias)
+			if err != nil {
+				return err
+			}
+		}
+
+		baseCreds = e.Tx.Creds[:len(e.Tx.Creds)-1]
+
+		if err := e.Backend.Fx.VerifyMultisigPermission(
+			e.Tx.Unsigned,
+			tx.Auth,
+			e.Tx.Creds[len(e.Tx.Creds)-1],
+			authOwners,
+			e.State,
+		); err != nil {
+			return fmt.Errorf("%w: %s", errAliasCredentialMismatch, err)
+		}
+
+		aliasID = alias.ID
+		nonce = alias.Nonce + 1
+		changeDelay = alias.OwnersChangeDelay
+	} else {
+		aliasID = multisig.ComputeAliasID(txID)
+	}
+
+	// verify the flowcheck
+
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
+	if err := e.FlowChecker.VerifyLock(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		baseCreds,
+		0,
+		baseFee,
+		e.Ctx.AVAXAssetID,
+		locked.StateUnlocked,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	// update state
+
+	newAlias := multisig.Alias{
+		UpgradeVersionID:      tx.MultisigAlias.UpgradeVersionID,
+		ID:                    aliasID,
+		Memo:                  tx.MultisigAlias.Memo,
+		Owners:                tx.MultisigAlias.Owners,
+		OwnersChangeThreshold: tx.MultisigAlias.OwnersChangeThreshold,
+		OwnersChangeDelay:     tx.MultisigAlias.OwnersChangeDelay,
+		SpendLimitThreshold:   tx.MultisigAlias.SpendLimitThreshold,
+		SpendLimitAmount:      tx.MultisigAlias.SpendLimitAmount,
+		SpendLimitPeriod:      tx.MultisigAlias.SpendLimitPeriod,
+	}
+
+	switch {
+	case tx.CancelPendingChange:
+		e.State.SetMultisigAliasChange(aliasID, nil)
+	case changeDelay > 0:
+		effectiveTime, err := math.Add64(uint64(chainTime.Unix()), changeDelay)
+		if err != nil {
+			return err
+		}
+		e.State.SetMultisigAliasChange(aliasID, &multisig.AliasChange{
+			Alias:         newAlias,
+			EffectiveTime: effectiveTime,
+		})
+	default:
+		e.State.SetMultisigAlias(&multisig.AliasWithNonce{
+			Alias: newAlias,
+			Nonce: nonce,
+		})
+	}
+
+	// Consume the UTXOS
+	avax.Consume(e.State, tx.Ins)
+	// Produce the UTXOS
+	avax.Produce(e.State, txID, tx.Outs)
+
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) AddDepositOfferTx(tx *txs.AddDepositOfferTx) error {
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	chainTime := e.State.GetTimestamp()
+
+	if !e.Config.IsAthensPhaseActivated(chainTime) {
+		return errNotAthensPhase
+	}
+
+	if tx.DepositOffer.UpgradeVersionID.Version() > 1 && !e.Config.IsBerlinPhaseActivated(chainTime) {
+		return errNotBerlinPhase
+	}
+
+	if len(e.Tx.Creds) < 2 {
+		return errWrongCredentialsNumber
+	}
+
+	// verify the flowcheck
+
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
+	if err := e.FlowChecker.VerifyLock(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		e.Tx.Creds[:len(e.Tx.Creds)-1], // base tx credentials
+		0,
+		baseFee,
+		e.Ctx.AVAXAssetID,
+		locked.StateUnlocked,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	// check role
+
+	depositOfferCreatorAddressState, err := e.State.GetAddressStates(tx.DepositOfferCreatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if depositOfferCreatorAddressState&txs.AddressStateOffersCreator == 0 {
+		return errNotOfferCreator
+	}
+
+	if err := e.Backend.Fx.VerifyMultisigPermission(
+		e.Tx.Unsigned,
+		tx.DepositOfferCreatorAuth,
+		e.Tx.Creds[len(e.Tx.Creds)-1], // offer creator credential
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{tx.DepositOfferCreatorAddress},
+		},
+		e.State,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errOfferCreatorCredentialMismatch, err)
+	}
+
+	// validate offer
+
+	availableSupply, err := e.availableDepositRewardSupply(uint64(chainTime.Unix()))
+	if err != nil {
+		return err
+	}
+
+	if tx.DepositOffer.TotalMaxRewardAmount > availableSupply {
+		return errSupplyOverflow
+	}
+
+	// update state
+
+	txID := e.Tx.ID()
+
+	tx.DepositOffer.ID = txID
+	e.State.SetDepositOffer(tx.DepositOffer)
+
+	avax.Consume(e.State, tx.Ins)
+	avax.Produce(e.State, txID, tx.Outs)
+
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) UpdateDepositOfferTx(tx *txs.UpdateDepositOfferTx) error {
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	chainTime := e.State.GetTimestamp()
+
+	if !e.Config.IsBerlinPhaseActivated(chainTime) {
+		return errNotBerlinPhase
+	}
+
+	if len(e.Tx.Creds) < 2 {
+		return errWrongCredentialsNumber
+	}
+
+	// verify the flowcheck
+
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
+	if err := e.FlowChecker.VerifyLock(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		e.Tx.Creds[:len(e.Tx.Creds)-1], // base tx credentials
+		0,
+		baseFee,
+		e.Ctx.AVAXAssetID,
+		locked.StateUnlocked,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	offer, err := e.State.GetDepositOffer(tx.DepositOfferID)
+	if err != nil {
+		return err
+	}
+
+	// check role
+
+	if offer.OwnerAddress == ids.ShortEmpty || tx.DepositOfferUpdaterAddress != offer.OwnerAddress {
+		depositOfferUpdaterAddressState, err := e.State.GetAddressStates(tx.DepositOfferUpdaterAddress)
+		if err != nil {
+			return err
+		}
+
+		if depositOfferUpdaterAddressState&txs.AddressStateRoleOffersAdmin == 0 {
+			return errNotOfferUpdater
+		}
+	}
+
+	if err := e.Backend.Fx.VerifyMultisigPermission(
+		e.Tx.Unsigned,
+		tx.DepositOfferUpdaterAuth,
+		e.Tx.Creds[len(e.Tx.Creds)-1], // offer updater credential
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{tx.DepositOfferUpdaterAddress},
+		},
+		e.State,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errOfferUpdaterCredentialMismatch, err)
+	}
+
+	// validate offer update
+
+	chainTimestamp := uint64(chainTime.Unix())
+
+	if tx.End < chainTimestamp || tx.End > offer.End {
+		return errWrongDepositOfferEnd
+	}
+
+	// Deposited (rewarded) amount is only tracked for offers with total max amount (total max reward amount),
+	// so limits can't be added to offer without them and can't be raised or removed from offer with them
+	if tx.TotalMaxAmount > offer.TotalMaxAmount || offer.TotalMaxAmount != 0 && tx.TotalMaxAmount < offer.DepositedAmount ||
+		tx.TotalMaxRewardAmount > offer.TotalMaxRewardAmount || offer.TotalMaxRewardAmount != 0 && tx.TotalMaxRewardAmount < offer.RewardedAmount {
+		return errWrongDepositOfferLimits
+	}
+
+	updatedOffer := *offer
+	updatedOffer.Flags = tx.Flags
+	updatedOffer.End = tx.End
+	updatedOffer.TotalMaxAmount = tx.TotalMaxAmount
+	updatedOffer.TotalMaxRewardAmount = tx.TotalMaxRewardAmount
+
+	if err := updatedOffer.Verify(); err != nil {
+		return err
 	}
 
+	// unlocked offer will reserve its remaining reward from available supply again
+	if !offer.IsActiveAt(chainTimestamp) && updatedOffer.IsActiveAt(chainTimestamp) {
+		availableSupply, err := e.availableDepositRewardSupply(chainTimestamp)
+		if err != nil {
+			return err
+		}
+
+		if updatedOffer.TotalMaxAmount != 0 && updatedOffer.MaxRemainingRewardByTotalMaxAmount() > availableSupply ||
+			updatedOffer.TotalMaxRewardAmount != 0 && updatedOffer.RemainingReward() > availableSupply {
+			return errSupplyOverflow
+		}
+	}
+
+	// update state
+
+	e.State.SetDepositOffer(&updatedOffer)
+
+	avax.Consume(e.State, tx.Ins)
+	avax.Produce(e.State, e.Tx.ID(), tx.Outs)
+
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) TransferDepositTx(tx *txs.TransferDepositTx) error {
+	caminoConfig, err := e.State.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	if !caminoConfig.LockModeBondDeposit {
+		return errWrongLockMode
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	chainTime := e.State.GetTimestamp()
+
+	if !e.Config.IsBerlinPhaseActivated(chainTime) {
+		return errNotBerlinPhase
+	}
+
+	deposit, err := e.State.GetDeposit(tx.DepositTxID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errDepositNotFound, err)
+	}
+
+	if deposit.IsExpired(uint64(chainTime.Unix())) {
+		return errDepositExpired
+	}
+
+	offer, err := e.State.GetDepositOffer(deposit.DepositOfferID)
+	if err != nil {
+		return err
+	}
+
+	// base tx credentials, reward owner credential and optional offer owner credential
+	expectedCredsNumber := len(tx.Ins) + 1
+	if offer.OwnerAddress != ids.ShortEmpty {
+		expectedCredsNumber++
+	}
+
+	if len(e.Tx.Creds) != expectedCredsNumber {
+		return errWrongCredentialsNumber
+	}
+
+	// verify current reward owner and offer owner permissions
+
+	rewardOwner, ok := deposit.RewardOwner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return errWrongOwnerType
+	}
+
+	if err := e.Fx.VerifyMultisigPermission(
+		tx,
+		tx.RewardOwnerAuth,
+		e.Tx.Creds[len(tx.Ins)], // reward owner credential
+		rewardOwner,
+		e.State,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errRewardOwnerCredentialMismatch, err)
+	}
+
+	if offer.OwnerAddress != ids.ShortEmpty {
+		if err := e.Fx.VerifyMultisigPermission(
+			tx,
+			tx.DepositOfferOwnerAuth,
+			e.Tx.Creds[len(tx.Ins)+1], // offer owner credential
+			&secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{offer.OwnerAddress},
+			},
+			e.State,
+		); err != nil {
+			return fmt.Errorf("%w: %s", errOfferOwnerCredentialMismatch, err)
+		}
+	}
+
+	newRewardOwner, ok := tx.RewardOwner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return errWrongOwnerType
+	}
+
+	if err := e.Fx.VerifyMultisigOwner(
+		&secp256k1fx.TransferOutput{
+			OutputOwners: *newRewardOwner,
+		}, e.State,
+	); err != nil {
+		return err
+	}
+
+	rewardOwnerID, err := txs.GetOwnerID(rewardOwner)
+	if err != nil {
+		return err
+	}
+
+	newRewardOwnerID, err := txs.GetOwnerID(newRewardOwner)
+	if err != nil {
+		return err
+	}
+
+	// verify the flowcheck
+
+	unlockedIns := make([]*avax.TransferableInput, 0, len(tx.Ins))
+	unlockedCreds := make([]verify.Verifiable, 0, len(tx.Ins))
+	transferredAmount := uint64(0)
+	for i, input := range tx.Ins {
+		lockedIn, ok := input.In.(*locked.In)
+		if !ok {
+			unlockedIns = append(unlockedIns, input)
+			unlockedCreds = append(unlockedCreds, e.Tx.Creds[i])
+			continue
+		}
+
+		utxo, err := e.State.GetUTXO(input.InputID())
+		if err != nil {
+			return fmt.Errorf("failed to read consumed UTXO %s due to: %w", &input.UTXOID, err)
+		}
+
+		lockedOut, ok := utxo.Out.(*locked.Out)
+		if !ok || lockedOut.IDs != lockedIn.IDs ||
+			utxo.AssetID() != e.Ctx.AVAXAssetID || input.AssetID() != e.Ctx.AVAXAssetID {
+			return errTransferredUTXOMismatch
+		}
+
+		if err := e.Fx.VerifyMultisigTransfer(tx, lockedIn.TransferableIn, e.Tx.Creds[i], lockedOut.TransferableOut, e.State); err != nil {
+			return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+		}
+
+		// tx is syntactically verified, so it can't overflow
+		transferredAmount += lockedIn.Amount()
+	}
+
+	// transferring only part of deposit would leave deposited tokens, that aren't owned by reward owner
+	if transferredAmount != deposit.Amount-deposit.UnlockedAmount {
+		return errDepositNotFullyTransferred
+	}
+
+	unlockedOuts := make([]*avax.TransferableOutput, 0, len(tx.Outs))
+	for _, output := range tx.Outs {
+		lockedOut, ok := output.Out.(*locked.Out)
+		if !ok {
+			unlockedOuts = append(unlockedOuts, output)
+			continue
+		}
+
+		if output.AssetID() != e.Ctx.AVAXAssetID {
+			return errTransferredUTXOMismatch
+		}
+
+		// transferred deposited tokens must be owned by reward owner,
+		// so they could be found by it, when deposit will be unlocked
+		ownerID, err := txs.GetOutputOwnerID(lockedOut.TransferableOut)
+		if err != nil {
+			return err
+		}
+		if ownerID != newRewardOwnerID {
+			return errTransferredToWrongOwner
+		}
+	}
+
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
+	if err := e.FlowChecker.VerifyLock(
+		tx,
+		e.State,
+		unlockedIns,
+		unlockedOuts,
+		unlockedCreds,
+		0,
+		baseFee,
+		e.Ctx.AVAXAssetID,
+		locked.StateUnlocked,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	// update state
+
+	if newRewardOwnerID != rewardOwnerID {
+		updatedDeposit := *deposit
+		updatedDeposit.RewardOwner = tx.RewardOwner
+		e.State.ModifyDeposit(tx.DepositTxID, &updatedDeposit)
+	}
+
+	avax.Consume(e.State, tx.Ins)
+	avax.Produce(e.State, e.Tx.ID(), tx.Outs)
+
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) SetRewardRestakeTx(tx *txs.SetRewardRestakeTx) error {
+	caminoConfig, err := e.State.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	if !caminoConfig.LockModeBondDeposit {
+		return errWrongLockMode
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	chainTime := e.State.GetTimestamp()
+
+	if !e.Config.IsBerlinPhaseActivated(chainTime) {
+		return errNotBerlinPhase
+	}
+
+	// base tx credentials and claimable owner credential
+	if len(e.Tx.Creds) != len(tx.Ins)+1 {
+		return errWrongCredentialsNumber
+	}
+
+	claimableOwner, ok := tx.ClaimableOwner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return errWrongOwnerType
+	}
+
+	if err := e.Fx.VerifyMultisigPermission(
+		tx,
+		tx.ClaimableOwnerAuth,
+		e.Tx.Creds[len(tx.Ins)], // claimable owner credential
+		claimableOwner,
+		e.State,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errClaimableCredentialMismatch, err)
+	}
+
+	var setting *state.RewardRestakeSetting
+	if tx.DepositOfferID != ids.Empty {
+		offer, err := e.State.GetDepositOffer(tx.DepositOfferID)
+		if err != nil {
+			return fmt.Errorf("can't get deposit offer: %w", err)
+		}
+
+		switch {
+		case offer.OwnerAddress != ids.ShortEmpty:
+			// restaked deposits are created without offer owner permission
+			return errRestakeOfferHasOwner
+		case offer.HasEligibilityRules():
+			// restaked deposits are created without deposit creator
+			return errRestakeOfferHasEligibilityRules
+		case !offer.IsActiveAt(uint64(chainTime.Unix())):
+			return errDepositOfferInactive
+		case tx.DepositDuration < offer.MinDuration:
+			return errDepositDurationTooSmall
+		case tx.DepositDuration > offer.MaxDuration:
+			return errDepositDurationTooBig
+		}
+
+		setting = &state.RewardRestakeSetting{
+			DepositOfferID:  tx.DepositOfferID,
+			DepositDuration: tx.DepositDuration,
+		}
+	}
+
+	// verify the flowcheck
+
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
+	if err := e.FlowChecker.VerifyLock(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		e.Tx.Creds[:len(tx.Ins)], // base tx credentials
+		0,
+		baseFee,
+		e.Ctx.AVAXAssetID,
+		locked.StateUnlocked,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	ownerID, err := txs.GetOwnerID(claimableOwner)
+	if err != nil {
+		return err
+	}
+
+	// update state
+
+	e.State.SetRewardRestakeSetting(ownerID, setting)
+
+	avax.Consume(e.State, tx.Ins)
+	avax.Produce(e.State, e.Tx.ID(), tx.Outs)
+
+	return nil
+}
+
+// restakeValidatorReward deposits all validator reward of [claimable] with id [depositTxID],
+// if claimable owner has reward restake setting and the deposit is allowed by restake offer.
+// Returns updated claimable, which will be nil, if there is nothing left to claim.
+// If the deposit isn't allowed, reward stays claimable.
+func (e *CaminoStandardTxExecutor) restakeValidatorReward(
+	ownerID ids.ID,
+	depositTxID ids.ID,
+	claimable *state.Claimable,
+	chainTime time.Time,
+) (*state.Claimable, error) {
+	setting, err := e.State.GetRewardRestakeSetting(ownerID)
+	if err == database.ErrNotFound {
+		return claimable, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	offer, err := e.State.GetDepositOffer(setting.DepositOfferID)
+	if err != nil {
+		return nil, err
+	}
+
+	currentSupply, err := e.State.GetCurrentSupply(constants.PrimaryNetworkID)
+	if err != nil {
+		return nil, err
+	}
+
+	depositAmount := claimable.ValidatorReward
+	deposit := &deposits.Deposit{
+		DepositOfferID: setting.DepositOfferID,
+		Duration:       setting.DepositDuration,
+		Amount:         depositAmount,
+		Start:          uint64(chainTime.Unix()),
+		RewardOwner:    claimable.Owner,
+	}
+	potentialReward := deposit.TotalReward(offer)
+	newSupply, err := math.Add64(currentSupply, potentialReward)
+
+	if err != nil ||
+		newSupply > e.Config.RewardConfig.SupplyCap ||
+		!offer.IsActiveAt(deposit.Start) ||
+		deposit.Duration < offer.MinDuration ||
+		deposit.Duration > offer.MaxDuration ||
+		depositAmount < offer.MinAmount ||
+		offer.TotalMaxAmount > 0 && depositAmount > offer.RemainingAmount() ||
+		offer.TotalMaxRewardAmount > 0 && potentialReward > offer.RemainingReward() {
+		return claimable, nil
+	}
+
+	if offer.TotalMaxAmount > 0 {
+		updatedOffer := *offer
+		updatedOffer.DepositedAmount += depositAmount
+		e.State.SetDepositOffer(&updatedOffer)
+	} else if offer.TotalMaxRewardAmount > 0 {
+		updatedOffer := *offer
+		updatedOffer.RewardedAmount += potentialReward
+		e.State.SetDepositOffer(&updatedOffer)
+	}
+
+	if newSupply != currentSupply {
+		e.State.SetCurrentSupply(constants.PrimaryNetworkID, newSupply)
+	}
+	e.State.AddDeposit(depositTxID, deposit)
+
+	if err := utxo.ProduceLocked(e.State, depositTxID, []*avax.TransferableOutput{{
+		Asset: avax.Asset{ID: e.Ctx.AVAXAssetID},
+		Out: &locked.Out{
+			IDs: locked.IDs{
+				DepositTxID: locked.ThisTxID,
+				BondTxID:    ids.Empty,
+			},
+			TransferableOut: &secp256k1fx.TransferOutput{
+				Amt:          depositAmount,
+				OutputOwners: *claimable.Owner,
+			},
+		},
+	}}, locked.StateDeposited); err != nil {
+		return nil, err
+	}
+
+	if claimable.ExpiredDepositReward == 0 {
+		return nil, nil
+	}
+	return &state.Claimable{
+		Owner:                claimable.Owner,
+		ExpiredDepositReward: claimable.ExpiredDepositReward,
+	}, nil
+}
+
+// availableDepositRewardSupply returns supply, that isn't reserved for rewards of active deposit offers
+func (e *CaminoStandardTxExecutor) availableDepositRewardSupply(chainTimestamp uint64) (uint64, error) {
+	currentSupply, err := e.State.GetCurrentSupply(constants.PrimaryNetworkID)
+	if err != nil {
+		return 0, err
+	}
+
+	allOffers, err := e.State.GetAllDepositOffers()
+	if err != nil {
+		return 0, err
+	}
+
+	availableSupply := e.Config.RewardConfig.SupplyCap - currentSupply
+
+	for _, offer := range allOffers {
+		if offer.IsActiveAt(chainTimestamp) {
+			if offer.TotalMaxAmount != 0 {
+				availableSupply -= offer.MaxRemainingRewardByTotalMaxAmount()
+			} else if offer.TotalMaxRewardAmount != 0 {
+				availableSupply -= offer.RemainingReward()
+			}
+		}
+	}
+
+	return availableSupply, nil
+}
+
+func (e *CaminoStandardTxExecutor) SetSubnetValidatorRequirementsTx(tx *txs.SetSubnetValidatorRequirementsTx) error {
+	caminoConfig, err := e.State.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	if !caminoConfig.LockModeBondDeposit {
+		return errWrongLockMode
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	if !e.Config.IsBerlinPhaseActivated(e.State.GetTimestamp()) {
+		return errNotBerlinPhase
+	}
+
+	// requirements can't be changed after subnet is transformed
+	baseTxCreds, err := verifyPoASubnetAuthorization(e.Backend, e.State, e.Tx, tx.Subnet, tx.SubnetAuth)
+	if err != nil {
+		return err
+	}
+
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
+	if err := e.FlowChecker.VerifyLock(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		baseTxCreds,
+		0,
+		baseFee,
+		e.Ctx.AVAXAssetID,
+		locked.StateUnlocked,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	var requirements *state.SubnetValidatorRequirements
+	if tx.RequireConsortiumMember || tx.RequireKYCVerified {
+		requirements = &state.SubnetValidatorRequirements{
+			RequireConsortiumMember: tx.RequireConsortiumMember,
+			RequireKYCVerified:      tx.RequireKYCVerified,
+		}
+	}
+	e.State.SetSubnetValidatorRequirements(tx.Subnet, requirements)
+
+	avax.Consume(e.State, tx.Ins)
+	avax.Produce(e.State, e.Tx.ID(), tx.Outs)
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) TreasurySpendTx(tx *txs.TreasurySpendTx) error {
+	caminoConfig, err := e.State.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	if !caminoConfig.LockModeBondDeposit {
+		return errWrongLockMode
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	chainTime := e.State.GetTimestamp()
+
+	if !e.Config.IsBerlinPhaseActivated(chainTime) {
+		return errNotBerlinPhase
+	}
+
+	if caminoConfig.TreasuryAdmin == ids.ShortEmpty {
+		return errTreasuryAdminNotSet
+	}
+
+	if len(e.Tx.Creds) == 0 {
+		return errWrongCredentialsNumber
+	}
+
+	// verify treasury admin
+
+	if err := e.Fx.VerifyMultisigPermission(
+		tx,
+		tx.TreasuryAdminAuth,
+		e.Tx.Creds[len(e.Tx.Creds)-1], // treasury admin credential
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{caminoConfig.TreasuryAdmin},
+		},
+		e.State,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errTreasuryAdminCredentialMismatch, err)
+	}
+
+	// verify treasury inputs, they don't have credentials and are authorized by treasury admin
+
+	consumed := uint64(0)
+	for _, in := range tx.TreasuryIns {
+		utxo, err := e.State.GetUTXO(in.InputID())
+		if err != nil {
+			return fmt.Errorf("failed to get treasury utxo %s: %w", in.InputID(), err)
+		}
+
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok || !out.OutputOwners.Equals(treasury.Owner) ||
+			utxo.AssetID() != e.Ctx.AVAXAssetID || in.AssetID() != e.Ctx.AVAXAssetID {
+			return errNotTreasuryUTXO
+		}
+
+		if _, ok := in.In.(*secp256k1fx.TransferInput); !ok {
+			return locked.ErrWrongInType
+		}
+
+		if out.Amt != in.In.Amount() {
+			return fmt.Errorf("utxo.Amt %d, input.Amt %d: %w", out.Amt, in.In.Amount(), errInputAmountMismatch)
+		}
+
+		consumed, err = math.Add64(consumed, out.Amt)
+		if err != nil {
+			return err
+		}
+	}
+
+	// verify treasury outputs, outputs owned by treasury are change
+
+	produced := uint64(0)
+	spent := uint64(0)
+	for _, output := range tx.TreasuryOuts {
+		out, ok := output.Out.(*secp256k1fx.TransferOutput)
+		if !ok || output.AssetID() != e.Ctx.AVAXAssetID {
+			return errWrongTreasuryOutType
+		}
+
+		produced, err = math.Add64(produced, out.Amt)
+		if err != nil {
+			return err
+		}
+
+		if out.OutputOwners.Equals(treasury.Owner) {
+			continue
+		}
+
+		spent, err = math.Add64(spent, out.Amt)
+		if err != nil {
+			return err
+		}
+	}
+
+	if consumed != produced {
+		return errTreasurySpendNotBalanced
+	}
+
+	if spent == 0 {
+		return errNoTreasurySpend
+	}
+
+	// verify spend limit
+
+	periodStart := caminoConfig.TreasurySpendPeriodStart(uint64(chainTime.Unix()))
+	spentInPeriod, err := e.State.GetTreasurySpentAmount(periodStart)
+	if err != nil {
+		return err
+	}
+
+	spentInPeriod, err = math.Add64(spentInPeriod, spent)
+	if err != nil {
+		return err
+	}
+
+	if caminoConfig.TreasurySpendLimit != 0 && spentInPeriod > caminoConfig.TreasurySpendLimit {
+		return fmt.Errorf("%w: limit %d, spent %d", errTreasurySpendLimitExceeded, caminoConfig.TreasurySpendLimit, spentInPeriod)
+	}
+
+	// verify fee
+
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
+	if err := e.FlowChecker.VerifyLock(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		e.Tx.Creds[:len(e.Tx.Creds)-1], // base tx credentials
+		0,
+		baseFee,
+		e.Ctx.AVAXAssetID,
+		locked.StateUnlocked,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	// update state
+
+	txID := e.Tx.ID()
+
+	e.State.SetTreasurySpentAmount(periodStart, spentInPeriod)
+	e.State.AddTreasurySpend(&state.TreasurySpend{
+		TxID:      txID,
+		Timestamp: uint64(chainTime.Unix()),
+		Amount:    spent,
+		Reference: tx.Reference,
+	})
+
+	avax.Consume(e.State, tx.Ins)
+	avax.Consume(e.State, tx.TreasuryIns)
+	avax.Produce(e.State, txID, tx.Outputs())
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) RotateValidatorNodeTx(tx *txs.RotateValidatorNodeTx) error {
+	caminoConfig, err := e.State.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	if !caminoConfig.LockModeBondDeposit {
+		return errWrongLockMode
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	if !e.Config.IsBerlinPhaseActivated(e.State.GetTimestamp()) {
+		return errNotBerlinPhase
+	}
+
+	if len(e.Tx.Creds) < 2 {
+		return errWrongCredentialsNumber
+	}
+
+	// verify consortium member state
+
+	consortiumMemberAddressState, err := e.State.GetAddressStates(tx.NodeOwnerAddress)
+	if err != nil {
+		return err
+	}
+
+	if consortiumMemberAddressState&txs.AddressStateConsortiumMember == 0 {
+		return errNotConsortiumMember
+	}
+
+	// verify old nodeID ownership
+
+	linkedNodeID, err := e.State.GetShortIDLink(tx.NodeOwnerAddress, state.ShortLinkKeyRegisterNode)
+	switch {
+	case err == database.ErrNotFound:
+		return errNotNodeOwner
+	case err != nil:
+		return err
+	case tx.OldNodeID != ids.NodeID(linkedNodeID):
+		return errNotNodeOwner
+	}
+
+	// verify that the new node is not registered
+
+	if _, err := e.State.GetShortIDLink(ids.ShortID(tx.NewNodeID), state.ShortLinkKeyRegisterNode); err == nil {
+		return errNodeAlreadyRegistered
+	} else if err != database.ErrNotFound {
+		return err
+	}
+
+	// verify consortium member cred
+
+	if err := e.Backend.Fx.VerifyMultisigPermission(
+		e.Tx.Unsigned,
+		tx.NodeOwnerAuth,
+		e.Tx.Creds[len(e.Tx.Creds)-1], // consortium member cred
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{tx.NodeOwnerAddress},
+		},
+		e.State,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errSignatureMissing, err)
+	}
+
+	// verify new nodeID cred
+
+	if err := e.verifyNodeSignatureSig(tx.NewNodeID, e.Tx.Creds[len(e.Tx.Creds)-2]); err != nil {
+		return err
+	}
+
+	// verify that the old node is current primary network validator,
+	// which node can be replaced without affecting other stakers
+
+	validator, err := e.State.GetCurrentValidator(constants.PrimaryNetworkID, tx.OldNodeID)
+	if err == database.ErrNotFound {
+		return errValidatorNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if err := validatorExists(e.State, constants.PrimaryNetworkID, tx.NewNodeID); err != nil {
+		return err
+	}
+
+	if err := verifyNoOtherNodeStakers(e.State, validator); err != nil {
+		return err
+	}
+
+	// verify the flowcheck
+
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
+	if err := e.FlowChecker.VerifyLock(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		e.Tx.Creds[:len(e.Tx.Creds)-2], // base tx creds
+		0,
+		baseFee,
+		e.Ctx.AVAXAssetID,
+		locked.StateUnlocked,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	// update state
+
+	// Validator is re-added with the same txID, so it keeps its bond, uptime and potential reward
+	rotatedValidator := *validator
+	rotatedValidator.NodeID = tx.NewNodeID
+	e.State.DeleteCurrentValidator(validator)
+	e.State.PutCurrentValidator(&rotatedValidator)
+
+	e.State.SetShortIDLink(ids.ShortID(tx.OldNodeID), state.ShortLinkKeyRegisterNode, nil)
+	e.State.SetShortIDLink(ids.ShortID(tx.NewNodeID),
+		state.ShortLinkKeyRegisterNode,
+		&tx.NodeOwnerAddress,
+	)
+	link := ids.ShortID(tx.NewNodeID)
+	e.State.SetShortIDLink(tx.NodeOwnerAddress,
+		state.ShortLinkKeyRegisterNode,
+		&link,
+	)
+
+	txID := e.Tx.ID()
+
+	// Consume the UTXOS
 	avax.Consume(e.State, tx.Ins)
-	avax.Produce(e.State, e.Tx.ID(), tx.Outs)
+	// Produce the UTXOS
+	avax.Produce(e.State, txID, tx.Outs)
 
 	return nil
 }
 
-func (e *CaminoStandardTxExecutor) MultisigAliasTx(tx *txs.MultisigAliasTx) error {
+func (e *CaminoStandardTxExecutor) AddProposalTx(tx *txs.AddProposalTx) error {
+	caminoConfig, err := e.State.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	if !caminoConfig.LockModeBondDeposit {
+		return errWrongLockMode
+	}
+
 	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
 		return err
 	}
 
-	baseCreds := e.Tx.Creds[:len(e.Tx.Creds)]
+	chainTime := e.State.GetTimestamp()
 
-	var aliasID ids.ShortID
-	nonce := uint64(0)
+	if !e.Config.IsBerlinPhaseActivated(chainTime) {
+		return errNotBerlinPhase
+	}
 
-	txID := e.Tx.ID()
+	if len(e.Tx.Creds) < 2 {
+		return errWrongCredentialsNumber
+	}
 
-	// Update existing multisig definition
+	// verify proposer
 
-	if tx.MultisigAlias.ID != ids.ShortEmpty {
-		if len(e.Tx.Creds) < 2 {
-			return errWrongCredentialsNumber
-		}
+	proposerAddressState, err := e.State.GetAddressStates(tx.ProposerAddress)
+	if err != nil {
+		return err
+	}
 
-		alias, err := e.State.GetMultisigAlias(tx.MultisigAlias.ID)
-		if err != nil {
-			return fmt.Errorf("%w, alias: %s", errAliasNotFound, tx.MultisigAlias.ID)
-		}
+	if proposerAddressState&txs.AddressStateConsortiumMember == 0 {
+		return errNotConsortiumMember
+	}
 
-		baseCreds = e.Tx.Creds[:len(e.Tx.Creds)-1]
+	if err := e.Backend.Fx.VerifyMultisigPermission(
+		e.Tx.Unsigned,
+		tx.ProposerAuth,
+		e.Tx.Creds[len(e.Tx.Creds)-1], // proposer credential
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{tx.ProposerAddress},
+		},
+		e.State,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errProposerCredentialMismatch, err)
+	}
 
-		if err := e.Backend.Fx.VerifyMultisigPermission(
-			e.Tx.Unsigned,
-			tx.Auth,
-			e.Tx.Creds[len(e.Tx.Creds)-1],
-			alias.Owners,
-			e.State,
-		); err != nil {
-			return fmt.Errorf("%w: %s", errAliasCredentialMismatch, err)
-		}
+	// verify proposal
 
-		aliasID = alias.ID
-		nonce = alias.Nonce + 1
-	} else {
-		aliasID = multisig.ComputeAliasID(txID)
+	if tx.Proposal.StartTime().Before(chainTime) {
+		return errProposalStartToEarly
+	}
+
+	bondAmount, err := tx.BondAmount()
+	if err != nil {
+		return err
+	}
+
+	if bondAmount != e.Config.CaminoConfig.DaoProposalBondAmount {
+		return errWrongProposalBondAmount
+	}
+
+	if err := tx.Proposal.Visit(e.proposalVerifier(tx)); err != nil {
+		return err
 	}
 
 	// verify the flowcheck
 
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
 	if err := e.FlowChecker.VerifyLock(
 		tx,
 		e.State,
 		tx.Ins,
 		tx.Outs,
-		baseCreds,
+		e.Tx.Creds[:len(e.Tx.Creds)-1], // base tx credentials
 		0,
-		e.Config.TxFee,
+		baseFee,
 		e.Ctx.AVAXAssetID,
-		locked.StateUnlocked,
+		locked.StateBonded,
 	); err != nil {
 		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
 	}
 
 	// update state
 
-	e.State.SetMultisigAlias(&multisig.AliasWithNonce{
-		Alias: multisig.Alias{
-			ID:     aliasID,
-			Memo:   tx.MultisigAlias.Memo,
-			Owners: tx.MultisigAlias.Owners,
-		},
-		Nonce: nonce,
-	})
+	consortiumMembers, err := e.State.GetAddressesWithStates(txs.AddressStateConsortiumMember)
+	if err != nil {
+		return err
+	}
+
+	txID := e.Tx.ID()
+
+	e.State.AddProposal(txID, tx.Proposal.CreateProposalState(consortiumMembers))
 
-	// Consume the UTXOS
 	avax.Consume(e.State, tx.Ins)
-	// Produce the UTXOS
-	avax.Produce(e.State, txID, tx.Outs)
+	if err := utxo.ProduceLocked(e.State, txID, tx.Outs, locked.StateBonded); err != nil {
+		return err
+	}
 
 	return nil
 }
 
-func (e *CaminoStandardTxExecutor) AddDepositOfferTx(tx *txs.AddDepositOfferTx) error {
+func (e *CaminoStandardTxExecutor) AddVoteTx(tx *txs.AddVoteTx) error {
+	caminoConfig, err := e.State.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	if !caminoConfig.LockModeBondDeposit {
+		return errWrongLockMode
+	}
+
 	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
 		return err
 	}
 
 	chainTime := e.State.GetTimestamp()
 
-	if !e.Config.IsAthensPhaseActivated(chainTime) {
-		return errNotAthensPhase
+	if !e.Config.IsBerlinPhaseActivated(chainTime) {
+		return errNotBerlinPhase
 	}
 
 	if len(e.Tx.Creds) < 2 {
 		return errWrongCredentialsNumber
 	}
 
+	// verify voter
+
+	voterAddressState, err := e.State.GetAddressStates(tx.VoterAddress)
+	if err != nil {
+		return err
+	}
+
+	if voterAddressState&txs.AddressStateConsortiumMember == 0 {
+		return errNotConsortiumMember
+	}
+
+	if err := e.Backend.Fx.VerifyMultisigPermission(
+		e.Tx.Unsigned,
+		tx.VoterAuth,
+		e.Tx.Creds[len(e.Tx.Creds)-1], // voter credential
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{tx.VoterAddress},
+		},
+		e.State,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errVoterCredentialMismatch, err)
+	}
+
+	// verify vote
+
+	proposal, err := e.State.GetProposal(tx.ProposalID)
+	if err != nil {
+		return err
+	}
+
+	if !proposal.IsActiveAt(chainTime) {
+		return errProposalInactive
+	}
+
+	updatedProposal, err := proposal.AddVote(tx.VoterAddress, tx.Vote)
+	if err != nil {
+		return err
+	}
+
 	// verify the flowcheck
 
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
 	if err := e.FlowChecker.VerifyLock(
 		tx,
 		e.State,
@@ -1581,72 +3645,116 @@ func (e *CaminoStandardTxExecutor) AddDepositOfferTx(tx *txs.AddDepositOfferTx)
 		tx.Outs,
 		e.Tx.Creds[:len(e.Tx.Creds)-1], // base tx credentials
 		0,
-		e.Config.TxFee,
+		baseFee,
 		e.Ctx.AVAXAssetID,
 		locked.StateUnlocked,
 	); err != nil {
 		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
 	}
 
-	// check role
+	// update state
 
-	depositOfferCreatorAddressState, err := e.State.GetAddressStates(tx.DepositOfferCreatorAddress)
+	e.State.ModifyProposal(tx.ProposalID, updatedProposal)
+	if updatedProposal.CanBeFinished() {
+		e.State.AddProposalIDToFinish(tx.ProposalID)
+	}
+
+	txID := e.Tx.ID()
+
+	avax.Consume(e.State, tx.Ins)
+	avax.Produce(e.State, txID, tx.Outs)
+
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) FinishProposalsTx(tx *txs.FinishProposalsTx) error {
+	caminoConfig, err := e.State.CaminoConfig()
 	if err != nil {
 		return err
 	}
 
-	if depositOfferCreatorAddressState&txs.AddressStateOffersCreator == 0 {
-		return errNotOfferCreator
+	if !caminoConfig.LockModeBondDeposit {
+		return errWrongLockMode
 	}
 
-	if err := e.Backend.Fx.VerifyMultisigPermission(
-		e.Tx.Unsigned,
-		tx.DepositOfferCreatorAuth,
-		e.Tx.Creds[len(e.Tx.Creds)-1], // offer creator credential
-		&secp256k1fx.OutputOwners{
-			Threshold: 1,
-			Addrs:     []ids.ShortID{tx.DepositOfferCreatorAddress},
-		},
-		e.State,
-	); err != nil {
-		return fmt.Errorf("%w: %s", errOfferCreatorCredentialMismatch, err)
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
 	}
 
-	// validate offer
+	if len(e.Tx.Creds) != 0 {
+		return errWrongCredentialsNumber
+	}
 
-	currentSupply, err := e.State.GetCurrentSupply(constants.PrimaryNetworkID)
+	chainTime := e.State.GetTimestamp()
+	if !e.Config.IsBerlinPhaseActivated(chainTime) {
+		return errNotBerlinPhase
+	}
+
+	// verify finished proposals
+
+	earlyFinishedProposalIDs, expiredProposalIDs, err := GetFinishedProposalIDs(e.State, chainTime)
 	if err != nil {
 		return err
 	}
 
-	allOffers, err := e.State.GetAllDepositOffers()
+	if !slices.Equal(tx.ExpiredProposalIDs, expiredProposalIDs) {
+		return errExpiredProposalsMismatch
+	}
+
+	if !slices.Equal(tx.EarlyFinishedProposalIDs, earlyFinishedProposalIDs) {
+		return errEarlyFinishedProposalsMismatch
+	}
+
+	// verify ins and outs
+
+	proposalIDs := tx.ProposalIDs()
+
+	ins, outs, err := e.FlowChecker.Unlock(e.State, proposalIDs, locked.StateBonded)
 	if err != nil {
 		return err
 	}
 
-	availableSupply := e.Config.RewardConfig.SupplyCap - currentSupply
-	chainTimestamp := uint64(chainTime.Unix())
+	// comparing serialized bodies, so cached input ids and fx ids won't affect the result
+	expectedBodyBytes, err := txs.Codec.Marshal(txs.Version, &avax.BaseTx{
+		NetworkID:    e.Ctx.NetworkID,
+		BlockchainID: e.Ctx.ChainID,
+		Ins:          ins,
+		Outs:         outs,
+	})
+	if err != nil {
+		return err
+	}
 
-	for _, offer := range allOffers {
-		if offer.IsActiveAt(chainTimestamp) {
-			if offer.TotalMaxAmount != 0 {
-				availableSupply -= offer.MaxRemainingRewardByTotalMaxAmount()
-			} else if offer.TotalMaxRewardAmount != 0 {
-				availableSupply -= offer.RemainingReward()
-			}
-		}
+	bodyBytes, err := txs.Codec.Marshal(txs.Version, &tx.BaseTx.BaseTx)
+	if err != nil {
+		return err
 	}
 
-	if tx.DepositOffer.TotalMaxRewardAmount > availableSupply {
-		return errSupplyOverflow
+	if !bytes.Equal(bodyBytes, expectedBodyBytes) {
+		return errInvalidSystemTxBody
 	}
 
 	// update state
 
-	txID := e.Tx.ID()
+	for _, proposalID := range proposalIDs {
+		proposal, err := e.State.GetProposal(proposalID)
+		if err != nil {
+			return err
+		}
 
-	tx.DepositOffer.ID = txID
-	e.State.SetDepositOffer(tx.DepositOffer)
+		if proposal.IsSuccessful() {
+			if err := proposal.Visit(e.proposalExecutor()); err != nil {
+				return err
+			}
+		}
+
+		if proposal.CanBeFinished() {
+			e.State.RemoveProposalIDToFinish(proposalID)
+		}
+		e.State.RemoveProposal(proposalID, proposal)
+	}
+
+	txID := e.Tx.ID()
 
 	avax.Consume(e.State, tx.Ins)
 	avax.Produce(e.State, txID, tx.Outs)
@@ -1673,11 +3781,17 @@ func (e *CaminoStandardTxExecutor) AddressStateTx(tx *txs.AddressStateTx) error
 
 	roles := txs.AddressStateEmpty
 	creds := e.Tx.Creds
+	statesBit := txs.AddressState(1) << tx.State
+	// address that will be recorded in address state history as executor of this change
+	executor := tx.Executor
 
 	if tx.UpgradeVersionID.Version() > 0 {
 		if !e.Config.IsAthensPhaseActivated(e.State.GetTimestamp()) {
 			return errNotAthensPhase
 		}
+		if tx.UpgradeVersionID.Version() > 1 && !e.Config.IsBerlinPhaseActivated(e.State.GetTimestamp()) {
+			return errNotBerlinPhase
+		}
 		if err = e.Backend.Fx.VerifyMultisigPermission(
 			e.Tx.Unsigned,
 			tx.ExecutorAuth,
@@ -1709,9 +3823,15 @@ func (e *CaminoStandardTxExecutor) AddressStateTx(tx *txs.AddressStateTx) error
 			return errWrongNumberOfCredentials
 		}
 
-		// Accumulate roles over all signers
+		// Accumulate roles over all signers,
+		// first signer that is allowed to make this change is considered its executor
 		checkSelfRemove := tx.Remove && tx.State == txs.AddressStateBitRoleAdmin
+		signers := make([]ids.ShortID, 0, len(addresses))
 		for address := range addresses {
+			signers = append(signers, address)
+		}
+		utils.Sort(signers)
+		for _, address := range signers {
 			states, err := e.State.GetAddressStates(address)
 			if err != nil {
 				return err
@@ -1719,10 +3839,12 @@ func (e *CaminoStandardTxExecutor) AddressStateTx(tx *txs.AddressStateTx) error
 			if checkSelfRemove && address == tx.Address {
 				return errAdminCannotBeDeleted
 			}
+			if executor == ids.ShortEmpty && verifyAccess(states, statesBit) {
+				executor = address
+			}
 			roles |= states
 		}
 	}
-	statesBit := txs.AddressState(1) << tx.State
 
 	// Check for AthensPhase Bits if we time has not passed yet
 	if (statesBit&txs.AddressStateAthensPhaseBits) != 0 &&
@@ -1747,8 +3869,31 @@ func (e *CaminoStandardTxExecutor) AddressStateTx(tx *txs.AddressStateTx) error
 	} else if !tx.Remove {
 		newStates |= statesBit
 	}
+	// Verifying kyc again means that it isn't expired anymore
+	kycExpiredRemoved := tx.State == txs.AddressStateBitKYCVerified && !tx.Remove &&
+		states&txs.AddressStateKYCExpired != 0
+	if kycExpiredRemoved {
+		newStates &^= txs.AddressStateKYCExpired
+	}
+
+	// Kyc expiration is bound to kyc verified state
+	oldKYCExpiration := uint64(0)
+	if tx.State == txs.AddressStateBitKYCVerified {
+		if tx.KYCExpiration != 0 && tx.KYCExpiration <= uint64(e.State.GetTimestamp().Unix()) {
+			return errKYCExpirationInThePast
+		}
+		oldKYCExpiration, err = e.State.GetKYCExpiration(tx.Address)
+		if err != nil && err != database.ErrNotFound {
+			return err
+		}
+	}
 
 	// Verify the flowcheck
+	baseFee, err := e.State.GetBaseFee()
+	if err != nil {
+		return err
+	}
+
 	if err := e.FlowChecker.VerifySpend(
 		tx,
 		e.State,
@@ -1756,7 +3901,7 @@ func (e *CaminoStandardTxExecutor) AddressStateTx(tx *txs.AddressStateTx) error
 		tx.Outs,
 		creds,
 		map[ids.ID]uint64{
-			e.Ctx.AVAXAssetID: e.Config.TxFee,
+			e.Ctx.AVAXAssetID: baseFee,
 		},
 	); err != nil {
 		return err
@@ -1777,6 +3922,7 @@ func (e *CaminoStandardTxExecutor) AddressStateTx(tx *txs.AddressStateTx) error
 				return fmt.Errorf("validator with nodeID %s, does not exist in deferred stakers set: %w", nodeID, errValidatorNotFound)
 			}
 			e.State.DeleteDeferredValidator(stakerToReactivate)
+			e.State.SetDeferredValidatorInfo(stakerToReactivate.TxID, nil)
 			e.State.PutCurrentValidator(stakerToReactivate)
 		} else {
 			// transfer staker to from current to deferred stakers set
@@ -1786,6 +3932,11 @@ func (e *CaminoStandardTxExecutor) AddressStateTx(tx *txs.AddressStateTx) error
 			}
 			e.State.DeleteCurrentValidator(stakerToDefer)
 			e.State.PutDeferredValidator(stakerToDefer)
+			e.State.SetDeferredValidatorInfo(stakerToDefer.TxID, &state.DeferredValidatorInfo{
+				TxID:      txID,
+				Executor:  executor,
+				Timestamp: uint64(e.State.GetTimestamp().Unix()),
+			})
 		}
 	}
 
@@ -1793,14 +3944,46 @@ func (e *CaminoStandardTxExecutor) AddressStateTx(tx *txs.AddressStateTx) error
 	avax.Consume(e.State, tx.Ins)
 	// Produce the UTXOS
 	avax.Produce(e.State, txID, tx.Outs)
-	// Set the new states if changed
+	// Set the new states and record the changes in address state history if changed
 	if states != newStates {
 		e.State.SetAddressStates(tx.Address, newStates)
 	}
+	if states&statesBit != newStates&statesBit {
+		e.State.AddAddressStateChange(tx.Address, &state.AddressStateChange{
+			TxID:     txID,
+			Executor: executor,
+			Bit:      tx.State,
+			Remove:   tx.Remove,
+		})
+	}
+	if kycExpiredRemoved {
+		e.State.AddAddressStateChange(tx.Address, &state.AddressStateChange{
+			TxID:     txID,
+			Executor: executor,
+			Bit:      txs.AddressStateBitKYCExpired,
+			Remove:   true,
+		})
+	}
+	// Set the new kyc expiration if changed, removed kyc verified state also removes expiration
+	if tx.State == txs.AddressStateBitKYCVerified && oldKYCExpiration != tx.KYCExpiration {
+		e.State.SetKYCExpiration(tx.Address, tx.KYCExpiration)
+	}
 
 	return nil
 }
 
+// VerifyBerlinPhaseOutputs verifies that [tx] doesn't produce outputs,
+// which are allowed only since BerlinPhase, before its activation
+func VerifyBerlinPhaseOutputs(backend *Backend, chainTime time.Time, tx txs.UnsignedTx) error {
+	if backend.Config.IsBerlinPhaseActivated(chainTime) {
+		return nil
+	}
+	if err := locked.VerifyNoVesting(tx.Outputs()); err != nil {
+		return fmt.Errorf("%w: %s", errNotBerlinPhase, err)
+	}
+	return nil
+}
+
 // [state] must have only one bit set
 func verifyAccess(roles, state txs.AddressState) bool {
 	switch {
@@ -1813,6 +3996,31 @@ func verifyAccess(roles, state txs.AddressState) bool {
 	return true
 }
 
+// Verifies that there are no current or pending stakers on [validator] node, except [validator] itself
+func verifyNoOtherNodeStakers(chainState state.Chain, validator *state.Staker) error {
+	currentStakerIterator, err := chainState.GetCurrentStakerIterator()
+	if err != nil {
+		return err
+	}
+	defer currentStakerIterator.Release()
+
+	pendingStakerIterator, err := chainState.GetPendingStakerIterator()
+	if err != nil {
+		return err
+	}
+	defer pendingStakerIterator.Release()
+
+	for _, stakerIterator := range []state.StakerIterator{currentStakerIterator, pendingStakerIterator} {
+		for stakerIterator.Next() {
+			staker := stakerIterator.Value()
+			if staker.NodeID == validator.NodeID && staker.TxID != validator.TxID {
+				return errRotatedNodeHasStakers
+			}
+		}
+	}
+	return nil
+}
+
 func validatorExists(state state.Chain, subnetID ids.ID, nodeID ids.NodeID) error {
 	if _, err := GetValidator(state, subnetID, nodeID); err == nil {
 		return errValidatorExists
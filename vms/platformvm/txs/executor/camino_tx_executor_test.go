@@ -4,6 +4,7 @@
 package executor
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"testing"
@@ -11,11 +12,13 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
 
 	"github.com/ava-labs/avalanchego/chains/atomic"
 	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/hashing"
@@ -26,13 +29,16 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/api"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/utxo"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
@@ -1630,6 +1636,8 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 	}
 	sigIndices := []uint32{0}
 
+	chainTime := uint64(env.state.GetTimestamp().Unix())
+
 	tests := map[string]struct {
 		UpgradeVersion uint16
 		stateAddress   ids.ShortID
@@ -1641,6 +1649,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 		remove         bool
 		executor       ids.ShortID
 		executorAuth   *secp256k1fx.Input
+		kycExpiration  uint64
 	}{
 		// Bob has Admin role, and he is trying to give himself Admin role (again)
 		"State: Admin, Flag: Admin role, Add, Same Address": {
@@ -1657,7 +1666,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			targetAddress: bob,
 			txFlag:        txs.AddressStateBitRoleKYC,
 			existingState: txs.AddressStateRoleKYC,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 			remove:        false,
 		},
 		// Bob has KYC role, and he is trying to give himself Admin role
@@ -1666,7 +1675,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			targetAddress: bob,
 			txFlag:        txs.AddressStateBitRoleAdmin,
 			existingState: txs.AddressStateRoleKYC,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 			remove:        false,
 		},
 		// Bob has Admin role, and he is trying to give Alice Admin role
@@ -1712,7 +1721,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			txFlag:        txs.AddressStateBitRoleAdmin,
 			existingState: txs.AddressStateRoleAdmin,
 			expectedState: 0,
-			expectedErrs:  []error{errAdminCannotBeDeleted, errAdminCannotBeDeleted},
+			expectedErrs:  []error{errAdminCannotBeDeleted, errAdminCannotBeDeleted, errAdminCannotBeDeleted},
 			remove:        true,
 		},
 		// Bob has Admin role, and he is trying to give Alice the KYC Verified state
@@ -1766,7 +1775,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			targetAddress: alice,
 			txFlag:        txs.AddressStateBitRoleAdmin,
 			existingState: txs.AddressStateRoleAdmin,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 			remove:        false,
 		},
 		// An Empty Address has Admin role, and he is trying to give Alice Admin role
@@ -1775,7 +1784,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			targetAddress: alice,
 			txFlag:        txs.AddressStateBitRoleAdmin,
 			existingState: txs.AddressStateRoleAdmin,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 			remove:        false,
 		},
 		// Bob has Admin role, and he is trying to give Admin role to an Empty Address
@@ -1784,7 +1793,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			targetAddress: ids.ShortEmpty,
 			txFlag:        txs.AddressStateBitRoleAdmin,
 			existingState: txs.AddressStateRoleAdmin,
-			expectedErrs:  []error{txs.ErrEmptyAddress, txs.ErrEmptyAddress},
+			expectedErrs:  []error{txs.ErrEmptyAddress, txs.ErrEmptyAddress, txs.ErrEmptyAddress},
 			remove:        false,
 		},
 		// Bob has empty addr state, and he is trying to give Alice Admin role
@@ -1794,7 +1803,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			txFlag:        txs.AddressStateBitRoleAdmin,
 			existingState: txs.AddressStateEmpty,
 			remove:        false,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 		},
 		// Bob has empty addr state, and he is trying to remove Admin role from Alice
 		"State: none, Flag: Admin role, Remove, Different Address": {
@@ -1803,7 +1812,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			txFlag:        txs.AddressStateBitRoleAdmin,
 			existingState: txs.AddressStateEmpty,
 			remove:        true,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 		},
 		// Bob has empty addr state, and he is trying to give Alice KYC role
 		"State: none, Flag: KYC role, Add, Different Address": {
@@ -1812,7 +1821,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			txFlag:        txs.AddressStateBitRoleKYC,
 			existingState: txs.AddressStateEmpty,
 			remove:        false,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 		},
 		// Bob has empty addr state, and he is trying to remove KYC role from Alice
 		"State: none, Flag: KYC role, Remove, Different Address": {
@@ -1821,7 +1830,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			txFlag:        txs.AddressStateBitRoleKYC,
 			existingState: txs.AddressStateEmpty,
 			remove:        true,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 		},
 		// Bob has empty addr state, and he is trying to give Alice KYC Verified state
 		"State: none, Flag: KYC Verified, Add, Different Address": {
@@ -1830,7 +1839,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			txFlag:        txs.AddressStateBitKYCVerified,
 			existingState: txs.AddressStateEmpty,
 			remove:        false,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 		},
 		// Bob has empty addr state, and he is trying to remove KYC Verified state from Alice
 		"State: none, Flag: KYC Verified, Remove, Different Address": {
@@ -1839,7 +1848,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			txFlag:        txs.AddressStateBitKYCVerified,
 			existingState: txs.AddressStateEmpty,
 			remove:        true,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 		},
 		// Bob has KYC role, and he is trying to give Alice KYC Expired state
 		"Upgrade: 1, State: KYC, Flag: KYC Expired, Add, Different Address": {
@@ -1854,6 +1863,33 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			executor:       bob,
 			executorAuth:   &secp256k1fx.Input{SigIndices: []uint32{0}},
 		},
+		// Bob has KYC role, and he is trying to give Alice KYC Verified state with expiration
+		"Upgrade: 2, State: KYC, Flag: KYC Verified, Add with expiration, Different Address": {
+			UpgradeVersion: 2,
+			stateAddress:   bob,
+			targetAddress:  alice,
+			txFlag:         txs.AddressStateBitKYCVerified,
+			existingState:  txs.AddressStateRoleKYC,
+			expectedState:  txs.AddressStateKYCVerified,
+			expectedErrs:   []error{errNotAthensPhase, errNotBerlinPhase, nil},
+			remove:         false,
+			executor:       bob,
+			executorAuth:   &secp256k1fx.Input{SigIndices: []uint32{0}},
+			kycExpiration:  chainTime + 100,
+		},
+		// Bob has KYC role, and he is trying to give Alice KYC Verified state with already passed expiration
+		"Upgrade: 2, State: KYC, Flag: KYC Verified, Add with passed expiration, Different Address": {
+			UpgradeVersion: 2,
+			stateAddress:   bob,
+			targetAddress:  alice,
+			txFlag:         txs.AddressStateBitKYCVerified,
+			existingState:  txs.AddressStateRoleKYC,
+			expectedErrs:   []error{errNotAthensPhase, errNotBerlinPhase, errKYCExpirationInThePast},
+			remove:         false,
+			executor:       bob,
+			executorAuth:   &secp256k1fx.Input{SigIndices: []uint32{0}},
+			kycExpiration:  chainTime,
+		},
 		// Bob has KYC role, alice tries to executor her KYC Expired state
 		"Upgrade: 1, State: KYC, Flag: KYC Expired, wrong executor": {
 			UpgradeVersion: 1,
@@ -1862,7 +1898,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			txFlag:         txs.AddressStateBitKYCExpired,
 			existingState:  txs.AddressStateRoleKYC,
 			expectedState:  txs.AddressStateKYCExpired,
-			expectedErrs:   []error{errNotAthensPhase, errSignatureMissing},
+			expectedErrs:   []error{errNotAthensPhase, errSignatureMissing, errSignatureMissing},
 			remove:         false,
 			executor:       alice,
 			executorAuth:   &secp256k1fx.Input{SigIndices: []uint32{0}},
@@ -1883,10 +1919,17 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 	athensPhaseTimes := []time.Time{
 		env.state.GetTimestamp().Add(24 * time.Hour), // AthensPhase not yet active (> chainTime)
 		env.state.GetTimestamp(),                     // AthensPhase active (<= chainTime)
+		env.state.GetTimestamp(),                     // AthensPhase active (<= chainTime)
+	}
+	berlinPhaseTimes := []time.Time{
+		env.state.GetTimestamp().Add(24 * time.Hour), // BerlinPhase not yet active (> chainTime)
+		env.state.GetTimestamp().Add(24 * time.Hour), // BerlinPhase not yet active (> chainTime)
+		env.state.GetTimestamp(),                     // BerlinPhase active (<= chainTime)
 	}
 
-	for phase := 0; phase < 2; phase++ {
+	for phase := 0; phase < 3; phase++ {
 		env.config.AthensPhaseTime = athensPhaseTimes[phase]
+		env.config.BerlinPhaseTime = berlinPhaseTimes[phase]
 		for name, tt := range tests {
 			t.Run(fmt.Sprintf("Phase %d; %s", phase, name), func(t *testing.T) {
 				addressStateTx := &txs.AddressStateTx{
@@ -1897,6 +1940,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 					Remove:           tt.remove,
 					Executor:         tt.executor,
 					ExecutorAuth:     tt.executorAuth,
+					KYCExpiration:    tt.kycExpiration,
 				}
 
 				tx, err := txs.NewSigned(addressStateTx, txs.Codec, signers)
@@ -1929,12 +1973,143 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 				if err == nil {
 					targetStates, _ := executor.State.GetAddressStates(tt.targetAddress)
 					require.Equal(t, targetStates, tt.expectedState)
+					kycExpiration, err := executor.State.GetKYCExpiration(tt.targetAddress)
+					if tt.kycExpiration == 0 {
+						require.ErrorIs(t, err, database.ErrNotFound)
+					} else {
+						require.NoError(t, err)
+						require.Equal(t, tt.kycExpiration, kycExpiration)
+					}
 				}
 			})
 		}
 	}
 }
 
+func TestCaminoStandardTxExecutorAddressStateTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	executorKey, executorAddr, _ := generateKeyAndOwner(t)
+	targetAddr := ids.ShortID{1}
+	chainTime := time.Unix(100, 0)
+
+	feeUTXO := generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+
+	utx := func(stateBit txs.AddressStateBit, kycExpiration uint64) *txs.AddressStateTx {
+		upgradeVersionID := codec.UpgradeVersion1
+		if kycExpiration != 0 {
+			upgradeVersionID = codec.UpgradeVersion2
+		}
+		return &txs.AddressStateTx{
+			UpgradeVersionID: upgradeVersionID,
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+				Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
+			}},
+			Address:       targetAddr,
+			State:         stateBit,
+			Executor:      executorAddr,
+			ExecutorAuth:  &secp256k1fx.Input{SigIndices: []uint32{0}},
+			KYCExpiration: kycExpiration,
+		}
+	}
+
+	tests := map[string]struct {
+		stateBit      txs.AddressStateBit
+		kycExpiration uint64
+		state         func(*gomock.Controller, *txs.AddressStateTx, ids.ID) *state.MockDiff
+		expectedErr   error
+	}{
+		"OK: state isn't changed, history isn't recorded": {
+			stateBit: txs.AddressStateBitConsortium,
+			state: func(c *gomock.Controller, utx *txs.AddressStateTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{executorAddr}, nil)
+				s.EXPECT().GetAddressStates(executorAddr).Return(txs.AddressStateRoleAdmin, nil)
+				s.EXPECT().GetAddressStates(targetAddr).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				expectConsumeUTXOs(s, utx.Ins)
+				return s
+			},
+		},
+		"OK: state is changed, history is recorded": {
+			stateBit: txs.AddressStateBitConsortium,
+			state: func(c *gomock.Controller, utx *txs.AddressStateTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{executorAddr}, nil)
+				s.EXPECT().GetAddressStates(executorAddr).Return(txs.AddressStateRoleAdmin, nil)
+				s.EXPECT().GetAddressStates(targetAddr).Return(txs.AddressStateKYCVerified, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				expectConsumeUTXOs(s, utx.Ins)
+				s.EXPECT().SetAddressStates(targetAddr, txs.AddressStateKYCVerified|txs.AddressStateConsortiumMember)
+				s.EXPECT().AddAddressStateChange(targetAddr, &state.AddressStateChange{
+					TxID:     txID,
+					Executor: executorAddr,
+					Bit:      txs.AddressStateBitConsortium,
+				})
+				return s
+			},
+		},
+		"OK: expired kyc is verified again, kyc expired is removed": {
+			stateBit:      txs.AddressStateBitKYCVerified,
+			kycExpiration: uint64(chainTime.Unix()) + 100,
+			state: func(c *gomock.Controller, utx *txs.AddressStateTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime).Times(3)
+				expectVerifyMultisigPermission(s, []ids.ShortID{executorAddr}, nil)
+				s.EXPECT().GetAddressStates(executorAddr).Return(txs.AddressStateRoleAdmin, nil)
+				s.EXPECT().GetAddressStates(targetAddr).Return(txs.AddressStateKYCExpired|txs.AddressStateConsortiumMember, nil)
+				s.EXPECT().GetKYCExpiration(targetAddr).Return(uint64(0), nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				expectConsumeUTXOs(s, utx.Ins)
+				s.EXPECT().SetAddressStates(targetAddr, txs.AddressStateKYCVerified|txs.AddressStateConsortiumMember)
+				s.EXPECT().AddAddressStateChange(targetAddr, &state.AddressStateChange{
+					TxID:     txID,
+					Executor: executorAddr,
+					Bit:      txs.AddressStateBitKYCVerified,
+				})
+				s.EXPECT().AddAddressStateChange(targetAddr, &state.AddressStateChange{
+					TxID:     txID,
+					Executor: executorAddr,
+					Bit:      txs.AddressStateBitKYCExpired,
+					Remove:   true,
+				})
+				s.EXPECT().SetKYCExpiration(targetAddr, utx.KYCExpiration)
+				return s
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
+
+			utx := utx(tt.stateBit, tt.kycExpiration)
+			tx, err := txs.NewSigned(utx, txs.Codec, [][]*secp256k1.PrivateKey{{feeOwnerKey}, {executorKey}})
+			require.NoError(t, err)
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, utx, tx.ID()),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
 func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 	ctx, _ := defaultCtx(nil)
 
@@ -1982,6 +2157,25 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 		OwnerAddress: offerOwnerAddr,
 	}
 
+	allowlist := []ids.ShortID{depositCreatorAddr, utxoOwnerAddr}
+	utils.Sort(allowlist)
+	allowlistRoot, err := deposit.AllowlistRoot(allowlist)
+	require.NoError(t, err)
+	depositCreatorAllowlistProof, err := deposit.AllowlistProof(allowlist, depositCreatorAddr)
+	require.NoError(t, err)
+
+	offerWithEligibilityRules := &deposit.Offer{
+		UpgradeVersionID:     codec.UpgradeVersion2,
+		ID:                   ids.ID{0, 0, 5},
+		End:                  100,
+		MinAmount:            2,
+		MinDuration:          10,
+		MaxDuration:          20,
+		RequiredAddressState: uint64(txs.AddressStateKYCVerified),
+		MaxAddressAmount:     3,
+		AllowlistRoot:        allowlistRoot,
+	}
+
 	feeUTXO := generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
 	doubleFeeUTXO := generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, defaultTxFee*2, feeOwner, ids.Empty, ids.Empty)
 	unlockedUTXO1 := generateTestUTXO(ids.ID{2}, ctx.AVAXAssetID, offer.MinAmount, utxoOwner, ids.Empty, ids.Empty)
@@ -1989,6 +2183,30 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 	unlockedUTXO3 := generateTestUTXO(ids.ID{4}, ctx.AVAXAssetID, offerWithMaxRewardAmount.MaxRemainingAmountByReward(), utxoOwner, ids.Empty, ids.Empty)
 	bondedUTXOWithMinAmount := generateTestUTXO(ids.ID{4}, ctx.AVAXAssetID, offer.MinAmount, utxoOwner, ids.Empty, ids.ID{100})
 
+	depositTxWithCreator := func(allowlistProof []ids.ID) *txs.DepositTx {
+		return &txs.DepositTx{
+			UpgradeVersionID: codec.UpgradeVersion2,
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+				Ins: []*avax.TransferableInput{
+					generateTestInFromUTXO(feeUTXO, []uint32{0}),
+					generateTestInFromUTXO(unlockedUTXO1, []uint32{0}),
+				},
+				Outs: []*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, offerWithEligibilityRules.MinAmount, utxoOwner, locked.ThisTxID, ids.Empty),
+				},
+			}},
+			DepositOfferID:        offerWithEligibilityRules.ID,
+			DepositDuration:       offerWithEligibilityRules.MaxDuration,
+			RewardsOwner:          &secp256k1fx.OutputOwners{},
+			DepositCreatorAddress: depositCreatorAddr,
+			DepositCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			DepositOfferOwnerAuth: &secp256k1fx.Input{},
+			AllowlistProof:        allowlistProof,
+		}
+	}
+
 	phases := []struct {
 		name    string
 		prepare func(*caminoEnvironment, time.Time)
@@ -1997,12 +2215,21 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 			name: "SunrisePhase0",
 			prepare: func(env *caminoEnvironment, chaintime time.Time) {
 				env.config.AthensPhaseTime = chaintime.Add(1 * time.Second)
+				env.config.BerlinPhaseTime = chaintime.Add(1 * time.Second)
 			},
 		},
 		{
 			name: "AthensPhase",
 			prepare: func(env *caminoEnvironment, chaintime time.Time) {
 				env.config.AthensPhaseTime = chaintime
+				env.config.BerlinPhaseTime = chaintime.Add(1 * time.Second)
+			},
+		},
+		{
+			name: "BerlinPhase",
+			prepare: func(env *caminoEnvironment, chaintime time.Time) {
+				env.config.AthensPhaseTime = chaintime
+				env.config.BerlinPhaseTime = chaintime
 			},
 		},
 	}
@@ -2031,7 +2258,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 					RewardsOwner: &secp256k1fx.OutputOwners{},
 				}
 			},
-			expectedErr: []error{errWrongLockMode, errWrongLockMode},
+			expectedErr: []error{errWrongLockMode, errWrongLockMode, errWrongLockMode},
 		},
 		"Stakeable ins": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2051,7 +2278,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 					RewardsOwner: &secp256k1fx.OutputOwners{},
 				}
 			},
-			expectedErr: []error{locked.ErrWrongInType, locked.ErrWrongInType},
+			expectedErr: []error{locked.ErrWrongInType, locked.ErrWrongInType, locked.ErrWrongInType},
 		},
 		"Stakeable outs": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2071,7 +2298,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 					RewardsOwner: &secp256k1fx.OutputOwners{},
 				}
 			},
-			expectedErr: []error{locked.ErrWrongOutType, locked.ErrWrongOutType},
+			expectedErr: []error{locked.ErrWrongOutType, locked.ErrWrongOutType, locked.ErrWrongOutType},
 		},
 		"Not existing deposit offer ID": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2090,7 +2317,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 					RewardsOwner:   &secp256k1fx.OutputOwners{},
 				}
 			},
-			expectedErr: []error{database.ErrNotFound, database.ErrNotFound},
+			expectedErr: []error{database.ErrNotFound, database.ErrNotFound, database.ErrNotFound},
 		},
 		"Deposit offer is inactive by flag": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2111,7 +2338,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				}
 			},
 			chaintime:   offer.StartTime(),
-			expectedErr: []error{errDepositOfferInactive, errDepositOfferInactive},
+			expectedErr: []error{errDepositOfferInactive, errDepositOfferInactive, errDepositOfferInactive},
 		},
 		"Deposit offer is not active yet": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2132,7 +2359,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				}
 			},
 			chaintime:   offer.StartTime().Add(-1),
-			expectedErr: []error{errDepositOfferInactive, errDepositOfferInactive},
+			expectedErr: []error{errDepositOfferInactive, errDepositOfferInactive, errDepositOfferInactive},
 		},
 		"Deposit offer has expired": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2153,7 +2380,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				}
 			},
 			chaintime:   offer.EndTime().Add(time.Second),
-			expectedErr: []error{errDepositOfferInactive, errDepositOfferInactive},
+			expectedErr: []error{errDepositOfferInactive, errDepositOfferInactive, errDepositOfferInactive},
 		},
 		"Deposit duration is too small": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2175,7 +2402,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				}
 			},
 			chaintime:   offer.StartTime(),
-			expectedErr: []error{errDepositDurationTooSmall, errDepositDurationTooSmall},
+			expectedErr: []error{errDepositDurationTooSmall, errDepositDurationTooSmall, errDepositDurationTooSmall},
 		},
 		"Deposit duration is too big": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2197,7 +2424,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				}
 			},
 			chaintime:   offer.StartTime(),
-			expectedErr: []error{errDepositDurationTooBig, errDepositDurationTooBig},
+			expectedErr: []error{errDepositDurationTooBig, errDepositDurationTooBig, errDepositDurationTooBig},
 		},
 		"Deposit amount is too small": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2222,7 +2449,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				}
 			},
 			chaintime:   offer.StartTime(),
-			expectedErr: []error{errDepositTooSmall, errDepositTooSmall},
+			expectedErr: []error{errDepositTooSmall, errDepositTooSmall, errDepositTooSmall},
 		},
 		"Deposit amount is too big (offer.TotalMaxAmount)": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2248,7 +2475,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				}
 			},
 			chaintime:   offerWithMaxAmount.StartTime(),
-			expectedErr: []error{errDepositTooBig, errDepositTooBig},
+			expectedErr: []error{errDepositTooBig, errDepositTooBig, errDepositTooBig},
 		},
 		"Deposit amount is too big (offer.TotalMaxRewardAmount)": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2274,7 +2501,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				}
 			},
 			chaintime:   offerWithMaxRewardAmount.StartTime(),
-			expectedErr: []error{errNotAthensPhase, errDepositTooBig},
+			expectedErr: []error{errNotAthensPhase, errDepositTooBig, errDepositTooBig},
 		},
 		"UTXO not found": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2304,7 +2531,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				}
 			},
 			chaintime:   offer.StartTime(),
-			expectedErr: []error{errFlowCheckFailed, errFlowCheckFailed},
+			expectedErr: []error{errFlowCheckFailed, errFlowCheckFailed, errFlowCheckFailed},
 		},
 		"Inputs and credentials length mismatch": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2334,7 +2561,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 			},
 			chaintime:   offer.StartTime(),
 			signers:     [][]*secp256k1.PrivateKey{{utxoOwnerKey}, {utxoOwnerKey}},
-			expectedErr: []error{errFlowCheckFailed, errFlowCheckFailed},
+			expectedErr: []error{errFlowCheckFailed, errFlowCheckFailed, errFlowCheckFailed},
 		},
 		"Owned offer, bad offer permission credential (wrong deposit creator addr)": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2381,7 +2608,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				copy(cred.Sigs[0][:], sig)
 				return cred
 			},
-			expectedErr: []error{errNotAthensPhase, errOfferPermissionCredentialMismatch},
+			expectedErr: []error{errNotAthensPhase, errOfferPermissionCredentialMismatch, errOfferPermissionCredentialMismatch},
 		},
 		"Owned offer, bad offer permission credential (wrong offer owner key)": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2428,7 +2655,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				copy(cred.Sigs[0][:], sig)
 				return cred
 			},
-			expectedErr: []error{errNotAthensPhase, errOfferPermissionCredentialMismatch},
+			expectedErr: []error{errNotAthensPhase, errOfferPermissionCredentialMismatch, errOfferPermissionCredentialMismatch},
 		},
 		"Owned offer, bad offer permission credential (wrong offer owner auth)": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2475,7 +2702,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				copy(cred.Sigs[0][:], sig)
 				return cred
 			},
-			expectedErr: []error{errNotAthensPhase, errOfferPermissionCredentialMismatch},
+			expectedErr: []error{errNotAthensPhase, errOfferPermissionCredentialMismatch, errOfferPermissionCredentialMismatch},
 		},
 		"Owned offer, bad deposit creator credential (wrong key)": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2522,7 +2749,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				copy(cred.Sigs[0][:], sig)
 				return cred
 			},
-			expectedErr: []error{errNotAthensPhase, errDepositCreatorCredentialMismatch},
+			expectedErr: []error{errNotAthensPhase, errDepositCreatorCredentialMismatch, errDepositCreatorCredentialMismatch},
 		},
 		"Owned offer, bad deposit creator credential (wrong auth)": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2569,7 +2796,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				copy(cred.Sigs[0][:], sig)
 				return cred
 			},
-			expectedErr: []error{errNotAthensPhase, errDepositCreatorCredentialMismatch},
+			expectedErr: []error{errNotAthensPhase, errDepositCreatorCredentialMismatch, errDepositCreatorCredentialMismatch},
 		},
 		"Supply overflow": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2614,7 +2841,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 			},
 			chaintime:   offer.StartTime(),
 			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {utxoOwnerKey}},
-			expectedErr: []error{errSupplyOverflow, errSupplyOverflow},
+			expectedErr: []error{errSupplyOverflow, errSupplyOverflow, errSupplyOverflow},
 		},
 		"OK": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -3033,6 +3260,100 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 			},
 			expectedErr: []error{errNotAthensPhase},
 		},
+		"Offer with eligibility rules, deposit creator doesn't have required address state": {
+			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offerWithEligibilityRules, nil)
+				s.EXPECT().GetTimestamp().Return(offerWithEligibilityRules.StartTime())
+				if phaseIndex > 1 { // if Berlin
+					expectVerifyMultisigPermission(s, []ids.ShortID{depositCreatorAddr}, nil)
+					s.EXPECT().GetAddressStates(depositCreatorAddr).Return(txs.AddressStateConsortiumMember, nil)
+				}
+				return s
+			},
+			utx:         func() *txs.DepositTx { return depositTxWithCreator(depositCreatorAllowlistProof) },
+			chaintime:   offerWithEligibilityRules.StartTime(),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {utxoOwnerKey}, {depositCreatorKey}},
+			expectedErr: []error{errNotBerlinPhase, errNotBerlinPhase, errDepositCreatorNotEligible},
+		},
+		"Offer with eligibility rules, deposit creator isn't in allowlist": {
+			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offerWithEligibilityRules, nil)
+				s.EXPECT().GetTimestamp().Return(offerWithEligibilityRules.StartTime())
+				if phaseIndex > 1 { // if Berlin
+					expectVerifyMultisigPermission(s, []ids.ShortID{depositCreatorAddr}, nil)
+					s.EXPECT().GetAddressStates(depositCreatorAddr).Return(txs.AddressStateKYCVerified, nil)
+				}
+				return s
+			},
+			utx:         func() *txs.DepositTx { return depositTxWithCreator(nil) },
+			chaintime:   offerWithEligibilityRules.StartTime(),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {utxoOwnerKey}, {depositCreatorKey}},
+			expectedErr: []error{errNotBerlinPhase, errNotBerlinPhase, errDepositCreatorNotAllowlisted},
+		},
+		"Offer with eligibility rules, deposit creator exceeds max address amount": {
+			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offerWithEligibilityRules, nil)
+				s.EXPECT().GetTimestamp().Return(offerWithEligibilityRules.StartTime())
+				if phaseIndex > 1 { // if Berlin
+					expectVerifyMultisigPermission(s, []ids.ShortID{depositCreatorAddr}, nil)
+					s.EXPECT().GetAddressStates(depositCreatorAddr).Return(txs.AddressStateKYCVerified, nil)
+					s.EXPECT().GetDepositOfferAddressAmount(offerWithEligibilityRules.ID, depositCreatorAddr).
+						Return(offerWithEligibilityRules.MaxAddressAmount-utx.DepositAmount()+1, nil)
+				}
+				return s
+			},
+			utx:         func() *txs.DepositTx { return depositTxWithCreator(depositCreatorAllowlistProof) },
+			chaintime:   offerWithEligibilityRules.StartTime(),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {utxoOwnerKey}, {depositCreatorKey}},
+			expectedErr: []error{errNotBerlinPhase, errNotBerlinPhase, errDepositCreatorLimitExceeded},
+		},
+		"OK|Fail: deposit offer with eligibility rules": {
+			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offerWithEligibilityRules, nil)
+				s.EXPECT().GetTimestamp().Return(offerWithEligibilityRules.StartTime())
+				if phaseIndex > 1 { // if Berlin
+					expectVerifyMultisigPermission(s, []ids.ShortID{depositCreatorAddr}, nil)
+					s.EXPECT().GetAddressStates(depositCreatorAddr).
+						Return(txs.AddressStateKYCVerified|txs.AddressStateConsortiumMember, nil)
+					s.EXPECT().GetDepositOfferAddressAmount(offerWithEligibilityRules.ID, depositCreatorAddr).
+						Return(offerWithEligibilityRules.MaxAddressAmount-utx.DepositAmount(), nil)
+					expectVerifyLock(s, utx.Ins,
+						[]*avax.UTXO{feeUTXO, unlockedUTXO1},
+						[]ids.ShortID{
+							feeOwnerAddr, utxoOwnerAddr, // consumed
+							utxoOwnerAddr, // produced
+						}, nil)
+
+					deposit1 := &deposit.Deposit{
+						DepositOfferID: utx.DepositOfferID,
+						Duration:       utx.DepositDuration,
+						Amount:         utx.DepositAmount(),
+						Start:          offerWithEligibilityRules.Start, // current chaintime
+						RewardOwner:    utx.RewardsOwner,
+					}
+					s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).
+						Return(cfg.RewardConfig.SupplyCap, nil)
+					s.EXPECT().SetDepositOfferAddressAmount(offerWithEligibilityRules.ID, depositCreatorAddr,
+						offerWithEligibilityRules.MaxAddressAmount)
+					s.EXPECT().AddDeposit(txID, deposit1)
+					expectConsumeUTXOs(s, utx.Ins)
+					expectProduceNewlyLockedUTXOs(s, utx.Outs, txID, 0, locked.StateDeposited)
+				}
+				return s
+			},
+			utx:         func() *txs.DepositTx { return depositTxWithCreator(depositCreatorAllowlistProof) },
+			chaintime:   offerWithEligibilityRules.StartTime(),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {utxoOwnerKey}, {depositCreatorKey}},
+			expectedErr: []error{errNotBerlinPhase, errNotBerlinPhase},
+		},
 	}
 	for name, tt := range tests {
 		for phaseIndex, phase := range phases {
@@ -3075,6 +3396,167 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 	}
 }
 
+func TestCaminoStandardTxExecutorDepositTxFeeSponsor(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+
+	sponsorKey, sponsorAddr, sponsorOwner := generateKeyAndOwner(t)
+	utxoOwnerKey, utxoOwnerAddr, utxoOwner := generateKeyAndOwner(t)
+	otherKey, _, _ := generateKeyAndOwner(t)
+
+	offer := &deposit.Offer{
+		ID:          ids.ID{0, 0, 1},
+		End:         100,
+		MinAmount:   2,
+		MinDuration: 10,
+		MaxDuration: 20,
+	}
+
+	sponsorUTXO := generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, defaultTxFee+1, sponsorOwner, ids.Empty, ids.Empty)
+	unlockedUTXO := generateTestUTXO(ids.ID{2}, ctx.AVAXAssetID, offer.MinAmount, utxoOwner, ids.Empty, ids.Empty)
+
+	utx := func() *txs.DepositTx {
+		return &txs.DepositTx{
+			UpgradeVersionID: codec.UpgradeVersion3,
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+				Ins: []*avax.TransferableInput{
+					generateTestInFromUTXO(unlockedUTXO, []uint32{0}),
+				},
+				Outs: []*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, offer.MinAmount, utxoOwner, locked.ThisTxID, ids.Empty),
+				},
+			}},
+			DepositOfferID:        offer.ID,
+			DepositDuration:       offer.MinDuration,
+			RewardsOwner:          &secp256k1fx.OutputOwners{},
+			DepositCreatorAuth:    &secp256k1fx.Input{},
+			DepositOfferOwnerAuth: &secp256k1fx.Input{},
+			FeeSponsorIns: []*avax.TransferableInput{
+				generateTestInFromUTXO(sponsorUTXO, []uint32{0}),
+			},
+			FeeSponsorOuts: []*avax.TransferableOutput{
+				generateTestOut(ctx.AVAXAssetID, 1, sponsorOwner, ids.Empty, ids.Empty),
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, *txs.DepositTx, ids.ID, *config.Config) *state.MockDiff
+		signers     [][]*secp256k1.PrivateKey
+		berlinPhase bool
+		expectedErr error
+	}{
+		"Fee sponsor before BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer, nil)
+				s.EXPECT().GetTimestamp().Return(offer.StartTime())
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{utxoOwnerKey}, {sponsorKey}},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Fee sponsor credential is signed by wrong key": {
+			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer, nil)
+				s.EXPECT().GetTimestamp().Return(offer.StartTime())
+				expectVerifyLock(s, utx.Ins,
+					[]*avax.UTXO{unlockedUTXO},
+					[]ids.ShortID{
+						utxoOwnerAddr, // consumed
+						utxoOwnerAddr, // produced
+					}, nil)
+				expectGetUTXOsFromInputs(s, utx.FeeSponsorIns, []*avax.UTXO{sponsorUTXO})
+				expectGetMultisigAliases(s, []ids.ShortID{sponsorAddr}, nil)
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{utxoOwnerKey}, {otherKey}},
+			berlinPhase: true,
+			expectedErr: errFlowCheckFailed,
+		},
+		"Single credential for both tx spender and fee sponsor": {
+			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer, nil)
+				s.EXPECT().GetTimestamp().Return(offer.StartTime())
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{unlockedUTXO}, nil, nil)
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{sponsorKey}},
+			berlinPhase: true,
+			expectedErr: errFlowCheckFailed,
+		},
+		"OK": {
+			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer, nil)
+				s.EXPECT().GetTimestamp().Return(offer.StartTime())
+				expectVerifyLock(s, utx.Ins,
+					[]*avax.UTXO{unlockedUTXO},
+					[]ids.ShortID{
+						utxoOwnerAddr, // consumed
+						utxoOwnerAddr, // produced
+					}, nil)
+				expectGetUTXOsFromInputs(s, utx.FeeSponsorIns, []*avax.UTXO{sponsorUTXO})
+				expectGetMultisigAliases(s, []ids.ShortID{
+					sponsorAddr, // consumed
+					sponsorAddr, // produced
+				}, nil)
+
+				deposit1 := &deposit.Deposit{
+					DepositOfferID: utx.DepositOfferID,
+					Duration:       utx.DepositDuration,
+					Amount:         utx.DepositAmount(),
+					Start:          offer.Start, // current chaintime
+					RewardOwner:    utx.RewardsOwner,
+				}
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).
+					Return(cfg.RewardConfig.SupplyCap-deposit1.TotalReward(offer), nil)
+				s.EXPECT().AddDeposit(txID, deposit1)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectConsumeUTXOs(s, utx.FeeSponsorIns)
+				expectProduceNewlyLockedUTXOs(s, utx.Outs, txID, 0, locked.StateDeposited)
+				expectProduceUTXOs(s, utx.FeeSponsorOuts, txID, len(utx.Outs))
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{utxoOwnerKey}, {sponsorKey}},
+			berlinPhase: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(api.Camino{}, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }() //nolint:lint
+
+			env.config.BerlinPhaseTime = offer.StartTime().Add(1 * time.Second)
+			if tt.berlinPhase {
+				env.config.BerlinPhaseTime = offer.StartTime()
+			}
+
+			utx := utx()
+			tx, err := txs.NewSigned(utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, utx, tx.ID(), env.config),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
 func TestCaminoStandardTxExecutorUnlockDepositTx(t *testing.T) {
 	ctx, _ := defaultCtx(nil)
 	caminoGenesisConf := api.Camino{
@@ -3422,18 +3904,325 @@ func TestCaminoStandardTxExecutorUnlockDepositTx(t *testing.T) {
 	}
 }
 
-func TestCaminoStandardTxExecutorClaimTx(t *testing.T) {
+func TestCaminoStandardTxExecutorForceUnlockDepositTx(t *testing.T) {
 	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+	cfg := defaultCaminoConfig(true)
 
 	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
-	depositRewardOwnerKey, _, depositRewardOwner := generateKeyAndOwner(t)
-	claimableOwnerKey1, _, claimableOwner1 := generateKeyAndOwner(t)
-	claimableOwnerKey2, _, claimableOwner2 := generateKeyAndOwner(t)
-	_, claimToOwnerAddr1, claimToOwner1 := generateKeyAndOwner(t)
-	_, claimToOwnerAddr2, claimToOwner2 := generateKeyAndOwner(t)
-	depositRewardMsigKeys, depositRewardMsigAlias, depositRewardMsigAliasOwner, depositRewardMsigOwner := generateMsigAliasAndKeys(t, 1, 2, false)
-	claimableMsigKeys, claimableMsigAlias, claimableMsigAliasOwner, claimableMsigOwner := generateMsigAliasAndKeys(t, 2, 3, false)
-	feeMsigKeys, feeMsigAlias, feeMsigAliasOwner, feeMsigOwner := generateMsigAliasAndKeys(t, 2, 2, false)
+	owner1Key, owner1Addr, owner1 := generateKeyAndOwner(t)
+	depositTxID1 := ids.ID{0, 0, 1}
+	depositTxID2 := ids.ID{0, 0, 2}
+	depositTxID3 := ids.ID{0, 0, 3}
+
+	depositOffer := &deposit.Offer{
+		ID:                    ids.ID{0, 1},
+		MinAmount:             1,
+		MinDuration:           100,
+		MaxDuration:           100,
+		UnlockPeriodDuration:  50,
+		InterestRateNominator: 365 * 24 * 60 * 60 * 1_000_000 / 100, // 1% per second
+		EarlyUnlockAllowed:    true,
+		EarlyUnlockPenalty:    deposit.EarlyUnlockPenaltyDenominator / 10, // 10%
+	}
+	depositOfferWithoutEarlyUnlock := &deposit.Offer{
+		ID:                   ids.ID{0, 2},
+		MinAmount:            1,
+		MinDuration:          100,
+		MaxDuration:          100,
+		UnlockPeriodDuration: 50,
+	}
+	deposit1 := &deposit.Deposit{
+		DepositOfferID:      depositOffer.ID,
+		Start:               100,
+		Duration:            depositOffer.MinDuration,
+		Amount:              10000,
+		ClaimedRewardAmount: 1,
+		RewardOwner:         &owner1,
+	}
+	deposit2 := &deposit.Deposit{
+		DepositOfferID: depositOfferWithoutEarlyUnlock.ID,
+		Start:          100,
+		Duration:       depositOfferWithoutEarlyUnlock.MinDuration,
+		Amount:         10000,
+		RewardOwner:    &owner1,
+	}
+	depositOfferWithRewardLimit := &deposit.Offer{
+		ID:                    ids.ID{0, 3},
+		UpgradeVersionID:      codec.UpgradeVersion1,
+		MinAmount:             1,
+		MinDuration:           100,
+		MaxDuration:           100,
+		UnlockPeriodDuration:  50,
+		InterestRateNominator: depositOffer.InterestRateNominator,
+		EarlyUnlockAllowed:    true,
+		EarlyUnlockPenalty:    depositOffer.EarlyUnlockPenalty,
+		TotalMaxRewardAmount:  1_000_000,
+		RewardedAmount:        100_000,
+	}
+	deposit3 := &deposit.Deposit{
+		DepositOfferID:      depositOfferWithRewardLimit.ID,
+		Start:               100,
+		Duration:            depositOfferWithRewardLimit.MinDuration,
+		Amount:              10000,
+		ClaimedRewardAmount: 1,
+		RewardOwner:         &owner1,
+	}
+
+	chainTime := deposit1.StartTime().Add(25 * time.Second) // before unlock period
+	deposit1Penalty := deposit1.EarlyUnlockPenalty(depositOffer, uint64(chainTime.Unix()))
+	deposit1ForfeitedReward := deposit1.TotalReward(depositOffer) - deposit1.ClaimedRewardAmount
+	require.Equal(t, deposit1.Amount/10, deposit1Penalty)
+	require.NotZero(t, deposit1ForfeitedReward)
+	deposit3Penalty := deposit3.EarlyUnlockPenalty(depositOfferWithRewardLimit, uint64(chainTime.Unix()))
+	deposit3ForfeitedReward := deposit3.TotalReward(depositOfferWithRewardLimit) - deposit3.ClaimedRewardAmount
+	require.NotZero(t, deposit3ForfeitedReward)
+	updatedDepositOfferWithRewardLimit := *depositOfferWithRewardLimit
+	updatedDepositOfferWithRewardLimit.RewardedAmount -= deposit3ForfeitedReward
+
+	feeUTXO := generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+	deposit1UTXO := generateTestUTXO(ids.ID{2}, ctx.AVAXAssetID, deposit1.Amount, owner1, depositTxID1, ids.Empty)
+	deposit2UTXO := generateTestUTXO(ids.ID{3}, ctx.AVAXAssetID, deposit2.Amount, owner1, depositTxID2, ids.Empty)
+	deposit3UTXO := generateTestUTXO(ids.ID{4}, ctx.AVAXAssetID, deposit3.Amount, owner1, depositTxID3, ids.Empty)
+
+	forceUnlockTx := func(ins []*avax.TransferableInput, outs ...*avax.TransferableOutput) *txs.UnlockDepositTx {
+		avax.SortTransferableOutputs(outs, txs.Codec)
+		return &txs.UnlockDepositTx{
+			UpgradeVersionID: codec.UpgradeVersion1,
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+				Ins:          ins,
+				Outs:         outs,
+			}},
+			Force: true,
+		}
+	}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, *txs.UnlockDepositTx, ids.ID) *state.MockDiff
+		utx         *txs.UnlockDepositTx
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
+				return s
+			},
+			utx: forceUnlockTx(
+				generateInsFromUTXOs([]*avax.UTXO{feeUTXO, deposit1UTXO}),
+				generateTestOut(ctx.AVAXAssetID, deposit1.Amount-deposit1Penalty, owner1, ids.Empty, ids.Empty),
+				generateTestOut(ctx.AVAXAssetID, deposit1Penalty, *treasury.Owner, ids.Empty, ids.Empty),
+			),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Force unlock expired deposit": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(deposit1.EndTime())
+				s.EXPECT().GetDeposit(depositTxID1).Return(deposit1, nil)
+				return s
+			},
+			utx: forceUnlockTx(
+				generateInsFromUTXOs([]*avax.UTXO{deposit1UTXO}),
+				generateTestOut(ctx.AVAXAssetID, deposit1.Amount, owner1, ids.Empty, ids.Empty),
+			),
+			signers:     [][]*secp256k1.PrivateKey{{owner1Key}},
+			expectedErr: errForceUnlockExpiredDeposit,
+		},
+		"Produced more than consumed minus fee": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDeposit(depositTxID1).Return(deposit1, nil)
+				s.EXPECT().GetBaseFee().Return(defaultTxFee, nil)
+				return s
+			},
+			utx: forceUnlockTx(
+				generateInsFromUTXOs([]*avax.UTXO{feeUTXO, deposit1UTXO}),
+				generateTestOut(ctx.AVAXAssetID, deposit1.Amount, owner1, ids.Empty, ids.Empty),
+				generateTestOut(ctx.AVAXAssetID, deposit1Penalty, *treasury.Owner, ids.Empty, ids.Empty),
+			),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+			expectedErr: errForceUnlockNotBalanced,
+		},
+		"Deposit offer doesn't allow early unlock": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyUnlockDeposit(s, utx.Ins,
+					[]*avax.UTXO{feeUTXO, deposit2UTXO},
+					[]ids.ShortID{
+						feeOwnerAddr, owner1Addr, // consumed
+						owner1Addr, // produced unlocked
+					}, nil)
+				s.EXPECT().GetDeposit(depositTxID2).Return(deposit2, nil).Times(2)
+				s.EXPECT().GetDepositOffer(deposit2.DepositOfferID).Return(depositOfferWithoutEarlyUnlock, nil)
+				return s
+			},
+			utx: forceUnlockTx(
+				generateInsFromUTXOs([]*avax.UTXO{feeUTXO, deposit2UTXO}),
+				generateTestOut(ctx.AVAXAssetID, deposit2.Amount, owner1, ids.Empty, ids.Empty),
+			),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+			expectedErr: errEarlyUnlockNotAllowed,
+		},
+		"Deposit isn't fully unlocked": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyUnlockDeposit(s, utx.Ins,
+					[]*avax.UTXO{feeUTXO, deposit1UTXO},
+					[]ids.ShortID{
+						feeOwnerAddr, owner1Addr, // consumed
+						owner1Addr, // produced unlocked
+					}, nil)
+				s.EXPECT().GetDeposit(depositTxID1).Return(deposit1, nil).Times(2)
+				return s
+			},
+			utx: forceUnlockTx(
+				generateInsFromUTXOs([]*avax.UTXO{feeUTXO, deposit1UTXO}),
+				generateTestOut(ctx.AVAXAssetID, deposit1.Amount-deposit1Penalty-1, owner1, ids.Empty, ids.Empty),
+				generateTestOut(ctx.AVAXAssetID, 1, owner1, depositTxID1, ids.Empty),
+				generateTestOut(ctx.AVAXAssetID, deposit1Penalty, *treasury.Owner, ids.Empty, ids.Empty),
+			),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+			expectedErr: errForceUnlockNotFull,
+		},
+		"Wrong penalty": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyUnlockDeposit(s, utx.Ins,
+					[]*avax.UTXO{feeUTXO, deposit1UTXO},
+					[]ids.ShortID{
+						feeOwnerAddr, owner1Addr, // consumed
+						owner1Addr, // produced unlocked
+					}, nil)
+				s.EXPECT().GetDeposit(depositTxID1).Return(deposit1, nil).Times(2)
+				s.EXPECT().GetDepositOffer(deposit1.DepositOfferID).Return(depositOffer, nil)
+				s.EXPECT().RemoveDeposit(depositTxID1, deposit1)
+				return s
+			},
+			utx: forceUnlockTx(
+				generateInsFromUTXOs([]*avax.UTXO{feeUTXO, deposit1UTXO}),
+				generateTestOut(ctx.AVAXAssetID, deposit1.Amount-deposit1Penalty+1, owner1, ids.Empty, ids.Empty),
+				generateTestOut(ctx.AVAXAssetID, deposit1Penalty-1, *treasury.Owner, ids.Empty, ids.Empty),
+			),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+			expectedErr: errWrongEarlyUnlockPenalty,
+		},
+		"OK": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// checks
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyUnlockDeposit(s, utx.Ins,
+					[]*avax.UTXO{feeUTXO, deposit1UTXO},
+					[]ids.ShortID{
+						feeOwnerAddr, owner1Addr, // consumed
+						owner1Addr, // produced unlocked
+					}, nil)
+				// state update: deposit1
+				s.EXPECT().GetDeposit(depositTxID1).Return(deposit1, nil).Times(2)
+				s.EXPECT().GetDepositOffer(deposit1.DepositOfferID).Return(depositOffer, nil)
+				s.EXPECT().RemoveDeposit(depositTxID1, deposit1)
+				// state update: forfeited reward
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(cfg.RewardConfig.SupplyCap, nil)
+				s.EXPECT().SetCurrentSupply(constants.PrimaryNetworkID, cfg.RewardConfig.SupplyCap-deposit1ForfeitedReward)
+				// state update: ins/outs/utxos
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
+			},
+			utx: forceUnlockTx(
+				generateInsFromUTXOs([]*avax.UTXO{feeUTXO, deposit1UTXO}),
+				generateTestOut(ctx.AVAXAssetID, deposit1.Amount-deposit1Penalty, owner1, ids.Empty, ids.Empty),
+				generateTestOut(ctx.AVAXAssetID, deposit1Penalty, *treasury.Owner, ids.Empty, ids.Empty),
+			),
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+		},
+		"OK: forfeited reward is returned to offer with reward limit": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// checks
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyUnlockDeposit(s, utx.Ins,
+					[]*avax.UTXO{feeUTXO, deposit3UTXO},
+					[]ids.ShortID{
+						feeOwnerAddr, owner1Addr, // consumed
+						owner1Addr, // produced unlocked
+					}, nil)
+				// state update: deposit3
+				s.EXPECT().GetDeposit(depositTxID3).Return(deposit3, nil).Times(2)
+				s.EXPECT().GetDepositOffer(deposit3.DepositOfferID).Return(depositOfferWithRewardLimit, nil)
+				s.EXPECT().SetDepositOffer(&updatedDepositOfferWithRewardLimit)
+				s.EXPECT().RemoveDeposit(depositTxID3, deposit3)
+				// state update: forfeited reward
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(cfg.RewardConfig.SupplyCap, nil)
+				s.EXPECT().SetCurrentSupply(constants.PrimaryNetworkID, cfg.RewardConfig.SupplyCap-deposit3ForfeitedReward)
+				// state update: ins/outs/utxos
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
+			},
+			utx: forceUnlockTx(
+				generateInsFromUTXOs([]*avax.UTXO{feeUTXO, deposit3UTXO}),
+				generateTestOut(ctx.AVAXAssetID, deposit3.Amount-deposit3Penalty, owner1, ids.Empty, ids.Empty),
+				generateTestOut(ctx.AVAXAssetID, deposit3Penalty, *treasury.Owner, ids.Empty, ids.Empty),
+			),
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(shutdownCaminoEnvironment(env)) }() //nolint:lint
+
+			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
+			require.NoError(err)
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, tt.utx, tx.ID()),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorClaimTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	depositRewardOwnerKey, _, depositRewardOwner := generateKeyAndOwner(t)
+	claimableOwnerKey1, _, claimableOwner1 := generateKeyAndOwner(t)
+	claimableOwnerKey2, _, claimableOwner2 := generateKeyAndOwner(t)
+	_, claimToOwnerAddr1, claimToOwner1 := generateKeyAndOwner(t)
+	_, claimToOwnerAddr2, claimToOwner2 := generateKeyAndOwner(t)
+	depositRewardMsigKeys, depositRewardMsigAlias, depositRewardMsigAliasOwner, depositRewardMsigOwner := generateMsigAliasAndKeys(t, 1, 2, false)
+	claimableMsigKeys, claimableMsigAlias, claimableMsigAliasOwner, claimableMsigOwner := generateMsigAliasAndKeys(t, 2, 3, false)
+	feeMsigKeys, feeMsigAlias, feeMsigAliasOwner, feeMsigOwner := generateMsigAliasAndKeys(t, 2, 2, false)
 
 	feeUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
 	msigFeeUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, *feeMsigOwner, ids.Empty, ids.Empty)
@@ -3489,6 +4278,29 @@ func TestCaminoStandardTxExecutorClaimTx(t *testing.T) {
 		signers     [][]*secp256k1.PrivateKey
 		expectedErr error
 	}{
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(timestamp.Add(-1 * time.Second))
+				return s
+			},
+			utx: &txs.ClaimTx{
+				UpgradeVersionID: codec.UpgradeVersion1,
+				BaseTx:           *baseTxWithFeeInput(nil), // doesn't matter
+				Claimables: []txs.ClaimAmount{{
+					ID:        depositTxID1,
+					Amount:    1,
+					Type:      txs.ClaimTypeActiveDepositReward,
+					OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{depositRewardOwnerKey},
+			},
+			expectedErr: errNotBerlinPhase,
+		},
 		"Deposit not found": {
 			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
 				s := state.NewMockDiff(c)
@@ -4259,6 +5071,7 @@ func TestCaminoStandardTxExecutorClaimTx(t *testing.T) {
 			defer ctrl.Finish()
 			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
 			defer func() { require.NoError(shutdownCaminoEnvironment(env)) }() //nolint:lint
+			env.config.BerlinPhaseTime = timestamp
 
 			// ensuring that ins and outs from test case are sorted, signing tx
 
@@ -4744,19 +5557,21 @@ func TestCaminoStandardTxExecutorRewardsImportTx(t *testing.T) {
 					Addrs:     []ids.ShortID{{4}},
 				}
 
-				staker1 := &state.Staker{TxID: ids.ID{0, 1}, SubnetID: constants.PrimaryNetworkID}
-				staker2 := &state.Staker{TxID: ids.ID{0, 2}, SubnetID: constants.PrimaryNetworkID}
+				staker1 := &state.Staker{TxID: ids.ID{0, 1}, SubnetID: constants.PrimaryNetworkID, Priority: txs.PrimaryNetworkValidatorCurrentPriority}
+				staker2 := &state.Staker{TxID: ids.ID{0, 2}, SubnetID: constants.PrimaryNetworkID, Priority: txs.PrimaryNetworkValidatorCurrentPriority}
 				staker3 := &state.Staker{TxID: ids.ID{0, 3}, SubnetID: ids.ID{0, 0, 1}}
-				staker4 := &state.Staker{TxID: ids.ID{0, 4}, SubnetID: constants.PrimaryNetworkID}
-				staker5 := &state.Staker{TxID: ids.ID{0, 5}, SubnetID: constants.PrimaryNetworkID}
+				staker4 := &state.Staker{TxID: ids.ID{0, 4}, SubnetID: constants.PrimaryNetworkID, Priority: txs.PrimaryNetworkValidatorCurrentPriority}
+				staker5 := &state.Staker{TxID: ids.ID{0, 5}, SubnetID: constants.PrimaryNetworkID, Priority: txs.PrimaryNetworkValidatorCurrentPriority}
+				staker6 := &state.Staker{TxID: ids.ID{0, 6}, SubnetID: constants.PrimaryNetworkID, Priority: txs.PrimaryNetworkDelegatorCurrentPriority}
 
 				currentStakerIterator := state.NewMockStakerIterator(c)
-				currentStakerIterator.EXPECT().Next().Return(true).Times(5)
+				currentStakerIterator.EXPECT().Next().Return(true).Times(6)
 				currentStakerIterator.EXPECT().Value().Return(staker1)
 				currentStakerIterator.EXPECT().Value().Return(staker2)
 				currentStakerIterator.EXPECT().Value().Return(staker3)
 				currentStakerIterator.EXPECT().Value().Return(staker4)
 				currentStakerIterator.EXPECT().Value().Return(staker5)
+				currentStakerIterator.EXPECT().Value().Return(staker6)
 				currentStakerIterator.EXPECT().Next().Return(false)
 				currentStakerIterator.EXPECT().Release()
 
@@ -4794,16 +5609,66 @@ func TestCaminoStandardTxExecutorRewardsImportTx(t *testing.T) {
 				}, nil)
 				s.EXPECT().GetClaimable(rewardOwnerID4).Return(nil, database.ErrNotFound)
 
+				// owner1 has no restake setting
+				s.EXPECT().GetRewardRestakeSetting(rewardOwnerID1).Return(nil, database.ErrNotFound)
 				s.EXPECT().SetClaimable(rewardOwnerID1, &state.Claimable{
 					Owner:                rewardOwner1,
 					ValidatorReward:      12,
 					ExpiredDepositReward: 100,
 				})
+
+				// owner2 validator reward is restaked
+				restakeOffer := &deposit.Offer{
+					ID:                    ids.ID{0, 0, 0, 1},
+					InterestRateNominator: 365 * 24 * 60 * 60 * 1_000_000, // 100% per second
+					End:                   uint64(athensBlockTime.Unix()) + 1,
+					MinDuration:           100,
+					MaxDuration:           100,
+					TotalMaxAmount:        100,
+					DepositedAmount:       10,
+				}
+				restakeDeposit := &deposit.Deposit{
+					DepositOfferID: restakeOffer.ID,
+					Duration:       100,
+					Amount:         21,
+					Start:          uint64(athensBlockTime.Unix()),
+					RewardOwner:    rewardOwner2,
+				}
+				currentSupply := uint64(1000)
+				rewardOwnerIDs := []ids.ID{rewardOwnerID1, rewardOwnerID2, rewardOwnerID4}
+				utils.Sort(rewardOwnerIDs)
+				depositTxID := txID.Prefix(uint64(slices.Index(rewardOwnerIDs, rewardOwnerID2)))
+				updatedRestakeOffer := *restakeOffer
+				updatedRestakeOffer.DepositedAmount += restakeDeposit.Amount
+
+				s.EXPECT().GetRewardRestakeSetting(rewardOwnerID2).Return(&state.RewardRestakeSetting{
+					DepositOfferID:  restakeOffer.ID,
+					DepositDuration: 100,
+				}, nil)
+				s.EXPECT().GetDepositOffer(restakeOffer.ID).Return(restakeOffer, nil)
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(currentSupply, nil)
+				s.EXPECT().SetDepositOffer(&updatedRestakeOffer)
+				s.EXPECT().SetCurrentSupply(constants.PrimaryNetworkID, currentSupply+2100)
+				s.EXPECT().AddDeposit(depositTxID, restakeDeposit)
+				s.EXPECT().AddUTXO(generateTestUTXOWithIndex(depositTxID, 0, ctx.AVAXAssetID, 21, *rewardOwner2, depositTxID, ids.Empty, false))
 				s.EXPECT().SetClaimable(rewardOwnerID2, &state.Claimable{
 					Owner:                rewardOwner2,
-					ValidatorReward:      21,
 					ExpiredDepositReward: 200,
 				})
+
+				// owner4 validator reward is less than restake offer min amount
+				s.EXPECT().GetRewardRestakeSetting(rewardOwnerID4).Return(&state.RewardRestakeSetting{
+					DepositOfferID:  ids.ID{0, 0, 0, 4},
+					DepositDuration: 100,
+				}, nil)
+				s.EXPECT().GetDepositOffer(ids.ID{0, 0, 0, 4}).Return(&deposit.Offer{
+					ID:          ids.ID{0, 0, 0, 4},
+					End:         uint64(athensBlockTime.Unix()) + 1,
+					MinAmount:   2,
+					MinDuration: 100,
+					MaxDuration: 100,
+				}, nil)
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(currentSupply, nil)
 				s.EXPECT().SetClaimable(rewardOwnerID4, &state.Claimable{
 					Owner:           rewardOwner4,
 					ValidatorReward: 1,
@@ -4882,11 +5747,11 @@ func TestCaminoStandardTxExecutorRewardsImportTx(t *testing.T) {
 					Addrs:     []ids.ShortID{cMemberAddr4},
 				}
 
-				staker1 := &state.Staker{TxID: ids.ID{0, 1}, NodeID: ids.NodeID(nodeID1), SubnetID: constants.PrimaryNetworkID}
-				staker2 := &state.Staker{TxID: ids.ID{0, 2}, NodeID: ids.NodeID(nodeID2), SubnetID: constants.PrimaryNetworkID}
+				staker1 := &state.Staker{TxID: ids.ID{0, 1}, NodeID: ids.NodeID(nodeID1), SubnetID: constants.PrimaryNetworkID, Priority: txs.PrimaryNetworkValidatorCurrentPriority}
+				staker2 := &state.Staker{TxID: ids.ID{0, 2}, NodeID: ids.NodeID(nodeID2), SubnetID: constants.PrimaryNetworkID, Priority: txs.PrimaryNetworkValidatorCurrentPriority}
 				staker3 := &state.Staker{TxID: ids.ID{0, 3}, NodeID: ids.NodeID(nodeID3), SubnetID: ids.ID{0, 0, 1}}
-				staker4 := &state.Staker{TxID: ids.ID{0, 4}, NodeID: ids.NodeID(nodeID4), SubnetID: constants.PrimaryNetworkID}
-				staker5 := &state.Staker{TxID: ids.ID{0, 5}, NodeID: ids.NodeID(nodeID5), SubnetID: constants.PrimaryNetworkID}
+				staker4 := &state.Staker{TxID: ids.ID{0, 4}, NodeID: ids.NodeID(nodeID4), SubnetID: constants.PrimaryNetworkID, Priority: txs.PrimaryNetworkValidatorCurrentPriority}
+				staker5 := &state.Staker{TxID: ids.ID{0, 5}, NodeID: ids.NodeID(nodeID5), SubnetID: constants.PrimaryNetworkID, Priority: txs.PrimaryNetworkValidatorCurrentPriority}
 
 				currentStakerIterator := state.NewMockStakerIterator(c)
 				currentStakerIterator.EXPECT().Next().Return(true).Times(5)
@@ -4924,6 +5789,10 @@ func TestCaminoStandardTxExecutorRewardsImportTx(t *testing.T) {
 				}, nil)
 				s.EXPECT().GetClaimable(rewardOwnerID4).Return(nil, database.ErrNotFound)
 
+				s.EXPECT().GetRewardRestakeSetting(rewardOwnerID1).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetRewardRestakeSetting(rewardOwnerID2).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetRewardRestakeSetting(rewardOwnerID4).Return(nil, database.ErrNotFound)
+
 				s.EXPECT().SetClaimable(rewardOwnerID1, &state.Claimable{
 					Owner:                rewardOwner1,
 					ValidatorReward:      12,
@@ -5429,12 +6298,105 @@ func TestCaminoCrossExport(t *testing.T) {
 	}
 }
 
+func TestCaminoStandardTxExecutorBaseTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	ownerKey, _, owner := generateKeyAndOwner(t)
+	aliasID := ids.ShortID{'m', 's', 'i', 'g'}
+	spentUTXO := generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, defaultTxFee+10, owner, ids.Empty, ids.Empty)
+	utx := &txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+		Ins:          []*avax.TransferableInput{generateTestInFromUTXO(spentUTXO, []uint32{0})},
+		Outs: []*avax.TransferableOutput{
+			generateTestOut(ctx.AVAXAssetID, 10, owner, ids.Empty, ids.Empty),
+		},
+	}}
+	usages := map[ids.ShortID]*multisig.SpendLimitUsage{
+		aliasID: {Spends: []multisig.LimitedSpend{{Time: 100, Amount: 10}}},
+	}
+	testErr := errors.New("test err")
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, ids.ID) *state.MockDiff
+		flowChecker func(*gomock.Controller, *txs.Tx, *state.MockDiff) *utxo.MockVerifier
+		expectedErr error
+	}{
+		"Flow check failed": {
+			state: func(c *gomock.Controller, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetBaseFee().Return(defaultTxFee, nil)
+				return s
+			},
+			flowChecker: func(c *gomock.Controller, tx *txs.Tx, s *state.MockDiff) *utxo.MockVerifier {
+				fc := utxo.NewMockVerifier(c)
+				fc.EXPECT().VerifyLockWithSpendLimits(utx, s, utx.Ins, utx.Outs, tx.Creds,
+					uint64(0), defaultTxFee, ctx.AVAXAssetID, locked.StateUnlocked).Return(nil, testErr)
+				return fc
+			},
+			expectedErr: errFlowCheckFailed,
+		},
+		"OK: spend limit usages are set": {
+			state: func(c *gomock.Controller, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetBaseFee().Return(defaultTxFee, nil)
+				s.EXPECT().SetMultisigAliasSpendLimitUsage(aliasID, usages[aliasID])
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
+			},
+			flowChecker: func(c *gomock.Controller, tx *txs.Tx, s *state.MockDiff) *utxo.MockVerifier {
+				fc := utxo.NewMockVerifier(c)
+				fc.EXPECT().VerifyLockWithSpendLimits(utx, s, utx.Ins, utx.Outs, tx.Creds,
+					uint64(0), defaultTxFee, ctx.AVAXAssetID, locked.StateUnlocked).Return(usages, nil)
+				return fc
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
+
+			tx, err := txs.NewSigned(utx, txs.Codec, [][]*secp256k1.PrivateKey{{ownerKey}})
+			require.NoError(t, err)
+
+			s := tt.state(ctrl, tx.ID())
+			backend := env.backend
+			backend.FlowChecker = tt.flowChecker(ctrl, tx, s)
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &backend,
+					State:   s,
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
 func TestCaminoStandardTxExecutorMultisigAliasTx(t *testing.T) {
 	ctx, _ := defaultCtx(nil)
 
 	ownerKey, ownerAddr, owner := generateKeyAndOwner(t)
 	msigKeys, msigAlias, msigAliasOwners, msigOwner := generateMsigAliasAndKeys(t, 2, 2, true)
 	_, newMsigAlias, _, _ := generateMsigAliasAndKeys(t, 2, 2, true)
+	policyMsigKeys, policyMsigAlias, policyMsigAliasOwners, _ := generateMsigAliasAndKeys(t, 1, 2, true)
+	policyMsigAlias.UpgradeVersionID = codec.UpgradeVersion1
+	policyMsigAlias.OwnersChangeThreshold = 2
+	policyMsigAlias.OwnersChangeDelay = 100
+	policyMsigAliasChange := &multisig.AliasChange{
+		Alias:         newMsigAlias.Alias,
+		EffectiveTime: 200,
+	}
 
 	ownerUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, owner, ids.Empty, ids.Empty)
 	msigUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, *msigOwner, ids.Empty, ids.Empty)
@@ -5445,14 +6407,15 @@ func TestCaminoStandardTxExecutorMultisigAliasTx(t *testing.T) {
 	}
 
 	tests := map[string]struct {
-		state       func(*gomock.Controller, *txs.MultisigAliasTx, ids.ID) *state.MockDiff
+		state       func(*gomock.Controller, *txs.MultisigAliasTx, ids.ID, *config.Config) *state.MockDiff
 		utx         *txs.MultisigAliasTx
 		signers     [][]*secp256k1.PrivateKey
 		expectedErr error
 	}{
 		"Updating alias which does not exist": {
-			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID) *state.MockDiff {
+			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
 				s.EXPECT().GetMultisigAlias(msigAlias.ID).Return(nil, database.ErrNotFound)
 				return s
 			},
@@ -5474,9 +6437,11 @@ func TestCaminoStandardTxExecutorMultisigAliasTx(t *testing.T) {
 			expectedErr: errAliasNotFound,
 		},
 		"Updating existing alias with less signatures than threshold": {
-			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID) *state.MockDiff {
+			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
 				s.EXPECT().GetMultisigAlias(msigAlias.ID).Return(msigAlias, nil)
+				s.EXPECT().GetMultisigAliasChange(msigAlias.ID).Return(nil, database.ErrNotFound)
 				expectVerifyMultisigPermission(s, []ids.ShortID{
 					msigAliasOwners.Addrs[0],
 					msigAliasOwners.Addrs[1],
@@ -5500,13 +6465,200 @@ func TestCaminoStandardTxExecutorMultisigAliasTx(t *testing.T) {
 			},
 			expectedErr: errAliasCredentialMismatch,
 		},
-		"OK, update existing alias": {
-			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID) *state.MockDiff {
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				s.EXPECT().GetMultisigAlias(msigAlias.ID).Return(msigAlias, nil)
-				expectVerifyMultisigPermission(s, []ids.ShortID{
-					msigAliasOwners.Addrs[0],
-					msigAliasOwners.Addrs[1],
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
+				return s
+			},
+			utx: &txs.MultisigAliasTx{
+				BaseTx: txs.BaseTx{
+					BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins:          []*avax.TransferableInput{generateTestInFromUTXO(ownerUTXO, []uint32{0})},
+					},
+				},
+				MultisigAlias: multisig.Alias{
+					UpgradeVersionID:      codec.UpgradeVersion1,
+					Memo:                  msigAlias.Memo,
+					Owners:                msigAlias.Owners,
+					OwnersChangeThreshold: 2,
+				},
+				Auth: &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{ownerKey},
+			},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Updating alias with pending change": {
+			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
+				s.EXPECT().GetMultisigAlias(policyMsigAlias.ID).Return(policyMsigAlias, nil)
+				s.EXPECT().GetMultisigAliasChange(policyMsigAlias.ID).Return(policyMsigAliasChange, nil)
+				return s
+			},
+			utx: &txs.MultisigAliasTx{
+				BaseTx: txs.BaseTx{
+					BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins:          []*avax.TransferableInput{generateTestInFromUTXO(ownerUTXO, []uint32{0})},
+					},
+				},
+				MultisigAlias: policyMsigAlias.Alias,
+				Auth:          &secp256k1fx.Input{SigIndices: []uint32{0, 1}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{ownerKey},
+				{policyMsigKeys[0], policyMsigKeys[1]},
+			},
+			expectedErr: errAliasChangePending,
+		},
+		"Cancelling alias pending change, when there is none": {
+			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
+				s.EXPECT().GetMultisigAlias(policyMsigAlias.ID).Return(policyMsigAlias, nil)
+				s.EXPECT().GetMultisigAliasChange(policyMsigAlias.ID).Return(nil, database.ErrNotFound)
+				return s
+			},
+			utx: &txs.MultisigAliasTx{
+				UpgradeVersionID: codec.UpgradeVersion1,
+				BaseTx: txs.BaseTx{
+					BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins:          []*avax.TransferableInput{generateTestInFromUTXO(ownerUTXO, []uint32{0})},
+					},
+				},
+				MultisigAlias:       policyMsigAlias.Alias,
+				Auth:                &secp256k1fx.Input{SigIndices: []uint32{0}},
+				CancelPendingChange: true,
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{ownerKey},
+				{policyMsigKeys[0]},
+			},
+			expectedErr: errNoPendingAliasChange,
+		},
+		"Updating alias with less signatures than owners change threshold": {
+			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
+				s.EXPECT().GetMultisigAlias(policyMsigAlias.ID).Return(policyMsigAlias, nil)
+				s.EXPECT().GetMultisigAliasChange(policyMsigAlias.ID).Return(nil, database.ErrNotFound)
+				expectVerifyMultisigPermission(s, []ids.ShortID{
+					policyMsigAliasOwners.Addrs[0],
+					policyMsigAliasOwners.Addrs[1],
+				}, []*multisig.AliasWithNonce{})
+				return s
+			},
+			utx: &txs.MultisigAliasTx{
+				BaseTx: txs.BaseTx{
+					BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins:          []*avax.TransferableInput{generateTestInFromUTXO(ownerUTXO, []uint32{0})},
+					},
+				},
+				MultisigAlias: policyMsigAlias.Alias,
+				Auth:          &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{ownerKey},
+				{policyMsigKeys[0]},
+			},
+			expectedErr: errAliasCredentialMismatch,
+		},
+		"OK, timelocked update of existing alias": {
+			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
+				s.EXPECT().GetMultisigAlias(policyMsigAlias.ID).Return(policyMsigAlias, nil)
+				s.EXPECT().GetMultisigAliasChange(policyMsigAlias.ID).Return(nil, database.ErrNotFound)
+				expectVerifyMultisigPermission(s, []ids.ShortID{
+					policyMsigAliasOwners.Addrs[0],
+					policyMsigAliasOwners.Addrs[1],
+				}, []*multisig.AliasWithNonce{})
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{ownerUTXO}, []ids.ShortID{ownerAddr}, nil)
+				s.EXPECT().SetMultisigAliasChange(policyMsigAlias.ID, &multisig.AliasChange{
+					Alias: multisig.Alias{
+						ID:     policyMsigAlias.ID,
+						Memo:   newMsigAlias.Memo,
+						Owners: newMsigAlias.Owners,
+					},
+					EffectiveTime: uint64(cfg.BerlinPhaseTime.Unix()) + policyMsigAlias.OwnersChangeDelay,
+				})
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
+			},
+			utx: &txs.MultisigAliasTx{
+				BaseTx: txs.BaseTx{
+					BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins:          []*avax.TransferableInput{generateTestInFromUTXO(ownerUTXO, []uint32{0})},
+					},
+				},
+				MultisigAlias: multisig.Alias{
+					ID:     policyMsigAlias.ID,
+					Memo:   newMsigAlias.Memo,
+					Owners: newMsigAlias.Owners,
+				},
+				Auth: &secp256k1fx.Input{SigIndices: []uint32{0, 1}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{ownerKey},
+				{policyMsigKeys[0], policyMsigKeys[1]},
+			},
+		},
+		"OK, cancel alias pending change": {
+			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
+				s.EXPECT().GetMultisigAlias(policyMsigAlias.ID).Return(policyMsigAlias, nil)
+				s.EXPECT().GetMultisigAliasChange(policyMsigAlias.ID).Return(policyMsigAliasChange, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{
+					policyMsigAliasOwners.Addrs[0],
+					policyMsigAliasOwners.Addrs[1],
+				}, []*multisig.AliasWithNonce{})
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{ownerUTXO}, []ids.ShortID{ownerAddr}, nil)
+				s.EXPECT().SetMultisigAliasChange(policyMsigAlias.ID, nil)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
+			},
+			utx: &txs.MultisigAliasTx{
+				UpgradeVersionID: codec.UpgradeVersion1,
+				BaseTx: txs.BaseTx{
+					BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins:          []*avax.TransferableInput{generateTestInFromUTXO(ownerUTXO, []uint32{0})},
+					},
+				},
+				MultisigAlias:       policyMsigAlias.Alias,
+				Auth:                &secp256k1fx.Input{SigIndices: []uint32{0}},
+				CancelPendingChange: true,
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{ownerKey},
+				{policyMsigKeys[0]},
+			},
+		},
+		"OK, update existing alias": {
+			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
+				s.EXPECT().GetMultisigAlias(msigAlias.ID).Return(msigAlias, nil)
+				s.EXPECT().GetMultisigAliasChange(msigAlias.ID).Return(nil, database.ErrNotFound)
+				expectVerifyMultisigPermission(s, []ids.ShortID{
+					msigAliasOwners.Addrs[0],
+					msigAliasOwners.Addrs[1],
 				}, []*multisig.AliasWithNonce{})
 				expectVerifyLock(s, utx.Ins, []*avax.UTXO{ownerUTXO}, []ids.ShortID{ownerAddr}, nil)
 				s.EXPECT().SetMultisigAlias(&multisig.AliasWithNonce{
@@ -5534,8 +6686,9 @@ func TestCaminoStandardTxExecutorMultisigAliasTx(t *testing.T) {
 			},
 		},
 		"OK, add new alias": {
-			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID) *state.MockDiff {
+			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
 				expectVerifyLock(s, utx.Ins, []*avax.UTXO{ownerUTXO}, []ids.ShortID{ownerAddr}, nil)
 				s.EXPECT().SetMultisigAlias(&multisig.AliasWithNonce{
 					Alias: multisig.Alias{
@@ -5569,8 +6722,9 @@ func TestCaminoStandardTxExecutorMultisigAliasTx(t *testing.T) {
 			},
 		},
 		"OK, add new alias with multisig sender": {
-			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID) *state.MockDiff {
+			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
 				expectVerifyLock(s, utx.Ins, []*avax.UTXO{msigUTXO}, []ids.ShortID{
 					msigAlias.ID,
 					msigAliasOwners.Addrs[0],
@@ -5625,7 +6779,7 @@ func TestCaminoStandardTxExecutorMultisigAliasTx(t *testing.T) {
 			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
 				StandardTxExecutor{
 					Backend: &env.backend,
-					State:   tt.state(ctrl, tt.utx, tx.ID()),
+					State:   tt.state(ctrl, tt.utx, tx.ID(), env.config),
 					Tx:      tx,
 				},
 			})
@@ -5688,6 +6842,27 @@ func TestCaminoStandardTxExecutorAddDepositOfferTx(t *testing.T) {
 			},
 			expectedErr: errNotAthensPhase,
 		},
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.AddDepositOfferTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
+				return s
+			},
+			utx: func() *txs.AddDepositOfferTx {
+				offer := *offer1
+				offer.UpgradeVersionID = codec.UpgradeVersion2
+				return &txs.AddDepositOfferTx{
+					BaseTx:                     baseTx,
+					DepositOffer:               &offer,
+					DepositOfferCreatorAddress: offerCreatorAddr,
+					DepositOfferCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {offerCreatorKey},
+			},
+			expectedErr: errNotBerlinPhase,
+		},
 		"Not offer creator": {
 			state: func(c *gomock.Controller, utx *txs.AddDepositOfferTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
@@ -5866,6 +7041,7 @@ func TestCaminoStandardTxExecutorAddDepositOfferTx(t *testing.T) {
 			defer ctrl.Finish()
 			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
 			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
+			env.config.BerlinPhaseTime = time.Unix(100, 0)
 
 			utx := tt.utx()
 			avax.SortTransferableInputsWithSigners(utx.Ins, tt.signers)
@@ -5884,3 +7060,3187 @@ func TestCaminoStandardTxExecutorAddDepositOfferTx(t *testing.T) {
 		})
 	}
 }
+
+func TestCaminoStandardTxExecutorUpdateDepositOfferTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	offerOwnerKey, offerOwnerAddr, _ := generateKeyAndOwner(t)
+	offersAdminKey, offersAdminAddr, _ := generateKeyAndOwner(t)
+
+	feeUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+
+	chainTime := time.Unix(100, 0)
+
+	offer1 := &deposit.Offer{
+		UpgradeVersionID:      codec.UpgradeVersion1,
+		ID:                    ids.ID{1},
+		Start:                 0,
+		End:                   200,
+		MinDuration:           1,
+		MaxDuration:           1,
+		MinAmount:             deposit.OfferMinDepositAmount,
+		InterestRateNominator: 1,
+		TotalMaxRewardAmount:  100,
+		RewardedAmount:        50,
+		OwnerAddress:          offerOwnerAddr,
+	}
+
+	lockedOffer1 := *offer1
+	lockedOffer1.Flags = deposit.OfferFlagLocked
+
+	baseTx := txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+		Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
+	}}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, *txs.UpdateDepositOfferTx, *config.Config) *state.MockDiff
+		utx         *txs.UpdateDepositOfferTx
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
+				return s
+			},
+			utx: &txs.UpdateDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferID:             offer1.ID,
+				End:                        offer1.End,
+				TotalMaxRewardAmount:       offer1.TotalMaxRewardAmount,
+				DepositOfferUpdaterAddress: offerOwnerAddr,
+				DepositOfferUpdaterAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {offerOwnerKey},
+			},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Deposit offer not found": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(nil, database.ErrNotFound)
+				return s
+			},
+			utx: &txs.UpdateDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferID:             offer1.ID,
+				End:                        offer1.End,
+				TotalMaxRewardAmount:       offer1.TotalMaxRewardAmount,
+				DepositOfferUpdaterAddress: offerOwnerAddr,
+				DepositOfferUpdaterAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {offerOwnerKey},
+			},
+			expectedErr: database.ErrNotFound,
+		},
+		"Not offer owner or offers admin": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer1, nil)
+				s.EXPECT().GetAddressStates(utx.DepositOfferUpdaterAddress).Return(txs.AddressStateOffersCreator, nil)
+				return s
+			},
+			utx: &txs.UpdateDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferID:             offer1.ID,
+				End:                        offer1.End,
+				TotalMaxRewardAmount:       offer1.TotalMaxRewardAmount,
+				DepositOfferUpdaterAddress: offersAdminAddr,
+				DepositOfferUpdaterAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {offersAdminKey},
+			},
+			expectedErr: errNotOfferUpdater,
+		},
+		"Bad offer updater signature": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer1, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
+				return s
+			},
+			utx: &txs.UpdateDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferID:             offer1.ID,
+				End:                        offer1.End,
+				TotalMaxRewardAmount:       offer1.TotalMaxRewardAmount,
+				DepositOfferUpdaterAddress: offerOwnerAddr,
+				DepositOfferUpdaterAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {feeOwnerKey},
+			},
+			expectedErr: errOfferUpdaterCredentialMismatch,
+		},
+		"End after current offer end": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer1, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
+				return s
+			},
+			utx: &txs.UpdateDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferID:             offer1.ID,
+				End:                        offer1.End + 1,
+				TotalMaxRewardAmount:       offer1.TotalMaxRewardAmount,
+				DepositOfferUpdaterAddress: offerOwnerAddr,
+				DepositOfferUpdaterAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {offerOwnerKey},
+			},
+			expectedErr: errWrongDepositOfferEnd,
+		},
+		"End before chain time": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer1, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
+				return s
+			},
+			utx: &txs.UpdateDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferID:             offer1.ID,
+				End:                        uint64(chainTime.Unix()) - 1,
+				TotalMaxRewardAmount:       offer1.TotalMaxRewardAmount,
+				DepositOfferUpdaterAddress: offerOwnerAddr,
+				DepositOfferUpdaterAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {offerOwnerKey},
+			},
+			expectedErr: errWrongDepositOfferEnd,
+		},
+		"Raised total max reward amount": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer1, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
+				return s
+			},
+			utx: &txs.UpdateDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferID:             offer1.ID,
+				End:                        offer1.End,
+				TotalMaxRewardAmount:       offer1.TotalMaxRewardAmount + 1,
+				DepositOfferUpdaterAddress: offerOwnerAddr,
+				DepositOfferUpdaterAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {offerOwnerKey},
+			},
+			expectedErr: errWrongDepositOfferLimits,
+		},
+		"Total max reward amount less than rewarded amount": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer1, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
+				return s
+			},
+			utx: &txs.UpdateDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferID:             offer1.ID,
+				End:                        offer1.End,
+				TotalMaxRewardAmount:       offer1.RewardedAmount - 1,
+				DepositOfferUpdaterAddress: offerOwnerAddr,
+				DepositOfferUpdaterAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {offerOwnerKey},
+			},
+			expectedErr: errWrongDepositOfferLimits,
+		},
+		"Added total max amount": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer1, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
+				return s
+			},
+			utx: &txs.UpdateDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferID:             offer1.ID,
+				End:                        offer1.End,
+				TotalMaxAmount:             1,
+				DepositOfferUpdaterAddress: offerOwnerAddr,
+				DepositOfferUpdaterAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {offerOwnerKey},
+			},
+			expectedErr: errWrongDepositOfferLimits,
+		},
+		"Supply overflow on unlock": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(&lockedOffer1, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).
+					Return(cfg.RewardConfig.SupplyCap-lockedOffer1.RemainingReward()+1, nil)
+				s.EXPECT().GetAllDepositOffers().Return([]*deposit.Offer{&lockedOffer1}, nil)
+				return s
+			},
+			utx: &txs.UpdateDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferID:             offer1.ID,
+				End:                        offer1.End,
+				TotalMaxRewardAmount:       offer1.TotalMaxRewardAmount,
+				DepositOfferUpdaterAddress: offerOwnerAddr,
+				DepositOfferUpdaterAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {offerOwnerKey},
+			},
+			expectedErr: errSupplyOverflow,
+		},
+		"OK: offer owner locks offer": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer1, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
+				s.EXPECT().SetDepositOffer(&lockedOffer1)
+				expectConsumeUTXOs(s, utx.Ins)
+				return s
+			},
+			utx: &txs.UpdateDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferID:             offer1.ID,
+				Flags:                      deposit.OfferFlagLocked,
+				End:                        offer1.End,
+				TotalMaxRewardAmount:       offer1.TotalMaxRewardAmount,
+				DepositOfferUpdaterAddress: offerOwnerAddr,
+				DepositOfferUpdaterAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {offerOwnerKey},
+			},
+		},
+		"OK: offers admin unlocks offer, shortens end and lowers limit": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(&lockedOffer1, nil)
+				s.EXPECT().GetAddressStates(utx.DepositOfferUpdaterAddress).Return(txs.AddressStateRoleOffersAdmin, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).
+					Return(cfg.RewardConfig.SupplyCap-1, nil)
+				s.EXPECT().GetAllDepositOffers().Return([]*deposit.Offer{&lockedOffer1}, nil)
+
+				updatedOffer := *offer1
+				updatedOffer.End = utx.End
+				updatedOffer.TotalMaxRewardAmount = utx.TotalMaxRewardAmount
+				s.EXPECT().SetDepositOffer(&updatedOffer)
+
+				expectConsumeUTXOs(s, utx.Ins)
+				return s
+			},
+			utx: &txs.UpdateDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferID:             offer1.ID,
+				End:                        offer1.End - 1,
+				TotalMaxRewardAmount:       offer1.RewardedAmount + 1,
+				DepositOfferUpdaterAddress: offersAdminAddr,
+				DepositOfferUpdaterAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {offersAdminKey},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
+
+			avax.SortTransferableInputsWithSigners(tt.utx.Ins, tt.signers)
+			avax.SortTransferableOutputs(tt.utx.Outs, txs.Codec)
+			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, tt.utx, env.config),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorTransferDepositTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	depositOwnerKey, depositOwnerAddr, depositOwner := generateKeyAndOwner(t)
+	offerOwnerKey, offerOwnerAddr, _ := generateKeyAndOwner(t)
+	_, newOwnerAddr, newOwner := generateKeyAndOwner(t)
+
+	chainTime := time.Unix(100, 0)
+	depositTxID := ids.ID{1}
+
+	offer := &deposit.Offer{ID: ids.ID{2}}
+	ownedOffer := &deposit.Offer{ID: ids.ID{3}, OwnerAddress: offerOwnerAddr}
+
+	deposit1 := &deposit.Deposit{
+		DepositOfferID: offer.ID,
+		Start:          0,
+		Duration:       200,
+		Amount:         10,
+		UnlockedAmount: 2,
+		RewardOwner:    &depositOwner,
+	}
+	depositWithOwnedOffer := *deposit1
+	depositWithOwnedOffer.DepositOfferID = ownedOffer.ID
+
+	feeUTXO := generateTestUTXO(ids.ID{4}, ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+	depositedUTXO := generateTestUTXO(ids.ID{5}, ctx.AVAXAssetID, deposit1.Amount-deposit1.UnlockedAmount, depositOwner, depositTxID, ids.Empty)
+
+	baseTx := func(outsOwner secp256k1fx.OutputOwners, outsAmount uint64) txs.BaseTx {
+		return txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    ctx.NetworkID,
+			BlockchainID: ctx.ChainID,
+			Ins: []*avax.TransferableInput{
+				generateTestInFromUTXO(feeUTXO, []uint32{0}),
+				generateTestInFromUTXO(depositedUTXO, []uint32{0}),
+			},
+			Outs: []*avax.TransferableOutput{
+				generateTestOut(ctx.AVAXAssetID, outsAmount, outsOwner, depositTxID, ids.Empty),
+			},
+		}}
+	}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, *txs.TransferDepositTx, ids.ID, *config.Config) *state.MockDiff
+		utx         *txs.TransferDepositTx
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
+				return s
+			},
+			utx: &txs.TransferDepositTx{
+				BaseTx:                baseTx(newOwner, depositedUTXO.Out.(avax.TransferableOut).Amount()),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &newOwner,
+				RewardOwnerAuth:       &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {depositOwnerKey}, {depositOwnerKey},
+			},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Deposit not found": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDeposit(utx.DepositTxID).Return(nil, database.ErrNotFound)
+				return s
+			},
+			utx: &txs.TransferDepositTx{
+				BaseTx:                baseTx(newOwner, depositedUTXO.Out.(avax.TransferableOut).Amount()),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &newOwner,
+				RewardOwnerAuth:       &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {depositOwnerKey}, {depositOwnerKey},
+			},
+			expectedErr: errDepositNotFound,
+		},
+		"Deposit expired": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(deposit1.EndTime())
+				s.EXPECT().GetDeposit(utx.DepositTxID).Return(deposit1, nil)
+				return s
+			},
+			utx: &txs.TransferDepositTx{
+				BaseTx:                baseTx(newOwner, depositedUTXO.Out.(avax.TransferableOut).Amount()),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &newOwner,
+				RewardOwnerAuth:       &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {depositOwnerKey}, {depositOwnerKey},
+			},
+			expectedErr: errDepositExpired,
+		},
+		"Missing offer owner credential": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDeposit(utx.DepositTxID).Return(&depositWithOwnedOffer, nil)
+				s.EXPECT().GetDepositOffer(depositWithOwnedOffer.DepositOfferID).Return(ownedOffer, nil)
+				return s
+			},
+			utx: &txs.TransferDepositTx{
+				BaseTx:                baseTx(newOwner, depositedUTXO.Out.(avax.TransferableOut).Amount()),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &newOwner,
+				RewardOwnerAuth:       &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {depositOwnerKey}, {depositOwnerKey},
+			},
+			expectedErr: errWrongCredentialsNumber,
+		},
+		"Bad reward owner signature": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDeposit(utx.DepositTxID).Return(deposit1, nil)
+				s.EXPECT().GetDepositOffer(deposit1.DepositOfferID).Return(offer, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{depositOwnerAddr}, nil)
+				return s
+			},
+			utx: &txs.TransferDepositTx{
+				BaseTx:                baseTx(newOwner, depositedUTXO.Out.(avax.TransferableOut).Amount()),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &newOwner,
+				RewardOwnerAuth:       &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {depositOwnerKey}, {feeOwnerKey},
+			},
+			expectedErr: errRewardOwnerCredentialMismatch,
+		},
+		"Bad offer owner signature": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDeposit(utx.DepositTxID).Return(&depositWithOwnedOffer, nil)
+				s.EXPECT().GetDepositOffer(depositWithOwnedOffer.DepositOfferID).Return(ownedOffer, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{depositOwnerAddr, offerOwnerAddr}, nil)
+				return s
+			},
+			utx: &txs.TransferDepositTx{
+				BaseTx:                baseTx(newOwner, depositedUTXO.Out.(avax.TransferableOut).Amount()),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &newOwner,
+				RewardOwnerAuth:       &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {depositOwnerKey}, {depositOwnerKey}, {depositOwnerKey},
+			},
+			expectedErr: errOfferOwnerCredentialMismatch,
+		},
+		"Deposited tokens transferred not to new reward owner": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDeposit(utx.DepositTxID).Return(deposit1, nil)
+				s.EXPECT().GetDepositOffer(deposit1.DepositOfferID).Return(offer, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{depositOwnerAddr, newOwnerAddr}, nil)
+				expectGetUTXOsFromInputs(s, utx.Ins[1:], []*avax.UTXO{depositedUTXO})
+				expectGetMultisigAliases(s, []ids.ShortID{depositOwnerAddr}, nil)
+				return s
+			},
+			utx: &txs.TransferDepositTx{
+				BaseTx:                baseTx(depositOwner, depositedUTXO.Out.(avax.TransferableOut).Amount()),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &newOwner,
+				RewardOwnerAuth:       &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {depositOwnerKey}, {depositOwnerKey},
+			},
+			expectedErr: errTransferredToWrongOwner,
+		},
+		"Deposit isn't fully transferred": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				deposit := *deposit1
+				deposit.UnlockedAmount = 0
+
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDeposit(utx.DepositTxID).Return(&deposit, nil)
+				s.EXPECT().GetDepositOffer(deposit.DepositOfferID).Return(offer, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{depositOwnerAddr, newOwnerAddr}, nil)
+				expectGetUTXOsFromInputs(s, utx.Ins[1:], []*avax.UTXO{depositedUTXO})
+				expectGetMultisigAliases(s, []ids.ShortID{depositOwnerAddr}, nil)
+				return s
+			},
+			utx: &txs.TransferDepositTx{
+				BaseTx:                baseTx(newOwner, depositedUTXO.Out.(avax.TransferableOut).Amount()),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &newOwner,
+				RewardOwnerAuth:       &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {depositOwnerKey}, {depositOwnerKey},
+			},
+			expectedErr: errDepositNotFullyTransferred,
+		},
+		"OK: new reward owner": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDeposit(utx.DepositTxID).Return(deposit1, nil)
+				s.EXPECT().GetDepositOffer(deposit1.DepositOfferID).Return(offer, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{depositOwnerAddr, newOwnerAddr}, nil)
+				expectGetUTXOsFromInputs(s, utx.Ins[1:], []*avax.UTXO{depositedUTXO})
+				expectGetMultisigAliases(s, []ids.ShortID{depositOwnerAddr}, nil)
+				expectVerifyLock(s, utx.Ins[:1], []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+
+				updatedDeposit := *deposit1
+				updatedDeposit.RewardOwner = &newOwner
+				s.EXPECT().ModifyDeposit(utx.DepositTxID, &updatedDeposit)
+
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
+			},
+			utx: &txs.TransferDepositTx{
+				BaseTx:                baseTx(newOwner, depositedUTXO.Out.(avax.TransferableOut).Amount()),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &newOwner,
+				RewardOwnerAuth:       &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {depositOwnerKey}, {depositOwnerKey},
+			},
+		},
+		"OK: same reward owner, owned offer": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDeposit(utx.DepositTxID).Return(&depositWithOwnedOffer, nil)
+				s.EXPECT().GetDepositOffer(depositWithOwnedOffer.DepositOfferID).Return(ownedOffer, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{depositOwnerAddr, offerOwnerAddr, depositOwnerAddr}, nil)
+				expectGetUTXOsFromInputs(s, utx.Ins[1:], []*avax.UTXO{depositedUTXO})
+				expectGetMultisigAliases(s, []ids.ShortID{depositOwnerAddr}, nil)
+				expectVerifyLock(s, utx.Ins[:1], []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
+			},
+			utx: &txs.TransferDepositTx{
+				BaseTx:                baseTx(depositOwner, depositedUTXO.Out.(avax.TransferableOut).Amount()),
+				DepositTxID:           depositTxID,
+				RewardOwner:           &depositOwner,
+				RewardOwnerAuth:       &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {depositOwnerKey}, {depositOwnerKey}, {offerOwnerKey},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
+
+			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, tt.utx, tx.ID(), env.config),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorSetRewardRestakeTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	claimableOwnerKey, claimableOwnerAddr, claimableOwner := generateKeyAndOwner(t)
+	_, offerOwnerAddr, _ := generateKeyAndOwner(t)
+
+	claimableOwnerID, err := txs.GetOwnerID(&claimableOwner)
+	require.NoError(t, err)
+
+	chainTime := time.Unix(100, 0)
+
+	offer := &deposit.Offer{
+		ID:          ids.ID{1},
+		Start:       0,
+		End:         200,
+		MinDuration: 10,
+		MaxDuration: 20,
+	}
+	ownedOffer := *offer
+	ownedOffer.ID = ids.ID{2}
+	ownedOffer.OwnerAddress = offerOwnerAddr
+	inactiveOffer := *offer
+	inactiveOffer.ID = ids.ID{3}
+	inactiveOffer.End = 99
+
+	feeUTXO := generateTestUTXO(ids.ID{4}, ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+
+	baseTx := txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+		Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
+	}}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, *txs.SetRewardRestakeTx, *config.Config) *state.MockDiff
+		utx         *txs.SetRewardRestakeTx
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.SetRewardRestakeTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
+				return s
+			},
+			utx: &txs.SetRewardRestakeTx{
+				BaseTx:             baseTx,
+				ClaimableOwner:     &claimableOwner,
+				ClaimableOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferID:     offer.ID,
+				DepositDuration:    offer.MinDuration,
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {claimableOwnerKey},
+			},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Wrong credentials number": {
+			state: func(c *gomock.Controller, utx *txs.SetRewardRestakeTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				return s
+			},
+			utx: &txs.SetRewardRestakeTx{
+				BaseTx:             baseTx,
+				ClaimableOwner:     &claimableOwner,
+				ClaimableOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferID:     offer.ID,
+				DepositDuration:    offer.MinDuration,
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+			},
+			expectedErr: errWrongCredentialsNumber,
+		},
+		"Bad claimable owner signature": {
+			state: func(c *gomock.Controller, utx *txs.SetRewardRestakeTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{claimableOwnerAddr}, nil)
+				return s
+			},
+			utx: &txs.SetRewardRestakeTx{
+				BaseTx:             baseTx,
+				ClaimableOwner:     &claimableOwner,
+				ClaimableOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferID:     offer.ID,
+				DepositDuration:    offer.MinDuration,
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {feeOwnerKey},
+			},
+			expectedErr: errClaimableCredentialMismatch,
+		},
+		"Deposit offer not found": {
+			state: func(c *gomock.Controller, utx *txs.SetRewardRestakeTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{claimableOwnerAddr}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(nil, database.ErrNotFound)
+				return s
+			},
+			utx: &txs.SetRewardRestakeTx{
+				BaseTx:             baseTx,
+				ClaimableOwner:     &claimableOwner,
+				ClaimableOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferID:     offer.ID,
+				DepositDuration:    offer.MinDuration,
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {claimableOwnerKey},
+			},
+			expectedErr: database.ErrNotFound,
+		},
+		"Deposit offer has owner": {
+			state: func(c *gomock.Controller, utx *txs.SetRewardRestakeTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{claimableOwnerAddr}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(&ownedOffer, nil)
+				return s
+			},
+			utx: &txs.SetRewardRestakeTx{
+				BaseTx:             baseTx,
+				ClaimableOwner:     &claimableOwner,
+				ClaimableOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferID:     ownedOffer.ID,
+				DepositDuration:    ownedOffer.MinDuration,
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {claimableOwnerKey},
+			},
+			expectedErr: errRestakeOfferHasOwner,
+		},
+		"Deposit offer inactive": {
+			state: func(c *gomock.Controller, utx *txs.SetRewardRestakeTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{claimableOwnerAddr}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(&inactiveOffer, nil)
+				return s
+			},
+			utx: &txs.SetRewardRestakeTx{
+				BaseTx:             baseTx,
+				ClaimableOwner:     &claimableOwner,
+				ClaimableOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferID:     inactiveOffer.ID,
+				DepositDuration:    inactiveOffer.MinDuration,
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {claimableOwnerKey},
+			},
+			expectedErr: errDepositOfferInactive,
+		},
+		"Deposit duration is too small": {
+			state: func(c *gomock.Controller, utx *txs.SetRewardRestakeTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{claimableOwnerAddr}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer, nil)
+				return s
+			},
+			utx: &txs.SetRewardRestakeTx{
+				BaseTx:             baseTx,
+				ClaimableOwner:     &claimableOwner,
+				ClaimableOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferID:     offer.ID,
+				DepositDuration:    offer.MinDuration - 1,
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {claimableOwnerKey},
+			},
+			expectedErr: errDepositDurationTooSmall,
+		},
+		"Deposit duration is too big": {
+			state: func(c *gomock.Controller, utx *txs.SetRewardRestakeTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{claimableOwnerAddr}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer, nil)
+				return s
+			},
+			utx: &txs.SetRewardRestakeTx{
+				BaseTx:             baseTx,
+				ClaimableOwner:     &claimableOwner,
+				ClaimableOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferID:     offer.ID,
+				DepositDuration:    offer.MaxDuration + 1,
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {claimableOwnerKey},
+			},
+			expectedErr: errDepositDurationTooBig,
+		},
+		"OK: set": {
+			state: func(c *gomock.Controller, utx *txs.SetRewardRestakeTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{claimableOwnerAddr}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().SetRewardRestakeSetting(claimableOwnerID, &state.RewardRestakeSetting{
+					DepositOfferID:  offer.ID,
+					DepositDuration: offer.MaxDuration,
+				})
+				expectConsumeUTXOs(s, utx.Ins)
+				return s
+			},
+			utx: &txs.SetRewardRestakeTx{
+				BaseTx:             baseTx,
+				ClaimableOwner:     &claimableOwner,
+				ClaimableOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				DepositOfferID:     offer.ID,
+				DepositDuration:    offer.MaxDuration,
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {claimableOwnerKey},
+			},
+		},
+		"OK: remove": {
+			state: func(c *gomock.Controller, utx *txs.SetRewardRestakeTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{claimableOwnerAddr}, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().SetRewardRestakeSetting(claimableOwnerID, nil)
+				expectConsumeUTXOs(s, utx.Ins)
+				return s
+			},
+			utx: &txs.SetRewardRestakeTx{
+				BaseTx:             baseTx,
+				ClaimableOwner:     &claimableOwner,
+				ClaimableOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {claimableOwnerKey},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
+
+			avax.SortTransferableInputsWithSigners(tt.utx.Ins, tt.signers)
+			avax.SortTransferableOutputs(tt.utx.Outs, txs.Codec)
+			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, tt.utx, env.config),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorAddDelegatorTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	delegatorKey, delegatorAddr, delegatorOwner := generateKeyAndOwner(t)
+	_, rewardOwnerAddr, rewardOwner := generateKeyAndOwner(t)
+	nodeID := ids.NodeID{1}
+	depositTxID := ids.ID{2}
+
+	chainTime := time.Unix(100, 0)
+	startTime := chainTime.Add(time.Second)
+	endTime := startTime.Add(defaultMinStakingDuration)
+	delegatorWeight := defaultCaminoValidatorWeight / 2
+	maxValidatorStake := 2 * defaultCaminoValidatorWeight
+
+	validator := &state.Staker{
+		TxID:      ids.ID{3},
+		NodeID:    nodeID,
+		SubnetID:  constants.PrimaryNetworkID,
+		Weight:    defaultCaminoValidatorWeight,
+		StartTime: chainTime,
+		EndTime:   endTime,
+	}
+
+	unlockedUTXO := generateTestUTXO(ids.ID{4}, ctx.AVAXAssetID, delegatorWeight+defaultTxFee, delegatorOwner, ids.Empty, ids.Empty)
+	bigUnlockedUTXO := generateTestUTXO(ids.ID{5}, ctx.AVAXAssetID, maxValidatorStake, delegatorOwner, ids.Empty, ids.Empty)
+	depositedUTXO := generateTestUTXO(ids.ID{6}, ctx.AVAXAssetID, delegatorWeight, delegatorOwner, depositTxID, ids.Empty)
+	feeUTXO := generateTestUTXO(ids.ID{7}, ctx.AVAXAssetID, defaultTxFee, delegatorOwner, ids.Empty, ids.Empty)
+
+	delegatorTx := func(start, end time.Time, weight uint64, utxos ...*avax.UTXO) *txs.CaminoAddDelegatorTx {
+		ins := make([]*avax.TransferableInput, len(utxos))
+		for i, utxo := range utxos {
+			ins[i] = generateTestInFromUTXO(utxo, []uint32{0})
+		}
+		depositTxID := ids.Empty
+		if lockedOut, ok := utxos[0].Out.(*locked.Out); ok {
+			depositTxID = lockedOut.DepositTxID
+		}
+		return &txs.CaminoAddDelegatorTx{AddDelegatorTx: txs.AddDelegatorTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+				Ins:          ins,
+				Outs: []*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, weight, delegatorOwner, depositTxID, locked.ThisTxID),
+				},
+			}},
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				Start:  uint64(start.Unix()),
+				End:    uint64(end.Unix()),
+				Wght:   weight,
+			},
+			DelegationRewardsOwner: &rewardOwner,
+		}}
+	}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, *txs.AddDelegatorTx, ids.ID, *config.Config) *state.MockDiff
+		utx         txs.UnsignedTx
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"Not camino tx": {
+			state: func(c *gomock.Controller, utx *txs.AddDelegatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				return s
+			},
+			utx:         &delegatorTx(startTime, endTime, delegatorWeight, unlockedUTXO).AddDelegatorTx,
+			signers:     [][]*secp256k1.PrivateKey{{delegatorKey}},
+			expectedErr: errWrongTxType,
+		},
+		"Not LockModeBondDeposit": {
+			state: func(c *gomock.Controller, utx *txs.AddDelegatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: false}, nil)
+				return s
+			},
+			utx:         delegatorTx(startTime, endTime, delegatorWeight, unlockedUTXO),
+			signers:     [][]*secp256k1.PrivateKey{{delegatorKey}},
+			expectedErr: errWrongLockMode,
+		},
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.AddDelegatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
+				return s
+			},
+			utx:         delegatorTx(startTime, endTime, delegatorWeight, unlockedUTXO),
+			signers:     [][]*secp256k1.PrivateKey{{delegatorKey}},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Stake is too short": {
+			state: func(c *gomock.Controller, utx *txs.AddDelegatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				return s
+			},
+			utx:         delegatorTx(startTime, endTime.Add(-time.Second), delegatorWeight, unlockedUTXO),
+			signers:     [][]*secp256k1.PrivateKey{{delegatorKey}},
+			expectedErr: errStakeTooShort,
+		},
+		"Weight is too small": {
+			state: func(c *gomock.Controller, utx *txs.AddDelegatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				return s
+			},
+			utx:         delegatorTx(startTime, endTime, 1, unlockedUTXO),
+			signers:     [][]*secp256k1.PrivateKey{{delegatorKey}},
+			expectedErr: errWeightTooSmall,
+		},
+		"Validator not found": {
+			state: func(c *gomock.Controller, utx *txs.AddDelegatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetPendingValidator(constants.PrimaryNetworkID, nodeID).Return(nil, database.ErrNotFound)
+				return s
+			},
+			utx:         delegatorTx(startTime, endTime, delegatorWeight, unlockedUTXO),
+			signers:     [][]*secp256k1.PrivateKey{{delegatorKey}},
+			expectedErr: database.ErrNotFound,
+		},
+		"Delegation ends after validation": {
+			state: func(c *gomock.Controller, utx *txs.AddDelegatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(validator, nil)
+				return s
+			},
+			utx:         delegatorTx(startTime, endTime.Add(time.Second), delegatorWeight, unlockedUTXO),
+			signers:     [][]*secp256k1.PrivateKey{{delegatorKey}},
+			expectedErr: errOverDelegated,
+		},
+		"Delegated weight is too big": {
+			state: func(c *gomock.Controller, utx *txs.AddDelegatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(validator, nil)
+				s.EXPECT().GetCurrentDelegatorIterator(constants.PrimaryNetworkID, nodeID).Return(state.EmptyIterator, nil).Times(2)
+				s.EXPECT().GetPendingDelegatorIterator(constants.PrimaryNetworkID, nodeID).Return(state.EmptyIterator, nil)
+				return s
+			},
+			utx:         delegatorTx(startTime, endTime, maxValidatorStake-defaultCaminoValidatorWeight+1, bigUnlockedUTXO),
+			signers:     [][]*secp256k1.PrivateKey{{delegatorKey}},
+			expectedErr: errOverDelegated,
+		},
+		"OK": {
+			state: func(c *gomock.Controller, utx *txs.AddDelegatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(validator, nil)
+				s.EXPECT().GetCurrentDelegatorIterator(constants.PrimaryNetworkID, nodeID).Return(state.EmptyIterator, nil).Times(2)
+				s.EXPECT().GetPendingDelegatorIterator(constants.PrimaryNetworkID, nodeID).Return(state.EmptyIterator, nil)
+				expectGetMultisigAliases(s, []ids.ShortID{rewardOwnerAddr}, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{unlockedUTXO}, []ids.ShortID{delegatorAddr, delegatorAddr}, nil)
+				staker, err := state.NewPendingStaker(txID, utx)
+				require.NoError(t, err)
+				s.EXPECT().PutPendingDelegator(staker)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceNewlyLockedUTXOs(s, utx.Outs, txID, 0, locked.StateBonded)
+				return s
+			},
+			utx:     delegatorTx(startTime, endTime, delegatorWeight, unlockedUTXO),
+			signers: [][]*secp256k1.PrivateKey{{delegatorKey}},
+		},
+		"OK: deposited tokens": {
+			state: func(c *gomock.Controller, utx *txs.AddDelegatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(validator, nil)
+				s.EXPECT().GetCurrentDelegatorIterator(constants.PrimaryNetworkID, nodeID).Return(state.EmptyIterator, nil).Times(2)
+				s.EXPECT().GetPendingDelegatorIterator(constants.PrimaryNetworkID, nodeID).Return(state.EmptyIterator, nil)
+				expectGetMultisigAliases(s, []ids.ShortID{rewardOwnerAddr}, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{depositedUTXO, feeUTXO}, []ids.ShortID{delegatorAddr, delegatorAddr, delegatorAddr}, nil)
+				staker, err := state.NewPendingStaker(txID, utx)
+				require.NoError(t, err)
+				s.EXPECT().PutPendingDelegator(staker)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceNewlyLockedUTXOs(s, utx.Outs, txID, 0, locked.StateBonded)
+				return s
+			},
+			utx:     delegatorTx(startTime, endTime, delegatorWeight, depositedUTXO, feeUTXO),
+			signers: [][]*secp256k1.PrivateKey{{delegatorKey}, {delegatorKey}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
+			env.config.MaxValidatorStake = maxValidatorStake
+
+			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
+
+			var utx *txs.AddDelegatorTx
+			switch castedUtx := tt.utx.(type) {
+			case *txs.CaminoAddDelegatorTx:
+				utx = &castedUtx.AddDelegatorTx
+			case *txs.AddDelegatorTx:
+				utx = castedUtx
+			}
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, utx, tx.ID(), env.config),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorTransformSubnetTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	subnetOwnerKey, _, subnetOwner := generateKeyAndOwner(t)
+	subnetID := ids.ID{1}
+	subnetAssetID := ids.ID{2}
+	depositTxID := ids.ID{3}
+
+	createSubnetTx := &txs.Tx{Unsigned: &txs.CreateSubnetTx{Owner: &subnetOwner}}
+
+	feeUTXO := generateTestUTXO(ids.ID{4}, ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+	rewardUTXO := generateTestUTXO(ids.ID{5}, subnetAssetID, 90, feeOwner, ids.Empty, ids.Empty)
+	smallRewardUTXO := generateTestUTXO(ids.ID{6}, subnetAssetID, 89, feeOwner, ids.Empty, ids.Empty)
+	depositedUTXO := generateTestUTXO(ids.ID{7}, ctx.AVAXAssetID, defaultTxFee, feeOwner, depositTxID, ids.Empty)
+
+	transformSubnetTx := func(maxStakeDuration uint32, utxos ...*avax.UTXO) *txs.TransformSubnetTx {
+		ins := make([]*avax.TransferableInput, len(utxos))
+		for i, utxo := range utxos {
+			ins[i] = generateTestInFromUTXO(utxo, []uint32{0})
+		}
+		avax.SortTransferableInputs(ins)
+		return &txs.TransformSubnetTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+				Ins:          ins,
+			}},
+			Subnet:                   subnetID,
+			AssetID:                  subnetAssetID,
+			InitialSupply:            10,
+			MaximumSupply:            100,
+			MaxConsumptionRate:       reward.PercentDenominator,
+			MinValidatorStake:        1,
+			MaxValidatorStake:        100,
+			MinStakeDuration:         1,
+			MaxStakeDuration:         maxStakeDuration,
+			MinDelegatorStake:        1,
+			MaxValidatorWeightFactor: 1,
+			SubnetAuth:               &secp256k1fx.Input{SigIndices: []uint32{0}},
+		}
+	}
+
+	cfg := defaultCaminoConfig(true)
+	cfg.BerlinPhaseTime = time.Unix(100, 0)
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, *txs.Tx) *state.MockDiff
+		utx         *txs.TransformSubnetTx
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"Locked input": {
+			state: func(c *gomock.Controller, tx *txs.Tx) *state.MockDiff {
+				return state.NewMockDiff(c)
+			},
+			utx:         transformSubnetTx(2, depositedUTXO, rewardUTXO),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {feeOwnerKey}, {subnetOwnerKey}},
+			expectedErr: locked.ErrWrongInType,
+		},
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, tx *txs.Tx) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
+				return s
+			},
+			utx:         transformSubnetTx(2, feeUTXO, rewardUTXO),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {feeOwnerKey}, {subnetOwnerKey}},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Max stake duration is too big": {
+			state: func(c *gomock.Controller, tx *txs.Tx) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
+				return s
+			},
+			utx:         transformSubnetTx(math.MaxUint32, feeUTXO, rewardUTXO),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {feeOwnerKey}, {subnetOwnerKey}},
+			expectedErr: errMaxStakeDurationTooLarge,
+		},
+		"Subnet is already transformed": {
+			state: func(c *gomock.Controller, tx *txs.Tx) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
+				s.EXPECT().GetTx(subnetID).Return(createSubnetTx, status.Committed, nil)
+				s.EXPECT().GetSubnetTransformation(subnetID).Return(&txs.Tx{}, nil)
+				return s
+			},
+			utx:         transformSubnetTx(2, feeUTXO, rewardUTXO),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {feeOwnerKey}, {subnetOwnerKey}},
+			expectedErr: errIsImmutable,
+		},
+		"Not enough subnet asset burned": {
+			state: func(c *gomock.Controller, tx *txs.Tx) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
+				s.EXPECT().GetTx(subnetID).Return(createSubnetTx, status.Committed, nil)
+				s.EXPECT().GetSubnetTransformation(subnetID).Return(nil, database.ErrNotFound)
+				// subnet asset is verified before avax, so verification fails before avax utxo is fetched
+				utx := tx.Unsigned.(*txs.TransformSubnetTx)
+				expectGetUTXOsFromInputs(s, utx.Ins[1:], []*avax.UTXO{smallRewardUTXO})
+				expectGetMultisigAliases(s, []ids.ShortID{feeOwnerAddr}, nil)
+				return s
+			},
+			utx:         transformSubnetTx(2, feeUTXO, smallRewardUTXO),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {feeOwnerKey}, {subnetOwnerKey}},
+			expectedErr: errFlowCheckFailed,
+		},
+		"OK": {
+			state: func(c *gomock.Controller, tx *txs.Tx) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
+				s.EXPECT().GetTx(subnetID).Return(createSubnetTx, status.Committed, nil)
+				s.EXPECT().GetSubnetTransformation(subnetID).Return(nil, database.ErrNotFound)
+				utx := tx.Unsigned.(*txs.TransformSubnetTx)
+				expectGetUTXOsFromInputs(s, utx.Ins, []*avax.UTXO{feeUTXO, rewardUTXO})
+				expectGetMultisigAliases(s, []ids.ShortID{feeOwnerAddr, feeOwnerAddr}, nil)
+				expectConsumeUTXOs(s, utx.Ins)
+				s.EXPECT().AddSubnetTransformation(tx)
+				s.EXPECT().SetCurrentSupply(subnetID, utx.InitialSupply)
+				return s
+			},
+			utx:     transformSubnetTx(2, feeUTXO, rewardUTXO),
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {feeOwnerKey}, {subnetOwnerKey}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
+			env.config.TransformSubnetTxFee = defaultTxFee
+			env.config.BerlinPhaseTime = cfg.BerlinPhaseTime
+
+			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, tx),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorAddPermissionlessValidatorTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	nodeOwnerKey, nodeOwnerAddr, _ := generateKeyAndOwner(t)
+	stakerKey, stakerAddr, stakerOwner := generateKeyAndOwner(t)
+	_, rewardOwnerAddr, rewardOwner := generateKeyAndOwner(t)
+	nodeID := ids.NodeID{1}
+	subnetID := ids.ID{2}
+	subnetAssetID := ids.ID{3}
+	otherAssetID := ids.ID{4}
+	stakeWeight := uint64(10)
+
+	chainTime := time.Unix(100, 0)
+	startTime := chainTime.Add(time.Second)
+	endTime := startTime.Add(100 * time.Second)
+
+	transformSubnetTx := &txs.Tx{Unsigned: &txs.TransformSubnetTx{
+		Subnet:            subnetID,
+		AssetID:           subnetAssetID,
+		MinValidatorStake: 1,
+		MaxValidatorStake: 100,
+		MinStakeDuration:  1,
+		MaxStakeDuration:  1000,
+	}}
+	primaryValidator := &state.Staker{
+		TxID:      ids.ID{5},
+		NodeID:    nodeID,
+		SubnetID:  constants.PrimaryNetworkID,
+		StartTime: chainTime,
+		EndTime:   endTime.Add(time.Second),
+	}
+
+	feeUTXO := generateTestUTXO(ids.ID{6}, ctx.AVAXAssetID, defaultTxFee, stakerOwner, ids.Empty, ids.Empty)
+	stakeUTXO := generateTestUTXO(ids.ID{7}, subnetAssetID, stakeWeight, stakerOwner, ids.Empty, ids.Empty)
+	otherStakeUTXO := generateTestUTXO(ids.ID{8}, otherAssetID, stakeWeight, stakerOwner, ids.Empty, ids.Empty)
+
+	validatorTx := func(stakeUTXO *avax.UTXO) *txs.CaminoAddPermissionlessValidatorTx {
+		ins := []*avax.TransferableInput{
+			generateTestInFromUTXO(feeUTXO, []uint32{0}),
+			generateTestInFromUTXO(stakeUTXO, []uint32{0}),
+		}
+		avax.SortTransferableInputs(ins)
+		return &txs.CaminoAddPermissionlessValidatorTx{
+			AddPermissionlessValidatorTx: txs.AddPermissionlessValidatorTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins:          ins,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(stakeUTXO.AssetID(), stakeWeight, stakerOwner, ids.Empty, locked.ThisTxID),
+					},
+				}},
+				Validator: txs.Validator{
+					NodeID: nodeID,
+					Start:  uint64(startTime.Unix()),
+					End:    uint64(endTime.Unix()),
+					Wght:   stakeWeight,
+				},
+				Subnet:                subnetID,
+				Signer:                &signer.Empty{},
+				ValidatorRewardsOwner: &rewardOwner,
+				DelegatorRewardsOwner: &rewardOwner,
+			},
+			NodeOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+		}
+	}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, *txs.AddPermissionlessValidatorTx, ids.ID) *state.MockDiff
+		utx         txs.UnsignedTx
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"Not camino tx": {
+			state: func(c *gomock.Controller, utx *txs.AddPermissionlessValidatorTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				return s
+			},
+			utx:         &validatorTx(stakeUTXO).AddPermissionlessValidatorTx,
+			signers:     [][]*secp256k1.PrivateKey{{stakerKey}, {stakerKey}},
+			expectedErr: errWrongTxType,
+		},
+		"Not LockModeBondDeposit": {
+			state: func(c *gomock.Controller, utx *txs.AddPermissionlessValidatorTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: false}, nil)
+				return s
+			},
+			utx:         validatorTx(stakeUTXO),
+			signers:     [][]*secp256k1.PrivateKey{{stakerKey}, {stakerKey}, {nodeOwnerKey}},
+			expectedErr: errWrongLockMode,
+		},
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.AddPermissionlessValidatorTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime.Add(-1 * time.Second))
+				return s
+			},
+			utx:         validatorTx(stakeUTXO),
+			signers:     [][]*secp256k1.PrivateKey{{stakerKey}, {stakerKey}, {nodeOwnerKey}},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Node isn't registered": {
+			state: func(c *gomock.Controller, utx *txs.AddPermissionlessValidatorTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode).
+					Return(ids.ShortEmpty, database.ErrNotFound)
+				return s
+			},
+			utx:         validatorTx(stakeUTXO),
+			signers:     [][]*secp256k1.PrivateKey{{stakerKey}, {stakerKey}, {nodeOwnerKey}},
+			expectedErr: errNodeNotRegistered,
+		},
+		"Node owner doesn't match subnet requirements": {
+			state: func(c *gomock.Controller, utx *txs.AddPermissionlessValidatorTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode).Return(nodeOwnerAddr, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				s.EXPECT().GetSubnetValidatorRequirements(subnetID).
+					Return(&state.SubnetValidatorRequirements{RequireKYCVerified: true}, nil)
+				s.EXPECT().GetAddressStates(nodeOwnerAddr).Return(txs.AddressStateConsortiumMember, nil)
+				return s
+			},
+			utx:         validatorTx(stakeUTXO),
+			signers:     [][]*secp256k1.PrivateKey{{stakerKey}, {stakerKey}, {nodeOwnerKey}},
+			expectedErr: errNotKYCVerified,
+		},
+		"Wrong staked asset": {
+			state: func(c *gomock.Controller, utx *txs.AddPermissionlessValidatorTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode).Return(nodeOwnerAddr, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				s.EXPECT().GetSubnetValidatorRequirements(subnetID).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetSubnetTransformation(subnetID).Return(transformSubnetTx, nil)
+				return s
+			},
+			utx:         validatorTx(otherStakeUTXO),
+			signers:     [][]*secp256k1.PrivateKey{{stakerKey}, {stakerKey}, {nodeOwnerKey}},
+			expectedErr: errWrongStakedAssetID,
+		},
+		"Not primary network validator": {
+			state: func(c *gomock.Controller, utx *txs.AddPermissionlessValidatorTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode).Return(nodeOwnerAddr, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				s.EXPECT().GetSubnetValidatorRequirements(subnetID).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetSubnetTransformation(subnetID).Return(transformSubnetTx, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetCurrentValidator(subnetID, nodeID).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetPendingValidator(subnetID, nodeID).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetDeferredValidator(subnetID, nodeID).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetPendingValidator(constants.PrimaryNetworkID, nodeID).Return(nil, database.ErrNotFound)
+				return s
+			},
+			utx:         validatorTx(stakeUTXO),
+			signers:     [][]*secp256k1.PrivateKey{{stakerKey}, {stakerKey}, {nodeOwnerKey}},
+			expectedErr: database.ErrNotFound,
+		},
+		"OK": {
+			state: func(c *gomock.Controller, utx *txs.AddPermissionlessValidatorTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode).Return(nodeOwnerAddr, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				s.EXPECT().GetSubnetValidatorRequirements(subnetID).
+					Return(&state.SubnetValidatorRequirements{RequireKYCVerified: true}, nil)
+				s.EXPECT().GetAddressStates(nodeOwnerAddr).Return(txs.AddressStateKYCVerified, nil)
+				s.EXPECT().GetSubnetTransformation(subnetID).Return(transformSubnetTx, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetCurrentValidator(subnetID, nodeID).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetPendingValidator(subnetID, nodeID).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetDeferredValidator(subnetID, nodeID).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(primaryValidator, nil)
+				expectGetMultisigAliases(s, []ids.ShortID{rewardOwnerAddr}, nil)
+				expectGetUTXOsFromInputs(s, utx.Ins, []*avax.UTXO{feeUTXO, stakeUTXO})
+				expectGetMultisigAliases(s, []ids.ShortID{stakerAddr, stakerAddr, stakerAddr}, nil)
+				staker, err := state.NewPendingStaker(txID, utx)
+				require.NoError(t, err)
+				s.EXPECT().PutPendingValidator(staker)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceNewlyLockedUTXOs(s, utx.Outs, txID, 0, locked.StateBonded)
+				return s
+			},
+			utx:     validatorTx(stakeUTXO),
+			signers: [][]*secp256k1.PrivateKey{{stakerKey}, {stakerKey}, {nodeOwnerKey}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
+			env.config.AddSubnetValidatorFee = defaultTxFee
+			env.config.BerlinPhaseTime = chainTime
+
+			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
+
+			var utx *txs.AddPermissionlessValidatorTx
+			switch castedUtx := tt.utx.(type) {
+			case *txs.CaminoAddPermissionlessValidatorTx:
+				utx = &castedUtx.AddPermissionlessValidatorTx
+			case *txs.AddPermissionlessValidatorTx:
+				utx = castedUtx
+			}
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, utx, tx.ID()),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorSetSubnetValidatorRequirementsTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	subnetOwnerKey, _, subnetOwner := generateKeyAndOwner(t)
+	subnetID := ids.ID{1}
+
+	createSubnetTx := &txs.Tx{Unsigned: &txs.CreateSubnetTx{Owner: &subnetOwner}}
+	feeUTXO := generateTestUTXO(ids.ID{2}, ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+
+	utx := &txs.SetSubnetValidatorRequirementsTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    ctx.NetworkID,
+			BlockchainID: ctx.ChainID,
+			Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
+		}},
+		Subnet:                  subnetID,
+		SubnetAuth:              &secp256k1fx.Input{SigIndices: []uint32{0}},
+		RequireConsortiumMember: true,
+	}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, ids.ID, *config.Config) *state.MockDiff
+		expectedErr error
+	}{
+		"Not LockModeBondDeposit": {
+			state: func(c *gomock.Controller, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: false}, nil)
+				return s
+			},
+			expectedErr: errWrongLockMode,
+		},
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
+				return s
+			},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Subnet is already transformed": {
+			state: func(c *gomock.Controller, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
+				s.EXPECT().GetTx(subnetID).Return(createSubnetTx, status.Committed, nil)
+				s.EXPECT().GetSubnetTransformation(subnetID).Return(&txs.Tx{}, nil)
+				return s
+			},
+			expectedErr: errIsImmutable,
+		},
+		"OK": {
+			state: func(c *gomock.Controller, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
+				s.EXPECT().GetTx(subnetID).Return(createSubnetTx, status.Committed, nil)
+				s.EXPECT().GetSubnetTransformation(subnetID).Return(nil, database.ErrNotFound)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().SetSubnetValidatorRequirements(subnetID, &state.SubnetValidatorRequirements{
+					RequireConsortiumMember: true,
+				})
+				expectConsumeUTXOs(s, utx.Ins)
+				return s
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
+
+			tx, err := txs.NewSigned(utx, txs.Codec, [][]*secp256k1.PrivateKey{{feeOwnerKey}, {subnetOwnerKey}})
+			require.NoError(t, err)
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, tx.ID(), env.config),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorTreasurySpendTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	adminKey, adminAddr, _ := generateKeyAndOwner(t)
+	_, _, recipientOwner := generateKeyAndOwner(t)
+
+	chainTime := time.Unix(120, 0)
+	caminoConfig := &state.CaminoConfig{
+		LockModeBondDeposit: true,
+		TreasuryAdmin:       adminAddr,
+		TreasurySpendLimit:  100,
+		TreasurySpendPeriod: 50,
+	}
+	periodStart := uint64(100)
+
+	feeUTXO := generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+	treasuryUTXO := generateTestUTXO(ids.ID{2}, ctx.AVAXAssetID, 80, *treasury.Owner, ids.Empty, ids.Empty)
+	notTreasuryUTXO := generateTestUTXO(ids.ID{2}, ctx.AVAXAssetID, 80, recipientOwner, ids.Empty, ids.Empty)
+
+	baseTx := txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+		Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
+	}}
+	treasuryIns := []*avax.TransferableInput{generateTestInFromUTXO(treasuryUTXO, []uint32{})}
+	treasuryOuts := []*avax.TransferableOutput{
+		generateTestOut(ctx.AVAXAssetID, 30, recipientOwner, ids.Empty, ids.Empty),
+		generateTestOut(ctx.AVAXAssetID, 50, *treasury.Owner, ids.Empty, ids.Empty),
+	}
+	avax.SortTransferableOutputs(treasuryOuts, txs.Codec)
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, *txs.TreasurySpendTx, ids.ID, *config.Config) *state.MockDiff
+		utx         *txs.TreasurySpendTx
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.TreasurySpendTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
+				return s
+			},
+			utx: &txs.TreasurySpendTx{
+				BaseTx:            baseTx,
+				Reference:         []byte{1},
+				TreasuryIns:       treasuryIns,
+				TreasuryOuts:      treasuryOuts,
+				TreasuryAdminAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {adminKey}},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Treasury admin isn't set": {
+			state: func(c *gomock.Controller, utx *txs.TreasurySpendTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				return s
+			},
+			utx: &txs.TreasurySpendTx{
+				BaseTx:            baseTx,
+				Reference:         []byte{1},
+				TreasuryIns:       treasuryIns,
+				TreasuryOuts:      treasuryOuts,
+				TreasuryAdminAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {adminKey}},
+			expectedErr: errTreasuryAdminNotSet,
+		},
+		"Bad treasury admin signature": {
+			state: func(c *gomock.Controller, utx *txs.TreasurySpendTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{adminAddr}, nil)
+				return s
+			},
+			utx: &txs.TreasurySpendTx{
+				BaseTx:            baseTx,
+				Reference:         []byte{1},
+				TreasuryIns:       treasuryIns,
+				TreasuryOuts:      treasuryOuts,
+				TreasuryAdminAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {feeOwnerKey}},
+			expectedErr: errTreasuryAdminCredentialMismatch,
+		},
+		"Treasury input doesn't spend treasury utxo": {
+			state: func(c *gomock.Controller, utx *txs.TreasurySpendTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{adminAddr}, nil)
+				expectGetUTXOsFromInputs(s, utx.TreasuryIns, []*avax.UTXO{notTreasuryUTXO})
+				return s
+			},
+			utx: &txs.TreasurySpendTx{
+				BaseTx:            baseTx,
+				Reference:         []byte{1},
+				TreasuryIns:       treasuryIns,
+				TreasuryOuts:      treasuryOuts,
+				TreasuryAdminAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {adminKey}},
+			expectedErr: errNotTreasuryUTXO,
+		},
+		"Treasury outputs amount doesn't match inputs amount": {
+			state: func(c *gomock.Controller, utx *txs.TreasurySpendTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{adminAddr}, nil)
+				expectGetUTXOsFromInputs(s, utx.TreasuryIns, []*avax.UTXO{treasuryUTXO})
+				return s
+			},
+			utx: &txs.TreasurySpendTx{
+				BaseTx:      baseTx,
+				Reference:   []byte{1},
+				TreasuryIns: treasuryIns,
+				TreasuryOuts: []*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, 30, recipientOwner, ids.Empty, ids.Empty),
+				},
+				TreasuryAdminAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {adminKey}},
+			expectedErr: errTreasurySpendNotBalanced,
+		},
+		"Nothing is spent": {
+			state: func(c *gomock.Controller, utx *txs.TreasurySpendTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{adminAddr}, nil)
+				expectGetUTXOsFromInputs(s, utx.TreasuryIns, []*avax.UTXO{treasuryUTXO})
+				return s
+			},
+			utx: &txs.TreasurySpendTx{
+				BaseTx:      baseTx,
+				Reference:   []byte{1},
+				TreasuryIns: treasuryIns,
+				TreasuryOuts: []*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, 80, *treasury.Owner, ids.Empty, ids.Empty),
+				},
+				TreasuryAdminAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {adminKey}},
+			expectedErr: errNoTreasurySpend,
+		},
+		"Spend limit exceeded": {
+			state: func(c *gomock.Controller, utx *txs.TreasurySpendTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{adminAddr}, nil)
+				expectGetUTXOsFromInputs(s, utx.TreasuryIns, []*avax.UTXO{treasuryUTXO})
+				s.EXPECT().GetTreasurySpentAmount(periodStart).Return(uint64(71), nil)
+				return s
+			},
+			utx: &txs.TreasurySpendTx{
+				BaseTx:            baseTx,
+				Reference:         []byte{1},
+				TreasuryIns:       treasuryIns,
+				TreasuryOuts:      treasuryOuts,
+				TreasuryAdminAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {adminKey}},
+			expectedErr: errTreasurySpendLimitExceeded,
+		},
+		"OK": {
+			state: func(c *gomock.Controller, utx *txs.TreasurySpendTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{adminAddr}, nil)
+				expectGetUTXOsFromInputs(s, utx.TreasuryIns, []*avax.UTXO{treasuryUTXO})
+				s.EXPECT().GetTreasurySpentAmount(periodStart).Return(uint64(70), nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().SetTreasurySpentAmount(periodStart, uint64(100))
+				s.EXPECT().AddTreasurySpend(&state.TreasurySpend{
+					TxID:      txID,
+					Timestamp: uint64(chainTime.Unix()),
+					Amount:    30,
+					Reference: utx.Reference,
+				})
+				expectConsumeUTXOs(s, utx.Ins)
+				expectConsumeUTXOs(s, utx.TreasuryIns)
+				expectProduceUTXOs(s, utx.TreasuryOuts, txID, 0)
+				return s
+			},
+			utx: &txs.TreasurySpendTx{
+				BaseTx:            baseTx,
+				Reference:         []byte{1},
+				TreasuryIns:       treasuryIns,
+				TreasuryOuts:      treasuryOuts,
+				TreasuryAdminAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {adminKey}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
+
+			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, tt.utx, tx.ID(), env.config),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorRotateValidatorNodeTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+	caminoConfig := &state.CaminoConfig{LockModeBondDeposit: true}
+
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	nodeOwnerKey, nodeOwnerAddr, _ := generateKeyAndOwner(t)
+	oldNodeKey, oldNodeAddr, _ := generateKeyAndOwner(t)
+	newNodeKey, newNodeAddr, _ := generateKeyAndOwner(t)
+	oldNodeID := ids.NodeID(oldNodeAddr)
+	newNodeID := ids.NodeID(newNodeAddr)
+
+	chainTime := time.Unix(120, 0)
+
+	feeUTXO := generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+
+	validator := &state.Staker{
+		TxID:            ids.ID{2},
+		NodeID:          oldNodeID,
+		SubnetID:        constants.PrimaryNetworkID,
+		Weight:          1,
+		StartTime:       time.Unix(100, 0),
+		EndTime:         time.Unix(200, 0),
+		PotentialReward: 10,
+		NextTime:        time.Unix(200, 0),
+		Priority:        txs.PrimaryNetworkValidatorCurrentPriority,
+	}
+	rotatedValidator := *validator
+	rotatedValidator.NodeID = newNodeID
+	subnetValidator := &state.Staker{
+		TxID:     ids.ID{3},
+		NodeID:   oldNodeID,
+		SubnetID: ids.ID{4},
+		Weight:   1,
+		Priority: txs.SubnetPermissionedValidatorCurrentPriority,
+	}
+
+	utx := &txs.RotateValidatorNodeTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    ctx.NetworkID,
+			BlockchainID: ctx.ChainID,
+			Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
+		}},
+		OldNodeID:        oldNodeID,
+		NewNodeID:        newNodeID,
+		NodeOwnerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+		NodeOwnerAddress: nodeOwnerAddr,
+	}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, *txs.RotateValidatorNodeTx, ids.ID, *config.Config) *state.MockDiff
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.RotateValidatorNodeTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {newNodeKey}, {nodeOwnerKey}},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Not consortium member": {
+			state: func(c *gomock.Controller, utx *txs.RotateValidatorNodeTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetAddressStates(nodeOwnerAddr).Return(txs.AddressStateEmpty, nil)
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {newNodeKey}, {nodeOwnerKey}},
+			expectedErr: errNotConsortiumMember,
+		},
+		"Old node isn't registered for consortium member": {
+			state: func(c *gomock.Controller, utx *txs.RotateValidatorNodeTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetAddressStates(nodeOwnerAddr).Return(txs.AddressStateConsortiumMember, nil)
+				s.EXPECT().GetShortIDLink(nodeOwnerAddr, state.ShortLinkKeyRegisterNode).Return(ids.ShortID{1}, nil)
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {newNodeKey}, {nodeOwnerKey}},
+			expectedErr: errNotNodeOwner,
+		},
+		"New node is already registered": {
+			state: func(c *gomock.Controller, utx *txs.RotateValidatorNodeTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetAddressStates(nodeOwnerAddr).Return(txs.AddressStateConsortiumMember, nil)
+				s.EXPECT().GetShortIDLink(nodeOwnerAddr, state.ShortLinkKeyRegisterNode).Return(oldNodeAddr, nil)
+				s.EXPECT().GetShortIDLink(newNodeAddr, state.ShortLinkKeyRegisterNode).Return(ids.ShortID{1}, nil)
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {newNodeKey}, {nodeOwnerKey}},
+			expectedErr: errNodeAlreadyRegistered,
+		},
+		"Bad consortium member signature": {
+			state: func(c *gomock.Controller, utx *txs.RotateValidatorNodeTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetAddressStates(nodeOwnerAddr).Return(txs.AddressStateConsortiumMember, nil)
+				s.EXPECT().GetShortIDLink(nodeOwnerAddr, state.ShortLinkKeyRegisterNode).Return(oldNodeAddr, nil)
+				s.EXPECT().GetShortIDLink(newNodeAddr, state.ShortLinkKeyRegisterNode).Return(ids.ShortEmpty, database.ErrNotFound)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {newNodeKey}, {feeOwnerKey}},
+			expectedErr: errSignatureMissing,
+		},
+		"Bad new node signature": {
+			state: func(c *gomock.Controller, utx *txs.RotateValidatorNodeTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetAddressStates(nodeOwnerAddr).Return(txs.AddressStateConsortiumMember, nil)
+				s.EXPECT().GetShortIDLink(nodeOwnerAddr, state.ShortLinkKeyRegisterNode).Return(oldNodeAddr, nil)
+				s.EXPECT().GetShortIDLink(newNodeAddr, state.ShortLinkKeyRegisterNode).Return(ids.ShortEmpty, database.ErrNotFound)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {oldNodeKey}, {nodeOwnerKey}},
+			expectedErr: errNodeSignatureMissing,
+		},
+		"Old node isn't current validator": {
+			state: func(c *gomock.Controller, utx *txs.RotateValidatorNodeTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetAddressStates(nodeOwnerAddr).Return(txs.AddressStateConsortiumMember, nil)
+				s.EXPECT().GetShortIDLink(nodeOwnerAddr, state.ShortLinkKeyRegisterNode).Return(oldNodeAddr, nil)
+				s.EXPECT().GetShortIDLink(newNodeAddr, state.ShortLinkKeyRegisterNode).Return(ids.ShortEmpty, database.ErrNotFound)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, oldNodeID).Return(nil, database.ErrNotFound)
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {newNodeKey}, {nodeOwnerKey}},
+			expectedErr: errValidatorNotFound,
+		},
+		"New node is validator": {
+			state: func(c *gomock.Controller, utx *txs.RotateValidatorNodeTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetAddressStates(nodeOwnerAddr).Return(txs.AddressStateConsortiumMember, nil)
+				s.EXPECT().GetShortIDLink(nodeOwnerAddr, state.ShortLinkKeyRegisterNode).Return(oldNodeAddr, nil)
+				s.EXPECT().GetShortIDLink(newNodeAddr, state.ShortLinkKeyRegisterNode).Return(ids.ShortEmpty, database.ErrNotFound)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, oldNodeID).Return(validator, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, newNodeID).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetPendingValidator(constants.PrimaryNetworkID, newNodeID).Return(&state.Staker{}, nil)
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {newNodeKey}, {nodeOwnerKey}},
+			expectedErr: errValidatorExists,
+		},
+		"Old node has subnet validator": {
+			state: func(c *gomock.Controller, utx *txs.RotateValidatorNodeTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetAddressStates(nodeOwnerAddr).Return(txs.AddressStateConsortiumMember, nil)
+				s.EXPECT().GetShortIDLink(nodeOwnerAddr, state.ShortLinkKeyRegisterNode).Return(oldNodeAddr, nil)
+				s.EXPECT().GetShortIDLink(newNodeAddr, state.ShortLinkKeyRegisterNode).Return(ids.ShortEmpty, database.ErrNotFound)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, oldNodeID).Return(validator, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, newNodeID).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetPendingValidator(constants.PrimaryNetworkID, newNodeID).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetDeferredValidator(constants.PrimaryNetworkID, newNodeID).Return(nil, database.ErrNotFound)
+				currentStakerIterator := state.NewMockStakerIterator(c)
+				gomock.InOrder(
+					currentStakerIterator.EXPECT().Next().Return(true),
+					currentStakerIterator.EXPECT().Value().Return(validator),
+					currentStakerIterator.EXPECT().Next().Return(true),
+					currentStakerIterator.EXPECT().Value().Return(subnetValidator),
+					currentStakerIterator.EXPECT().Release(),
+				)
+				s.EXPECT().GetCurrentStakerIterator().Return(currentStakerIterator, nil)
+				s.EXPECT().GetPendingStakerIterator().Return(state.EmptyIterator, nil)
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {newNodeKey}, {nodeOwnerKey}},
+			expectedErr: errRotatedNodeHasStakers,
+		},
+		"OK": {
+			state: func(c *gomock.Controller, utx *txs.RotateValidatorNodeTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoConfig, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetAddressStates(nodeOwnerAddr).Return(txs.AddressStateConsortiumMember, nil)
+				s.EXPECT().GetShortIDLink(nodeOwnerAddr, state.ShortLinkKeyRegisterNode).Return(oldNodeAddr, nil)
+				s.EXPECT().GetShortIDLink(newNodeAddr, state.ShortLinkKeyRegisterNode).Return(ids.ShortEmpty, database.ErrNotFound)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, oldNodeID).Return(validator, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, newNodeID).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetPendingValidator(constants.PrimaryNetworkID, newNodeID).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetDeferredValidator(constants.PrimaryNetworkID, newNodeID).Return(nil, database.ErrNotFound)
+				currentStakerIterator := state.NewMockStakerIterator(c)
+				gomock.InOrder(
+					currentStakerIterator.EXPECT().Next().Return(true),
+					currentStakerIterator.EXPECT().Value().Return(validator),
+					currentStakerIterator.EXPECT().Next().Return(false),
+					currentStakerIterator.EXPECT().Release(),
+				)
+				s.EXPECT().GetCurrentStakerIterator().Return(currentStakerIterator, nil)
+				s.EXPECT().GetPendingStakerIterator().Return(state.EmptyIterator, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().DeleteCurrentValidator(validator)
+				s.EXPECT().PutCurrentValidator(&rotatedValidator)
+				s.EXPECT().SetShortIDLink(oldNodeAddr, state.ShortLinkKeyRegisterNode, nil)
+				s.EXPECT().SetShortIDLink(newNodeAddr, state.ShortLinkKeyRegisterNode, &nodeOwnerAddr)
+				s.EXPECT().SetShortIDLink(nodeOwnerAddr, state.ShortLinkKeyRegisterNode, &newNodeAddr)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
+			},
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {newNodeKey}, {nodeOwnerKey}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
+
+			tx, err := txs.NewSigned(utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, utx, tx.ID(), env.config),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoProposalTxExecutorRewardSubnetValidator(t *testing.T) {
+	_, _, rewardOwner := generateKeyAndOwner(t)
+	subnetID := ids.ID{1}
+	subnetAssetID := ids.ID{2}
+	currentSupply := uint64(10000)
+
+	validator := &state.Staker{
+		TxID:            ids.ID{4},
+		NodeID:          ids.NodeID{1},
+		SubnetID:        subnetID,
+		PotentialReward: 1000,
+	}
+	validatorTx := &txs.CaminoAddPermissionlessValidatorTx{AddPermissionlessValidatorTx: txs.AddPermissionlessValidatorTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			Outs: []*avax.TransferableOutput{
+				generateTestOut(subnetAssetID, 10, rewardOwner, ids.Empty, locked.ThisTxID),
+			},
+		}},
+		ValidatorRewardsOwner: &rewardOwner,
+	}}
+	rewardTx := &txs.Tx{Unsigned: &txs.CaminoRewardValidatorTx{}}
+	rewardTx.SetBytes(nil, []byte{1})
+	rewardUTXO := &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: rewardTx.ID(), OutputIndex: 1},
+		Asset:  avax.Asset{ID: subnetAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          validator.PotentialReward,
+			OutputOwners: rewardOwner,
+		},
+	}
+
+	tests := map[string]struct {
+		onCommitState func(*gomock.Controller) *state.MockDiff
+		onAbortState  func(*gomock.Controller) *state.MockDiff
+		validator     *state.Staker
+		expectedErr   error
+	}{
+		"No potential reward": {
+			onCommitState: state.NewMockDiff,
+			onAbortState:  state.NewMockDiff,
+			validator: &state.Staker{
+				TxID:     validator.TxID,
+				NodeID:   validator.NodeID,
+				SubnetID: subnetID,
+			},
+		},
+		"OK": {
+			onCommitState: func(c *gomock.Controller) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().AddUTXO(rewardUTXO)
+				s.EXPECT().AddRewardUTXO(validator.TxID, rewardUTXO)
+				return s
+			},
+			onAbortState: func(c *gomock.Controller) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetCurrentSupply(subnetID).Return(currentSupply, nil)
+				s.EXPECT().SetCurrentSupply(subnetID, currentSupply-validator.PotentialReward)
+				return s
+			},
+			validator: validator,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			executor := &CaminoProposalTxExecutor{ProposalTxExecutor{
+				Backend:       &Backend{Fx: &secp256k1fx.Fx{}},
+				Tx:            rewardTx,
+				OnCommitState: tt.onCommitState(ctrl),
+				OnAbortState:  tt.onAbortState(ctrl),
+			}}
+			require.ErrorIs(t, executor.rewardSubnetValidator(tt.validator, validatorTx, 1), tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoProposalTxExecutorRewardDelegator(t *testing.T) {
+	_, _, delegatorRewardOwner := generateKeyAndOwner(t)
+	_, _, validatorRewardOwner := generateKeyAndOwner(t)
+	delegatorRewardOwnerID, err := txs.GetOwnerID(&delegatorRewardOwner)
+	require.NoError(t, err)
+	validatorRewardOwnerID, err := txs.GetOwnerID(&validatorRewardOwner)
+	require.NoError(t, err)
+	nodeID := ids.NodeID{1}
+
+	delegator := &state.Staker{
+		TxID:            ids.ID{1},
+		NodeID:          nodeID,
+		SubnetID:        constants.PrimaryNetworkID,
+		PotentialReward: 1000,
+	}
+	validator := &state.Staker{
+		TxID:     ids.ID{2},
+		NodeID:   nodeID,
+		SubnetID: constants.PrimaryNetworkID,
+	}
+	delegatorTx := &txs.Tx{Unsigned: &txs.CaminoAddDelegatorTx{AddDelegatorTx: txs.AddDelegatorTx{
+		DelegationRewardsOwner: &delegatorRewardOwner,
+	}}}
+	validatorTx := &txs.Tx{Unsigned: &txs.CaminoAddValidatorTx{AddValidatorTx: txs.AddValidatorTx{
+		RewardsOwner:     &validatorRewardOwner,
+		DelegationShares: reward.PercentDenominator / 10, // 10% fee
+	}}}
+	currentSupply := uint64(10000)
+
+	tests := map[string]struct {
+		onCommitState func(*gomock.Controller) *state.MockDiff
+		onAbortState  func(*gomock.Controller) *state.MockDiff
+		delegator     *state.Staker
+		expectedErr   error
+	}{
+		"No potential reward": {
+			onCommitState: state.NewMockDiff,
+			onAbortState:  state.NewMockDiff,
+			delegator: &state.Staker{
+				TxID:     ids.ID{1},
+				NodeID:   nodeID,
+				SubnetID: constants.PrimaryNetworkID,
+			},
+		},
+		"Validator not found": {
+			onCommitState: func(c *gomock.Controller) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetDeferredValidator(constants.PrimaryNetworkID, nodeID).Return(nil, database.ErrNotFound)
+				return s
+			},
+			onAbortState: func(c *gomock.Controller) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(currentSupply, nil)
+				s.EXPECT().SetCurrentSupply(constants.PrimaryNetworkID, currentSupply-delegator.PotentialReward)
+				return s
+			},
+			delegator:   delegator,
+			expectedErr: database.ErrNotFound,
+		},
+		"OK": {
+			onCommitState: func(c *gomock.Controller) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(validator, nil)
+				s.EXPECT().GetTx(validator.TxID).Return(validatorTx, status.Committed, nil)
+				s.EXPECT().GetTx(delegator.TxID).Return(delegatorTx, status.Committed, nil)
+				s.EXPECT().GetClaimable(delegatorRewardOwnerID).Return(nil, database.ErrNotFound)
+				s.EXPECT().SetClaimable(delegatorRewardOwnerID, &state.Claimable{
+					Owner:           &delegatorRewardOwner,
+					ValidatorReward: 900,
+				})
+				s.EXPECT().GetClaimable(validatorRewardOwnerID).Return(&state.Claimable{
+					Owner:                &validatorRewardOwner,
+					ValidatorReward:      10,
+					ExpiredDepositReward: 20,
+				}, nil)
+				s.EXPECT().SetClaimable(validatorRewardOwnerID, &state.Claimable{
+					Owner:                &validatorRewardOwner,
+					ValidatorReward:      110,
+					ExpiredDepositReward: 20,
+				})
+				return s
+			},
+			onAbortState: func(c *gomock.Controller) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(currentSupply, nil)
+				s.EXPECT().SetCurrentSupply(constants.PrimaryNetworkID, currentSupply-delegator.PotentialReward)
+				return s
+			},
+			delegator: delegator,
+		},
+		"OK: deferred validator": {
+			onCommitState: func(c *gomock.Controller) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetDeferredValidator(constants.PrimaryNetworkID, nodeID).Return(validator, nil)
+				s.EXPECT().GetTx(validator.TxID).Return(validatorTx, status.Committed, nil)
+				s.EXPECT().GetTx(delegator.TxID).Return(delegatorTx, status.Committed, nil)
+				s.EXPECT().GetClaimable(delegatorRewardOwnerID).Return(nil, database.ErrNotFound)
+				s.EXPECT().SetClaimable(delegatorRewardOwnerID, &state.Claimable{
+					Owner:           &delegatorRewardOwner,
+					ValidatorReward: 900,
+				})
+				s.EXPECT().GetClaimable(validatorRewardOwnerID).Return(nil, database.ErrNotFound)
+				s.EXPECT().SetClaimable(validatorRewardOwnerID, &state.Claimable{
+					Owner:           &validatorRewardOwner,
+					ValidatorReward: 100,
+				})
+				return s
+			},
+			onAbortState: func(c *gomock.Controller) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(currentSupply, nil)
+				s.EXPECT().SetCurrentSupply(constants.PrimaryNetworkID, currentSupply-delegator.PotentialReward)
+				return s
+			},
+			delegator: delegator,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			executor := &CaminoProposalTxExecutor{ProposalTxExecutor{
+				OnCommitState: tt.onCommitState(ctrl),
+				OnAbortState:  tt.onAbortState(ctrl),
+			}}
+			require.ErrorIs(t, executor.rewardDelegator(tt.delegator), tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorAddProposalTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+	caminoStateConf := &state.CaminoConfig{
+		VerifyNodeSignature: caminoGenesisConf.VerifyNodeSignature,
+		LockModeBondDeposit: caminoGenesisConf.LockModeBondDeposit,
+	}
+
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	proposerKey, proposerAddr, _ := generateKeyAndOwner(t)
+	applicantAddress := ids.ShortID{1}
+	nodeID := ids.NodeID{1, 1}
+
+	proposalBondAmt := uint64(100)
+	feeUTXO := generateTestUTXO(ids.ID{1, 2, 3, 4, 5}, ctx.AVAXAssetID, defaultTxFee+proposalBondAmt, feeOwner, ids.Empty, ids.Empty)
+
+	proposal := &dao.AddMemberProposal{ApplicantAddress: applicantAddress, Start: 100, End: 200}
+
+	baseTx := txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+		Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
+		Outs: []*avax.TransferableOutput{
+			generateTestOut(ctx.AVAXAssetID, proposalBondAmt, feeOwner, ids.Empty, locked.ThisTxID),
+		},
+	}}
+
+	signers := [][]*secp256k1.PrivateKey{{feeOwnerKey}, {proposerKey}}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, *txs.AddProposalTx, ids.ID, *config.Config) *state.MockDiff
+		config      func(*config.Config)
+		utx         func() *txs.AddProposalTx
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"Wrong lockModeBondDeposit flag": {
+			state: func(c *gomock.Controller, utx *txs.AddProposalTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: false}, nil)
+				return s
+			},
+			utx: func() *txs.AddProposalTx {
+				return &txs.AddProposalTx{
+					BaseTx:          baseTx,
+					Proposal:        proposal,
+					ProposerAddress: proposerAddr,
+					ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers:     signers,
+			expectedErr: errWrongLockMode,
+		},
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.AddProposalTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
+				return s
+			},
+			utx: func() *txs.AddProposalTx {
+				return &txs.AddProposalTx{
+					BaseTx:          baseTx,
+					Proposal:        proposal,
+					ProposerAddress: proposerAddr,
+					ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers:     signers,
+			expectedErr: errNotBerlinPhase,
+		},
+		"Proposer isn't consortium member": {
+			state: func(c *gomock.Controller, utx *txs.AddProposalTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				s.EXPECT().GetAddressStates(utx.ProposerAddress).Return(txs.AddressStateEmpty, nil)
+				return s
+			},
+			utx: func() *txs.AddProposalTx {
+				return &txs.AddProposalTx{
+					BaseTx:          baseTx,
+					Proposal:        proposal,
+					ProposerAddress: proposerAddr,
+					ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers:     signers,
+			expectedErr: errNotConsortiumMember,
+		},
+		"Bad proposer signature": {
+			state: func(c *gomock.Controller, utx *txs.AddProposalTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				s.EXPECT().GetAddressStates(utx.ProposerAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.ProposerAddress}, nil)
+				return s
+			},
+			utx: func() *txs.AddProposalTx {
+				return &txs.AddProposalTx{
+					BaseTx:          baseTx,
+					Proposal:        proposal,
+					ProposerAddress: proposerAddr,
+					ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {feeOwnerKey}},
+			expectedErr: errProposerCredentialMismatch,
+		},
+		"Proposal start time is to early": {
+			state: func(c *gomock.Controller, utx *txs.AddProposalTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(101, 0))
+				s.EXPECT().GetAddressStates(utx.ProposerAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.ProposerAddress}, nil)
+				return s
+			},
+			utx: func() *txs.AddProposalTx {
+				return &txs.AddProposalTx{
+					BaseTx:          baseTx,
+					Proposal:        proposal,
+					ProposerAddress: proposerAddr,
+					ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers:     signers,
+			expectedErr: errProposalStartToEarly,
+		},
+		"Wrong proposal bond amount": {
+			state: func(c *gomock.Controller, utx *txs.AddProposalTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				s.EXPECT().GetAddressStates(utx.ProposerAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.ProposerAddress}, nil)
+				return s
+			},
+			config: func(cfg *config.Config) {
+				cfg.CaminoConfig.DaoProposalBondAmount = proposalBondAmt + 1
+			},
+			utx: func() *txs.AddProposalTx {
+				return &txs.AddProposalTx{
+					BaseTx:          baseTx,
+					Proposal:        proposal,
+					ProposerAddress: proposerAddr,
+					ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers:     signers,
+			expectedErr: errWrongProposalBondAmount,
+		},
+		"Applicant is already consortium member": {
+			state: func(c *gomock.Controller, utx *txs.AddProposalTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				s.EXPECT().GetAddressStates(utx.ProposerAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.ProposerAddress}, nil)
+				s.EXPECT().GetAddressStates(applicantAddress).Return(txs.AddressStateConsortiumMember, nil)
+				return s
+			},
+			utx: func() *txs.AddProposalTx {
+				return &txs.AddProposalTx{
+					BaseTx:          baseTx,
+					Proposal:        proposal,
+					ProposerAddress: proposerAddr,
+					ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers:     signers,
+			expectedErr: errAlreadyConsortiumMember,
+		},
+		"Reinstate validator: proposer isn't node owner": {
+			state: func(c *gomock.Controller, utx *txs.AddProposalTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				s.EXPECT().GetAddressStates(utx.ProposerAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.ProposerAddress}, nil)
+				return s
+			},
+			utx: func() *txs.AddProposalTx {
+				return &txs.AddProposalTx{
+					BaseTx:          baseTx,
+					Proposal:        &dao.ReinstateValidatorProposal{NodeOwnerAddress: applicantAddress, Start: 100, End: 200},
+					ProposerAddress: proposerAddr,
+					ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers:     signers,
+			expectedErr: errNotNodeOwnerProposer,
+		},
+		"Reinstate validator: validator isn't deferred": {
+			state: func(c *gomock.Controller, utx *txs.AddProposalTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				s.EXPECT().GetAddressStates(utx.ProposerAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.ProposerAddress}, nil)
+				s.EXPECT().GetShortIDLink(utx.ProposerAddress, state.ShortLinkKeyRegisterNode).Return(ids.ShortID(nodeID), nil)
+				s.EXPECT().GetDeferredValidator(constants.PrimaryNetworkID, nodeID).Return(nil, database.ErrNotFound)
+				return s
+			},
+			utx: func() *txs.AddProposalTx {
+				return &txs.AddProposalTx{
+					BaseTx:          baseTx,
+					Proposal:        &dao.ReinstateValidatorProposal{NodeOwnerAddress: proposerAddr, Start: 100, End: 200},
+					ProposerAddress: proposerAddr,
+					ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers:     signers,
+			expectedErr: errValidatorNotDeferred,
+		},
+		"OK": {
+			state: func(c *gomock.Controller, utx *txs.AddProposalTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				consortiumMembers := []ids.ShortID{proposerAddr, {2}}
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				s.EXPECT().GetAddressStates(utx.ProposerAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.ProposerAddress}, nil)
+				s.EXPECT().GetAddressStates(applicantAddress).Return(txs.AddressStateEmpty, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{
+					feeOwnerAddr, // consumed
+					feeOwnerAddr, // produced
+				}, nil)
+				s.EXPECT().GetAddressesWithStates(txs.AddressStateConsortiumMember).Return(consortiumMembers, nil)
+				s.EXPECT().AddProposal(txID, utx.Proposal.CreateProposalState(consortiumMembers))
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceNewlyLockedUTXOs(s, utx.Outs, txID, 0, locked.StateBonded)
+				return s
+			},
+			utx: func() *txs.AddProposalTx {
+				return &txs.AddProposalTx{
+					BaseTx:          baseTx,
+					Proposal:        proposal,
+					ProposerAddress: proposerAddr,
+					ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers: signers,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
+
+			env.config.CaminoConfig.DaoProposalBondAmount = proposalBondAmt
+			if tt.config != nil {
+				tt.config(env.config)
+			}
+
+			utx := tt.utx()
+			avax.SortTransferableInputsWithSigners(utx.Ins, tt.signers)
+			avax.SortTransferableOutputs(utx.Outs, txs.Codec)
+			tx, err := txs.NewSigned(utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, utx, tx.ID(), env.config),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorAddVoteTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+	caminoStateConf := &state.CaminoConfig{
+		VerifyNodeSignature: caminoGenesisConf.VerifyNodeSignature,
+		LockModeBondDeposit: caminoGenesisConf.LockModeBondDeposit,
+	}
+
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	voterKey1, voterAddr1, _ := generateKeyAndOwner(t)
+	_, voterAddr2, _ := generateKeyAndOwner(t)
+
+	feeUTXO := generateTestUTXO(ids.ID{1, 2, 3, 4, 5}, ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+
+	proposalID := ids.ID{1, 1, 1}
+	proposal := (&dao.BaseFeeProposal{Start: 100, End: 200, Options: []uint64{1, 2}}).
+		CreateProposalState([]ids.ShortID{voterAddr1, voterAddr2})
+	twoVotersProposal := (&dao.BaseFeeProposal{Start: 100, End: 200, Options: []uint64{1, 2}}).
+		CreateProposalState([]ids.ShortID{voterAddr1, voterAddr2, {1}})
+
+	baseTx := txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+		Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
+	}}
+
+	signers := [][]*secp256k1.PrivateKey{{feeOwnerKey}, {voterKey1}}
+
+	newUtx := func() *txs.AddVoteTx {
+		return &txs.AddVoteTx{
+			BaseTx:       baseTx,
+			ProposalID:   proposalID,
+			Vote:         &dao.SimpleVote{OptionIndex: 1},
+			VoterAddress: voterAddr1,
+			VoterAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+		}
+	}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, *txs.AddVoteTx, ids.ID) *state.MockDiff
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.AddVoteTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(99, 0))
+				return s
+			},
+			signers:     signers,
+			expectedErr: errNotBerlinPhase,
+		},
+		"Voter isn't consortium member": {
+			state: func(c *gomock.Controller, utx *txs.AddVoteTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				s.EXPECT().GetAddressStates(utx.VoterAddress).Return(txs.AddressStateEmpty, nil)
+				return s
+			},
+			signers:     signers,
+			expectedErr: errNotConsortiumMember,
+		},
+		"Bad voter signature": {
+			state: func(c *gomock.Controller, utx *txs.AddVoteTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				s.EXPECT().GetAddressStates(utx.VoterAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.VoterAddress}, nil)
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {feeOwnerKey}},
+			expectedErr: errVoterCredentialMismatch,
+		},
+		"Proposal is inactive": {
+			state: func(c *gomock.Controller, utx *txs.AddVoteTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(200, 0))
+				s.EXPECT().GetAddressStates(utx.VoterAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.VoterAddress}, nil)
+				s.EXPECT().GetProposal(utx.ProposalID).Return(proposal, nil)
+				return s
+			},
+			signers:     signers,
+			expectedErr: errProposalInactive,
+		},
+		"OK": {
+			state: func(c *gomock.Controller, utx *txs.AddVoteTx, txID ids.ID) *state.MockDiff {
+				updatedProposal, err := twoVotersProposal.AddVote(utx.VoterAddress, utx.Vote)
+				require.NoError(t, err)
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				s.EXPECT().GetAddressStates(utx.VoterAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.VoterAddress}, nil)
+				s.EXPECT().GetProposal(utx.ProposalID).Return(twoVotersProposal, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().ModifyProposal(utx.ProposalID, updatedProposal)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
+			},
+			signers: signers,
+		},
+		"OK: proposal can be finished": {
+			state: func(c *gomock.Controller, utx *txs.AddVoteTx, txID ids.ID) *state.MockDiff {
+				votedProposal, err := proposal.AddVote(voterAddr2, &dao.SimpleVote{OptionIndex: 1})
+				require.NoError(t, err)
+				updatedProposal, err := votedProposal.AddVote(utx.VoterAddress, utx.Vote)
+				require.NoError(t, err)
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				s.EXPECT().GetAddressStates(utx.VoterAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.VoterAddress}, nil)
+				s.EXPECT().GetProposal(utx.ProposalID).Return(votedProposal, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().ModifyProposal(utx.ProposalID, updatedProposal)
+				s.EXPECT().AddProposalIDToFinish(utx.ProposalID)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
+			},
+			signers: signers,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
+			env.config.BerlinPhaseTime = time.Unix(100, 0)
+
+			utx := newUtx()
+			avax.SortTransferableInputsWithSigners(utx.Ins, tt.signers)
+			tx, err := txs.NewSigned(utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, utx, tx.ID()),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorFinishProposalsTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+	caminoStateConf := &state.CaminoConfig{
+		VerifyNodeSignature: caminoGenesisConf.VerifyNodeSignature,
+		LockModeBondDeposit: caminoGenesisConf.LockModeBondDeposit,
+	}
+
+	_, bondOwnerAddr, bondOwner := generateKeyAndOwner(t)
+	voterAddr1 := ids.ShortID{1}
+	voterAddr2 := ids.ShortID{2}
+	memberAddr := ids.ShortID{3}
+	chainTime := time.Unix(200, 0)
+	berlinPhaseTime := time.Unix(100, 0)
+
+	earlyFinishedProposalID := ids.ID{1}
+	expiredProposalID := ids.ID{2}
+
+	earlyFinishedProposal := (&dao.ExcludeMemberProposal{Start: 100, End: 300, MemberAddress: memberAddr}).
+		CreateProposalState([]ids.ShortID{voterAddr1, voterAddr2})
+	earlyFinishedProposal, err := earlyFinishedProposal.AddVote(voterAddr1, &dao.SimpleVote{OptionIndex: 0})
+	require.NoError(t, err)
+	earlyFinishedProposal, err = earlyFinishedProposal.AddVote(voterAddr2, &dao.SimpleVote{OptionIndex: 0})
+	require.NoError(t, err)
+
+	expiredProposal := (&dao.BaseFeeProposal{Start: 100, End: uint64(chainTime.Unix()), Options: []uint64{1, 2}}).
+		CreateProposalState([]ids.ShortID{voterAddr1, voterAddr2})
+	expiredProposal, err = expiredProposal.AddVote(voterAddr1, &dao.SimpleVote{OptionIndex: 1})
+	require.NoError(t, err)
+
+	proposalBondAmt := uint64(100)
+	earlyFinishedProposalUTXO := generateTestUTXO(earlyFinishedProposalID, ctx.AVAXAssetID, proposalBondAmt, bondOwner, ids.Empty, earlyFinishedProposalID)
+	expiredProposalUTXO := generateTestUTXO(expiredProposalID, ctx.AVAXAssetID, proposalBondAmt, bondOwner, ids.Empty, expiredProposalID)
+	earlyFinishedProposalTx := &txs.Tx{Unsigned: &txs.AddProposalTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+		Outs: []*avax.TransferableOutput{
+			generateTestOut(ctx.AVAXAssetID, proposalBondAmt, bondOwner, ids.Empty, locked.ThisTxID),
+		},
+	}}}}
+	expiredProposalTx := &txs.Tx{Unsigned: &txs.AddProposalTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+		Outs: []*avax.TransferableOutput{
+			generateTestOut(ctx.AVAXAssetID, proposalBondAmt, bondOwner, ids.Empty, locked.ThisTxID),
+		},
+	}}}}
+
+	expectUnlock := func(s *state.MockDiff) {
+		s.EXPECT().GetTx(earlyFinishedProposalID).Return(earlyFinishedProposalTx, status.Committed, nil)
+		s.EXPECT().GetTx(expiredProposalID).Return(expiredProposalTx, status.Committed, nil)
+		s.EXPECT().LockedUTXOs(
+			set.Set[ids.ID]{earlyFinishedProposalID: struct{}{}, expiredProposalID: struct{}{}},
+			set.Set[ids.ShortID]{bondOwnerAddr: struct{}{}},
+			locked.StateBonded,
+		).Return([]*avax.UTXO{earlyFinishedProposalUTXO, expiredProposalUTXO}, nil)
+	}
+
+	baseTx := txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+		Ins: []*avax.TransferableInput{
+			generateTestInFromUTXO(earlyFinishedProposalUTXO, []uint32{}),
+			generateTestInFromUTXO(expiredProposalUTXO, []uint32{}),
+		},
+		Outs: []*avax.TransferableOutput{
+			generateTestOut(ctx.AVAXAssetID, proposalBondAmt, bondOwner, ids.Empty, ids.Empty),
+			generateTestOut(ctx.AVAXAssetID, proposalBondAmt, bondOwner, ids.Empty, ids.Empty),
+		},
+	}}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, *txs.FinishProposalsTx, ids.ID) *state.MockDiff
+		utx         func() *txs.FinishProposalsTx
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"Not zero credentials": {
+			state: func(c *gomock.Controller, utx *txs.FinishProposalsTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				return s
+			},
+			utx: func() *txs.FinishProposalsTx {
+				return &txs.FinishProposalsTx{
+					BaseTx:                   baseTx,
+					EarlyFinishedProposalIDs: []ids.ID{earlyFinishedProposalID},
+					ExpiredProposalIDs:       []ids.ID{expiredProposalID},
+				}
+			},
+			signers:     [][]*secp256k1.PrivateKey{{}},
+			expectedErr: errWrongCredentialsNumber,
+		},
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.FinishProposalsTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(berlinPhaseTime.Add(-time.Second))
+				return s
+			},
+			utx: func() *txs.FinishProposalsTx {
+				return &txs.FinishProposalsTx{
+					BaseTx:                   baseTx,
+					EarlyFinishedProposalIDs: []ids.ID{earlyFinishedProposalID},
+					ExpiredProposalIDs:       []ids.ID{expiredProposalID},
+				}
+			},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Expired proposals mismatch": {
+			state: func(c *gomock.Controller, utx *txs.FinishProposalsTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime.Add(-time.Second))
+				s.EXPECT().GetNextToExpireProposalIDsAndTime(nil).Return([]ids.ID{expiredProposalID}, chainTime, nil)
+				s.EXPECT().GetProposalIDsToFinish().Return([]ids.ID{earlyFinishedProposalID}, nil)
+				return s
+			},
+			utx: func() *txs.FinishProposalsTx {
+				return &txs.FinishProposalsTx{
+					BaseTx:                   baseTx,
+					EarlyFinishedProposalIDs: []ids.ID{earlyFinishedProposalID},
+					ExpiredProposalIDs:       []ids.ID{expiredProposalID},
+				}
+			},
+			expectedErr: errExpiredProposalsMismatch,
+		},
+		"Early finished proposals mismatch": {
+			state: func(c *gomock.Controller, utx *txs.FinishProposalsTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetNextToExpireProposalIDsAndTime(nil).Return([]ids.ID{expiredProposalID}, chainTime, nil)
+				s.EXPECT().GetProposalIDsToFinish().Return(nil, nil)
+				return s
+			},
+			utx: func() *txs.FinishProposalsTx {
+				return &txs.FinishProposalsTx{
+					BaseTx:                   baseTx,
+					EarlyFinishedProposalIDs: []ids.ID{earlyFinishedProposalID},
+					ExpiredProposalIDs:       []ids.ID{expiredProposalID},
+				}
+			},
+			expectedErr: errEarlyFinishedProposalsMismatch,
+		},
+		"Invalid outs": {
+			state: func(c *gomock.Controller, utx *txs.FinishProposalsTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetNextToExpireProposalIDsAndTime(nil).Return([]ids.ID{expiredProposalID}, chainTime, nil)
+				s.EXPECT().GetProposalIDsToFinish().Return([]ids.ID{earlyFinishedProposalID}, nil)
+				expectUnlock(s)
+				return s
+			},
+			utx: func() *txs.FinishProposalsTx {
+				return &txs.FinishProposalsTx{
+					BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins:          baseTx.Ins,
+						Outs: []*avax.TransferableOutput{
+							generateTestOut(ctx.AVAXAssetID, proposalBondAmt*2, bondOwner, ids.Empty, ids.Empty),
+						},
+					}},
+					EarlyFinishedProposalIDs: []ids.ID{earlyFinishedProposalID},
+					ExpiredProposalIDs:       []ids.ID{expiredProposalID},
+				}
+			},
+			expectedErr: errInvalidSystemTxBody,
+		},
+		"OK": {
+			state: func(c *gomock.Controller, utx *txs.FinishProposalsTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetNextToExpireProposalIDsAndTime(nil).Return([]ids.ID{expiredProposalID}, chainTime, nil)
+				s.EXPECT().GetProposalIDsToFinish().Return([]ids.ID{earlyFinishedProposalID}, nil)
+				expectUnlock(s)
+				// early finished proposal: member is excluded
+				s.EXPECT().GetProposal(earlyFinishedProposalID).Return(earlyFinishedProposal, nil)
+				s.EXPECT().GetAddressStates(memberAddr).
+					Return(txs.AddressStateConsortiumMember|txs.AddressStateKYCVerified, nil)
+				s.EXPECT().SetAddressStates(memberAddr, txs.AddressStateKYCVerified)
+				s.EXPECT().AddAddressStateChange(memberAddr, &state.AddressStateChange{
+					TxID:   txID,
+					Bit:    txs.AddressStateBitConsortium,
+					Remove: true,
+				})
+				s.EXPECT().RemoveProposalIDToFinish(earlyFinishedProposalID)
+				s.EXPECT().RemoveProposal(earlyFinishedProposalID, earlyFinishedProposal)
+				// expired proposal: not enough votes, nothing is applied
+				s.EXPECT().GetProposal(expiredProposalID).Return(expiredProposal, nil)
+				s.EXPECT().RemoveProposal(expiredProposalID, expiredProposal)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
+			},
+			utx: func() *txs.FinishProposalsTx {
+				return &txs.FinishProposalsTx{
+					BaseTx:                   baseTx,
+					EarlyFinishedProposalIDs: []ids.ID{earlyFinishedProposalID},
+					ExpiredProposalIDs:       []ids.ID{expiredProposalID},
+				}
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
+			env.config.BerlinPhaseTime = berlinPhaseTime
+
+			utx := tt.utx()
+			tx, err := txs.NewSigned(utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, utx, tx.ID()),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoProposalExecutorAddMemberProposal(t *testing.T) {
+	applicantAddr := ids.ShortID{1}
+	proposal := &dao.AddMemberProposalState{ApplicantAddress: applicantAddr}
+	finishProposalsTxID := ids.ID{2}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller) *state.MockDiff
+		expectedErr error
+	}{
+		"Applicant is already consortium member": {
+			state: func(c *gomock.Controller) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetAddressStates(applicantAddr).
+					Return(txs.AddressStateConsortiumMember|txs.AddressStateKYCVerified, nil)
+				return s
+			},
+		},
+		"OK": {
+			state: func(c *gomock.Controller) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetAddressStates(applicantAddr).Return(txs.AddressStateKYCVerified, nil)
+				s.EXPECT().SetAddressStates(applicantAddr, txs.AddressStateConsortiumMember|txs.AddressStateKYCVerified)
+				s.EXPECT().AddAddressStateChange(applicantAddr, &state.AddressStateChange{
+					TxID: finishProposalsTxID,
+					Bit:  txs.AddressStateBitConsortium,
+				})
+				return s
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			executor := &proposalExecutor{state: tt.state(ctrl), txID: finishProposalsTxID}
+			require.ErrorIs(t, executor.AddMemberProposal(proposal), tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoProposalExecutorExcludeMemberProposal(t *testing.T) {
+	memberAddr := ids.ShortID{1}
+	proposal := &dao.ExcludeMemberProposalState{MemberAddress: memberAddr}
+	finishProposalsTxID := ids.ID{2}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller) *state.MockDiff
+		expectedErr error
+	}{
+		"Member isn't consortium member anymore": {
+			state: func(c *gomock.Controller) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetAddressStates(memberAddr).Return(txs.AddressStateKYCVerified, nil)
+				return s
+			},
+		},
+		"OK": {
+			state: func(c *gomock.Controller) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetAddressStates(memberAddr).
+					Return(txs.AddressStateConsortiumMember|txs.AddressStateKYCVerified, nil)
+				s.EXPECT().SetAddressStates(memberAddr, txs.AddressStateKYCVerified)
+				s.EXPECT().AddAddressStateChange(memberAddr, &state.AddressStateChange{
+					TxID:   finishProposalsTxID,
+					Bit:    txs.AddressStateBitConsortium,
+					Remove: true,
+				})
+				return s
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			executor := &proposalExecutor{state: tt.state(ctrl), txID: finishProposalsTxID}
+			require.ErrorIs(t, executor.ExcludeMemberProposal(proposal), tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoProposalExecutorReinstateValidatorProposal(t *testing.T) {
+	nodeOwnerAddr := ids.ShortID{1}
+	nodeID := ids.NodeID{1, 1}
+	proposal := &dao.ReinstateValidatorProposalState{NodeOwnerAddress: nodeOwnerAddr}
+	finishProposalsTxID := ids.ID{2}
+	deferredValidator := &state.Staker{TxID: ids.ID{1}, NodeID: nodeID, SubnetID: constants.PrimaryNetworkID}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller) *state.MockDiff
+		expectedErr error
+	}{
+		"Validator isn't deferred anymore": {
+			state: func(c *gomock.Controller) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetShortIDLink(nodeOwnerAddr, state.ShortLinkKeyRegisterNode).Return(ids.ShortID(nodeID), nil)
+				s.EXPECT().GetDeferredValidator(constants.PrimaryNetworkID, nodeID).Return(nil, database.ErrNotFound)
+				return s
+			},
+		},
+		"OK": {
+			state: func(c *gomock.Controller) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetShortIDLink(nodeOwnerAddr, state.ShortLinkKeyRegisterNode).Return(ids.ShortID(nodeID), nil)
+				s.EXPECT().GetDeferredValidator(constants.PrimaryNetworkID, nodeID).Return(deferredValidator, nil)
+				s.EXPECT().DeleteDeferredValidator(deferredValidator)
+				s.EXPECT().SetDeferredValidatorInfo(deferredValidator.TxID, nil)
+				s.EXPECT().PutCurrentValidator(deferredValidator)
+				s.EXPECT().GetAddressStates(nodeOwnerAddr).
+					Return(txs.AddressStateConsortiumMember|txs.AddressStateNodeDeferred, nil)
+				s.EXPECT().SetAddressStates(nodeOwnerAddr, txs.AddressStateConsortiumMember)
+				s.EXPECT().AddAddressStateChange(nodeOwnerAddr, &state.AddressStateChange{
+					TxID:   finishProposalsTxID,
+					Bit:    txs.AddressStateBitNodeDeferred,
+					Remove: true,
+				})
+				return s
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			executor := &proposalExecutor{state: tt.state(ctrl), txID: finishProposalsTxID}
+			require.ErrorIs(t, executor.ReinstateValidatorProposal(proposal), tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoProposalExecutorTreasuryConfigProposal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	proposal := &dao.TreasuryConfigProposalState{Admin: ids.ShortID{1}, SpendLimit: 10, SpendPeriod: 100}
+	s := state.NewMockDiff(ctrl)
+	s.EXPECT().SetTreasuryConfig(proposal.Admin, proposal.SpendLimit, proposal.SpendPeriod)
+
+	executor := &proposalExecutor{state: s}
+	require.NoError(t, executor.TreasuryConfigProposal(proposal))
+}
+
+func TestVerifyBerlinPhaseOutputs(t *testing.T) {
+	berlinPhaseTime := time.Unix(100, 0)
+	vestingTx := &txs.BaseTx{BaseTx: avax.BaseTx{
+		Outs: []*avax.TransferableOutput{{
+			Out: &locked.VestingOut{
+				TransferableOut: &secp256k1fx.TransferOutput{Amt: 1},
+				Schedule:        locked.VestingSchedule{Start: 100, Duration: 100, TotalAmount: 1},
+			},
+		}},
+	}}
+	unlockedTx := &txs.BaseTx{BaseTx: avax.BaseTx{
+		Outs: []*avax.TransferableOutput{{Out: &secp256k1fx.TransferOutput{Amt: 1}}},
+	}}
+
+	tests := map[string]struct {
+		chainTime   time.Time
+		utx         txs.UnsignedTx
+		expectedErr error
+	}{
+		"Vesting out before BerlinPhase": {
+			chainTime:   berlinPhaseTime.Add(-1 * time.Second),
+			utx:         vestingTx,
+			expectedErr: errNotBerlinPhase,
+		},
+		"OK: vesting out after BerlinPhase": {
+			chainTime: berlinPhaseTime,
+			utx:       vestingTx,
+		},
+		"OK: no vesting outs before BerlinPhase": {
+			chainTime: berlinPhaseTime.Add(-1 * time.Second),
+			utx:       unlockedTx,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			backend := &Backend{Config: &config.Config{BerlinPhaseTime: berlinPhaseTime}}
+			require.ErrorIs(t, VerifyBerlinPhaseOutputs(backend, tt.chainTime, tt.utx), tt.expectedErr)
+		})
+	}
+}
@@ -45,6 +45,42 @@ func (*StandardTxExecutor) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
 	return errWrongTxType
 }
 
+func (*StandardTxExecutor) AddProposalTx(*txs.AddProposalTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) AddVoteTx(*txs.AddVoteTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) UpdateDepositOfferTx(*txs.UpdateDepositOfferTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) TransferDepositTx(*txs.TransferDepositTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) SetRewardRestakeTx(*txs.SetRewardRestakeTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) SetSubnetValidatorRequirementsTx(*txs.SetSubnetValidatorRequirementsTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) TreasurySpendTx(*txs.TreasurySpendTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) RotateValidatorNodeTx(*txs.RotateValidatorNodeTx) error {
+	return errWrongTxType
+}
+
 // Proposal
 
 func (*ProposalTxExecutor) AddressStateTx(*txs.AddressStateTx) error {
@@ -83,6 +119,42 @@ func (*ProposalTxExecutor) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
 	return errWrongTxType
 }
 
+func (*ProposalTxExecutor) AddProposalTx(*txs.AddProposalTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) AddVoteTx(*txs.AddVoteTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) UpdateDepositOfferTx(*txs.UpdateDepositOfferTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) TransferDepositTx(*txs.TransferDepositTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) SetRewardRestakeTx(*txs.SetRewardRestakeTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) SetSubnetValidatorRequirementsTx(*txs.SetSubnetValidatorRequirementsTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) TreasurySpendTx(*txs.TreasurySpendTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) RotateValidatorNodeTx(*txs.RotateValidatorNodeTx) error {
+	return errWrongTxType
+}
+
 // Atomic
 
 func (*AtomicTxExecutor) AddressStateTx(*txs.AddressStateTx) error {
@@ -121,6 +193,42 @@ func (*AtomicTxExecutor) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
 	return errWrongTxType
 }
 
+func (*AtomicTxExecutor) AddProposalTx(*txs.AddProposalTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) AddVoteTx(*txs.AddVoteTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) UpdateDepositOfferTx(*txs.UpdateDepositOfferTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) TransferDepositTx(*txs.TransferDepositTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) SetRewardRestakeTx(*txs.SetRewardRestakeTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) SetSubnetValidatorRequirementsTx(*txs.SetSubnetValidatorRequirementsTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) TreasurySpendTx(*txs.TreasurySpendTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) RotateValidatorNodeTx(*txs.RotateValidatorNodeTx) error {
+	return errWrongTxType
+}
+
 // MemPool
 
 func (v *MempoolTxVerifier) AddressStateTx(tx *txs.AddressStateTx) error {
@@ -158,3 +266,39 @@ func (v *MempoolTxVerifier) MultisigAliasTx(tx *txs.MultisigAliasTx) error {
 func (v *MempoolTxVerifier) AddDepositOfferTx(tx *txs.AddDepositOfferTx) error {
 	return v.standardTx(tx)
 }
+
+func (v *MempoolTxVerifier) AddProposalTx(tx *txs.AddProposalTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) AddVoteTx(tx *txs.AddVoteTx) error {
+	return v.standardTx(tx)
+}
+
+func (*MempoolTxVerifier) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errWrongTxType
+}
+
+func (v *MempoolTxVerifier) UpdateDepositOfferTx(tx *txs.UpdateDepositOfferTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) TransferDepositTx(tx *txs.TransferDepositTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) SetRewardRestakeTx(tx *txs.SetRewardRestakeTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) SetSubnetValidatorRequirementsTx(tx *txs.SetSubnetValidatorRequirementsTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) TreasurySpendTx(tx *txs.TreasurySpendTx) error {
+	return v.standardTx(tx)
+}
+
+func (v *MempoolTxVerifier) RotateValidatorNodeTx(tx *txs.RotateValidatorNodeTx) error {
+	return v.standardTx(tx)
+}
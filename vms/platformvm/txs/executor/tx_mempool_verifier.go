@@ -90,6 +90,10 @@ func (v *MempoolTxVerifier) standardTx(tx txs.UnsignedTx) error {
 		return err
 	}
 
+	if err := VerifyBerlinPhaseOutputs(v.Backend, baseState.GetTimestamp(), tx); err != nil {
+		return err
+	}
+
 	executor := CaminoStandardTxExecutor{
 		StandardTxExecutor{
 			Backend: v.Backend,
@@ -220,6 +220,20 @@ func generateTestStakeableUTXO(txID ids.ID, assetID ids.ID, amount, locktime uin
 	}
 }
 
+func generateTestVestingUTXO(txID ids.ID, assetID ids.ID, amount uint64, outputOwners secp256k1fx.OutputOwners, schedule locked.VestingSchedule) *avax.UTXO {
+	return &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: txID},
+		Asset:  avax.Asset{ID: assetID},
+		Out: &locked.VestingOut{
+			Schedule: schedule,
+			TransferableOut: &secp256k1fx.TransferOutput{
+				Amt:          amount,
+				OutputOwners: outputOwners,
+			},
+		},
+	}
+}
+
 func generateTestInsFromUTXOs(utxos []*avax.UTXO) []*avax.TransferableInput {
 	ins := make([]*avax.TransferableInput, len(utxos))
 	for i := range utxos {
@@ -244,6 +258,11 @@ func generateTestInFromUTXO(utxo *avax.UTXO, sigIndices []uint32) *avax.Transfer
 				Input: secp256k1fx.Input{SigIndices: sigIndices},
 			},
 		}
+	case *locked.VestingOut:
+		in = &secp256k1fx.TransferInput{
+			Amt:   out.Amount(),
+			Input: secp256k1fx.Input{SigIndices: sigIndices},
+		}
 	case *stakeable.LockOut:
 		in = &stakeable.LockIn{
 			Locktime: out.Locktime,
@@ -337,6 +356,19 @@ func generateTestStakeableOut(assetID ids.ID, amount, locktime uint64, outputOwn
 	}
 }
 
+func generateTestVestingOut(assetID ids.ID, amount uint64, outputOwners secp256k1fx.OutputOwners, schedule locked.VestingSchedule) *avax.TransferableOutput {
+	return &avax.TransferableOutput{
+		Asset: avax.Asset{ID: assetID},
+		Out: &locked.VestingOut{
+			Schedule: schedule,
+			TransferableOut: &secp256k1fx.TransferOutput{
+				Amt:          amount,
+				OutputOwners: outputOwners,
+			},
+		},
+	}
+}
+
 func generateOwnersAndSig(tx txs.UnsignedTx) (secp256k1fx.OutputOwners, *secp256k1fx.Credential) {
 	txHash := hashing.ComputeHash256(tx.Bytes())
 
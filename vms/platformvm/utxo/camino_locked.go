@@ -4,23 +4,25 @@
 package utxo
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
-	"sort"
+	"time"
 
 	"go.uber.org/zap"
 
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
@@ -45,8 +47,40 @@ var (
 	errNotLockedUTXO             = errors.New("can't spend unlocked utxo")
 	errUTXOOutTypeOrAmtMismatch  = errors.New("inner out isn't *secp256k1fx.TransferOutput or inner out amount != input.Amt")
 	errCantSpend                 = errors.New("can't spend utxo with given credential and input")
+	errEarlyUnlockNotAllowed     = errors.New("deposit offer doesn't allow early unlock")
+	errForceUnlockBondedDeposit  = errors.New("can't force-unlock bonded deposited tokens")
+	errUnvestedAmountSpent       = errors.New("spent tokens that are not vested yet")
+	errNoChainTime               = errors.New("can't get chain time to check vesting")
+	errNoSpendLimitUsageState    = errors.New("can't get msig alias spend limit usage")
+	errFeeSponsorMismatch        = errors.New("fee sponsor outputs or credentials without fee sponsor inputs")
+	errFeeSponsorFailed          = errors.New("fee sponsor failed to pay fee")
 )
 
+// chainTimeGetter is used to get chain time, against which vesting schedules are checked
+type chainTimeGetter interface {
+	GetTimestamp() time.Time
+}
+
+// spendLimitUsageState is used to check usage of msig aliases spend limits
+type spendLimitUsageState interface {
+	chainTimeGetter
+	GetMultisigAliasSpendLimitUsage(aliasID ids.ShortID) (*multisig.SpendLimitUsage, error)
+}
+
+// spendLimitedAlias tracks amounts consumed from msig alias with its spend limit threshold
+// and amounts returned back to this alias
+type spendLimitedAlias struct {
+	alias    *multisig.AliasWithNonce
+	consumed uint64
+	produced uint64
+}
+
+// vestingKey identifies vesting outs with the same owner and schedule
+type vestingKey struct {
+	ownerID  ids.ID
+	schedule locked.VestingSchedule
+}
+
 // Creates UTXOs from [outs] and adds them to the UTXO set.
 // UTXOs with LockedOut will have 'thisTxID' replaced with [txID].
 // [txID] is the ID of the tx that created [outs].
@@ -117,6 +151,8 @@ type CaminoSpender interface {
 	// - [state] chainstate which will be used to fetch utxos and deposit data
 	// - [keys] are the owners of the deposits
 	// - [depositTxIDs] ids of deposit transactions
+	// - [force] if true, all remaining deposited tokens will be unlocked and
+	//   early unlock penalty will be produced to treasury
 	// Returns:
 	// - [inputs] unsorted inputs that should be consumed to fund the outputs
 	// - [outputs] unsorted outputs that should be returned to the UTXO set
@@ -125,6 +161,7 @@ type CaminoSpender interface {
 		state state.Chain,
 		keys []*secp256k1.PrivateKey,
 		depositTxIDs []ids.ID,
+		force bool,
 	) (
 		[]*avax.TransferableInput, // inputs
 		[]*avax.TransferableOutput, // outputs
@@ -157,6 +194,46 @@ type CaminoVerifier interface {
 		appliedLockState locked.State,
 	) error
 
+	// Same as VerifyLock, but unlocked utxos owned by msig alias with spend limit
+	// could also be spent with alias spend limit threshold.
+	// Returns new spend limit usages of such aliases. They aren't written into [utxoDB]
+	// and must be set by caller, if tx is valid.
+	//
+	// Precondition: [tx] has already been syntactically verified.
+	VerifyLockWithSpendLimits(
+		tx txs.UnsignedTx,
+		utxoDB avax.UTXOGetter,
+		ins []*avax.TransferableInput,
+		outs []*avax.TransferableOutput,
+		creds []verify.Verifiable,
+		mintedAmount uint64,
+		burnedAmount uint64,
+		assetID ids.ID,
+		appliedLockState locked.State,
+	) (map[ids.ShortID]*multisig.SpendLimitUsage, error)
+
+	// Same as VerifyLock, but if [feeSponsorIns] aren't empty, then [burnedAmount] is burned
+	// by fee sponsor instead of tx spender.
+	// Arguments:
+	// - [feeSponsorIns] and [feeSponsorOuts] are unlocked inputs and outputs of fee sponsor.
+	// - [feeSponsorCreds] are the credentials of fee sponsor, which allow [feeSponsorIns] to be spent.
+	//
+	// Precondition: [tx] has already been syntactically verified.
+	VerifyLockWithFeeSponsor(
+		tx txs.UnsignedTx,
+		utxoDB avax.UTXOGetter,
+		ins []*avax.TransferableInput,
+		outs []*avax.TransferableOutput,
+		creds []verify.Verifiable,
+		feeSponsorIns []*avax.TransferableInput,
+		feeSponsorOuts []*avax.TransferableOutput,
+		feeSponsorCreds []verify.Verifiable,
+		mintedAmount uint64,
+		burnedAmount uint64,
+		assetID ids.ID,
+		appliedLockState locked.State,
+	) error
+
 	// Verify that deposit unlock [tx] is semantically valid.
 	// Arguments:
 	// - [ins] and [outs] are the inputs and outputs of [tx].
@@ -235,7 +312,7 @@ func (h *handler) Lock(
 		return nil, nil, nil, nil, fmt.Errorf("couldn't get UTXOs: %w", err)
 	}
 
-	sortUTXOs(utxos, h.ctx.AVAXAssetID, appliedLockState)
+	locked.SortUTXOs(utxos, h.ctx.AVAXAssetID, appliedLockState)
 
 	kc := secp256k1fx.NewKeychain(signer...) // Keychain consumes UTXOs and creates new ones
 
@@ -245,8 +322,13 @@ func (h *handler) Lock(
 		now = uint64(h.clk.Time().Unix())
 	}
 
+	// Vesting schedules are checked against chain time,
+	// which can't be greater than time when this transaction will be issued at
+	vestingTime := uint64(0)
+
 	ins := []*avax.TransferableInput{}
 	outs := []*avax.TransferableOutput{}
+	vestingOuts := []*avax.TransferableOutput{}
 	signers := [][]*secp256k1.PrivateKey{}
 	owners := []*secp256k1fx.OutputOwners{}
 
@@ -306,6 +388,11 @@ func (h *handler) Lock(
 		}
 
 		out := utxo.Out
+		vestingOut, isVesting := out.(*locked.VestingOut)
+		if isVesting {
+			out = vestingOut.TransferableOut
+		}
+
 		lockIDs := locked.IDsEmpty
 		if lockedOut, ok := out.(*locked.Out); ok {
 			// Resolves to true for StateUnlocked
@@ -331,6 +418,11 @@ func (h *handler) Lock(
 		}
 
 		inIntf, inSigners, err := kc.SpendMultiSig(innerOut, now, utxoDB)
+		if err != nil && appliedLockState == locked.StateUnlocked && to != nil && !isVesting {
+			// Unlocked transfer (BaseTx) can spend from msig alias with its spend limit threshold,
+			// spend limit amount is checked during verification
+			inIntf, inSigners, err = spendWithinAliasSpendLimit(kc, innerOut, now, utxoDB)
+		}
 		if err != nil {
 			// We couldn't spend the output, so move on to the next one
 			continue
@@ -346,6 +438,23 @@ func (h *handler) Lock(
 
 		remainingValue := in.Amount()
 
+		// Unvested tokens can't be spent and must be returned into vesting out
+		unvestedAmount := uint64(0)
+		if isVesting {
+			if vestingTime == 0 {
+				vestingTime = now
+				if chainTimeState, ok := utxoDB.(chainTimeGetter); ok && asOf == 0 {
+					vestingTime = uint64(chainTimeState.GetTimestamp().Unix())
+				}
+			}
+			unvestedAmount = vestingOut.UnvestedAmount(vestingTime)
+			if unvestedAmount == remainingValue {
+				// Nothing is vested yet, so move on to the next one
+				continue
+			}
+			remainingValue -= unvestedAmount
+		}
+
 		lockedOwnerID := OwnerID{&innerOut.OutputOwners, &outOwnerID}
 		remainingOwnerID := lockedOwnerID
 
@@ -393,6 +502,19 @@ func (h *handler) Lock(
 			signers = append(signers, inSigners)
 			owners = append(owners, &innerOut.OutputOwners)
 
+			if unvestedAmount > 0 {
+				vestingOuts = append(vestingOuts, &avax.TransferableOutput{
+					Asset: avax.Asset{ID: h.ctx.AVAXAssetID},
+					Out: &locked.VestingOut{
+						Schedule: vestingOut.Schedule,
+						TransferableOut: &secp256k1fx.TransferOutput{
+							Amt:          unvestedAmount,
+							OutputOwners: innerOut.OutputOwners,
+						},
+					},
+				})
+			}
+
 			otherLockTxID := lockIDs.DepositTxID
 			if appliedLockState == locked.StateDeposited {
 				otherLockTxID = lockIDs.BondTxID
@@ -494,6 +616,8 @@ func (h *handler) Lock(
 		return nil, nil, nil, nil, errInsufficientBalance
 	}
 
+	outs = append(outs, vestingOuts...)
+
 	avax.SortTransferableInputsWithSigners(ins, signers) // sort inputs and keys
 	avax.SortTransferableOutputs(outs, txs.Codec)        // sort outputs
 
@@ -521,6 +645,20 @@ func (h *handler) Unlock(
 	lockTxAddresses := set.NewSet[ids.ShortID](0)
 	for lockTxID := range lockTxIDsSet {
 		tx, s, err := state.GetTx(lockTxID)
+		if err == database.ErrNotFound && removedLockState == locked.StateDeposited {
+			// deposits created by restaking validator rewards don't have their own tx,
+			// their deposited utxos are owned by deposit reward owner
+			deposit, err := state.GetDeposit(lockTxID)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%w: %s", errFailToGetDeposit, err)
+			}
+			rewardOwner, ok := deposit.RewardOwner.(*secp256k1fx.OutputOwners)
+			if !ok {
+				return nil, nil, fmt.Errorf("could not cast reward owner of deposit %s to output owners", lockTxID)
+			}
+			lockTxAddresses.Add(rewardOwner.Addrs...)
+			continue
+		}
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to fetch lockedTx %s: %w", lockTxID, err)
 		}
@@ -541,6 +679,17 @@ func (h *handler) Unlock(
 			}
 			lockTxAddresses.Add(innerOut.Addrs...)
 		}
+
+		// transferred deposited tokens are owned by deposit reward owner
+		if _, ok := tx.Unsigned.(*txs.DepositTx); ok && removedLockState == locked.StateDeposited {
+			deposit, err := state.GetDeposit(lockTxID)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%w: %s", errFailToGetDeposit, err)
+			}
+			if rewardOwner, ok := deposit.RewardOwner.(*secp256k1fx.OutputOwners); ok {
+				lockTxAddresses.Add(rewardOwner.Addrs...)
+			}
+		}
 	}
 
 	utxos, err := state.LockedUTXOs(lockTxIDsSet, lockTxAddresses, removedLockState)
@@ -586,7 +735,7 @@ func (h *handler) unlockUTXOs(
 				TxID:        utxo.TxID,
 				OutputIndex: utxo.OutputIndex,
 			},
-			Asset: avax.Asset{ID: h.ctx.AVAXAssetID},
+			Asset: utxo.Asset,
 			In: &locked.In{
 				IDs: out.IDs,
 				TransferableIn: &secp256k1fx.TransferInput{
@@ -598,7 +747,7 @@ func (h *handler) unlockUTXOs(
 
 		if newLockIDs := out.Unlock(removedLockState); newLockIDs.IsLocked() {
 			outs = append(outs, &avax.TransferableOutput{
-				Asset: avax.Asset{ID: h.ctx.AVAXAssetID},
+				Asset: utxo.Asset,
 				Out: &locked.Out{
 					IDs: newLockIDs,
 					TransferableOut: &secp256k1fx.TransferOutput{
@@ -609,7 +758,7 @@ func (h *handler) unlockUTXOs(
 			})
 		} else {
 			outs = append(outs, &avax.TransferableOutput{
-				Asset: avax.Asset{ID: h.ctx.AVAXAssetID},
+				Asset: utxo.Asset,
 				Out: &secp256k1fx.TransferOutput{
 					Amt:          innerOut.Amount(),
 					OutputOwners: innerOut.OutputOwners,
@@ -628,6 +777,7 @@ func (h *handler) UnlockDeposit(
 	state state.Chain,
 	keys []*secp256k1.PrivateKey,
 	depositTxIDs []ids.ID,
+	force bool,
 ) (
 	[]*avax.TransferableInput, // inputs
 	[]*avax.TransferableOutput, // outputs
@@ -647,8 +797,8 @@ func (h *handler) UnlockDeposit(
 	// Minimum time this transaction will be issued at
 	currentTimestamp := uint64(h.clk.Time().Unix())
 
-	unlockableAmounts, err := getDepositUnlockableAmounts(
-		state, depositTxSet, currentTimestamp,
+	unlockableAmounts, penalties, err := getDepositUnlockableAmounts(
+		state, depositTxSet, currentTimestamp, force,
 	)
 	if err != nil {
 		return nil, nil, nil, err
@@ -664,6 +814,7 @@ func (h *handler) UnlockDeposit(
 	ins := []*avax.TransferableInput{}
 	outs := []*avax.TransferableOutput{}
 	signers := [][]*secp256k1.PrivateKey{}
+	totalPenalty := uint64(0)
 
 	for _, utxo := range utxos {
 		out, ok := utxo.Out.(*locked.Out)
@@ -681,6 +832,10 @@ func (h *handler) UnlockDeposit(
 			continue
 		}
 
+		if penalties[out.DepositTxID] > 0 && out.BondTxID != ids.Empty {
+			return nil, nil, nil, errForceUnlockBondedDeposit
+		}
+
 		innerOut, ok := out.TransferableOut.(*secp256k1fx.TransferOutput)
 		if !ok {
 			// We only know how to clone secp256k1 outputs for now
@@ -722,25 +877,34 @@ func (h *handler) UnlockDeposit(
 		remainingValue -= amountToUnlock
 		unlockableAmounts[out.DepositTxID] -= amountToUnlock
 
-		if newLockIDs := out.Unlock(locked.StateDeposited); newLockIDs.IsLocked() {
-			outs = append(outs, &avax.TransferableOutput{
-				Asset: avax.Asset{ID: h.ctx.AVAXAssetID},
-				Out: &locked.Out{
-					IDs: newLockIDs,
-					TransferableOut: &secp256k1fx.TransferOutput{
+		// early unlock penalty is taken from unlocked tokens
+		penalty := math.Min(penalties[out.DepositTxID], amountToUnlock)
+		penalties[out.DepositTxID] -= penalty
+		totalPenalty += penalty
+		amountToUnlock -= penalty
+
+		// Unlocked tokens could fully go to penalty
+		if amountToUnlock > 0 {
+			if newLockIDs := out.Unlock(locked.StateDeposited); newLockIDs.IsLocked() {
+				outs = append(outs, &avax.TransferableOutput{
+					Asset: avax.Asset{ID: h.ctx.AVAXAssetID},
+					Out: &locked.Out{
+						IDs: newLockIDs,
+						TransferableOut: &secp256k1fx.TransferOutput{
+							Amt:          amountToUnlock,
+							OutputOwners: innerOut.OutputOwners,
+						},
+					},
+				})
+			} else {
+				outs = append(outs, &avax.TransferableOutput{
+					Asset: avax.Asset{ID: h.ctx.AVAXAssetID},
+					Out: &secp256k1fx.TransferOutput{
 						Amt:          amountToUnlock,
 						OutputOwners: innerOut.OutputOwners,
 					},
-				},
-			})
-		} else {
-			outs = append(outs, &avax.TransferableOutput{
-				Asset: avax.Asset{ID: h.ctx.AVAXAssetID},
-				Out: &secp256k1fx.TransferOutput{
-					Amt:          amountToUnlock,
-					OutputOwners: innerOut.OutputOwners,
-				},
-			})
+				})
+			}
 		}
 
 		// This input had extra value, so some of it must be returned
@@ -758,6 +922,16 @@ func (h *handler) UnlockDeposit(
 		}
 	}
 
+	if totalPenalty > 0 {
+		outs = append(outs, &avax.TransferableOutput{
+			Asset: avax.Asset{ID: h.ctx.AVAXAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          totalPenalty,
+				OutputOwners: *treasury.Owner,
+			},
+		})
+	}
+
 	return ins, outs, signers, nil
 }
 
@@ -772,16 +946,87 @@ func (h *handler) VerifyLock(
 	assetID ids.ID,
 	appliedLockState locked.State,
 ) error {
+	_, err := h.verifyLock(tx, utxoDB, ins, outs, creds, mintedAmount, burnedAmount, assetID, appliedLockState, false)
+	return err
+}
+
+func (h *handler) VerifyLockWithSpendLimits(
+	tx txs.UnsignedTx,
+	utxoDB avax.UTXOGetter,
+	ins []*avax.TransferableInput,
+	outs []*avax.TransferableOutput,
+	creds []verify.Verifiable,
+	mintedAmount uint64,
+	burnedAmount uint64,
+	assetID ids.ID,
+	appliedLockState locked.State,
+) (map[ids.ShortID]*multisig.SpendLimitUsage, error) {
+	return h.verifyLock(tx, utxoDB, ins, outs, creds, mintedAmount, burnedAmount, assetID, appliedLockState, true)
+}
+
+func (h *handler) VerifyLockWithFeeSponsor(
+	tx txs.UnsignedTx,
+	utxoDB avax.UTXOGetter,
+	ins []*avax.TransferableInput,
+	outs []*avax.TransferableOutput,
+	creds []verify.Verifiable,
+	feeSponsorIns []*avax.TransferableInput,
+	feeSponsorOuts []*avax.TransferableOutput,
+	feeSponsorCreds []verify.Verifiable,
+	mintedAmount uint64,
+	burnedAmount uint64,
+	assetID ids.ID,
+	appliedLockState locked.State,
+) error {
+	if len(feeSponsorIns) == 0 {
+		if len(feeSponsorOuts) != 0 || len(feeSponsorCreds) != 0 {
+			return errFeeSponsorMismatch
+		}
+		return h.VerifyLock(tx, utxoDB, ins, outs, creds, mintedAmount, burnedAmount, assetID, appliedLockState)
+	}
+
+	if err := h.VerifyLock(tx, utxoDB, ins, outs, creds, mintedAmount, 0, assetID, appliedLockState); err != nil {
+		return err
+	}
+
+	if err := h.VerifyLock(
+		tx,
+		utxoDB,
+		feeSponsorIns,
+		feeSponsorOuts,
+		feeSponsorCreds,
+		0,
+		burnedAmount,
+		assetID,
+		locked.StateUnlocked,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFeeSponsorFailed, err)
+	}
+	return nil
+}
+
+func (h *handler) verifyLock(
+	tx txs.UnsignedTx,
+	utxoDB avax.UTXOGetter,
+	ins []*avax.TransferableInput,
+	outs []*avax.TransferableOutput,
+	creds []verify.Verifiable,
+	mintedAmount uint64,
+	burnedAmount uint64,
+	assetID ids.ID,
+	appliedLockState locked.State,
+	allowSpendLimits bool,
+) (map[ids.ShortID]*multisig.SpendLimitUsage, error) {
 	msigState, ok := utxoDB.(secp256k1fx.AliasGetter)
 	if !ok {
-		return secp256k1fx.ErrNotAliasGetter
+		return nil, secp256k1fx.ErrNotAliasGetter
 	}
 
 	utxos := make([]*avax.UTXO, len(ins))
 	for index, input := range ins {
 		utxo, err := utxoDB.GetUTXO(input.InputID())
 		if err != nil {
-			return fmt.Errorf(
+			return nil, fmt.Errorf(
 				"failed to read consumed UTXO %s due to: %w",
 				&input.UTXOID,
 				err,
@@ -790,7 +1035,7 @@ func (h *handler) VerifyLock(
 		utxos[index] = utxo
 	}
 
-	return h.VerifyLockUTXOs(msigState, tx, utxos, ins, outs, creds, mintedAmount, burnedAmount, assetID, appliedLockState)
+	return h.verifyLockUTXOs(msigState, tx, utxos, ins, outs, creds, mintedAmount, burnedAmount, assetID, appliedLockState, allowSpendLimits)
 }
 
 func (h *handler) VerifyLockUTXOs(
@@ -805,14 +1050,34 @@ func (h *handler) VerifyLockUTXOs(
 	assetID ids.ID,
 	appliedLockState locked.State,
 ) error {
+	_, err := h.verifyLockUTXOs(msigState, tx, utxos, ins, outs, creds, mintedAmount, burnedAmount, assetID, appliedLockState, false)
+	return err
+}
+
+// verifyLockUTXOs verifies lock the same way as VerifyLockUTXOs. If [allowSpendLimits] is true,
+// unlocked utxos owned by msig alias with spend limit could also be spent with alias spend limit
+// threshold and new spend limit usages of such aliases are returned.
+func (h *handler) verifyLockUTXOs(
+	msigState secp256k1fx.AliasGetter,
+	tx txs.UnsignedTx,
+	utxos []*avax.UTXO,
+	ins []*avax.TransferableInput,
+	outs []*avax.TransferableOutput,
+	creds []verify.Verifiable,
+	mintedAmount uint64,
+	burnedAmount uint64,
+	assetID ids.ID,
+	appliedLockState locked.State,
+	allowSpendLimits bool,
+) (map[ids.ShortID]*multisig.SpendLimitUsage, error) {
 	if appliedLockState != locked.StateBonded &&
 		appliedLockState != locked.StateDeposited &&
 		appliedLockState != locked.StateUnlocked {
-		return errInvalidTargetLockState
+		return nil, errInvalidTargetLockState
 	}
 
 	if len(ins) != len(creds) {
-		return fmt.Errorf(
+		return nil, fmt.Errorf(
 			"there are %d inputs and %d credentials: %w",
 			len(ins),
 			len(creds),
@@ -821,7 +1086,7 @@ func (h *handler) VerifyLockUTXOs(
 	}
 
 	if len(ins) != len(utxos) {
-		return fmt.Errorf(
+		return nil, fmt.Errorf(
 			"there are %d inputs and %d utxos: %w",
 			len(ins),
 			len(utxos),
@@ -831,7 +1096,7 @@ func (h *handler) VerifyLockUTXOs(
 
 	for _, cred := range creds {
 		if err := cred.Verify(); err != nil {
-			return errBadCredentials
+			return nil, errBadCredentials
 		}
 	}
 
@@ -841,11 +1106,20 @@ func (h *handler) VerifyLockUTXOs(
 	consumed := make(map[ids.ID]map[ids.ID]uint64)
 	consumed[ids.Empty] = map[ids.ID]uint64{ids.Empty: mintedAmount} // TODO @evlekth simplify with dedicated var
 
+	// Track unvested amounts of consumed vesting utxos and amounts of produced vesting outs,
+	// unvested tokens must be returned into vesting outs with the same owner and schedule
+	consumedUnvested := make(map[vestingKey]uint64)
+	producedVesting := make(map[vestingKey]uint64)
+	chainTime := uint64(0)
+
+	// Track msig aliases spent with their spend limit threshold
+	spendLimitedAliases := make(map[ids.ShortID]*spendLimitedAlias)
+
 	for index, input := range ins {
 		utxo := utxos[index] // The UTXO consumed by [input]
 
 		if utxoAssetID := utxo.AssetID(); utxoAssetID != assetID {
-			return fmt.Errorf(
+			return nil, fmt.Errorf(
 				"utxo %d has asset ID %s but expect %s: %w",
 				index,
 				utxoAssetID,
@@ -855,7 +1129,7 @@ func (h *handler) VerifyLockUTXOs(
 		}
 
 		if inputAssetID := input.AssetID(); inputAssetID != assetID {
-			return fmt.Errorf(
+			return nil, fmt.Errorf(
 				"input %d has asset ID %s but expect %s: %w",
 				index,
 				inputAssetID,
@@ -866,17 +1140,22 @@ func (h *handler) VerifyLockUTXOs(
 
 		out := utxo.Out
 		if _, ok := out.(*stakeable.LockOut); ok {
-			return errWrongUTXOOutType
+			return nil, errWrongUTXOOutType
+		}
+
+		vestingOut, isVesting := out.(*locked.VestingOut)
+		if isVesting {
+			out = vestingOut.TransferableOut
 		}
 
 		lockIDs := &locked.IDsEmpty
 		if lockedOut, ok := out.(*locked.Out); ok {
 			// can only spend unlocked utxos, if appliedLockState is unlocked
 			if appliedLockState == locked.StateUnlocked {
-				return errLockedUTXO
+				return nil, errLockedUTXO
 				// utxo is already locked with appliedLockState, so it can't be locked it again
 			} else if lockedOut.IsLockedWith(appliedLockState) {
-				return errLockingLockedUTXO
+				return nil, errLockingLockedUTXO
 			}
 			out = lockedOut.TransferableOut
 			lockIDs = &lockedOut.IDs
@@ -884,23 +1163,62 @@ func (h *handler) VerifyLockUTXOs(
 
 		in := input.In
 		if _, ok := in.(*stakeable.LockIn); ok {
-			return errWrongInType
+			return nil, errWrongInType
 		}
 
 		if lockedIn, ok := in.(*locked.In); ok {
 			// This input is locked, but its LockIDs is wrong
 			if *lockIDs != lockedIn.IDs {
-				return errLockIDsMismatch
+				return nil, errLockIDsMismatch
 			}
 			in = lockedIn.TransferableIn
 		} else if lockIDs.IsLocked() {
 			// The UTXO says it's locked, but this input, which consumes it,
 			// is not locked - this is invalid.
-			return errLockedFundsNotMarkedAsLocked
+			return nil, errLockedFundsNotMarkedAsLocked
 		}
 
 		if err := h.fx.VerifyMultisigTransfer(tx, in, creds[index], out, msigState); err != nil {
-			return fmt.Errorf("failed to verify transfer: %w", err)
+			// Unlocked transfer can spend from msig alias with its spend limit threshold
+			if !allowSpendLimits || appliedLockState != locked.StateUnlocked || isVesting {
+				return nil, fmt.Errorf("failed to verify transfer: %w", err)
+			}
+			alias, spendLimitErr := h.verifySpendLimitTransfer(tx, in, creds[index], out, msigState)
+			if spendLimitErr != nil {
+				return nil, fmt.Errorf("failed to verify transfer: %w", err)
+			}
+			spendLimited, ok := spendLimitedAliases[alias.ID]
+			if !ok {
+				spendLimited = &spendLimitedAlias{alias: alias}
+				spendLimitedAliases[alias.ID] = spendLimited
+			}
+			consumedAmount, err := math.Add64(spendLimited.consumed, in.Amount())
+			if err != nil {
+				return nil, err
+			}
+			spendLimited.consumed = consumedAmount
+		}
+
+		if isVesting {
+			if chainTime == 0 {
+				chainTimeState, ok := msigState.(chainTimeGetter)
+				if !ok {
+					return nil, errNoChainTime
+				}
+				chainTime = uint64(chainTimeState.GetTimestamp().Unix())
+			}
+
+			ownerID, err := txs.GetOutputOwnerID(out)
+			if err != nil {
+				return nil, err
+			}
+
+			key := vestingKey{ownerID: ownerID, schedule: vestingOut.Schedule}
+			newAmount, err := math.Add64(consumedUnvested[key], vestingOut.UnvestedAmount(chainTime))
+			if err != nil {
+				return nil, err
+			}
+			consumedUnvested[key] = newAmount
 		}
 
 		otherLockTxID := &lockIDs.DepositTxID
@@ -912,7 +1230,7 @@ func (h *handler) VerifyLockUTXOs(
 		if *otherLockTxID != ids.Empty {
 			id, err := txs.GetOutputOwnerID(out)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			ownerID = &id
 		}
@@ -926,14 +1244,14 @@ func (h *handler) VerifyLockUTXOs(
 
 		newAmount, err := math.Add64(consumedOwnerAmounts[*otherLockTxID], amount)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		consumedOwnerAmounts[*otherLockTxID] = newAmount
 	}
 
 	for index, output := range outs {
 		if outputAssetID := output.AssetID(); outputAssetID != assetID {
-			return fmt.Errorf(
+			return nil, fmt.Errorf(
 				"output %d has asset ID %s but expect %s: %w",
 				index,
 				outputAssetID,
@@ -944,7 +1262,12 @@ func (h *handler) VerifyLockUTXOs(
 
 		out := output.Out
 		if _, ok := out.(*stakeable.LockOut); ok {
-			return errWrongOutType
+			return nil, errWrongOutType
+		}
+
+		vestingOut, isVesting := out.(*locked.VestingOut)
+		if isVesting {
+			out = vestingOut.TransferableOut
 		}
 
 		lockIDs := &locked.IDsEmpty
@@ -954,7 +1277,31 @@ func (h *handler) VerifyLockUTXOs(
 		}
 
 		if err := h.fx.VerifyMultisigOwner(out, msigState); err != nil {
-			return err
+			return nil, err
+		}
+
+		if len(spendLimitedAliases) > 0 && !isVesting && !lockIDs.IsLocked() {
+			if spendLimited, ok := spendLimitedAliases[aliasOwner(out)]; ok {
+				producedAmount, err := math.Add64(spendLimited.produced, out.Amount())
+				if err != nil {
+					return nil, err
+				}
+				spendLimited.produced = producedAmount
+			}
+		}
+
+		if isVesting {
+			ownerID, err := txs.GetOutputOwnerID(out)
+			if err != nil {
+				return nil, err
+			}
+
+			key := vestingKey{ownerID: ownerID, schedule: vestingOut.Schedule}
+			newAmount, err := math.Add64(producedVesting[key], out.Amount())
+			if err != nil {
+				return nil, err
+			}
+			producedVesting[key] = newAmount
 		}
 
 		otherLockTxID := &lockIDs.DepositTxID
@@ -966,7 +1313,7 @@ func (h *handler) VerifyLockUTXOs(
 		if *otherLockTxID != ids.Empty {
 			id, err := txs.GetOutputOwnerID(out)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			ownerID = &id
 		}
@@ -979,7 +1326,7 @@ func (h *handler) VerifyLockUTXOs(
 		}
 
 		if consumedAmount < producedAmount {
-			return fmt.Errorf(
+			return nil, fmt.Errorf(
 				"address %s produces %d and consumes %d for lockIDs %+v with lock '%s': %w",
 				ownerID,
 				producedAmount,
@@ -993,17 +1340,38 @@ func (h *handler) VerifyLockUTXOs(
 		consumedOwnerAmounts[*otherLockTxID] = consumedAmount - producedAmount
 	}
 
+	for key, unvestedAmount := range consumedUnvested {
+		if producedVesting[key] < unvestedAmount {
+			return nil, fmt.Errorf(
+				"owner %s spent %d unvested tokens of schedule %+v: %w",
+				key.ownerID,
+				unvestedAmount-producedVesting[key],
+				key.schedule,
+				errUnvestedAmountSpent,
+			)
+		}
+	}
+
+	var spendLimitUsages map[ids.ShortID]*multisig.SpendLimitUsage
+	if len(spendLimitedAliases) > 0 {
+		usages, err := spendWithinAliasesSpendLimits(msigState, spendLimitedAliases)
+		if err != nil {
+			return nil, err
+		}
+		spendLimitUsages = usages
+	}
+
 	amountToBurn := burnedAmount
 	for _, consumedOwnerAmounts := range consumed {
 		consumedUnlockedAmount := consumedOwnerAmounts[ids.Empty]
 		if consumedUnlockedAmount >= amountToBurn {
-			return nil
+			return spendLimitUsages, nil
 		}
 		amountToBurn -= consumedUnlockedAmount
 	}
 
 	if amountToBurn > 0 {
-		return fmt.Errorf(
+		return nil, fmt.Errorf(
 			"asset %s burned %d unlocked, but needed to burn %d: %w",
 			assetID,
 			burnedAmount-amountToBurn,
@@ -1012,7 +1380,118 @@ func (h *handler) VerifyLockUTXOs(
 		)
 	}
 
-	return nil
+	return spendLimitUsages, nil
+}
+
+// verifySpendLimitTransfer verifies that [in] with [cred] spends [out], which is owned by msig alias
+// with spend limit, with alias spend limit threshold. Returns this alias.
+func (h *handler) verifySpendLimitTransfer(
+	tx txs.UnsignedTx,
+	in avax.TransferableIn,
+	cred verify.Verifiable,
+	out verify.State,
+	msigState secp256k1fx.AliasGetter,
+) (*multisig.AliasWithNonce, error) {
+	secpOut, ok := out.(*secp256k1fx.TransferOutput)
+	if !ok {
+		return nil, errWrongUTXOOutType
+	}
+	spendLimitOut, alias, err := aliasSpendLimitOut(secpOut, msigState)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.fx.VerifyMultisigTransfer(tx, in, cred, spendLimitOut, msigState); err != nil {
+		return nil, err
+	}
+	return alias, nil
+}
+
+// spendWithinAliasesSpendLimits checks that amounts spent from [spendLimitedAliases] with their
+// spend limit threshold don't exceed their spend limits. Returns aliases spend limit usages with these spends added.
+func spendWithinAliasesSpendLimits(
+	msigState secp256k1fx.AliasGetter,
+	spendLimitedAliases map[ids.ShortID]*spendLimitedAlias,
+) (map[ids.ShortID]*multisig.SpendLimitUsage, error) {
+	usageState, ok := msigState.(spendLimitUsageState)
+	if !ok {
+		return nil, errNoSpendLimitUsageState
+	}
+	chainTime := uint64(usageState.GetTimestamp().Unix())
+
+	usages := make(map[ids.ShortID]*multisig.SpendLimitUsage, len(spendLimitedAliases))
+	for aliasID, spendLimited := range spendLimitedAliases {
+		if spendLimited.produced >= spendLimited.consumed {
+			continue
+		}
+		usage, err := usageState.GetMultisigAliasSpendLimitUsage(aliasID)
+		if err != nil && err != database.ErrNotFound {
+			return nil, err
+		}
+		newUsage, err := usage.Spend(&spendLimited.alias.Alias, chainTime, spendLimited.consumed-spendLimited.produced)
+		if err != nil {
+			return nil, fmt.Errorf("msig alias %s: %w", aliasID, err)
+		}
+		usages[aliasID] = newUsage
+	}
+	return usages, nil
+}
+
+// spendWithinAliasSpendLimit tries to spend [out], which is owned by msig alias with spend limit,
+// with alias spend limit threshold.
+func spendWithinAliasSpendLimit(
+	kc *secp256k1fx.Keychain,
+	out *secp256k1fx.TransferOutput,
+	now uint64,
+	utxoDB avax.UTXOReader,
+) (verify.Verifiable, []*secp256k1.PrivateKey, error) {
+	msigState, ok := utxoDB.(secp256k1fx.AliasGetter)
+	if !ok {
+		return nil, nil, secp256k1fx.ErrNotAliasGetter
+	}
+	spendLimitOut, _, err := aliasSpendLimitOut(out, msigState)
+	if err != nil {
+		return nil, nil, err
+	}
+	return kc.SpendMultiSig(spendLimitOut, now, msigState)
+}
+
+// aliasSpendLimitOut returns copy of [out], which is owned by msig alias with spend limit,
+// with owner replaced by alias owners with spend limit threshold. Returns this alias.
+// Signature indices for result out are the same as for [out].
+func aliasSpendLimitOut(
+	out *secp256k1fx.TransferOutput,
+	msigState secp256k1fx.AliasGetter,
+) (*secp256k1fx.TransferOutput, *multisig.AliasWithNonce, error) {
+	aliasID := aliasOwner(out)
+	if aliasID == ids.ShortEmpty {
+		return nil, nil, errCantSpend
+	}
+	alias, err := msigState.GetMultisigAlias(aliasID)
+	if err != nil {
+		return nil, nil, err
+	}
+	spendLimitOwners, err := secp256k1fx.AliasSpendLimitOwners(&alias.Alias)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &secp256k1fx.TransferOutput{
+		Amt: out.Amt,
+		OutputOwners: secp256k1fx.OutputOwners{
+			Locktime:  out.Locktime,
+			Threshold: spendLimitOwners.Threshold,
+			Addrs:     spendLimitOwners.Addrs,
+		},
+	}, alias, nil
+}
+
+// aliasOwner returns the only address of [out] owners with threshold 1,
+// which could be msig alias. Returns empty id otherwise.
+func aliasOwner(out verify.State) ids.ShortID {
+	secpOut, ok := out.(*secp256k1fx.TransferOutput)
+	if !ok || secpOut.Threshold != 1 || len(secpOut.Addrs) != 1 {
+		return ids.ShortEmpty
+	}
+	return secpOut.Addrs[0]
 }
 
 func (h *handler) VerifyUnlockDeposit(
@@ -1270,108 +1749,36 @@ func (*handler) isMultisigTransferOutput(utxoDB avax.UTXOReader, out verify.Stat
 	return false
 }
 
-type innerSortUTXOs struct {
-	utxos          []*avax.UTXO
-	allowedAssetID ids.ID
-	lockState      locked.State
-}
-
-func (sort *innerSortUTXOs) Less(i, j int) bool {
-	iUTXO := sort.utxos[i]
-	jUTXO := sort.utxos[j]
-
-	if iUTXO.AssetID() == sort.allowedAssetID && jUTXO.AssetID() != sort.allowedAssetID {
-		return true
-	}
-
-	iOut := iUTXO.Out
-	iLockIDs := &locked.IDsEmpty
-	if lockedOut, ok := iOut.(*locked.Out); ok {
-		iOut = lockedOut.TransferableOut
-		iLockIDs = &lockedOut.IDs
-	}
-
-	jOut := jUTXO.Out
-	jLockIDs := &locked.IDsEmpty
-	if lockedOut, ok := jOut.(*locked.Out); ok {
-		jOut = lockedOut.TransferableOut
-		jLockIDs = &lockedOut.IDs
-	}
-
-	if sort.lockState == locked.StateUnlocked {
-		// Sort all locks last
-		iEmpty := *iLockIDs == locked.IDsEmpty
-		if iEmpty != (*jLockIDs == locked.IDsEmpty) {
-			return iEmpty
-		}
-	} else {
-		iLockTxID := &iLockIDs.DepositTxID
-		jLockTxID := &jLockIDs.DepositTxID
-		iOtherLockTxID := &iLockIDs.BondTxID
-		jOtherLockTxID := &jLockIDs.BondTxID
-		if sort.lockState == locked.StateBonded {
-			iLockTxID = &iLockIDs.BondTxID
-			jLockTxID = &jLockIDs.BondTxID
-			iOtherLockTxID = &iLockIDs.DepositTxID
-			jOtherLockTxID = &jLockIDs.DepositTxID
-		}
-
-		if *iLockTxID == ids.Empty && *jLockTxID != ids.Empty {
-			return true
-		} else if *iLockTxID != ids.Empty && *jLockTxID == ids.Empty {
-			return false
-		}
-
-		switch bytes.Compare(iOtherLockTxID[:], jOtherLockTxID[:]) {
-		case -1:
-			return false
-		case 1:
-			return true
-		}
-	}
-
-	iAmount := uint64(0)
-	if amounter, ok := iOut.(avax.Amounter); ok {
-		iAmount = amounter.Amount()
-	}
-
-	jAmount := uint64(0)
-	if amounter, ok := jOut.(avax.Amounter); ok {
-		jAmount = amounter.Amount()
-	}
-
-	return iAmount < jAmount
-}
-
-func (sort *innerSortUTXOs) Len() int {
-	return len(sort.utxos)
-}
-
-func (sort *innerSortUTXOs) Swap(i, j int) {
-	u := sort.utxos
-	u[j], u[i] = u[i], u[j]
-}
-
-func sortUTXOs(utxos []*avax.UTXO, allowedAssetID ids.ID, lockState locked.State) {
-	sort.Sort(&innerSortUTXOs{utxos: utxos, allowedAssetID: allowedAssetID, lockState: lockState})
-}
-
+// Returns amounts that can be unlocked from [depositTxIDs] deposits at [currentTimestamp].
+// If [force] is true, returned amounts are all remaining deposited amounts and
+// second returned map contains early unlock penalties for those deposits.
 func getDepositUnlockableAmounts(
 	chainState state.Chain,
 	depositTxIDs set.Set[ids.ID],
 	currentTimestamp uint64,
-) (map[ids.ID]uint64, error) {
+	force bool,
+) (map[ids.ID]uint64, map[ids.ID]uint64, error) {
 	unlockableAmounts := make(map[ids.ID]uint64, len(depositTxIDs))
+	penalties := make(map[ids.ID]uint64, len(depositTxIDs))
 
 	for depositTxID := range depositTxIDs {
 		deposit, err := chainState.GetDeposit(depositTxID)
 		if err != nil {
-			return nil, fmt.Errorf("%w: %s", errFailToGetDeposit, err)
+			return nil, nil, fmt.Errorf("%w: %s", errFailToGetDeposit, err)
 		}
 
 		depositOffer, err := chainState.GetDepositOffer(deposit.DepositOfferID)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+
+		if force && !deposit.IsExpired(currentTimestamp) {
+			if !depositOffer.EarlyUnlockAllowed {
+				return nil, nil, fmt.Errorf("%w: %s", errEarlyUnlockNotAllowed, depositTxID)
+			}
+			unlockableAmounts[depositTxID] = deposit.Amount - deposit.UnlockedAmount
+			penalties[depositTxID] = deposit.EarlyUnlockPenalty(depositOffer, currentTimestamp)
+			continue
 		}
 
 		unlockableAmounts[depositTxID] = deposit.UnlockableAmount(
@@ -1380,5 +1787,5 @@ func getDepositUnlockableAmounts(
 		)
 	}
 
-	return unlockableAmounts, nil
+	return unlockableAmounts, penalties, nil
 }
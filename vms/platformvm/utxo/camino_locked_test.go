@@ -10,10 +10,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/versiondb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/version"
@@ -24,6 +26,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/golang/mock/gomock"
@@ -200,6 +203,7 @@ func TestLock(t *testing.T) {
 	}
 
 	existingTxID := ids.GenerateTestID()
+	vestingSchedule := locked.VestingSchedule{Start: 100, Duration: 100, Step: 10, TotalAmount: 10}
 
 	type args struct {
 		totalAmountToSpend uint64
@@ -453,6 +457,42 @@ func TestLock(t *testing.T) {
 				}
 			},
 		},
+		"Recipient transfer of vested tokens": {
+			args: args{
+				totalAmountToSpend: 3,
+				totalAmountToBurn:  1,
+				appliedLockState:   locked.StateUnlocked,
+				change:             &changeOwners,
+				recipient:          &recipientOwners,
+			},
+			utxos: []*avax.UTXO{
+				generateTestVestingUTXO(ids.ID{7, 7}, ctx.AVAXAssetID, 10, outputOwners, vestingSchedule),
+			},
+			generateWant: func(utxos []*avax.UTXO) want {
+				return want{
+					ins: []*avax.TransferableInput{
+						generateTestInFromUTXO(utxos[0], []uint32{0}),
+					},
+					outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 3, recipientOwners, ids.Empty, ids.Empty),
+						generateTestOut(ctx.AVAXAssetID, 1, changeOwners, ids.Empty, ids.Empty),
+						generateTestVestingOut(ctx.AVAXAssetID, 5, outputOwners, vestingSchedule),
+					},
+				}
+			},
+		},
+		"Not enough vested tokens": {
+			args: args{
+				totalAmountToSpend: 5,
+				totalAmountToBurn:  1,
+				appliedLockState:   locked.StateUnlocked,
+				recipient:          &recipientOwners,
+			},
+			utxos: []*avax.UTXO{
+				generateTestVestingUTXO(ids.ID{7, 7}, ctx.AVAXAssetID, 10, outputOwners, vestingSchedule),
+			},
+			expectError: errInsufficientBalance,
+		},
 	}
 
 	for name, tt := range tests {
@@ -478,6 +518,7 @@ func TestLock(t *testing.T) {
 			}
 			state.EXPECT().UTXOIDs(address.Bytes(), ids.Empty, math.MaxInt).Return(utxoIDs, nil)
 			state.EXPECT().GetMultisigAlias(gomock.Any()).Return(nil, database.ErrNotFound).AnyTimes()
+			state.EXPECT().GetTimestamp().Return(time.Unix(155, 0)).AnyTimes()
 
 			testHandler := defaultCaminoHandler(t)
 
@@ -525,6 +566,7 @@ func TestVerifyLockUTXOs(t *testing.T) {
 
 	depositTxID1 := ids.ID{0, 1}
 	depositTxID2 := ids.ID{0, 2}
+	bondTxID1 := ids.ID{0, 3}
 
 	noMsigState := func(c *gomock.Controller) *state.MockChain {
 		s := state.NewMockChain(c)
@@ -532,6 +574,13 @@ func TestVerifyLockUTXOs(t *testing.T) {
 		return s
 	}
 
+	vestingSchedule := locked.VestingSchedule{Start: 100, Duration: 100, Step: 10, TotalAmount: 10}
+	vestingState := func(c *gomock.Controller) *state.MockChain {
+		s := noMsigState(c)
+		s.EXPECT().GetTimestamp().Return(time.Unix(155, 0))
+		return s
+	}
+
 	// Note that setting [chainTimestamp] also set's the VM's clock.
 	// Adjust input/output locktimes accordingly.
 	tests := map[string]struct {
@@ -892,6 +941,96 @@ func TestVerifyLockUTXOs(t *testing.T) {
 			appliedLockState: locked.StateBonded,
 			expectedErr:      nil,
 		},
+		"OK: deposit bonded tokens, fee is paid by other owner": {
+			state: noMsigState,
+			utxos: []*avax.UTXO{
+				generateTestUTXO(ids.ID{1}, assetID, 10, outputOwners1, ids.Empty, bondTxID1),
+				generateTestUTXO(ids.ID{2}, assetID, 10, outputOwners2, ids.Empty, ids.Empty),
+			},
+			ins: generateTestInsFromUTXOs,
+			outs: []*avax.TransferableOutput{
+				generateTestOut(assetID, 10, outputOwners1, locked.ThisTxID, bondTxID1),
+				generateTestOut(assetID, 8, outputOwners2, ids.Empty, ids.Empty),
+			},
+			burnedAmount:     2,
+			creds:            []verify.Verifiable{cred1, cred2},
+			appliedLockState: locked.StateDeposited,
+		},
+		"Fail: spent unvested tokens": {
+			state: vestingState,
+			utxos: []*avax.UTXO{
+				generateTestVestingUTXO(ids.ID{1}, assetID, 10, outputOwners1, vestingSchedule),
+			},
+			ins: generateTestInsFromUTXOs,
+			outs: []*avax.TransferableOutput{
+				generateTestOut(assetID, 4, outputOwners1, ids.Empty, ids.Empty),
+				generateTestVestingOut(assetID, 4, outputOwners1, vestingSchedule),
+			},
+			burnedAmount:     2,
+			creds:            []verify.Verifiable{cred1},
+			appliedLockState: locked.StateUnlocked,
+			expectedErr:      errUnvestedAmountSpent,
+		},
+		"Fail: unvested tokens returned with different schedule": {
+			state: vestingState,
+			utxos: []*avax.UTXO{
+				generateTestVestingUTXO(ids.ID{1}, assetID, 10, outputOwners1, vestingSchedule),
+			},
+			ins: generateTestInsFromUTXOs,
+			outs: []*avax.TransferableOutput{
+				generateTestOut(assetID, 4, outputOwners1, ids.Empty, ids.Empty),
+				generateTestVestingOut(assetID, 5, outputOwners1, locked.VestingSchedule{
+					Start: 100, Duration: 100, TotalAmount: 10,
+				}),
+			},
+			burnedAmount:     1,
+			creds:            []verify.Verifiable{cred1},
+			appliedLockState: locked.StateUnlocked,
+			expectedErr:      errUnvestedAmountSpent,
+		},
+		"Fail: unvested tokens returned to different owner": {
+			state: vestingState,
+			utxos: []*avax.UTXO{
+				generateTestVestingUTXO(ids.ID{1}, assetID, 10, outputOwners1, vestingSchedule),
+			},
+			ins: generateTestInsFromUTXOs,
+			outs: []*avax.TransferableOutput{
+				generateTestOut(assetID, 4, outputOwners1, ids.Empty, ids.Empty),
+				generateTestVestingOut(assetID, 5, outputOwners2, vestingSchedule),
+			},
+			burnedAmount:     1,
+			creds:            []verify.Verifiable{cred1},
+			appliedLockState: locked.StateUnlocked,
+			expectedErr:      errUnvestedAmountSpent,
+		},
+		"OK: spent vested tokens, unvested tokens returned into vesting out": {
+			state: vestingState,
+			utxos: []*avax.UTXO{
+				generateTestVestingUTXO(ids.ID{1}, assetID, 10, outputOwners1, vestingSchedule),
+			},
+			ins: generateTestInsFromUTXOs,
+			outs: []*avax.TransferableOutput{
+				generateTestOut(assetID, 4, outputOwners2, ids.Empty, ids.Empty),
+				generateTestVestingOut(assetID, 5, outputOwners1, vestingSchedule),
+			},
+			burnedAmount:     1,
+			creds:            []verify.Verifiable{cred1},
+			appliedLockState: locked.StateUnlocked,
+		},
+		"OK: deposit vested tokens": {
+			state: vestingState,
+			utxos: []*avax.UTXO{
+				generateTestVestingUTXO(ids.ID{1}, assetID, 10, outputOwners1, vestingSchedule),
+			},
+			ins: generateTestInsFromUTXOs,
+			outs: []*avax.TransferableOutput{
+				generateTestOut(assetID, 4, outputOwners1, locked.ThisTxID, ids.Empty),
+				generateTestVestingOut(assetID, 5, outputOwners1, vestingSchedule),
+			},
+			burnedAmount:     1,
+			creds:            []verify.Verifiable{cred1},
+			appliedLockState: locked.StateDeposited,
+		},
 		"OK: bond; produced + fee == consumed + minted": {
 			state: noMsigState,
 			utxos: []*avax.UTXO{
@@ -950,6 +1089,201 @@ func TestVerifyLockUTXOs(t *testing.T) {
 	}
 }
 
+func TestLockSpendLimit(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	signerKey, otherKey := secp256k1.TestKeys()[0], secp256k1.TestKeys()[1]
+	aliasOwnerAddrs := []ids.ShortID{signerKey.Address(), otherKey.Address()}
+	utils.Sort(aliasOwnerAddrs)
+	signerSigIndex := uint32(0)
+	if aliasOwnerAddrs[1] == signerKey.Address() {
+		signerSigIndex = 1
+	}
+
+	alias := &multisig.AliasWithNonce{Alias: multisig.Alias{
+		UpgradeVersionID: codec.UpgradeVersion2,
+		ID:               ids.ShortID{'m', 's', 'i', 'g'},
+		Owners: &secp256k1fx.OutputOwners{
+			Threshold: 2,
+			Addrs:     aliasOwnerAddrs,
+		},
+		SpendLimitThreshold: 1,
+		SpendLimitAmount:    100,
+		SpendLimitPeriod:    100,
+	}}
+	aliasOwners := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{alias.ID}}
+	recipientOwners := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{otherKey.Address()}}
+	utxo := generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, 150, aliasOwners, ids.Empty, ids.Empty)
+
+	tests := map[string]struct {
+		recipient       *secp256k1fx.OutputOwners
+		expectedIns     []*avax.TransferableInput
+		expectedOuts    []*avax.TransferableOutput
+		expectedSigners [][]*secp256k1.PrivateKey
+		expectedErr     error
+	}{
+		"OK: transfer": {
+			recipient:   &recipientOwners,
+			expectedIns: []*avax.TransferableInput{generateTestInFromUTXO(utxo, []uint32{signerSigIndex})},
+			expectedOuts: []*avax.TransferableOutput{
+				generateTestOut(ctx.AVAXAssetID, 60, recipientOwners, ids.Empty, ids.Empty),
+				generateTestOut(ctx.AVAXAssetID, 80, aliasOwners, ids.Empty, ids.Empty),
+			},
+			expectedSigners: [][]*secp256k1.PrivateKey{{signerKey}},
+		},
+		"Fail: not transfer": {
+			expectedErr: errInsufficientBalance,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := state.NewMockState(ctrl)
+			s.EXPECT().UTXOIDs(alias.ID.Bytes(), ids.Empty, math.MaxInt).Return([]ids.ID{utxo.InputID()}, nil)
+			s.EXPECT().GetUTXO(utxo.InputID()).Return(utxo, nil)
+			s.EXPECT().GetMultisigAlias(alias.ID).Return(alias, nil).AnyTimes()
+			s.EXPECT().GetMultisigAlias(gomock.Any()).Return(nil, database.ErrNotFound).AnyTimes()
+
+			ins, outs, signers, _, err := defaultCaminoHandler(t).Lock(
+				s,
+				[]*secp256k1.PrivateKey{secp256k1.FakePrivateKey(alias.ID), nil, signerKey},
+				60,
+				10,
+				locked.StateUnlocked,
+				tt.recipient,
+				nil,
+				0,
+			)
+			require.ErrorIs(t, err, tt.expectedErr)
+			avax.SortTransferableOutputs(tt.expectedOuts, txs.Codec)
+			require.Equal(t, tt.expectedIns, ins)
+			require.Equal(t, tt.expectedOuts, outs)
+			require.Equal(t, tt.expectedSigners, signers)
+		})
+	}
+}
+
+func TestVerifyLockUTXOsSpendLimit(t *testing.T) {
+	assetID := ids.ID{'t', 'e', 's', 't'}
+	tx := &txs.BaseTx{}
+	tx.SetBytes([]byte{1})
+
+	signerOwners, signerCred := generateOwnersAndSig(tx)
+	otherOwners, _ := generateOwnersAndSig(tx)
+	signerAddr := signerOwners.Addrs[0]
+	aliasOwnerAddrs := []ids.ShortID{signerAddr, otherOwners.Addrs[0]}
+	utils.Sort(aliasOwnerAddrs)
+	signerSigIndex := uint32(0)
+	if aliasOwnerAddrs[1] == signerAddr {
+		signerSigIndex = 1
+	}
+
+	alias := &multisig.AliasWithNonce{Alias: multisig.Alias{
+		UpgradeVersionID: codec.UpgradeVersion2,
+		ID:               ids.ShortID{'m', 's', 'i', 'g'},
+		Owners: &secp256k1fx.OutputOwners{
+			Threshold: 2,
+			Addrs:     aliasOwnerAddrs,
+		},
+		SpendLimitThreshold: 1,
+		SpendLimitAmount:    100,
+		SpendLimitPeriod:    100,
+	}}
+	aliasOwners := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{alias.ID}}
+	chainTime := uint64(1000)
+	usage := &multisig.SpendLimitUsage{Spends: []multisig.LimitedSpend{
+		{Time: chainTime - 100, Amount: 50}, // out of rolling window
+		{Time: chainTime - 50, Amount: 30},
+	}}
+
+	aliasState := func(c *gomock.Controller) *state.MockChain {
+		s := state.NewMockChain(c)
+		s.EXPECT().GetMultisigAlias(alias.ID).Return(alias, nil).AnyTimes()
+		s.EXPECT().GetMultisigAlias(gomock.Any()).Return(nil, database.ErrNotFound).AnyTimes()
+		return s
+	}
+
+	tests := map[string]struct {
+		state            func(*gomock.Controller) *state.MockChain
+		allowSpendLimits bool
+		outs             []*avax.TransferableOutput
+		expectedUsages   map[ids.ShortID]*multisig.SpendLimitUsage
+		expectedErr      error
+	}{
+		"OK: spent within limit": {
+			state: func(c *gomock.Controller) *state.MockChain {
+				s := aliasState(c)
+				s.EXPECT().GetTimestamp().Return(time.Unix(int64(chainTime), 0))
+				s.EXPECT().GetMultisigAliasSpendLimitUsage(alias.ID).Return(usage, nil)
+				return s
+			},
+			allowSpendLimits: true,
+			outs: []*avax.TransferableOutput{
+				generateTestOut(assetID, 60, otherOwners, ids.Empty, ids.Empty),
+				generateTestOut(assetID, 80, aliasOwners, ids.Empty, ids.Empty),
+			},
+			expectedUsages: map[ids.ShortID]*multisig.SpendLimitUsage{
+				alias.ID: {Spends: []multisig.LimitedSpend{
+					{Time: chainTime - 50, Amount: 30},
+					{Time: chainTime, Amount: 70},
+				}},
+			},
+		},
+		"Fail: spend limit exceeded": {
+			state: func(c *gomock.Controller) *state.MockChain {
+				s := aliasState(c)
+				s.EXPECT().GetTimestamp().Return(time.Unix(int64(chainTime), 0))
+				s.EXPECT().GetMultisigAliasSpendLimitUsage(alias.ID).Return(usage, nil)
+				return s
+			},
+			allowSpendLimits: true,
+			outs: []*avax.TransferableOutput{
+				generateTestOut(assetID, 70, otherOwners, ids.Empty, ids.Empty),
+				generateTestOut(assetID, 69, aliasOwners, ids.Empty, ids.Empty),
+			},
+			expectedErr: multisig.ErrSpendLimitExceeded,
+		},
+		"Fail: spend limits aren't allowed": {
+			state: aliasState,
+			outs: []*avax.TransferableOutput{
+				generateTestOut(assetID, 60, otherOwners, ids.Empty, ids.Empty),
+				generateTestOut(assetID, 80, aliasOwners, ids.Empty, ids.Empty),
+			},
+			expectedErr: errors.New("unable to spend this UTXO"), // secp256k1fx error
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			testHandler := defaultCaminoHandler(t)
+
+			utxos := []*avax.UTXO{generateTestUTXO(ids.ID{1}, assetID, 150, aliasOwners, ids.Empty, ids.Empty)}
+
+			usages, err := testHandler.verifyLockUTXOs(
+				tt.state(ctrl),
+				tx,
+				utxos,
+				[]*avax.TransferableInput{generateTestInFromUTXO(utxos[0], []uint32{signerSigIndex})},
+				tt.outs,
+				[]verify.Verifiable{signerCred},
+				0,
+				10,
+				assetID,
+				locked.StateUnlocked,
+				tt.allowSpendLimits,
+			)
+			if tt.expectedErr != nil {
+				require.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.expectedUsages, usages)
+		})
+	}
+}
+
 func TestGetDepositUnlockableAmounts(t *testing.T) {
 	config := defaultConfig()
 	ctx := snow.DefaultContextTest()
@@ -975,11 +1309,13 @@ func TestGetDepositUnlockableAmounts(t *testing.T) {
 		depositTxIDs set.Set[ids.ID]
 		currentTime  uint64
 		addresses    set.Set[ids.ShortID]
+		force        bool
 	}
 	tests := map[string]struct {
-		args args
-		want map[ids.ID]uint64
-		err  error
+		args          args
+		want          map[ids.ID]uint64
+		wantPenalties map[ids.ID]uint64
+		err           error
 	}{
 		"Success retrieval of all unlockable amounts": {
 			args: args{
@@ -1029,6 +1365,60 @@ func TestGetDepositUnlockableAmounts(t *testing.T) {
 			},
 			want: map[ids.ID]uint64{testID: depositedAmount / 2},
 		},
+		"Success retrieval of force-unlockable amounts and penalties": {
+			args: args{
+				state: func(ctrl *gomock.Controller) state.Chain {
+					nowMinus20m := uint64(now.Add(-20 * time.Minute).Unix())
+					s := state.NewMockChain(ctrl)
+					deposit1 := deposit.Deposit{
+						DepositOfferID: testID,
+						Start:          nowMinus20m,
+						Duration:       uint32((40 * time.Minute).Seconds()),
+						Amount:         depositedAmount,
+						UnlockedAmount: depositedAmount / 4,
+					}
+					s.EXPECT().GetDeposit(testID).Return(&deposit1, nil)
+					s.EXPECT().GetDepositOffer(testID).Return(&deposit.Offer{
+						Start:                nowMinus20m,
+						UnlockPeriodDuration: uint32((40 * time.Minute).Seconds()),
+						EarlyUnlockAllowed:   true,
+						EarlyUnlockPenalty:   deposit.EarlyUnlockPenaltyDenominator / 10,
+					}, nil)
+					return s
+				},
+				depositTxIDs: depositTxSet,
+				currentTime:  uint64(now.Unix()),
+				addresses:    outputOwners.AddressesSet(),
+				force:        true,
+			},
+			want:          map[ids.ID]uint64{testID: depositedAmount * 3 / 4},
+			wantPenalties: map[ids.ID]uint64{testID: depositedAmount / 2 / 10},
+		},
+		"Deposit offer doesn't allow early unlock": {
+			args: args{
+				state: func(ctrl *gomock.Controller) state.Chain {
+					nowMinus20m := uint64(now.Add(-20 * time.Minute).Unix())
+					s := state.NewMockChain(ctrl)
+					deposit1 := deposit.Deposit{
+						DepositOfferID: testID,
+						Start:          nowMinus20m,
+						Duration:       uint32((40 * time.Minute).Seconds()),
+						Amount:         depositedAmount,
+					}
+					s.EXPECT().GetDeposit(testID).Return(&deposit1, nil)
+					s.EXPECT().GetDepositOffer(testID).Return(&deposit.Offer{
+						Start:                nowMinus20m,
+						UnlockPeriodDuration: uint32((40 * time.Minute).Seconds()),
+					}, nil)
+					return s
+				},
+				depositTxIDs: depositTxSet,
+				currentTime:  uint64(now.Unix()),
+				addresses:    outputOwners.AddressesSet(),
+				force:        true,
+			},
+			err: errEarlyUnlockNotAllowed,
+		},
 		"Failed to get deposit offer": {
 			args: args{
 				state: func(ctrl *gomock.Controller) state.Chain {
@@ -1058,7 +1448,7 @@ func TestGetDepositUnlockableAmounts(t *testing.T) {
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
-			got, err := getDepositUnlockableAmounts(test.args.state(ctrl), test.args.depositTxIDs, test.args.currentTime)
+			got, gotPenalties, err := getDepositUnlockableAmounts(test.args.state(ctrl), test.args.depositTxIDs, test.args.currentTime, test.args.force)
 
 			if test.err != nil {
 				require.ErrorContains(t, err, test.err.Error())
@@ -1067,6 +1457,10 @@ func TestGetDepositUnlockableAmounts(t *testing.T) {
 
 			require.NoError(t, err)
 			require.Equal(t, test.want, got)
+			if test.wantPenalties == nil {
+				test.wantPenalties = map[ids.ID]uint64{}
+			}
+			require.Equal(t, test.wantPenalties, gotPenalties)
 		})
 	}
 }
@@ -1089,6 +1483,7 @@ func TestUnlockDeposit(t *testing.T) {
 		state        func(*gomock.Controller) state.Chain
 		keys         []*secp256k1.PrivateKey
 		depositTxIDs []ids.ID
+		force        bool
 	}
 	sigIndices := []uint32{0}
 
@@ -1191,11 +1586,48 @@ func TestUnlockDeposit(t *testing.T) {
 			},
 			want2: [][]*secp256k1.PrivateKey{{preFundedKeys[0]}},
 		},
+		"Successful force unlock with penalty": {
+			args: args{
+				state: func(ctrl *gomock.Controller) state.Chain {
+					s := state.NewMockChain(ctrl)
+					deposit1 := deposit.Deposit{
+						DepositOfferID: testID,
+						Start:          nowMinus10m,
+						Duration:       uint32((15 * time.Minute).Seconds()),
+						Amount:         depositedAmount,
+					}
+					depositTxSet := set.NewSet[ids.ID](1)
+					depositTxSet.Add(testID)
+
+					s.EXPECT().GetDeposit(testID).Return(&deposit1, nil)
+					s.EXPECT().GetDepositOffer(testID).Return(&deposit.Offer{
+						Start:                nowMinus10m,
+						UnlockPeriodDuration: uint32((10 * time.Minute).Seconds()),
+						EarlyUnlockAllowed:   true,
+						EarlyUnlockPenalty:   deposit.EarlyUnlockPenaltyDenominator / 10,
+					}, nil)
+					s.EXPECT().LockedUTXOs(depositTxSet, gomock.Any(), locked.StateDeposited).Return(depositedUTXOs, nil)
+					s.EXPECT().GetMultisigAlias(preFundedKeys[0].Address()).Return(nil, database.ErrNotFound)
+					return s
+				},
+				keys:         []*secp256k1.PrivateKey{preFundedKeys[0]},
+				depositTxIDs: []ids.ID{testID},
+				force:        true,
+			},
+			want: []*avax.TransferableInput{
+				generateTestInFromUTXO(depositedUTXOs[0], sigIndices),
+			},
+			want1: []*avax.TransferableOutput{
+				generateTestOut(ctx.AVAXAssetID, depositedAmount-depositedAmount/2/10, outputOwners, ids.Empty, ids.Empty),
+				generateTestOut(ctx.AVAXAssetID, depositedAmount/2/10, *treasury.Owner, ids.Empty, ids.Empty),
+			},
+			want2: [][]*secp256k1.PrivateKey{{preFundedKeys[0]}},
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
-			got, got1, got2, err := testHandler.UnlockDeposit(tt.args.state(ctrl), tt.args.keys, tt.args.depositTxIDs)
+			got, got1, got2, err := testHandler.UnlockDeposit(tt.args.state(ctrl), tt.args.keys, tt.args.depositTxIDs, tt.args.force)
 			if tt.err != nil {
 				require.ErrorContains(t, err, tt.err.Error())
 				return
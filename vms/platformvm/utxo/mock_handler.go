@@ -13,6 +13,7 @@ import (
 	ids "github.com/ava-labs/avalanchego/ids"
 	secp256k1 "github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	avax "github.com/ava-labs/avalanchego/vms/components/avax"
+	multisig "github.com/ava-labs/avalanchego/vms/components/multisig"
 	verify "github.com/ava-labs/avalanchego/vms/components/verify"
 	locked "github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	state "github.com/ava-labs/avalanchego/vms/platformvm/state"
@@ -112,9 +113,9 @@ func (mr *MockHandlerMockRecorder) Unlock(arg0, arg1, arg2 interface{}) *gomock.
 }
 
 // UnlockDeposit mocks base method.
-func (m *MockHandler) UnlockDeposit(arg0 state.Chain, arg1 []*secp256k1.PrivateKey, arg2 []ids.ID) ([]*avax.TransferableInput, []*avax.TransferableOutput, [][]*secp256k1.PrivateKey, error) {
+func (m *MockHandler) UnlockDeposit(arg0 state.Chain, arg1 []*secp256k1.PrivateKey, arg2 []ids.ID, arg3 bool) ([]*avax.TransferableInput, []*avax.TransferableOutput, [][]*secp256k1.PrivateKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UnlockDeposit", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "UnlockDeposit", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].([]*avax.TransferableInput)
 	ret1, _ := ret[1].([]*avax.TransferableOutput)
 	ret2, _ := ret[2].([][]*secp256k1.PrivateKey)
@@ -123,9 +124,9 @@ func (m *MockHandler) UnlockDeposit(arg0 state.Chain, arg1 []*secp256k1.PrivateK
 }
 
 // UnlockDeposit indicates an expected call of UnlockDeposit.
-func (mr *MockHandlerMockRecorder) UnlockDeposit(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockHandlerMockRecorder) UnlockDeposit(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockDeposit", reflect.TypeOf((*MockHandler)(nil).UnlockDeposit), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockDeposit", reflect.TypeOf((*MockHandler)(nil).UnlockDeposit), arg0, arg1, arg2, arg3)
 }
 
 // VerifyLock mocks base method.
@@ -142,6 +143,35 @@ func (mr *MockHandlerMockRecorder) VerifyLock(arg0, arg1, arg2, arg3, arg4, arg5
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyLock", reflect.TypeOf((*MockHandler)(nil).VerifyLock), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 }
 
+// VerifyLockWithFeeSponsor mocks base method.
+func (m *MockHandler) VerifyLockWithFeeSponsor(arg0 txs.UnsignedTx, arg1 avax.UTXOGetter, arg2 []*avax.TransferableInput, arg3 []*avax.TransferableOutput, arg4 []verify.Verifiable, arg5 []*avax.TransferableInput, arg6 []*avax.TransferableOutput, arg7 []verify.Verifiable, arg8 uint64, arg9 uint64, arg10 ids.ID, arg11 locked.State) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyLockWithFeeSponsor", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyLockWithFeeSponsor indicates an expected call of VerifyLockWithFeeSponsor.
+func (mr *MockHandlerMockRecorder) VerifyLockWithFeeSponsor(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyLockWithFeeSponsor", reflect.TypeOf((*MockHandler)(nil).VerifyLockWithFeeSponsor), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11)
+}
+
+// VerifyLockWithSpendLimits mocks base method.
+func (m *MockHandler) VerifyLockWithSpendLimits(arg0 txs.UnsignedTx, arg1 avax.UTXOGetter, arg2 []*avax.TransferableInput, arg3 []*avax.TransferableOutput, arg4 []verify.Verifiable, arg5 uint64, arg6 uint64, arg7 ids.ID, arg8 locked.State) (map[ids.ShortID]*multisig.SpendLimitUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyLockWithSpendLimits", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+	ret0, _ := ret[0].(map[ids.ShortID]*multisig.SpendLimitUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyLockWithSpendLimits indicates an expected call of VerifyLockWithSpendLimits.
+func (mr *MockHandlerMockRecorder) VerifyLockWithSpendLimits(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyLockWithSpendLimits", reflect.TypeOf((*MockHandler)(nil).VerifyLockWithSpendLimits), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+}
+
 // VerifySpend mocks base method.
 func (m *MockHandler) VerifySpend(arg0 txs.UnsignedTx, arg1 avax.UTXOGetter, arg2 []*avax.TransferableInput, arg3 []*avax.TransferableOutput, arg4 []verify.Verifiable, arg5 map[ids.ID]uint64) error {
 	m.ctrl.T.Helper()
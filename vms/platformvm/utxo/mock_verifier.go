@@ -12,6 +12,7 @@ import (
 
 	ids "github.com/ava-labs/avalanchego/ids"
 	avax "github.com/ava-labs/avalanchego/vms/components/avax"
+	multisig "github.com/ava-labs/avalanchego/vms/components/multisig"
 	verify "github.com/ava-labs/avalanchego/vms/components/verify"
 	locked "github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	state "github.com/ava-labs/avalanchego/vms/platformvm/state"
@@ -72,6 +73,35 @@ func (mr *MockVerifierMockRecorder) VerifyLock(arg0, arg1, arg2, arg3, arg4, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyLock", reflect.TypeOf((*MockVerifier)(nil).VerifyLock), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
 }
 
+// VerifyLockWithFeeSponsor mocks base method.
+func (m *MockVerifier) VerifyLockWithFeeSponsor(arg0 txs.UnsignedTx, arg1 avax.UTXOGetter, arg2 []*avax.TransferableInput, arg3 []*avax.TransferableOutput, arg4 []verify.Verifiable, arg5 []*avax.TransferableInput, arg6 []*avax.TransferableOutput, arg7 []verify.Verifiable, arg8 uint64, arg9 uint64, arg10 ids.ID, arg11 locked.State) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyLockWithFeeSponsor", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyLockWithFeeSponsor indicates an expected call of VerifyLockWithFeeSponsor.
+func (mr *MockVerifierMockRecorder) VerifyLockWithFeeSponsor(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyLockWithFeeSponsor", reflect.TypeOf((*MockVerifier)(nil).VerifyLockWithFeeSponsor), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11)
+}
+
+// VerifyLockWithSpendLimits mocks base method.
+func (m *MockVerifier) VerifyLockWithSpendLimits(arg0 txs.UnsignedTx, arg1 avax.UTXOGetter, arg2 []*avax.TransferableInput, arg3 []*avax.TransferableOutput, arg4 []verify.Verifiable, arg5 uint64, arg6 uint64, arg7 ids.ID, arg8 locked.State) (map[ids.ShortID]*multisig.SpendLimitUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyLockWithSpendLimits", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+	ret0, _ := ret[0].(map[ids.ShortID]*multisig.SpendLimitUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyLockWithSpendLimits indicates an expected call of VerifyLockWithSpendLimits.
+func (mr *MockVerifierMockRecorder) VerifyLockWithSpendLimits(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyLockWithSpendLimits", reflect.TypeOf((*MockVerifier)(nil).VerifyLockWithSpendLimits), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+}
+
 // VerifySpend mocks base method.
 func (m *MockVerifier) VerifySpend(arg0 txs.UnsignedTx, arg1 avax.UTXOGetter, arg2 []*avax.TransferableInput, arg3 []*avax.TransferableOutput, arg4 []verify.Verifiable, arg5 map[ids.ID]uint64) error {
 	m.ctrl.T.Helper()
@@ -17,8 +17,11 @@ import (
 const MaxSignatures = 256
 
 var (
-	errTooManySignatures = errors.New("too many signatures")
-	errCyclicAliases     = errors.New("cyclic aliases not allowed")
+	errTooManySignatures          = errors.New("too many signatures")
+	errCyclicAliases              = errors.New("cyclic aliases not allowed")
+	errWrongOwnersChangeThreshold = errors.New("msig alias owners change threshold must be between owners threshold and number of owners")
+	errWrongSpendLimitThreshold   = errors.New("msig alias spend limit threshold must be less than owners threshold")
+	errNoSpendLimit               = errors.New("msig alias has no spend limit")
 )
 
 // SpendMultisig attempts to create an input from outputowners which can contain multisig aliases
@@ -240,3 +243,45 @@ func TraverseAliases(out *OutputOwners, msig AliasGetter, callback TraverseAlias
 	}
 	return nil
 }
+
+// AliasOwnersChangeOwners returns owners, which signatures are required to change [alias]:
+// alias owners with threshold raised to alias owners change threshold, if it's set.
+// Nested aliases are always resolved with their owners threshold.
+func AliasOwnersChangeOwners(alias *multisig.Alias) (*OutputOwners, error) {
+	owners, ok := alias.Owners.(*OutputOwners)
+	if !ok {
+		return nil, ErrWrongOwnerType
+	}
+	if alias.OwnersChangeThreshold == 0 {
+		return owners, nil
+	}
+	if alias.OwnersChangeThreshold < owners.Threshold || int(alias.OwnersChangeThreshold) > len(owners.Addrs) {
+		return nil, errWrongOwnersChangeThreshold
+	}
+	return &OutputOwners{
+		Locktime:  owners.Locktime,
+		Threshold: alias.OwnersChangeThreshold,
+		Addrs:     owners.Addrs,
+	}, nil
+}
+
+// AliasSpendLimitOwners returns owners, which signatures are enough to spend
+// from [alias] within its spend limit: alias owners with threshold lowered to alias spend limit threshold.
+// Nested aliases are always resolved with their owners threshold.
+func AliasSpendLimitOwners(alias *multisig.Alias) (*OutputOwners, error) {
+	if alias.SpendLimitThreshold == 0 {
+		return nil, errNoSpendLimit
+	}
+	owners, ok := alias.Owners.(*OutputOwners)
+	if !ok {
+		return nil, ErrWrongOwnerType
+	}
+	if alias.SpendLimitThreshold >= owners.Threshold {
+		return nil, errWrongSpendLimitThreshold
+	}
+	return &OutputOwners{
+		Locktime:  owners.Locktime,
+		Threshold: alias.SpendLimitThreshold,
+		Addrs:     owners.Addrs,
+	}, nil
+}
@@ -211,3 +211,94 @@ func TestUnverifiedNestedOwner(t *testing.T) {
 	require.Equal(1, len(sigs), 1)
 	require.Equal(uint32(2), ti.(*TransferInput).SigIndices[0])
 }
+
+func TestAliasOwnersChangeOwners(t *testing.T) {
+	owners := &OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{{1}, {2}, {3}},
+	}
+
+	tests := map[string]struct {
+		alias          *multisig.Alias
+		expectedOwners *OutputOwners
+		expectedErr    error
+	}{
+		"Change threshold is less than owners threshold": {
+			alias: &multisig.Alias{
+				Owners:                &OutputOwners{Threshold: 2, Addrs: owners.Addrs},
+				OwnersChangeThreshold: 1,
+			},
+			expectedErr: errWrongOwnersChangeThreshold,
+		},
+		"Change threshold is more than owners count": {
+			alias: &multisig.Alias{
+				Owners:                owners,
+				OwnersChangeThreshold: 4,
+			},
+			expectedErr: errWrongOwnersChangeThreshold,
+		},
+		"OK: no change threshold": {
+			alias:          &multisig.Alias{Owners: owners},
+			expectedOwners: owners,
+		},
+		"OK: change threshold": {
+			alias: &multisig.Alias{
+				Owners:                owners,
+				OwnersChangeThreshold: 3,
+			},
+			expectedOwners: &OutputOwners{
+				Threshold: 3,
+				Addrs:     owners.Addrs,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			changeOwners, err := AliasOwnersChangeOwners(tt.alias)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expectedOwners, changeOwners)
+		})
+	}
+}
+
+func TestAliasSpendLimitOwners(t *testing.T) {
+	owners := &OutputOwners{
+		Threshold: 2,
+		Addrs:     []ids.ShortID{{1}, {2}, {3}},
+	}
+
+	tests := map[string]struct {
+		alias          *multisig.Alias
+		expectedOwners *OutputOwners
+		expectedErr    error
+	}{
+		"No spend limit": {
+			alias:       &multisig.Alias{Owners: owners},
+			expectedErr: errNoSpendLimit,
+		},
+		"Spend limit threshold isn't less than owners threshold": {
+			alias: &multisig.Alias{
+				Owners:              owners,
+				SpendLimitThreshold: 2,
+			},
+			expectedErr: errWrongSpendLimitThreshold,
+		},
+		"OK": {
+			alias: &multisig.Alias{
+				Owners:              owners,
+				SpendLimitThreshold: 1,
+			},
+			expectedOwners: &OutputOwners{
+				Threshold: 1,
+				Addrs:     owners.Addrs,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			spendLimitOwners, err := AliasSpendLimitOwners(tt.alias)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expectedOwners, spendLimitOwners)
+		})
+	}
+}
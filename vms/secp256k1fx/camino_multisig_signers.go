@@ -0,0 +1,69 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package secp256k1fx
+
+import (
+	"errors"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+)
+
+var ErrNotEnoughSignatures = errors.New("not enough signatures")
+
+// OwnersSigIndices returns global signature indices of every address of [owners]
+// with multisig aliases resolved. The same address can occupy multiple indices,
+// if it is owner of multiple nested aliases.
+func OwnersSigIndices(owners *OutputOwners, msig AliasGetter) (map[ids.ShortID][]uint32, error) {
+	sigIndices := map[ids.ShortID][]uint32{}
+
+	// Never verifying any address will force traversal through all of them
+	tf := func(addr ids.ShortID, totalVisited, _ uint32) (bool, error) {
+		sigIndices[addr] = append(sigIndices[addr], totalVisited)
+		return false, nil
+	}
+
+	if _, err := TraverseOwners(owners, msig, tf); err != nil && err != errCantSpend {
+		return nil, err
+	}
+	return sigIndices, nil
+}
+
+// SelectSignatures returns multisig credential with signatures from [sigs], mapped by
+// their global signature index, which are enough to prove that [owners] signed the message.
+// Signatures which aren't needed to meet owners threshold won't be included.
+func SelectSignatures(
+	owners *OutputOwners,
+	msig AliasGetter,
+	sigs map[uint32][secp256k1.SignatureLen]byte,
+) (*MultisigCredential, error) {
+	cred := &MultisigCredential{}
+
+	tf := func(_ ids.ShortID, totalVisited, totalVerified uint32) (bool, error) {
+		sig, ok := sigs[totalVisited]
+		if !ok {
+			return false, nil
+		}
+		// In case a nested alias doesnt meet threshold,
+		if totalVerified < uint32(len(cred.SigIdxs)) {
+			cred.SigIdxs = cred.SigIdxs[:totalVerified]
+			cred.Sigs = cred.Sigs[:totalVerified]
+		}
+		cred.SigIdxs = append(cred.SigIdxs, totalVisited)
+		cred.Sigs = append(cred.Sigs, sig)
+		return true, nil
+	}
+
+	totalVerified, err := TraverseOwners(owners, msig, tf)
+	switch {
+	case err == errCantSpend:
+		return nil, ErrNotEnoughSignatures
+	case err != nil:
+		return nil, err
+	}
+
+	cred.SigIdxs = cred.SigIdxs[:totalVerified]
+	cred.Sigs = cred.Sigs[:totalVerified]
+	return cred, nil
+}
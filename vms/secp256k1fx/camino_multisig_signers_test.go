@@ -0,0 +1,109 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package secp256k1fx
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+)
+
+func TestSelectSignatures(t *testing.T) {
+	msg := []byte{1, 2, 3}
+	msgHash := hashing.ComputeHash256(msg)
+
+	key0, addr0 := generateKey(t)
+	key1, addr1 := generateKey(t)
+	key2, addr2 := generateKey(t)
+	aliasAddrs := []ids.ShortID{addr1, addr2}
+	utils.Sort(aliasAddrs)
+	keys := map[ids.ShortID]*secp256k1.PrivateKey{addr0: key0, addr1: key1, addr2: key2}
+
+	// owners: 2 of [addr0, alias]; alias: 1 of [addr1, addr2]
+	// sig indices: addr0 - 0 or 2, alias addrs - 0/1 or 1/2, depending on sorting
+	aliasID := ids.ShortID{1}
+	alias := &multisig.AliasWithNonce{Alias: multisig.Alias{
+		ID:     aliasID,
+		Owners: &OutputOwners{Threshold: 1, Addrs: aliasAddrs},
+	}}
+	ownersAddrs := []ids.ShortID{addr0, aliasID}
+	utils.Sort(ownersAddrs)
+	owners := &OutputOwners{Threshold: 2, Addrs: ownersAddrs}
+
+	sign := func(t *testing.T, addrs ...ids.ShortID) map[uint32][secp256k1.SignatureLen]byte {
+		ctrl := gomock.NewController(t)
+		msig := NewMockAliasGetter(ctrl)
+		expectGetMultisigAliases(msig, []*multisig.AliasWithNonce{alias})
+		sigIndices, err := OwnersSigIndices(owners, msig)
+		require.NoError(t, err)
+		require.Len(t, sigIndices, 3)
+
+		sigs := map[uint32][secp256k1.SignatureLen]byte{}
+		for _, addr := range addrs {
+			sigBytes, err := keys[addr].SignHash(msgHash)
+			require.NoError(t, err)
+			var sig [secp256k1.SignatureLen]byte
+			copy(sig[:], sigBytes)
+			for _, sigIndex := range sigIndices[addr] {
+				sigs[sigIndex] = sig
+			}
+		}
+		return sigs
+	}
+
+	tests := map[string]struct {
+		signers         []ids.ShortID
+		expectedSigsNum int
+		expectedErr     error
+	}{
+		"OK: all signers": {
+			signers:         []ids.ShortID{addr0, addr1, addr2},
+			expectedSigsNum: 2,
+		},
+		"OK: addr0 and one of alias owners": {
+			signers:         []ids.ShortID{addr0, addr2},
+			expectedSigsNum: 2,
+		},
+		"Fail: only alias owners": {
+			signers:     []ids.ShortID{addr1, addr2},
+			expectedErr: ErrNotEnoughSignatures,
+		},
+		"Fail: no signers": {
+			expectedErr: ErrNotEnoughSignatures,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			sigs := sign(t, tt.signers...)
+
+			ctrl := gomock.NewController(t)
+			msig := NewMockAliasGetter(ctrl)
+			expectGetMultisigAliases(msig, []*multisig.AliasWithNonce{alias})
+
+			cred, err := SelectSignatures(owners, msig, sigs)
+			require.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+			require.Len(t, cred.Sigs, tt.expectedSigsNum)
+
+			fx := defaultFx(t)
+			expectGetMultisigAliases(msig, []*multisig.AliasWithNonce{alias})
+			require.NoError(t, fx.VerifyMultisigPermission(
+				&TestTx{UnsignedBytes: msg},
+				&Input{SigIndices: []uint32{}},
+				cred,
+				owners,
+				msig,
+			))
+		})
+	}
+}
@@ -39,6 +39,8 @@ type backend struct {
 	Context
 	ChainUTXOs
 
+	stateGetter CaminoStateGetter
+
 	txsLock sync.RWMutex
 	// txID -> tx
 	txs map[ids.ID]*txs.Tx
@@ -1,3 +1,13 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -36,6 +46,8 @@ var (
 // Builder provides a convenient interface for building unsigned P-chain
 // transactions.
 type Builder interface {
+	CaminoBuilder
+
 	// GetBalance calculates the amount of each asset that this builder has
 	// control over.
 	GetBalance(
@@ -251,6 +263,10 @@ type BuilderBackend interface {
 	Context
 	UTXOs(ctx stdcontext.Context, sourceChainID ids.ID) ([]*avax.UTXO, error)
 	GetTx(ctx stdcontext.Context, txID ids.ID) (*txs.Tx, error)
+	// BaseFee returns current base fee, which is burned by camino txs
+	BaseFee(ctx stdcontext.Context) (uint64, error)
+	// GetDepositRewardOwner returns current reward owner of deposit
+	GetDepositRewardOwner(ctx stdcontext.Context, depositTxID ids.ID) (*secp256k1fx.OutputOwners, error)
 }
 
 type builder struct {
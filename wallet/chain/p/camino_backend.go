@@ -0,0 +1,97 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	stdcontext "context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var errDepositNotFound = errors.New("deposit not found")
+
+// BaseFeeGetter returns current base fee of the node, e.g. platformvm.Client
+type BaseFeeGetter interface {
+	GetBaseFee(ctx stdcontext.Context, options ...rpc.Option) (uint64, error)
+}
+
+// CaminoStateGetter returns current camino state of the node, e.g. platformvm.Client
+type CaminoStateGetter interface {
+	BaseFeeGetter
+	GetDeposits(ctx stdcontext.Context, depositTxIDs []ids.ID, options ...rpc.Option) (*platformvm.GetDepositsReply, error)
+}
+
+// NewCaminoBackend returns backend, which fetches current base fee and deposits with [stateGetter].
+// Base fee could be changed by dao proposal and deposit reward owner could be changed by
+// transfer deposit tx, so static context base tx fee and deposit txs could be outdated.
+func NewCaminoBackend(
+	ctx Context,
+	utxos ChainUTXOs,
+	txs map[ids.ID]*txs.Tx,
+	stateGetter CaminoStateGetter,
+) Backend {
+	return &backend{
+		Context:     ctx,
+		ChainUTXOs:  utxos,
+		txs:         txs,
+		stateGetter: stateGetter,
+	}
+}
+
+// BaseFee returns current base fee fetched from node.
+// If backend has no state getter, then context base tx fee is returned.
+func (b *backend) BaseFee(ctx stdcontext.Context) (uint64, error) {
+	if b.stateGetter == nil {
+		return b.BaseTxFee(), nil
+	}
+	return b.stateGetter.GetBaseFee(ctx)
+}
+
+// GetDepositRewardOwner returns reward owner of deposit fetched from node.
+// If backend has no state getter, then reward owner is taken from deposit tx,
+// which isn't possible for restaked deposits.
+func (b *backend) GetDepositRewardOwner(ctx stdcontext.Context, depositTxID ids.ID) (*secp256k1fx.OutputOwners, error) {
+	if b.stateGetter == nil {
+		depositTx, err := b.GetTx(ctx, depositTxID)
+		if err != nil {
+			return nil, err
+		}
+		utx, ok := depositTx.Unsigned.(*txs.DepositTx)
+		if !ok {
+			return nil, errNotDepositTx
+		}
+		owner, ok := utx.RewardsOwner.(*secp256k1fx.OutputOwners)
+		if !ok {
+			return nil, errUnknownOwnerType
+		}
+		return owner, nil
+	}
+
+	reply, err := b.stateGetter.GetDeposits(ctx, []ids.ID{depositTxID})
+	if err != nil {
+		return nil, err
+	}
+	if len(reply.Deposits) != 1 {
+		return nil, fmt.Errorf("%w: %s", errDepositNotFound, depositTxID)
+	}
+	apiOwner := reply.Deposits[0].RewardOwner
+	addrs, err := address.ParseToIDs(apiOwner.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	utils.Sort(addrs)
+	return &secp256k1fx.OutputOwners{
+		Locktime:  uint64(apiOwner.Locktime),
+		Threshold: uint32(apiOwner.Threshold),
+		Addrs:     addrs,
+	}, nil
+}
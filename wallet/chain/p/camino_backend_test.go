@@ -0,0 +1,109 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	stdcontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/api"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var _ CaminoStateGetter = (*testStateGetter)(nil)
+
+type testStateGetter struct {
+	baseFee  uint64
+	deposits map[ids.ID]*platformvm.APIDeposit
+}
+
+func (g *testStateGetter) GetBaseFee(stdcontext.Context, ...rpc.Option) (uint64, error) {
+	return g.baseFee, nil
+}
+
+func (g *testStateGetter) GetDeposits(_ stdcontext.Context, depositTxIDs []ids.ID, _ ...rpc.Option) (*platformvm.GetDepositsReply, error) {
+	reply := &platformvm.GetDepositsReply{}
+	for _, depositTxID := range depositTxIDs {
+		if deposit, ok := g.deposits[depositTxID]; ok {
+			reply.Deposits = append(reply.Deposits, deposit)
+		}
+	}
+	return reply, nil
+}
+
+func TestGetDepositRewardOwner(t *testing.T) {
+	_, addr1, _ := generateKeyAndOwner(t)
+	_, addr2, _ := generateKeyAndOwner(t)
+	addrStr1, err := address.Format("P", constants.UnitTestHRP, addr1.Bytes())
+	require.NoError(t, err)
+	addrStr2, err := address.Format("P", constants.UnitTestHRP, addr2.Bytes())
+	require.NoError(t, err)
+
+	depositTxID := ids.ID{1}
+	restakedDepositTxID := depositTxID.Prefix(0)
+	txOwner := &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr1}}
+	depositTx := &txs.Tx{Unsigned: &txs.DepositTx{RewardsOwner: txOwner}}
+
+	tests := map[string]struct {
+		stateGetter   CaminoStateGetter
+		depositTxID   ids.ID
+		expectedOwner *secp256k1fx.OutputOwners
+		expectedErr   error
+	}{
+		"No state getter: owner from deposit tx": {
+			depositTxID:   depositTxID,
+			expectedOwner: txOwner,
+		},
+		"Deposit not found": {
+			stateGetter: &testStateGetter{},
+			depositTxID: restakedDepositTxID,
+			expectedErr: errDepositNotFound,
+		},
+		"OK: restaked deposit": {
+			stateGetter: &testStateGetter{deposits: map[ids.ID]*platformvm.APIDeposit{
+				restakedDepositTxID: {
+					DepositTxID: restakedDepositTxID,
+					RewardOwner: api.Owner{
+						Locktime:  json.Uint64(5),
+						Threshold: json.Uint32(2),
+						Addresses: []string{addrStr1, addrStr2},
+					},
+				},
+			}},
+			depositTxID: restakedDepositTxID,
+			expectedOwner: func() *secp256k1fx.OutputOwners {
+				owner := &secp256k1fx.OutputOwners{
+					Locktime:  5,
+					Threshold: 2,
+					Addrs:     []ids.ShortID{addr1, addr2},
+				}
+				owner.Sort()
+				return owner
+			}(),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			backend := NewCaminoBackend(
+				newTestBackend(nil, nil).Context,
+				nil,
+				map[ids.ID]*txs.Tx{depositTxID: depositTx},
+				tt.stateGetter,
+			)
+			owner, err := backend.GetDepositRewardOwner(stdcontext.Background(), tt.depositTxID)
+			require.ErrorIs(err, tt.expectedErr)
+			require.Equal(tt.expectedOwner, owner)
+		})
+	}
+}
@@ -0,0 +1,1148 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"errors"
+	"fmt"
+
+	stdcontext "context"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+var (
+	errInvalidTargetLockState = errors.New("invalid target lock state")
+	errUnknownClaimableOwner  = errors.New("claimable owner isn't controlled by given addresses")
+	errNotDepositTx           = errors.New("tx isn't deposit tx")
+	errUnknownAuthType        = errors.New("unknown auth type")
+	errFeeSponsorIsSpender    = errors.New("fee sponsor addresses intersect with spender addresses")
+	errFeeSponsorNotSupported = errors.New("fee sponsor isn't supported by this tx type")
+
+	_ CaminoBuilder = (*builder)(nil)
+)
+
+// CaminoBuilder provides a convenient interface for building unsigned camino
+// P-chain transactions.
+//
+// Inputs of built transactions only reference utxos owned directly by the
+// builder's addresses, multisig aliases aren't resolved.
+type CaminoBuilder interface {
+	// NewAddressStateTx sets or removes [state] bit of [address] address state.
+	//
+	// - [remove] specifies whether the bit will be removed or set.
+	NewAddressStateTx(
+		address ids.ShortID,
+		remove bool,
+		state txs.AddressStateBit,
+		options ...common.Option,
+	) (*txs.AddressStateTx, error)
+
+	// NewVerifyKYCTx sets KYC verified bit of [address] address state with
+	// expiration. KYC verified bit will be automatically replaced with
+	// KYC expired bit when chain time reaches expiration.
+	//
+	// - [executorAddress] specifies the address with KYC or admin role.
+	// - [kycExpiration] specifies unix time when KYC verification expires.
+	//   Zero means that it never expires.
+	NewVerifyKYCTx(
+		address ids.ShortID,
+		executorAddress ids.ShortID,
+		kycExpiration uint64,
+		options ...common.Option,
+	) (*txs.AddressStateTx, error)
+
+	// NewDepositTx creates a new deposit of [amount] tokens with the specified
+	// offer.
+	//
+	// - [depositOfferID] specifies the offer that will be used for this deposit.
+	// - [duration] specifies the deposit duration in seconds.
+	// - [rewardsOwner] specifies the owner of all the rewards this deposit
+	//   may accrue.
+	NewDepositTx(
+		depositOfferID ids.ID,
+		duration uint32,
+		amount uint64,
+		rewardsOwner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.DepositTx, error)
+
+	// NewUnlockDepositTx unlocks deposited tokens.
+	//
+	// - [amountsToUnlock] maps depositTxID to the amount of tokens that will be
+	//   unlocked from this deposit. Amounts must not exceed deposits unlockable
+	//   amounts, otherwise tx will be rejected.
+	NewUnlockDepositTx(
+		amountsToUnlock map[ids.ID]uint64,
+		options ...common.Option,
+	) (*txs.UnlockDepositTx, error)
+
+	// NewForceUnlockDepositTx fully unlocks deposits before the end of their
+	// unlock period. Deposit rewards are forfeited.
+	//
+	// - [amountsToUnlock] maps depositTxID to the amount of tokens that will be
+	//   unlocked from this deposit. Amounts must be equal to deposits remaining
+	//   amounts, otherwise tx will be rejected.
+	// - [penalty] specifies total early unlock penalty of given deposits, that
+	//   will be taken from unlocked tokens and sent to treasury. Penalty must
+	//   match deposits penalty at tx execution time, otherwise tx will be
+	//   rejected.
+	NewForceUnlockDepositTx(
+		amountsToUnlock map[ids.ID]uint64,
+		penalty uint64,
+		options ...common.Option,
+	) (*txs.UnlockDepositTx, error)
+
+	// NewClaimTx claims deposit and validator rewards or treasury claimables.
+	//
+	// - [claimables] specifies what and how much will be claimed. Owner auths
+	//   will be overridden by the builder. Deposit rewards owners are taken
+	//   from deposit txs, other claimables must be owned by a single builder
+	//   address.
+	// - [claimTo] specifies the owner of claimed tokens.
+	NewClaimTx(
+		claimables []txs.ClaimAmount,
+		claimTo *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.ClaimTx, error)
+
+	// NewRegisterNodeTx registers [newNodeID] for [nodeOwnerAddress] consortium
+	// member, unregistering [oldNodeID].
+	//
+	// - [oldNodeID] could be empty, if there is no registered node.
+	// - [newNodeID] could be empty, if node is only unregistered.
+	NewRegisterNodeTx(
+		oldNodeID ids.NodeID,
+		newNodeID ids.NodeID,
+		nodeOwnerAddress ids.ShortID,
+		options ...common.Option,
+	) (*txs.RegisterNodeTx, error)
+
+	// NewMultisigAliasTx creates new multisig alias or updates existing one.
+	//
+	// - [alias] specifies the alias definition. Its ID must be empty, if new
+	//   alias is created.
+	// - [aliasOwners] specifies the current owners of the updated alias with
+	//   alias owners change threshold, see secp256k1fx.AliasOwnersChangeOwners.
+	//   Ignored, if new alias is created.
+	NewMultisigAliasTx(
+		alias *multisig.Alias,
+		aliasOwners *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.MultisigAliasTx, error)
+
+	// NewAddDepositOfferTx creates a new deposit offer.
+	//
+	// - [offer] specifies the offer that will be added.
+	// - [offerCreatorAddress] specifies the address with deposit offers
+	//   creator role.
+	NewAddDepositOfferTx(
+		offer *deposit.Offer,
+		offerCreatorAddress ids.ShortID,
+		options ...common.Option,
+	) (*txs.AddDepositOfferTx, error)
+
+	// NewUpdateDepositOfferTx updates existing deposit offer.
+	//
+	// - [offerID] specifies the offer that will be updated.
+	// - [flags] specifies new offer flags. Only locked flag can be changed.
+	// - [end] specifies new offer end. Can't be after current one.
+	// - [totalMaxAmount] and [totalMaxRewardAmount] specify new offer limits.
+	//   They can't be more than current ones.
+	// - [offerUpdaterAddress] specifies the address with deposit offers
+	//   admin role or offer owner address.
+	NewUpdateDepositOfferTx(
+		offerID ids.ID,
+		flags deposit.OfferFlag,
+		end uint64,
+		totalMaxAmount uint64,
+		totalMaxRewardAmount uint64,
+		offerUpdaterAddress ids.ShortID,
+		options ...common.Option,
+	) (*txs.UpdateDepositOfferTx, error)
+
+	// NewSetRewardRestakeTx creates set reward restake transaction.
+	//
+	// - [claimableOwner] specifies the owner of claimable, which validator
+	//   rewards will be restaked.
+	// - [depositOfferID] specifies the offer that will be used for restaked
+	//   deposits. Empty id disables restaking.
+	// - [depositDuration] specifies the duration of restaked deposits.
+	NewSetRewardRestakeTx(
+		claimableOwner *secp256k1fx.OutputOwners,
+		depositOfferID ids.ID,
+		depositDuration uint32,
+		options ...common.Option,
+	) (*txs.SetRewardRestakeTx, error)
+
+	// NewSetSubnetValidatorRequirementsTx creates set subnet validator
+	// requirements transaction.
+	//
+	// - [subnetID] specifies the subnet, which validators requirements will be
+	//   set. Subnet must not be transformed yet.
+	// - [requireConsortiumMember] specifies if validator node owner must be
+	//   consortium member.
+	// - [requireKYCVerified] specifies if validator node owner must be kyc
+	//   verified.
+	NewSetSubnetValidatorRequirementsTx(
+		subnetID ids.ID,
+		requireConsortiumMember bool,
+		requireKYCVerified bool,
+		options ...common.Option,
+	) (*txs.SetSubnetValidatorRequirementsTx, error)
+}
+
+func (b *builder) NewAddressStateTx(
+	address ids.ShortID,
+	remove bool,
+	state txs.AddressStateBit,
+	options ...common.Option,
+) (*txs.AddressStateTx, error) {
+	ops := common.NewOptions(options)
+	baseFee, err := b.backend.BaseFee(ops.Context())
+	if err != nil {
+		return nil, err
+	}
+	inputs, outputs, err := b.lock(0, baseFee, locked.StateUnlocked, nil, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.AddressStateTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		Address: address,
+		State:   state,
+		Remove:  remove,
+	}, nil
+}
+
+func (b *builder) NewVerifyKYCTx(
+	address ids.ShortID,
+	executorAddress ids.ShortID,
+	kycExpiration uint64,
+	options ...common.Option,
+) (*txs.AddressStateTx, error) {
+	ops := common.NewOptions(options)
+	baseFee, err := b.backend.BaseFee(ops.Context())
+	if err != nil {
+		return nil, err
+	}
+	inputs, outputs, err := b.lock(0, baseFee, locked.StateUnlocked, nil, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	executorAuth, err := authorize(
+		&secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{executorAddress}},
+		ops.Addresses(b.addrs),
+		ops.MinIssuanceTime(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.AddressStateTx{
+		UpgradeVersionID: codec.UpgradeVersion2,
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		Address:       address,
+		State:         txs.AddressStateBitKYCVerified,
+		Executor:      executorAddress,
+		ExecutorAuth:  executorAuth,
+		KYCExpiration: kycExpiration,
+	}, nil
+}
+
+func (b *builder) NewDepositTx(
+	depositOfferID ids.ID,
+	duration uint32,
+	amount uint64,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.DepositTx, error) {
+	ops := common.NewOptions(options)
+	baseFee, err := b.backend.BaseFee(ops.Context())
+	if err != nil {
+		return nil, err
+	}
+	inputs, outputs, feeSponsorIns, feeSponsorOuts, err := b.lockWithFeeSponsor(
+		amount, baseFee, locked.StateDeposited, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	utils.Sort(rewardsOwner.Addrs)
+	utx := &txs.DepositTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		DepositOfferID:  depositOfferID,
+		DepositDuration: duration,
+		RewardsOwner:    rewardsOwner,
+	}
+	if len(feeSponsorIns) != 0 {
+		utx.UpgradeVersionID = codec.UpgradeVersion3
+		utx.DepositCreatorAuth = &secp256k1fx.Input{}
+		utx.DepositOfferOwnerAuth = &secp256k1fx.Input{}
+		utx.FeeSponsorIns = feeSponsorIns
+		utx.FeeSponsorOuts = feeSponsorOuts
+	}
+	return utx, nil
+}
+
+func (b *builder) NewUnlockDepositTx(
+	amountsToUnlock map[ids.ID]uint64,
+	options ...common.Option,
+) (*txs.UnlockDepositTx, error) {
+	ops := common.NewOptions(options)
+	inputs, outputs, err := b.unlockDeposit(amountsToUnlock, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee, err := b.backend.BaseFee(ops.Context())
+	if err != nil {
+		return nil, err
+	}
+	feeInputs, feeOutputs, err := b.lock(0, baseFee, locked.StateUnlocked, nil, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs = append(inputs, feeInputs...)
+	outputs = append(outputs, feeOutputs...)
+	utils.Sort(inputs)
+	avax.SortTransferableOutputs(outputs, txs.Codec)
+
+	return &txs.UnlockDepositTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+	}, nil
+}
+
+func (b *builder) NewForceUnlockDepositTx(
+	amountsToUnlock map[ids.ID]uint64,
+	penalty uint64,
+	options ...common.Option,
+) (*txs.UnlockDepositTx, error) {
+	ops := common.NewOptions(options)
+	inputs, outputs, err := b.unlockDeposit(amountsToUnlock, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	// early unlock penalty is taken from unlocked tokens
+	remainingPenalty := penalty
+	unlockedOutputs := make([]*avax.TransferableOutput, 0, len(outputs))
+	for _, output := range outputs {
+		if out, ok := output.Out.(*secp256k1fx.TransferOutput); ok {
+			outPenalty := math.Min(remainingPenalty, out.Amt)
+			remainingPenalty -= outPenalty
+			out.Amt -= outPenalty
+			if out.Amt == 0 {
+				continue
+			}
+		}
+		unlockedOutputs = append(unlockedOutputs, output)
+	}
+	if remainingPenalty > 0 {
+		return nil, fmt.Errorf(
+			"%w: unlocked tokens are %d less than penalty",
+			errInsufficientFunds,
+			remainingPenalty,
+		)
+	}
+	outputs = appendOutput(unlockedOutputs, b.backend.AVAXAssetID(), penalty, locked.IDsEmpty, treasury.Owner)
+
+	baseFee, err := b.backend.BaseFee(ops.Context())
+	if err != nil {
+		return nil, err
+	}
+	feeInputs, feeOutputs, err := b.lock(0, baseFee, locked.StateUnlocked, nil, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs = append(inputs, feeInputs...)
+	outputs = append(outputs, feeOutputs...)
+	utils.Sort(inputs)
+	avax.SortTransferableOutputs(outputs, txs.Codec)
+
+	return &txs.UnlockDepositTx{
+		UpgradeVersionID: codec.UpgradeVersion1,
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		Force: true,
+	}, nil
+}
+
+func (b *builder) NewClaimTx(
+	claimables []txs.ClaimAmount,
+	claimTo *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.ClaimTx, error) {
+	ops := common.NewOptions(options)
+	baseFee, err := b.backend.BaseFee(ops.Context())
+	if err != nil {
+		return nil, err
+	}
+	inputs, outputs, feeSponsorIns, feeSponsorOuts, err := b.lockWithFeeSponsor(
+		0, baseFee, locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := ops.Addresses(b.addrs)
+	utils.Sort(claimTo.Addrs)
+	txClaimables := make([]txs.ClaimAmount, len(claimables))
+	for i, claimable := range claimables {
+		owner, err := getClaimableOwner(ops.Context(), b.backend, claimable, addrs)
+		if err != nil {
+			return nil, err
+		}
+		ownerAuth, err := authorize(owner, addrs, ops.MinIssuanceTime())
+		if err != nil {
+			return nil, err
+		}
+
+		txClaimables[i] = claimable
+		txClaimables[i].OwnerAuth = ownerAuth
+
+		outputs = append(outputs, &avax.TransferableOutput{
+			Asset: avax.Asset{ID: b.backend.AVAXAssetID()},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          claimable.Amount,
+				OutputOwners: *claimTo,
+			},
+		})
+	}
+	avax.SortTransferableOutputs(outputs, txs.Codec)
+
+	utx := &txs.ClaimTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		Claimables: txClaimables,
+	}
+	if len(feeSponsorIns) != 0 {
+		utx.UpgradeVersionID = codec.UpgradeVersion1
+		utx.FeeSponsorIns = feeSponsorIns
+		utx.FeeSponsorOuts = feeSponsorOuts
+	}
+	return utx, nil
+}
+
+func (b *builder) NewRegisterNodeTx(
+	oldNodeID ids.NodeID,
+	newNodeID ids.NodeID,
+	nodeOwnerAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.RegisterNodeTx, error) {
+	ops := common.NewOptions(options)
+	baseFee, err := b.backend.BaseFee(ops.Context())
+	if err != nil {
+		return nil, err
+	}
+	inputs, outputs, err := b.lock(0, baseFee, locked.StateUnlocked, nil, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeOwnerAuth, err := authorize(
+		&secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{nodeOwnerAddress}},
+		ops.Addresses(b.addrs),
+		ops.MinIssuanceTime(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.RegisterNodeTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		OldNodeID:        oldNodeID,
+		NewNodeID:        newNodeID,
+		NodeOwnerAuth:    nodeOwnerAuth,
+		NodeOwnerAddress: nodeOwnerAddress,
+	}, nil
+}
+
+func (b *builder) NewMultisigAliasTx(
+	alias *multisig.Alias,
+	aliasOwners *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.MultisigAliasTx, error) {
+	ops := common.NewOptions(options)
+	baseFee, err := b.backend.BaseFee(ops.Context())
+	if err != nil {
+		return nil, err
+	}
+	inputs, outputs, err := b.lock(0, baseFee, locked.StateUnlocked, nil, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	auth := &secp256k1fx.Input{}
+	if alias.ID != ids.ShortEmpty {
+		auth, err = authorize(aliasOwners, ops.Addresses(b.addrs), ops.MinIssuanceTime())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &txs.MultisigAliasTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		MultisigAlias: *alias,
+		Auth:          auth,
+	}, nil
+}
+
+func (b *builder) NewAddDepositOfferTx(
+	offer *deposit.Offer,
+	offerCreatorAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.AddDepositOfferTx, error) {
+	ops := common.NewOptions(options)
+	baseFee, err := b.backend.BaseFee(ops.Context())
+	if err != nil {
+		return nil, err
+	}
+	inputs, outputs, err := b.lock(0, baseFee, locked.StateUnlocked, nil, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	offerCreatorAuth, err := authorize(
+		&secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{offerCreatorAddress}},
+		ops.Addresses(b.addrs),
+		ops.MinIssuanceTime(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.AddDepositOfferTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		DepositOffer:               offer,
+		DepositOfferCreatorAddress: offerCreatorAddress,
+		DepositOfferCreatorAuth:    offerCreatorAuth,
+	}, nil
+}
+
+func (b *builder) NewUpdateDepositOfferTx(
+	offerID ids.ID,
+	flags deposit.OfferFlag,
+	end uint64,
+	totalMaxAmount uint64,
+	totalMaxRewardAmount uint64,
+	offerUpdaterAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.UpdateDepositOfferTx, error) {
+	ops := common.NewOptions(options)
+	baseFee, err := b.backend.BaseFee(ops.Context())
+	if err != nil {
+		return nil, err
+	}
+	inputs, outputs, err := b.lock(0, baseFee, locked.StateUnlocked, nil, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	offerUpdaterAuth, err := authorize(
+		&secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{offerUpdaterAddress}},
+		ops.Addresses(b.addrs),
+		ops.MinIssuanceTime(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.UpdateDepositOfferTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		DepositOfferID:             offerID,
+		Flags:                      flags,
+		End:                        end,
+		TotalMaxAmount:             totalMaxAmount,
+		TotalMaxRewardAmount:       totalMaxRewardAmount,
+		DepositOfferUpdaterAddress: offerUpdaterAddress,
+		DepositOfferUpdaterAuth:    offerUpdaterAuth,
+	}, nil
+}
+
+// lock mirrors utxo.handler.Lock. It consumes avax utxos owned by builder
+// addresses, so that [amountToLock] tokens will be locked with
+// [appliedLockState] and [amountToBurn] unlocked tokens will be burned.
+//
+//   - [to] specifies the owner of tokens that will be transferred
+//     ([appliedLockState] is unlocked) or deposited. If nil, tokens will stay
+//     with their current owner. Bonded tokens always stay with their owner.
+//   - Unlocked change is returned to the change owner from [options], locked
+//     change is returned to its current owner.
+func (b *builder) lock(
+	amountToLock uint64,
+	amountToBurn uint64,
+	appliedLockState locked.State,
+	to *secp256k1fx.OutputOwners,
+	options *common.Options,
+) (
+	inputs []*avax.TransferableInput,
+	outputs []*avax.TransferableOutput,
+	err error,
+) {
+	switch appliedLockState {
+	case locked.StateBonded,
+		locked.StateDeposited,
+		locked.StateUnlocked:
+	default:
+		return nil, nil, errInvalidTargetLockState
+	}
+
+	if options.FeeSponsorAddresses().Len() > 0 && amountToBurn > 0 {
+		return nil, nil, errFeeSponsorNotSupported
+	}
+
+	addrs := options.Addresses(b.addrs)
+
+	utxos, err := b.backend.UTXOs(options.Context(), constants.PlatformChainID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	minIssuanceTime := options.MinIssuanceTime()
+
+	addr, ok := addrs.Peek()
+	if !ok {
+		return nil, nil, errNoChangeAddress
+	}
+	changeOwner := options.ChangeOwner(&secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{addr},
+	})
+
+	avaxAssetID := b.backend.AVAXAssetID()
+	locked.SortUTXOs(utxos, avaxAssetID, appliedLockState)
+
+	type lockedAndRemainedAmounts struct {
+		locked   uint64
+		remained uint64
+	}
+	type ownerAmounts struct {
+		amounts map[ids.ID]lockedAndRemainedAmounts
+		owners  *secp256k1fx.OutputOwners
+	}
+	// Track the amount of transfers and their owners
+	// if appliedLockState == bond, then otherLockTxID is depositTxID and vice versa
+	// ownerID -> otherLockTxID -> amounts
+	insAmounts := make(map[ids.ID]*ownerAmounts)
+	addAmounts := func(owners *secp256k1fx.OutputOwners, otherLockTxID ids.ID, lockedAmount, remainedAmount uint64) error {
+		ownerID, err := txs.GetOwnerID(owners)
+		if err != nil {
+			return err
+		}
+		ownerAmts, ok := insAmounts[ownerID]
+		if !ok {
+			ownerAmts = &ownerAmounts{
+				amounts: make(map[ids.ID]lockedAndRemainedAmounts),
+				owners:  owners,
+			}
+			insAmounts[ownerID] = ownerAmts
+		}
+		amounts := ownerAmts.amounts[otherLockTxID]
+		if amounts.locked, err = math.Add64(amounts.locked, lockedAmount); err != nil {
+			return err
+		}
+		if amounts.remained, err = math.Add64(amounts.remained, remainedAmount); err != nil {
+			return err
+		}
+		ownerAmts.amounts[otherLockTxID] = amounts
+		return nil
+	}
+
+	totalAmountLocked := uint64(0)
+	totalAmountBurned := uint64(0)
+
+	for _, utxo := range utxos {
+		// If we have consumed more AVAX than we are trying to lock,
+		// and we have burned more AVAX than we need to,
+		// then we have no need to consume more AVAX
+		if totalAmountBurned >= amountToBurn && totalAmountLocked >= amountToLock {
+			break
+		}
+
+		// We only care about locking AVAX,
+		// and because utxos are sorted we can skip other utxos
+		if utxo.AssetID() != avaxAssetID {
+			break
+		}
+
+		outIntf := utxo.Out
+		lockIDs := locked.IDsEmpty
+		if lockedOut, ok := outIntf.(*locked.Out); ok {
+			if lockedOut.IsLockedWith(appliedLockState) {
+				// This output can't be locked with target lockState,
+				// and because utxos are sorted we can skip other utxos
+				break
+			}
+			outIntf = lockedOut.TransferableOut
+			lockIDs = lockedOut.IDs
+		}
+
+		out, ok := outIntf.(*secp256k1fx.TransferOutput)
+		if !ok {
+			// We only know how to clone secp256k1 outputs for now
+			continue
+		}
+
+		inputSigIndices, ok := common.MatchOwners(&out.OutputOwners, addrs, minIssuanceTime)
+		if !ok {
+			// We couldn't spend this UTXO, so we skip to the next one
+			continue
+		}
+
+		remainingValue := out.Amt
+		lockedOwner := &out.OutputOwners
+		remainingOwner := &out.OutputOwners
+
+		if !lockIDs.IsLocked() {
+			// Burn any value that should be burned
+			amountToBurnNow := math.Min(
+				amountToBurn-totalAmountBurned, // Amount we still need to burn
+				remainingValue,                 // Amount available to burn
+			)
+			totalAmountBurned += amountToBurnNow
+			remainingValue -= amountToBurnNow
+
+			if to != nil && appliedLockState != locked.StateBonded {
+				lockedOwner = to
+			}
+			remainingOwner = changeOwner
+		}
+
+		// Lock any value that should be locked
+		amountToLockNow := math.Min(
+			amountToLock-totalAmountLocked, // Amount we still need to lock
+			remainingValue,                 // Amount available to lock
+		)
+		totalAmountLocked += amountToLockNow
+		remainingValue -= amountToLockNow
+
+		if amountToLockNow == 0 && amountToBurn == 0 {
+			continue
+		}
+
+		var in avax.TransferableIn = &secp256k1fx.TransferInput{
+			Amt: out.Amt,
+			Input: secp256k1fx.Input{
+				SigIndices: inputSigIndices,
+			},
+		}
+		if lockIDs.IsLocked() {
+			in = &locked.In{
+				IDs:            lockIDs,
+				TransferableIn: in,
+			}
+		}
+		inputs = append(inputs, &avax.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In:     in,
+		})
+
+		otherLockTxID := lockIDs.DepositTxID
+		if appliedLockState == locked.StateDeposited {
+			otherLockTxID = lockIDs.BondTxID
+		}
+		if err := addAmounts(lockedOwner, otherLockTxID, amountToLockNow, 0); err != nil {
+			return nil, nil, err
+		}
+		if err := addAmounts(remainingOwner, otherLockTxID, 0, remainingValue); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if totalAmountBurned < amountToBurn || totalAmountLocked < amountToLock {
+		return nil, nil, fmt.Errorf(
+			"%w: provided UTXOs need %d more units of asset %q to burn and %d more units to lock",
+			errInsufficientFunds,
+			amountToBurn-totalAmountBurned,
+			avaxAssetID,
+			amountToLock-totalAmountLocked,
+		)
+	}
+
+	for _, ownerAmts := range insAmounts {
+		for otherLockTxID, amounts := range ownerAmts.amounts {
+			lockIDs := locked.IDs{}
+			switch appliedLockState {
+			case locked.StateBonded:
+				lockIDs.DepositTxID = otherLockTxID
+			case locked.StateDeposited:
+				lockIDs.BondTxID = otherLockTxID
+			}
+
+			// Unlocked amounts are merged with remaining amounts to compact outputs
+			remainingAmount := amounts.remained
+			if newLockIDs := lockIDs.Lock(appliedLockState); newLockIDs.IsLocked() {
+				outputs = appendOutput(outputs, avaxAssetID, amounts.locked, newLockIDs, ownerAmts.owners)
+			} else if remainingAmount, err = math.Add64(remainingAmount, amounts.locked); err != nil {
+				return nil, nil, err
+			}
+			outputs = appendOutput(outputs, avaxAssetID, remainingAmount, lockIDs, ownerAmts.owners)
+		}
+	}
+
+	utils.Sort(inputs)                               // sort inputs
+	avax.SortTransferableOutputs(outputs, txs.Codec) // sort outputs
+	return inputs, outputs, nil
+}
+
+// lockWithFeeSponsor locks [amountToLock] with utxos of tx spender and burns [amountToBurn]
+// with unlocked utxos of fee sponsor addresses from [options]. Fee sponsor inputs and outputs
+// are returned separately, cause they are signed with their own credentials.
+// If [options] don't have fee sponsor, then it is the same as lock.
+func (b *builder) lockWithFeeSponsor(
+	amountToLock uint64,
+	amountToBurn uint64,
+	appliedLockState locked.State,
+	options *common.Options,
+) (
+	inputs []*avax.TransferableInput,
+	outputs []*avax.TransferableOutput,
+	feeSponsorIns []*avax.TransferableInput,
+	feeSponsorOuts []*avax.TransferableOutput,
+	err error,
+) {
+	sponsorAddrs := options.FeeSponsorAddresses()
+	if sponsorAddrs.Len() == 0 || amountToBurn == 0 {
+		inputs, outputs, err = b.lock(amountToLock, amountToBurn, appliedLockState, nil, options)
+		return inputs, outputs, nil, nil, err
+	}
+
+	addrs := options.Addresses(b.addrs)
+	if addrs.Overlaps(sponsorAddrs) {
+		return nil, nil, nil, nil, errFeeSponsorIsSpender
+	}
+
+	inputs, outputs, err = b.lock(amountToLock, 0, appliedLockState, nil, options)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	sponsorOptions := common.NewOptions([]common.Option{
+		common.WithContext(options.Context()),
+		common.WithCustomAddresses(sponsorAddrs),
+		common.WithMinIssuanceTime(options.MinIssuanceTime()),
+	})
+	feeSponsorIns, feeSponsorOuts, err = b.lock(0, amountToBurn, locked.StateUnlocked, nil, sponsorOptions)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("fee sponsor can't pay fee: %w", err)
+	}
+	return inputs, outputs, feeSponsorIns, feeSponsorOuts, nil
+}
+
+// unlockDeposit mirrors utxo.handler.UnlockDeposit. It consumes deposited
+// utxos owned by builder addresses, so that [amountsToUnlock] tokens will be
+// unlocked from deposits. Returned inputs and outputs aren't sorted.
+func (b *builder) unlockDeposit(
+	amountsToUnlock map[ids.ID]uint64,
+	options *common.Options,
+) (
+	inputs []*avax.TransferableInput,
+	outputs []*avax.TransferableOutput,
+	err error,
+) {
+	utxos, err := b.backend.UTXOs(options.Context(), constants.PlatformChainID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	addrs := options.Addresses(b.addrs)
+	minIssuanceTime := options.MinIssuanceTime()
+	avaxAssetID := b.backend.AVAXAssetID()
+
+	remainingAmountsToUnlock := make(map[ids.ID]uint64, len(amountsToUnlock))
+	for depositTxID, amount := range amountsToUnlock {
+		remainingAmountsToUnlock[depositTxID] = amount
+	}
+
+	for _, utxo := range utxos {
+		lockedOut, ok := utxo.Out.(*locked.Out)
+		if !ok || utxo.AssetID() != avaxAssetID {
+			continue
+		}
+
+		remainingAmountToUnlock := remainingAmountsToUnlock[lockedOut.DepositTxID]
+		if remainingAmountToUnlock == 0 {
+			// This output isn't deposited by one of given deposits
+			// or we have already unlocked enough from its deposit
+			continue
+		}
+
+		out, ok := lockedOut.TransferableOut.(*secp256k1fx.TransferOutput)
+		if !ok {
+			// We only know how to clone secp256k1 outputs for now
+			continue
+		}
+
+		inputSigIndices, ok := common.MatchOwners(&out.OutputOwners, addrs, minIssuanceTime)
+		if !ok {
+			// We couldn't spend this UTXO, so we skip to the next one
+			continue
+		}
+
+		inputs = append(inputs, &avax.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In: &locked.In{
+				IDs: lockedOut.IDs,
+				TransferableIn: &secp256k1fx.TransferInput{
+					Amt: out.Amt,
+					Input: secp256k1fx.Input{
+						SigIndices: inputSigIndices,
+					},
+				},
+			},
+		})
+
+		amountToUnlock := math.Min(remainingAmountToUnlock, out.Amt)
+		remainingAmountsToUnlock[lockedOut.DepositTxID] -= amountToUnlock
+
+		outputs = appendOutput(outputs, avaxAssetID, amountToUnlock, lockedOut.Unlock(locked.StateDeposited), &out.OutputOwners)
+		// This input had extra value, so some of it must be returned
+		outputs = appendOutput(outputs, avaxAssetID, out.Amt-amountToUnlock, lockedOut.IDs, &out.OutputOwners)
+	}
+
+	for depositTxID, amount := range remainingAmountsToUnlock {
+		if amount != 0 {
+			return nil, nil, fmt.Errorf(
+				"%w: provided UTXOs need %d more units deposited with %q to unlock",
+				errInsufficientFunds,
+				amount,
+				depositTxID,
+			)
+		}
+	}
+
+	return inputs, outputs, nil
+}
+
+// appendOutput appends output with [amount] tokens, [lockIDs] lock and
+// [owners] owners to [outputs]. If [amount] is zero, [outputs] are returned
+// unchanged.
+func appendOutput(
+	outputs []*avax.TransferableOutput,
+	assetID ids.ID,
+	amount uint64,
+	lockIDs locked.IDs,
+	owners *secp256k1fx.OutputOwners,
+) []*avax.TransferableOutput {
+	if amount == 0 {
+		return outputs
+	}
+	var out avax.TransferableOut = &secp256k1fx.TransferOutput{
+		Amt:          amount,
+		OutputOwners: *owners,
+	}
+	if lockIDs.IsLocked() {
+		out = &locked.Out{
+			IDs:             lockIDs,
+			TransferableOut: out,
+		}
+	}
+	return append(outputs, &avax.TransferableOutput{
+		Asset: avax.Asset{ID: assetID},
+		Out:   out,
+	})
+}
+
+// authorize returns input that proves [addrs] control over [owners].
+func authorize(
+	owners *secp256k1fx.OutputOwners,
+	addrs set.Set[ids.ShortID],
+	minIssuanceTime uint64,
+) (*secp256k1fx.Input, error) {
+	inputSigIndices, ok := common.MatchOwners(owners, addrs, minIssuanceTime)
+	if !ok {
+		return nil, errInsufficientAuthorization
+	}
+	return &secp256k1fx.Input{
+		SigIndices: inputSigIndices,
+	}, nil
+}
+
+// getClaimableOwner returns the owner of [claimable]. Deposit rewards owners
+// are taken from deposits state, other claimables owners are searched among
+// single-address owners of [addrs].
+func getClaimableOwner(
+	ctx stdcontext.Context,
+	backend interface {
+		GetDepositRewardOwner(ctx stdcontext.Context, depositTxID ids.ID) (*secp256k1fx.OutputOwners, error)
+	},
+	claimable txs.ClaimAmount,
+	addrs set.Set[ids.ShortID],
+) (*secp256k1fx.OutputOwners, error) {
+	if claimable.Type == txs.ClaimTypeActiveDepositReward {
+		owner, err := backend.GetDepositRewardOwner(ctx, claimable.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch deposit %q: %w", claimable.ID, err)
+		}
+		return owner, nil
+	}
+
+	for addr := range addrs {
+		owner := &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{addr},
+		}
+		ownerID, err := txs.GetOwnerID(owner)
+		if err != nil {
+			return nil, err
+		}
+		if ownerID == claimable.ID {
+			return owner, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", errUnknownClaimableOwner, claimable.ID)
+}
+
+func (b *builder) NewSetRewardRestakeTx(
+	claimableOwner *secp256k1fx.OutputOwners,
+	depositOfferID ids.ID,
+	depositDuration uint32,
+	options ...common.Option,
+) (*txs.SetRewardRestakeTx, error) {
+	ops := common.NewOptions(options)
+	baseFee, err := b.backend.BaseFee(ops.Context())
+	if err != nil {
+		return nil, err
+	}
+	inputs, outputs, err := b.lock(0, baseFee, locked.StateUnlocked, nil, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	claimableOwnerAuth, err := authorize(
+		claimableOwner,
+		ops.Addresses(b.addrs),
+		ops.MinIssuanceTime(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.SetRewardRestakeTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		ClaimableOwner:     claimableOwner,
+		ClaimableOwnerAuth: claimableOwnerAuth,
+		DepositOfferID:     depositOfferID,
+		DepositDuration:    depositDuration,
+	}, nil
+}
+
+func (b *builder) NewSetSubnetValidatorRequirementsTx(
+	subnetID ids.ID,
+	requireConsortiumMember bool,
+	requireKYCVerified bool,
+	options ...common.Option,
+) (*txs.SetSubnetValidatorRequirementsTx, error) {
+	ops := common.NewOptions(options)
+	baseFee, err := b.backend.BaseFee(ops.Context())
+	if err != nil {
+		return nil, err
+	}
+	inputs, outputs, err := b.lock(0, baseFee, locked.StateUnlocked, nil, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	subnetAuth, err := b.authorizeSubnet(subnetID, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.SetSubnetValidatorRequirementsTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.backend.NetworkID(),
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         ops.Memo(),
+		}},
+		Subnet:                  subnetID,
+		SubnetAuth:              subnetAuth,
+		RequireConsortiumMember: requireConsortiumMember,
+		RequireKYCVerified:      requireKYCVerified,
+	}, nil
+}
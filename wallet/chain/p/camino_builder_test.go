@@ -0,0 +1,356 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	stdcontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+func TestCaminoBuilderBaseFee(t *testing.T) {
+	_, addr, owner := generateKeyAndOwner(t)
+	utxo := generateTestUTXO(ids.ID{1}, 100, owner)
+	nodeID := ids.NodeID{1}
+	newAliasOwner := &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{2}}}
+	offer := &deposit.Offer{End: 100, MinAmount: 1, MinDuration: 1, MaxDuration: 1}
+
+	tests := map[string]func(Builder) (txs.UnsignedTx, error){
+		"AddressStateTx": func(b Builder) (txs.UnsignedTx, error) {
+			return b.NewAddressStateTx(addr, false, txs.AddressStateBitConsortium)
+		},
+		"VerifyKYCTx": func(b Builder) (txs.UnsignedTx, error) {
+			return b.NewVerifyKYCTx(ids.ShortID{2}, addr, 100)
+		},
+		"DepositTx": func(b Builder) (txs.UnsignedTx, error) {
+			return b.NewDepositTx(ids.ID{2}, 100, 50, owner)
+		},
+		"ClaimTx": func(b Builder) (txs.UnsignedTx, error) {
+			ownerID, err := txs.GetOwnerID(owner)
+			require.NoError(t, err)
+			return b.NewClaimTx([]txs.ClaimAmount{{
+				ID:     ownerID,
+				Type:   txs.ClaimTypeValidatorReward,
+				Amount: 5,
+			}}, owner)
+		},
+		"RegisterNodeTx": func(b Builder) (txs.UnsignedTx, error) {
+			return b.NewRegisterNodeTx(ids.EmptyNodeID, nodeID, addr)
+		},
+		"MultisigAliasTx": func(b Builder) (txs.UnsignedTx, error) {
+			return b.NewMultisigAliasTx(&multisig.Alias{Owners: newAliasOwner}, nil)
+		},
+		"AddDepositOfferTx": func(b Builder) (txs.UnsignedTx, error) {
+			return b.NewAddDepositOfferTx(offer, addr)
+		},
+		"UpdateDepositOfferTx": func(b Builder) (txs.UnsignedTx, error) {
+			return b.NewUpdateDepositOfferTx(ids.ID{2}, 0, 100, 0, 0, addr)
+		},
+		"SetRewardRestakeTx": func(b Builder) (txs.UnsignedTx, error) {
+			return b.NewSetRewardRestakeTx(owner, ids.ID{2}, 100)
+		},
+	}
+	for name, newUnsignedTx := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			backend := newTestBackend([]*avax.UTXO{utxo}, nil)
+			// base fee reported by node differs from context base tx fee
+			backend.baseFee = testBaseTxFee + 5
+			addrs := set.NewSet[ids.ShortID](1)
+			addrs.Add(addr)
+
+			utx, err := newUnsignedTx(NewBuilder(addrs, backend))
+			require.NoError(err)
+			ins, outs := utx.InputIDs(), utx.Outputs()
+			require.Len(ins, 1)
+			consumed := utxo.Out.(*secp256k1fx.TransferOutput).Amt
+			produced := uint64(0)
+			for _, out := range outs {
+				produced += out.Out.Amount()
+			}
+			if claimTx, ok := utx.(*txs.ClaimTx); ok {
+				// claimed amount is produced without inputs
+				produced -= claimTx.Claimables[0].Amount
+			}
+			require.Equal(backend.baseFee, consumed-produced)
+		})
+	}
+}
+
+func TestCaminoBuilderFeeSponsorNotSupported(t *testing.T) {
+	_, addr, owner := generateKeyAndOwner(t)
+	_, sponsorAddr, sponsorOwner := generateKeyAndOwner(t)
+	utxos := []*avax.UTXO{
+		generateTestUTXO(ids.ID{1}, 100, owner),
+		generateTestUTXO(ids.ID{2}, 100, sponsorOwner),
+	}
+	offer := &deposit.Offer{End: 100, MinAmount: 1, MinDuration: 1, MaxDuration: 1}
+	sponsorAddrs := set.NewSet[ids.ShortID](1)
+	sponsorAddrs.Add(sponsorAddr)
+	feeSponsor := common.WithFeeSponsor(sponsorAddrs)
+
+	tests := map[string]func(Builder) (txs.UnsignedTx, error){
+		"AddressStateTx": func(b Builder) (txs.UnsignedTx, error) {
+			return b.NewAddressStateTx(addr, false, txs.AddressStateBitConsortium, feeSponsor)
+		},
+		"VerifyKYCTx": func(b Builder) (txs.UnsignedTx, error) {
+			return b.NewVerifyKYCTx(ids.ShortID{2}, addr, 100, feeSponsor)
+		},
+		"UnlockDepositTx": func(b Builder) (txs.UnsignedTx, error) {
+			return b.NewUnlockDepositTx(nil, feeSponsor)
+		},
+		"ForceUnlockDepositTx": func(b Builder) (txs.UnsignedTx, error) {
+			return b.NewForceUnlockDepositTx(nil, 0, feeSponsor)
+		},
+		"RegisterNodeTx": func(b Builder) (txs.UnsignedTx, error) {
+			return b.NewRegisterNodeTx(ids.EmptyNodeID, ids.NodeID{1}, addr, feeSponsor)
+		},
+		"MultisigAliasTx": func(b Builder) (txs.UnsignedTx, error) {
+			return b.NewMultisigAliasTx(&multisig.Alias{Owners: owner}, nil, feeSponsor)
+		},
+		"AddDepositOfferTx": func(b Builder) (txs.UnsignedTx, error) {
+			return b.NewAddDepositOfferTx(offer, addr, feeSponsor)
+		},
+		"UpdateDepositOfferTx": func(b Builder) (txs.UnsignedTx, error) {
+			return b.NewUpdateDepositOfferTx(ids.ID{2}, 0, 100, 0, 0, addr, feeSponsor)
+		},
+		"SetRewardRestakeTx": func(b Builder) (txs.UnsignedTx, error) {
+			return b.NewSetRewardRestakeTx(owner, ids.ID{2}, 100, feeSponsor)
+		},
+	}
+	for name, newUnsignedTx := range tests {
+		t.Run(name, func(t *testing.T) {
+			addrs := set.NewSet[ids.ShortID](1)
+			addrs.Add(addr)
+			_, err := newUnsignedTx(NewBuilder(addrs, newTestBackend(utxos, nil)))
+			require.ErrorIs(t, err, errFeeSponsorNotSupported)
+		})
+	}
+}
+
+func TestLockWithFeeSponsor(t *testing.T) {
+	_, addr, owner := generateKeyAndOwner(t)
+	_, sponsorAddr, sponsorOwner := generateKeyAndOwner(t)
+	utxo := generateTestUTXO(ids.ID{1}, 100, owner)
+	sponsorUTXO := generateTestUTXO(ids.ID{2}, 30, sponsorOwner)
+
+	addrs := set.NewSet[ids.ShortID](1)
+	addrs.Add(addr)
+	sponsorAddrs := set.NewSet[ids.ShortID](1)
+	sponsorAddrs.Add(sponsorAddr)
+	spenderAsSponsorAddrs := set.NewSet[ids.ShortID](2)
+	spenderAsSponsorAddrs.Add(addr, sponsorAddr)
+
+	tests := map[string]struct {
+		utxos               []*avax.UTXO
+		options             []common.Option
+		expectedIns         []ids.ID
+		expectedOutsAmount  uint64
+		expectedSponsorIns  []ids.ID
+		expectedSponsorOuts []*avax.TransferableOutput
+		expectedErr         error
+	}{
+		"Fee sponsor is spender": {
+			utxos:       []*avax.UTXO{utxo, sponsorUTXO},
+			options:     []common.Option{common.WithFeeSponsor(spenderAsSponsorAddrs)},
+			expectedErr: errFeeSponsorIsSpender,
+		},
+		"Fee sponsor has not enough funds": {
+			utxos:       []*avax.UTXO{utxo},
+			options:     []common.Option{common.WithFeeSponsor(sponsorAddrs)},
+			expectedErr: errInsufficientFunds,
+		},
+		"OK: no fee sponsor": {
+			utxos:              []*avax.UTXO{utxo, sponsorUTXO},
+			expectedIns:        []ids.ID{utxo.InputID()},
+			expectedOutsAmount: 100 - testBaseTxFee,
+		},
+		"OK: fee sponsor": {
+			utxos:              []*avax.UTXO{utxo, sponsorUTXO},
+			options:            []common.Option{common.WithFeeSponsor(sponsorAddrs)},
+			expectedIns:        []ids.ID{utxo.InputID()},
+			expectedOutsAmount: 100,
+			expectedSponsorIns: []ids.ID{sponsorUTXO.InputID()},
+			expectedSponsorOuts: []*avax.TransferableOutput{{
+				Asset: avax.Asset{ID: testAVAXAssetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt:          30 - testBaseTxFee,
+					OutputOwners: *sponsorOwner,
+				},
+			}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			b := &builder{addrs: addrs, backend: newTestBackend(tt.utxos, nil)}
+
+			ins, outs, sponsorIns, sponsorOuts, err := b.lockWithFeeSponsor(
+				50, testBaseTxFee, locked.StateDeposited, common.NewOptions(tt.options))
+			require.ErrorIs(err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+
+			require.Equal(tt.expectedIns, inputIDs(ins))
+			outsAmount := uint64(0)
+			for _, out := range outs {
+				outsAmount += out.Out.Amount()
+			}
+			require.Equal(tt.expectedOutsAmount, outsAmount)
+			require.Equal(tt.expectedSponsorIns, inputIDs(sponsorIns))
+			require.Equal(tt.expectedSponsorOuts, sponsorOuts)
+		})
+	}
+}
+
+func TestNewClaimTx(t *testing.T) {
+	feeKey, feeAddr, feeOwner := generateKeyAndOwner(t)
+	rewardKey, rewardAddr, rewardOwner := generateKeyAndOwner(t)
+	_, _, claimToOwner := generateKeyAndOwner(t)
+
+	feeUTXO := generateTestUTXO(ids.ID{1}, testBaseTxFee, feeOwner)
+	restakedDepositTxID := ids.ID{2}.Prefix(0) // restaked deposits have no deposit tx
+
+	tests := map[string]struct {
+		deposits        map[ids.ID]*secp256k1fx.OutputOwners
+		claimables      []txs.ClaimAmount
+		expectedSigners [][]ids.ShortID
+		expectedErr     error
+	}{
+		"Restaked deposit not found": {
+			claimables: []txs.ClaimAmount{{
+				ID:     restakedDepositTxID,
+				Type:   txs.ClaimTypeActiveDepositReward,
+				Amount: 5,
+			}},
+			expectedErr: database.ErrNotFound,
+		},
+		"OK: restaked deposit": {
+			deposits: map[ids.ID]*secp256k1fx.OutputOwners{
+				restakedDepositTxID: rewardOwner,
+			},
+			claimables: []txs.ClaimAmount{{
+				ID:     restakedDepositTxID,
+				Type:   txs.ClaimTypeActiveDepositReward,
+				Amount: 5,
+			}},
+			expectedSigners: [][]ids.ShortID{{feeAddr}, {rewardAddr}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			backend := newTestBackend([]*avax.UTXO{feeUTXO}, nil)
+			for depositTxID, owner := range tt.deposits {
+				backend.deposits[depositTxID] = owner
+			}
+			addrs := set.NewSet[ids.ShortID](2)
+			addrs.Add(feeAddr, rewardAddr)
+			builder := NewBuilder(addrs, backend)
+
+			utx, err := builder.NewClaimTx(tt.claimables, claimToOwner)
+			require.ErrorIs(err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+			require.Len(utx.Claimables, len(tt.claimables))
+			require.Equal(&secp256k1fx.Input{SigIndices: []uint32{0}}, utx.Claimables[0].OwnerAuth)
+
+			signer := NewSigner(secp256k1fx.NewKeychain(feeKey, rewardKey), backend)
+			tx, err := signer.SignUnsigned(stdcontext.Background(), utx)
+			require.NoError(err)
+			requireCredsSigners(t, tx, tt.expectedSigners)
+		})
+	}
+}
+
+func TestCaminoBuilderFeeSponsor(t *testing.T) {
+	key, addr, owner := generateKeyAndOwner(t)
+	sponsorKey, sponsorAddr, sponsorOwner := generateKeyAndOwner(t)
+	utxo := generateTestUTXO(ids.ID{1}, 100, owner)
+	sponsorUTXO := generateTestUTXO(ids.ID{2}, 30, sponsorOwner)
+	ownerID, err := txs.GetOwnerID(owner)
+	require.NoError(t, err)
+
+	sponsorAddrs := set.NewSet[ids.ShortID](1)
+	sponsorAddrs.Add(sponsorAddr)
+	feeSponsor := common.WithFeeSponsor(sponsorAddrs)
+
+	tests := map[string]struct {
+		newUnsignedTx   func(*testing.T, Builder) txs.UnsignedTx
+		expectedSigners [][]ids.ShortID
+	}{
+		"DepositTx": {
+			newUnsignedTx: func(t *testing.T, b Builder) txs.UnsignedTx {
+				utx, err := b.NewDepositTx(ids.ID{3}, 100, 50, owner, feeSponsor)
+				require.NoError(t, err)
+				require.Equal(t, codec.UpgradeVersion3, utx.UpgradeVersionID)
+				require.Equal(t, []*avax.TransferableInput{generateTestIn(utxo, []uint32{0})}, utx.Ins)
+				require.Equal(t, []*avax.TransferableInput{generateTestIn(sponsorUTXO, []uint32{0})}, utx.FeeSponsorIns)
+				return utx
+			},
+			expectedSigners: [][]ids.ShortID{{addr}, {sponsorAddr}},
+		},
+		"ClaimTx": {
+			newUnsignedTx: func(t *testing.T, b Builder) txs.UnsignedTx {
+				utx, err := b.NewClaimTx([]txs.ClaimAmount{{
+					ID:     ownerID,
+					Type:   txs.ClaimTypeValidatorReward,
+					Amount: 5,
+				}}, owner, feeSponsor)
+				require.NoError(t, err)
+				require.Equal(t, codec.UpgradeVersion1, utx.UpgradeVersionID)
+				require.Empty(t, utx.Ins)
+				require.Equal(t, []*avax.TransferableInput{generateTestIn(sponsorUTXO, []uint32{0})}, utx.FeeSponsorIns)
+				return utx
+			},
+			expectedSigners: [][]ids.ShortID{{addr}, {sponsorAddr}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			backend := newTestBackend([]*avax.UTXO{utxo, sponsorUTXO}, nil)
+			addrs := set.NewSet[ids.ShortID](1)
+			addrs.Add(addr)
+
+			utx := tt.newUnsignedTx(t, NewBuilder(addrs, backend))
+
+			// sponsor signs with its own key in the same keychain
+			signer := NewSigner(secp256k1fx.NewKeychain(key, sponsorKey), backend)
+			tx, err := signer.SignUnsigned(stdcontext.Background(), utx)
+			require.NoError(err)
+			requireCredsSigners(t, tx, tt.expectedSigners)
+
+			parsedTx, err := txs.Parse(txs.Codec, tx.Bytes())
+			require.NoError(err)
+			require.Equal(tx.ID(), parsedTx.ID())
+		})
+	}
+}
+
+func inputIDs(ins []*avax.TransferableInput) []ids.ID {
+	if len(ins) == 0 {
+		return nil
+	}
+	inputIDs := make([]ids.ID, len(ins))
+	for i, in := range ins {
+		inputIDs[i] = in.InputID()
+	}
+	return inputIDs
+}
@@ -0,0 +1,179 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+var _ CaminoBuilder = (*builderWithOptions)(nil)
+
+func (b *builderWithOptions) NewAddressStateTx(
+	address ids.ShortID,
+	remove bool,
+	state txs.AddressStateBit,
+	options ...common.Option,
+) (*txs.AddressStateTx, error) {
+	return b.Builder.NewAddressStateTx(
+		address,
+		remove,
+		state,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewVerifyKYCTx(
+	address ids.ShortID,
+	executorAddress ids.ShortID,
+	kycExpiration uint64,
+	options ...common.Option,
+) (*txs.AddressStateTx, error) {
+	return b.Builder.NewVerifyKYCTx(
+		address,
+		executorAddress,
+		kycExpiration,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewDepositTx(
+	depositOfferID ids.ID,
+	duration uint32,
+	amount uint64,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.DepositTx, error) {
+	return b.Builder.NewDepositTx(
+		depositOfferID,
+		duration,
+		amount,
+		rewardsOwner,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewUnlockDepositTx(
+	amountsToUnlock map[ids.ID]uint64,
+	options ...common.Option,
+) (*txs.UnlockDepositTx, error) {
+	return b.Builder.NewUnlockDepositTx(
+		amountsToUnlock,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewForceUnlockDepositTx(
+	amountsToUnlock map[ids.ID]uint64,
+	penalty uint64,
+	options ...common.Option,
+) (*txs.UnlockDepositTx, error) {
+	return b.Builder.NewForceUnlockDepositTx(
+		amountsToUnlock,
+		penalty,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewClaimTx(
+	claimables []txs.ClaimAmount,
+	claimTo *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.ClaimTx, error) {
+	return b.Builder.NewClaimTx(
+		claimables,
+		claimTo,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewRegisterNodeTx(
+	oldNodeID ids.NodeID,
+	newNodeID ids.NodeID,
+	nodeOwnerAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.RegisterNodeTx, error) {
+	return b.Builder.NewRegisterNodeTx(
+		oldNodeID,
+		newNodeID,
+		nodeOwnerAddress,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewMultisigAliasTx(
+	alias *multisig.Alias,
+	aliasOwners *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.MultisigAliasTx, error) {
+	return b.Builder.NewMultisigAliasTx(
+		alias,
+		aliasOwners,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewAddDepositOfferTx(
+	offer *deposit.Offer,
+	offerCreatorAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.AddDepositOfferTx, error) {
+	return b.Builder.NewAddDepositOfferTx(
+		offer,
+		offerCreatorAddress,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewUpdateDepositOfferTx(
+	offerID ids.ID,
+	flags deposit.OfferFlag,
+	end uint64,
+	totalMaxAmount uint64,
+	totalMaxRewardAmount uint64,
+	offerUpdaterAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.UpdateDepositOfferTx, error) {
+	return b.Builder.NewUpdateDepositOfferTx(
+		offerID,
+		flags,
+		end,
+		totalMaxAmount,
+		totalMaxRewardAmount,
+		offerUpdaterAddress,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewSetRewardRestakeTx(
+	claimableOwner *secp256k1fx.OutputOwners,
+	depositOfferID ids.ID,
+	depositDuration uint32,
+	options ...common.Option,
+) (*txs.SetRewardRestakeTx, error) {
+	return b.Builder.NewSetRewardRestakeTx(
+		claimableOwner,
+		depositOfferID,
+		depositDuration,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *builderWithOptions) NewSetSubnetValidatorRequirementsTx(
+	subnetID ids.ID,
+	requireConsortiumMember bool,
+	requireKYCVerified bool,
+	options ...common.Option,
+) (*txs.SetSubnetValidatorRequirementsTx, error) {
+	return b.Builder.NewSetSubnetValidatorRequirementsTx(
+		subnetID,
+		requireConsortiumMember,
+		requireKYCVerified,
+		common.UnionOptions(b.options, options)...,
+	)
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	stdcontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+const testBaseTxFee = 10
+
+var (
+	_ BuilderBackend = (*testBackend)(nil)
+	_ SignerBackend  = (*testBackend)(nil)
+
+	testAVAXAssetID = ids.ID{'a', 'v', 'a', 'x'}
+	testKeyFactory  = secp256k1.Factory{}
+)
+
+// testBackend is in-memory wallet backend with static utxos and txs
+type testBackend struct {
+	Context
+	utxos    []*avax.UTXO
+	txs      map[ids.ID]*txs.Tx
+	deposits map[ids.ID]*secp256k1fx.OutputOwners // depositTxID -> reward owner
+	baseFee  uint64
+}
+
+func newTestBackend(utxos []*avax.UTXO, txs map[ids.ID]*txs.Tx) *testBackend {
+	return &testBackend{
+		Context: NewContext(
+			constants.UnitTestID,
+			testAVAXAssetID,
+			testBaseTxFee,
+			testBaseTxFee,
+			testBaseTxFee,
+			testBaseTxFee,
+			testBaseTxFee,
+			testBaseTxFee,
+			testBaseTxFee,
+			testBaseTxFee,
+		),
+		utxos:    utxos,
+		txs:      txs,
+		deposits: map[ids.ID]*secp256k1fx.OutputOwners{},
+		baseFee:  testBaseTxFee,
+	}
+}
+
+func (b *testBackend) UTXOs(_ stdcontext.Context, sourceChainID ids.ID) ([]*avax.UTXO, error) {
+	if sourceChainID != constants.PlatformChainID {
+		return nil, nil
+	}
+	return b.utxos, nil
+}
+
+func (b *testBackend) GetUTXO(_ stdcontext.Context, chainID, utxoID ids.ID) (*avax.UTXO, error) {
+	if chainID == constants.PlatformChainID {
+		for _, utxo := range b.utxos {
+			if utxo.InputID() == utxoID {
+				return utxo, nil
+			}
+		}
+	}
+	return nil, database.ErrNotFound
+}
+
+func (b *testBackend) GetTx(_ stdcontext.Context, txID ids.ID) (*txs.Tx, error) {
+	tx, ok := b.txs[txID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return tx, nil
+}
+
+func (b *testBackend) BaseFee(stdcontext.Context) (uint64, error) {
+	return b.baseFee, nil
+}
+
+func (b *testBackend) GetDepositRewardOwner(_ stdcontext.Context, depositTxID ids.ID) (*secp256k1fx.OutputOwners, error) {
+	owner, ok := b.deposits[depositTxID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return owner, nil
+}
+
+func generateKeyAndOwner(t *testing.T) (*secp256k1.PrivateKey, ids.ShortID, *secp256k1fx.OutputOwners) {
+	key, err := testKeyFactory.NewPrivateKey()
+	require.NoError(t, err)
+	addr := key.Address()
+	return key, addr, &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{addr},
+	}
+}
+
+func generateTestUTXO(txID ids.ID, amount uint64, owner *secp256k1fx.OutputOwners) *avax.UTXO {
+	return &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: txID},
+		Asset:  avax.Asset{ID: testAVAXAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          amount,
+			OutputOwners: *owner,
+		},
+	}
+}
+
+func generateTestIn(utxo *avax.UTXO, sigIndices []uint32) *avax.TransferableInput {
+	return &avax.TransferableInput{
+		UTXOID: utxo.UTXOID,
+		Asset:  utxo.Asset,
+		In: &secp256k1fx.TransferInput{
+			Amt:   utxo.Out.(*secp256k1fx.TransferOutput).Amt,
+			Input: secp256k1fx.Input{SigIndices: sigIndices},
+		},
+	}
+}
+
+// requireCredsSigners checks that [tx] credentials are signed by [expectedSigners].
+// Empty address in [expectedSigners] means that signature must be empty.
+func requireCredsSigners(t *testing.T, tx *txs.Tx, expectedSigners [][]ids.ShortID) {
+	require := require.New(t)
+	require.Len(tx.Creds, len(expectedSigners))
+	unsignedBytes := tx.Unsigned.Bytes()
+	unsignedHash := hashing.ComputeHash256(unsignedBytes)
+	for credIndex, credSigners := range expectedSigners {
+		cred, ok := tx.Creds[credIndex].(*secp256k1fx.Credential)
+		require.True(ok)
+		require.Len(cred.Sigs, len(credSigners))
+		for sigIndex, signer := range credSigners {
+			if signer == ids.ShortEmpty {
+				require.Equal(emptySig, cred.Sigs[sigIndex])
+				continue
+			}
+			pk, err := testKeyFactory.RecoverHashPublicKey(unsignedHash, cred.Sigs[sigIndex][:])
+			require.NoError(err)
+			require.Equal(signer, pk.Address())
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"errors"
+	"fmt"
+
+	stdcontext "context"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	_ keychain.Keychain = (*addressesKeychain)(nil)
+
+	errNoPartiallySignedTxs   = errors.New("no partially signed txs to merge")
+	errUnknownCredentialOwner = errors.New("credential owner is unknown to the signer")
+	errWrongCredentialsCount  = errors.New("credentials owners count doesn't match credentials count")
+)
+
+type CaminoSigner interface {
+	// NewPartiallySignedTx returns partially signed tx without signatures
+	// for [utx], e.g. produced by builder. Credentials owners are resolved
+	// the same way as when signing [utx], multisig aliases referenced by
+	// them are resolved with [msig]. Txs with credentials owners unknown
+	// to the signer (e.g. treasury admin) must be created with
+	// txs.NewPartiallySignedTx.
+	NewPartiallySignedTx(
+		ctx stdcontext.Context,
+		utx txs.UnsignedTx,
+		msig secp256k1fx.AliasGetter,
+	) (*txs.PartiallySignedTx, error)
+
+	// SignPartially adds signatures of all keys of this signer,
+	// which are required by [ptx] credentials.
+	SignPartially(ptx *txs.PartiallySignedTx) error
+
+	// MergePartiallySigned returns new partially signed tx with signatures
+	// collected from all [ptxs]. All [ptxs] must have the same unsigned tx.
+	MergePartiallySigned(ptxs ...*txs.PartiallySignedTx) (*txs.PartiallySignedTx, error)
+}
+
+func (s *txSigner) NewPartiallySignedTx(
+	ctx stdcontext.Context,
+	utx txs.UnsignedTx,
+	msig secp256k1fx.AliasGetter,
+) (*txs.PartiallySignedTx, error) {
+	// keychain without signers, so visitor only collects credentials owners
+	tx := &txs.Tx{Unsigned: utx}
+	visitor := &signerVisitor{
+		kc:      addressesKeychain{addrs: s.kc.Addresses()},
+		backend: s.backend,
+		ctx:     ctx,
+		tx:      tx,
+	}
+	if err := utx.Visit(visitor); err != nil {
+		return nil, err
+	}
+	if len(visitor.credsOwners) != len(tx.Creds) {
+		return nil, errWrongCredentialsCount
+	}
+	for credIndex, owner := range visitor.credsOwners {
+		if owner == nil {
+			return nil, fmt.Errorf("%w: credential %d", errUnknownCredentialOwner, credIndex)
+		}
+	}
+	return txs.NewPartiallySignedTx(utx, visitor.credsOwners, msig)
+}
+
+func (s *txSigner) SignPartially(ptx *txs.PartiallySignedTx) error {
+	return ptx.Sign(s.kc)
+}
+
+func (*txSigner) MergePartiallySigned(ptxs ...*txs.PartiallySignedTx) (*txs.PartiallySignedTx, error) {
+	if len(ptxs) == 0 {
+		return nil, errNoPartiallySignedTxs
+	}
+
+	// copying first tx, so none of given txs will be modified
+	ptxBytes, err := ptxs[0].Bytes()
+	if err != nil {
+		return nil, err
+	}
+	mergedPtx, err := txs.ParsePartiallySignedTx(ptxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ptx := range ptxs[1:] {
+		if err := mergedPtx.Merge(ptx); err != nil {
+			return nil, err
+		}
+	}
+	return mergedPtx, nil
+}
+
+// addressesKeychain has addresses, but no signers
+type addressesKeychain struct {
+	addrs set.Set[ids.ShortID]
+}
+
+func (addressesKeychain) Get(ids.ShortID) (keychain.Signer, bool) {
+	return nil, false
+}
+
+func (kc addressesKeychain) Addresses() set.Set[ids.ShortID] {
+	return kc.addrs
+}
@@ -0,0 +1,194 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	stdcontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var _ secp256k1fx.AliasGetter = testAliasGetter(nil)
+
+type testAliasGetter map[ids.ShortID]*multisig.AliasWithNonce
+
+func (g testAliasGetter) GetMultisigAlias(aliasID ids.ShortID) (*multisig.AliasWithNonce, error) {
+	alias, ok := g[aliasID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return alias, nil
+}
+
+func TestSignDAOTxs(t *testing.T) {
+	feeKey, feeAddr, feeOwner := generateKeyAndOwner(t)
+	memberKey, memberAddr, _ := generateKeyAndOwner(t)
+	_, otherMemberAddr, _ := generateKeyAndOwner(t)
+
+	feeUTXO := generateTestUTXO(ids.ID{1}, testBaseTxFee, feeOwner)
+	baseTx := txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    constants.UnitTestID,
+		BlockchainID: constants.PlatformChainID,
+		Ins:          []*avax.TransferableInput{generateTestIn(feeUTXO, []uint32{0})},
+	}}
+	proposal := &dao.BaseFeeProposal{Start: 100, End: 200, Options: []uint64{1}}
+
+	tests := map[string]struct {
+		utx             txs.UnsignedTx
+		expectedSigners [][]ids.ShortID
+	}{
+		"AddProposalTx": {
+			utx: &txs.AddProposalTx{
+				BaseTx:          baseTx,
+				Proposal:        proposal,
+				ProposerAddress: memberAddr,
+				ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			expectedSigners: [][]ids.ShortID{{feeAddr}, {memberAddr}},
+		},
+		"AddProposalTx: proposer key isn't in keychain": {
+			utx: &txs.AddProposalTx{
+				BaseTx:          baseTx,
+				Proposal:        proposal,
+				ProposerAddress: otherMemberAddr,
+				ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			expectedSigners: [][]ids.ShortID{{feeAddr}, {ids.ShortEmpty}},
+		},
+		"AddVoteTx": {
+			utx: &txs.AddVoteTx{
+				BaseTx:       baseTx,
+				ProposalID:   ids.ID{2},
+				Vote:         &dao.SimpleVote{OptionIndex: 0},
+				VoterAddress: memberAddr,
+				VoterAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			expectedSigners: [][]ids.ShortID{{feeAddr}, {memberAddr}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			signer := NewSigner(
+				secp256k1fx.NewKeychain(feeKey, memberKey),
+				newTestBackend([]*avax.UTXO{feeUTXO}, nil),
+			)
+
+			tx, err := signer.SignUnsigned(stdcontext.Background(), tt.utx)
+			require.NoError(err)
+			requireCredsSigners(t, tx, tt.expectedSigners)
+
+			parsedTx, err := txs.Parse(txs.Codec, tx.Bytes())
+			require.NoError(err)
+			require.Equal(tx.ID(), parsedTx.ID())
+			requireCredsSigners(t, parsedTx, tt.expectedSigners)
+		})
+	}
+}
+
+func TestNewPartiallySignedTx(t *testing.T) {
+	require := require.New(t)
+	ctx := stdcontext.Background()
+	key1, addr1, _ := generateKeyAndOwner(t)
+	key2, addr2, _ := generateKeyAndOwner(t)
+	_, _, newAliasOwner := generateKeyAndOwner(t)
+
+	ownerAddrs := []ids.ShortID{addr1, addr2}
+	utils.Sort(ownerAddrs)
+	owner := &secp256k1fx.OutputOwners{Threshold: 2, Addrs: ownerAddrs}
+	aliasID := ids.ShortID{1}
+	alias := &multisig.AliasWithNonce{Alias: multisig.Alias{ID: aliasID, Owners: owner}}
+	aliasOwner := &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{aliasID}}
+	msig := testAliasGetter{aliasID: alias}
+
+	feeUTXO := generateTestUTXO(ids.ID{1}, testBaseTxFee, owner)
+	backend := newTestBackend([]*avax.UTXO{feeUTXO}, nil)
+	builderAddrs := set.NewSet[ids.ShortID](2)
+	builderAddrs.Add(addr1, addr2)
+	utx, err := NewBuilder(builderAddrs, backend).NewMultisigAliasTx(
+		&multisig.Alias{ID: aliasID, Owners: newAliasOwner},
+		owner,
+	)
+	require.NoError(err)
+
+	// each signer creates and signs its own partially signed tx
+	signer1 := NewSigner(secp256k1fx.NewKeychain(key1), backend)
+	ptx1, err := signer1.NewPartiallySignedTx(ctx, utx, msig)
+	require.NoError(err)
+	require.Len(ptx1.Creds, 2)
+	require.Equal(*owner, ptx1.Creds[0].Owners)
+	require.Equal(*aliasOwner, ptx1.Creds[1].Owners)
+	require.Equal([]*multisig.AliasWithNonce{alias}, ptx1.Aliases)
+	require.NoError(signer1.SignPartially(ptx1))
+
+	missingSigners, err := ptx1.MissingSigners()
+	require.NoError(err)
+	require.Equal(map[int][]ids.ShortID{0: {addr2}, 1: {addr2}}, missingSigners)
+	_, err = ptx1.SignedTx()
+	require.ErrorIs(err, txs.ErrMissingSigners)
+
+	signer2 := NewSigner(secp256k1fx.NewKeychain(key2), backend)
+	ptx2, err := signer2.NewPartiallySignedTx(ctx, utx, msig)
+	require.NoError(err)
+	require.NoError(signer2.SignPartially(ptx2))
+
+	mergedPtx, err := signer1.MergePartiallySigned(ptx1, ptx2)
+	require.NoError(err)
+	missingSigners, err = mergedPtx.MissingSigners()
+	require.NoError(err)
+	require.Empty(missingSigners)
+	tx, err := mergedPtx.SignedTx()
+	require.NoError(err)
+	require.Len(tx.Creds, 2)
+}
+
+func TestNewPartiallySignedTxUnknownOwner(t *testing.T) {
+	key, _, owner := generateKeyAndOwner(t)
+	feeUTXO := generateTestUTXO(ids.ID{1}, testBaseTxFee, owner)
+	unknownUTXO := generateTestUTXO(ids.ID{2}, testBaseTxFee, owner)
+
+	tests := map[string]struct {
+		utx txs.UnsignedTx
+	}{
+		"Input utxo isn't known": {
+			utx: &txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    constants.UnitTestID,
+				BlockchainID: constants.PlatformChainID,
+				Ins:          []*avax.TransferableInput{generateTestIn(unknownUTXO, []uint32{0})},
+			}},
+		},
+		"Treasury admin isn't known": {
+			utx: &txs.TreasurySpendTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    constants.UnitTestID,
+					BlockchainID: constants.PlatformChainID,
+					Ins:          []*avax.TransferableInput{generateTestIn(feeUTXO, []uint32{0})},
+				}},
+				TreasuryAdminAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			signer := NewSigner(
+				secp256k1fx.NewKeychain(key),
+				newTestBackend([]*avax.UTXO{feeUTXO}, nil),
+			)
+			_, err := signer.NewPartiallySignedTx(stdcontext.Background(), tt.utx, testAliasGetter{})
+			require.ErrorIs(t, err, errUnknownCredentialOwner)
+		})
+	}
+}
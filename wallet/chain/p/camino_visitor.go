@@ -4,8 +4,12 @@
 package p
 
 import (
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
 // backend
@@ -15,7 +19,11 @@ func (b *backendVisitor) AddressStateTx(tx *txs.AddressStateTx) error {
 }
 
 func (b *backendVisitor) DepositTx(tx *txs.DepositTx) error {
-	return b.baseTx(&tx.BaseTx)
+	return b.b.removeUTXOs(
+		b.ctx,
+		constants.PlatformChainID,
+		tx.InputIDs(),
+	)
 }
 
 func (b *backendVisitor) UnlockDepositTx(tx *txs.UnlockDepositTx) error {
@@ -23,7 +31,11 @@ func (b *backendVisitor) UnlockDepositTx(tx *txs.UnlockDepositTx) error {
 }
 
 func (b *backendVisitor) ClaimTx(tx *txs.ClaimTx) error {
-	return b.baseTx(&tx.BaseTx)
+	return b.b.removeUTXOs(
+		b.ctx,
+		constants.PlatformChainID,
+		tx.InputIDs(),
+	)
 }
 
 func (b *backendVisitor) RegisterNodeTx(tx *txs.RegisterNodeTx) error {
@@ -46,6 +58,46 @@ func (b *backendVisitor) AddDepositOfferTx(tx *txs.AddDepositOfferTx) error {
 	return b.baseTx(&tx.BaseTx)
 }
 
+func (b *backendVisitor) AddProposalTx(tx *txs.AddProposalTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) AddVoteTx(tx *txs.AddVoteTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) FinishProposalsTx(tx *txs.FinishProposalsTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) UpdateDepositOfferTx(tx *txs.UpdateDepositOfferTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) TransferDepositTx(tx *txs.TransferDepositTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) SetRewardRestakeTx(tx *txs.SetRewardRestakeTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) SetSubnetValidatorRequirementsTx(tx *txs.SetSubnetValidatorRequirementsTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) TreasurySpendTx(tx *txs.TreasurySpendTx) error {
+	return b.b.removeUTXOs(
+		b.ctx,
+		constants.PlatformChainID,
+		tx.InputIDs(),
+	)
+}
+
+func (b *backendVisitor) RotateValidatorNodeTx(tx *txs.RotateValidatorNodeTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
 // signer
 
 func (s *signerVisitor) AddressStateTx(tx *txs.AddressStateTx) error {
@@ -53,6 +105,16 @@ func (s *signerVisitor) AddressStateTx(tx *txs.AddressStateTx) error {
 	if err != nil {
 		return err
 	}
+	if tx.UpgradeVersionID.Version() > 0 {
+		executorAuthSigners, err := s.getAuthSigners(
+			&secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{tx.Executor}},
+			tx.ExecutorAuth,
+		)
+		if err != nil {
+			return err
+		}
+		txSigners = append(txSigners, executorAuthSigners)
+	}
 	return sign(s.tx, false, txSigners)
 }
 
@@ -61,6 +123,11 @@ func (s *signerVisitor) DepositTx(tx *txs.DepositTx) error {
 	if err != nil {
 		return err
 	}
+	feeSponsorSigners, err := s.getSigners(constants.PlatformChainID, tx.FeeSponsorIns)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, feeSponsorSigners...)
 	return sign(s.tx, false, txSigners)
 }
 
@@ -77,6 +144,22 @@ func (s *signerVisitor) ClaimTx(tx *txs.ClaimTx) error {
 	if err != nil {
 		return err
 	}
+	for _, claimable := range tx.Claimables {
+		owner, err := getClaimableOwner(s.ctx, s.backend, claimable, s.kc.Addresses())
+		if err != nil {
+			return err
+		}
+		ownerAuthSigners, err := s.getAuthSigners(owner, claimable.OwnerAuth)
+		if err != nil {
+			return err
+		}
+		txSigners = append(txSigners, ownerAuthSigners)
+	}
+	feeSponsorSigners, err := s.getSigners(constants.PlatformChainID, tx.FeeSponsorIns)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, feeSponsorSigners...)
 	return sign(s.tx, false, txSigners)
 }
 
@@ -85,6 +168,17 @@ func (s *signerVisitor) RegisterNodeTx(tx *txs.RegisterNodeTx) error {
 	if err != nil {
 		return err
 	}
+	if tx.NewNodeID != ids.EmptyNodeID {
+		txSigners = append(txSigners, s.getNodeSigners(tx.NewNodeID))
+	}
+	nodeOwnerAuthSigners, err := s.getAuthSigners(
+		&secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{tx.NodeOwnerAddress}},
+		tx.NodeOwnerAuth,
+	)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, nodeOwnerAuthSigners)
 	return sign(s.tx, false, txSigners)
 }
 
@@ -105,6 +199,19 @@ func (s *signerVisitor) MultisigAliasTx(tx *txs.MultisigAliasTx) error {
 	if err != nil {
 		return err
 	}
+	if tx.MultisigAlias.ID != ids.ShortEmpty {
+		// Current alias owners are unknown to the signer, so alias auth
+		// credential is left for signing outside of this wallet.
+		auth, ok := tx.Auth.(*secp256k1fx.Input)
+		if !ok {
+			return errUnknownAuthType
+		}
+		txSigners = append(txSigners, make([]keychain.Signer, len(auth.SigIndices)))
+		s.credsOwners = append(s.credsOwners, &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{tx.MultisigAlias.ID},
+		})
+	}
 	return sign(s.tx, false, txSigners)
 }
 
@@ -113,5 +220,171 @@ func (s *signerVisitor) AddDepositOfferTx(tx *txs.AddDepositOfferTx) error {
 	if err != nil {
 		return err
 	}
+	offerCreatorAuthSigners, err := s.getAuthSigners(
+		&secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{tx.DepositOfferCreatorAddress}},
+		tx.DepositOfferCreatorAuth,
+	)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, offerCreatorAuthSigners)
+	return sign(s.tx, false, txSigners)
+}
+
+func (s *signerVisitor) AddProposalTx(tx *txs.AddProposalTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	proposerAuthSigners, err := s.getAuthSigners(
+		&secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{tx.ProposerAddress}},
+		tx.ProposerAuth,
+	)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, proposerAuthSigners)
+	return sign(s.tx, false, txSigners)
+}
+
+func (s *signerVisitor) AddVoteTx(tx *txs.AddVoteTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	voterAuthSigners, err := s.getAuthSigners(
+		&secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{tx.VoterAddress}},
+		tx.VoterAuth,
+	)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, voterAuthSigners)
+	return sign(s.tx, false, txSigners)
+}
+
+func (*signerVisitor) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errUnsupportedTxType
+}
+
+func (s *signerVisitor) UpdateDepositOfferTx(tx *txs.UpdateDepositOfferTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	offerUpdaterAuthSigners, err := s.getAuthSigners(
+		&secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{tx.DepositOfferUpdaterAddress}},
+		tx.DepositOfferUpdaterAuth,
+	)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, offerUpdaterAuthSigners)
+	return sign(s.tx, false, txSigners)
+}
+
+// Wallet backend doesn't know current deposit reward owner and deposit offer owner,
+// so it can't sign deposit transfer auths
+func (*signerVisitor) TransferDepositTx(*txs.TransferDepositTx) error {
+	return errUnsupportedTxType
+}
+
+func (s *signerVisitor) SetRewardRestakeTx(tx *txs.SetRewardRestakeTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	claimableOwner, ok := tx.ClaimableOwner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return errUnknownOwnerType
+	}
+	claimableOwnerAuthSigners, err := s.getAuthSigners(claimableOwner, tx.ClaimableOwnerAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, claimableOwnerAuthSigners)
+	return sign(s.tx, false, txSigners)
+}
+
+func (s *signerVisitor) SetSubnetValidatorRequirementsTx(tx *txs.SetSubnetValidatorRequirementsTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	subnetAuthSigners, err := s.getSubnetSigners(tx.Subnet, tx.SubnetAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, subnetAuthSigners)
+	return sign(s.tx, false, txSigners)
+}
+
+func (s *signerVisitor) TreasurySpendTx(tx *txs.TreasurySpendTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	// Treasury admin is multisig alias, which owners are unknown to the signer,
+	// so admin auth credential is left for signing outside of this wallet.
+	// Treasury inputs don't have credentials, they are authorized by admin auth.
+	auth, ok := tx.TreasuryAdminAuth.(*secp256k1fx.Input)
+	if !ok {
+		return errUnknownAuthType
+	}
+	txSigners = append(txSigners, make([]keychain.Signer, len(auth.SigIndices)))
+	s.credsOwners = append(s.credsOwners, nil)
+	return sign(s.tx, false, txSigners)
+}
+
+func (s *signerVisitor) RotateValidatorNodeTx(tx *txs.RotateValidatorNodeTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, s.getNodeSigners(tx.NewNodeID))
+	nodeOwnerAuthSigners, err := s.getAuthSigners(
+		&secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{tx.NodeOwnerAddress}},
+		tx.NodeOwnerAuth,
+	)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, nodeOwnerAuthSigners)
 	return sign(s.tx, false, txSigners)
 }
+
+func (s *signerVisitor) getNodeSigners(nodeID ids.NodeID) []keychain.Signer {
+	s.credsOwners = append(s.credsOwners, &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.ShortID(nodeID)},
+	})
+	nodeSigners := make([]keychain.Signer, 1)
+	if key, ok := s.kc.Get(ids.ShortID(nodeID)); ok {
+		nodeSigners[0] = key
+	}
+	return nodeSigners
+}
+
+func (s *signerVisitor) getAuthSigners(owner *secp256k1fx.OutputOwners, auth verify.Verifiable) ([]keychain.Signer, error) {
+	authInput, ok := auth.(*secp256k1fx.Input)
+	if !ok {
+		return nil, errUnknownAuthType
+	}
+	s.credsOwners = append(s.credsOwners, owner)
+
+	authSigners := make([]keychain.Signer, len(authInput.SigIndices))
+	for sigIndex, addrIndex := range authInput.SigIndices {
+		if addrIndex >= uint32(len(owner.Addrs)) {
+			return nil, errInvalidUTXOSigIndex
+		}
+
+		key, ok := s.kc.Get(owner.Addrs[addrIndex])
+		if !ok {
+			// If we don't have access to the key, then we can't sign this
+			// transaction. However, we can attempt to partially sign it.
+			continue
+		}
+		authSigners[sigIndex] = key
+	}
+	return authSigners, nil
+}
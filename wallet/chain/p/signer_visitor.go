@@ -1,3 +1,13 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -17,6 +27,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
@@ -41,6 +52,10 @@ type signerVisitor struct {
 	backend SignerBackend
 	ctx     stdcontext.Context
 	tx      *txs.Tx
+
+	// Owners of tx credentials in the same order as credentials.
+	// Owner is nil, if it's unknown to the signer.
+	credsOwners []*secp256k1fx.OutputOwners
 }
 
 func (*signerVisitor) AdvanceTimeTx(*txs.AdvanceTimeTx) error {
@@ -168,8 +183,11 @@ func (s *signerVisitor) getSigners(sourceChainID ids.ID, ins []*avax.Transferabl
 	txSigners := make([][]keychain.Signer, len(ins))
 	for credIndex, transferInput := range ins {
 		inIntf := transferInput.In
-		if stakeableIn, ok := inIntf.(*stakeable.LockIn); ok {
-			inIntf = stakeableIn.TransferableIn
+		switch in := inIntf.(type) {
+		case *stakeable.LockIn:
+			inIntf = in.TransferableIn
+		case *locked.In:
+			inIntf = in.TransferableIn
 		}
 
 		input, ok := inIntf.(*secp256k1fx.TransferInput)
@@ -185,6 +203,7 @@ func (s *signerVisitor) getSigners(sourceChainID ids.ID, ins []*avax.Transferabl
 		if err == database.ErrNotFound {
 			// If we don't have access to the UTXO, then we can't sign this
 			// transaction. However, we can attempt to partially sign it.
+			s.credsOwners = append(s.credsOwners, nil)
 			continue
 		}
 		if err != nil {
@@ -192,14 +211,20 @@ func (s *signerVisitor) getSigners(sourceChainID ids.ID, ins []*avax.Transferabl
 		}
 
 		outIntf := utxo.Out
-		if stakeableOut, ok := outIntf.(*stakeable.LockOut); ok {
-			outIntf = stakeableOut.TransferableOut
+		switch out := outIntf.(type) {
+		case *stakeable.LockOut:
+			outIntf = out.TransferableOut
+		case *locked.Out:
+			outIntf = out.TransferableOut
+		case *locked.VestingOut:
+			outIntf = out.TransferableOut
 		}
 
 		out, ok := outIntf.(*secp256k1fx.TransferOutput)
 		if !ok {
 			return nil, errUnknownOutputType
 		}
+		s.credsOwners = append(s.credsOwners, &out.OutputOwners)
 
 		for sigIndex, addrIndex := range input.SigIndices {
 			if addrIndex >= uint32(len(out.Addrs)) {
@@ -242,6 +267,7 @@ func (s *signerVisitor) getSubnetSigners(subnetID ids.ID, subnetAuth verify.Veri
 	if !ok {
 		return nil, errUnknownOwnerType
 	}
+	s.credsOwners = append(s.credsOwners, owner)
 
 	authSigners := make([]keychain.Signer, len(subnetInput.SigIndices))
 	for sigIndex, addrIndex := range subnetInput.SigIndices {
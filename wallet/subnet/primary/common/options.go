@@ -29,6 +29,8 @@ type Options struct {
 
 	changeOwner *secp256k1fx.OutputOwners
 
+	feeSponsorAddresses set.Set[ids.ShortID]
+
 	memo []byte
 
 	assumeDecided bool
@@ -89,6 +91,12 @@ func (o *Options) ChangeOwner(defaultOwner *secp256k1fx.OutputOwners) *secp256k1
 	return defaultOwner
 }
 
+// FeeSponsorAddresses returns addresses, which utxos must be used to pay tx fee.
+// Returns nil, if tx fee is paid by tx spender.
+func (o *Options) FeeSponsorAddresses() set.Set[ids.ShortID] {
+	return o.feeSponsorAddresses
+}
+
 func (o *Options) Memo() []byte {
 	return o.memo
 }
@@ -136,6 +144,16 @@ func WithChangeOwner(changeOwner *secp256k1fx.OutputOwners) Option {
 	}
 }
 
+// WithFeeSponsor makes tx fee to be paid from utxos of [sponsorAddrs]
+// instead of utxos of tx spender. Sponsor utxos must be known to wallet backend,
+// sponsor change is returned to one of [sponsorAddrs]. Sponsor inputs have their
+// own credentials, so tx must be signed by sponsor as well.
+func WithFeeSponsor(sponsorAddrs set.Set[ids.ShortID]) Option {
+	return func(o *Options) {
+		o.feeSponsorAddresses = sponsorAddrs
+	}
+}
+
 func WithMemo(memo []byte) Option {
 	return func(o *Options) {
 		o.memo = memo
@@ -88,10 +88,10 @@ func NewWalletWithTxsAndState(
 ) Wallet {
 	addrs := kc.Addresses()
 	pUTXOs := NewChainUTXOs(constants.PlatformChainID, utxos)
-	pBackend := p.NewBackend(pCTX, pUTXOs, pTXs)
+	pClient := platformvm.NewClient(uri)
+	pBackend := p.NewCaminoBackend(pCTX, pUTXOs, pTXs, pClient)
 	pBuilder := p.NewBuilder(addrs, pBackend)
 	pSigner := p.NewSigner(kc, pBackend)
-	pClient := platformvm.NewClient(uri)
 
 	xChainID := xCTX.BlockchainID()
 	xUTXOs := NewChainUTXOs(xChainID, utxos)